@@ -38,3 +38,5 @@ for contract_name in $contracts; do
 	contract_name=$(basename $contract_name .sol)
 	create_binding $contract_name
 done
+
+go run ./pkg/bindings/mocks/gen
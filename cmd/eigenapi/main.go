@@ -0,0 +1,154 @@
+// Command eigenapi serves a self-hosted EigenLayer REST API, as implemented by pkg/restapi.
+//
+// It indexes the events of the DelegationManager, StrategyManager and RewardsCoordinator into
+// memory from a start block, replays them into a ledger of queued withdrawals, lists the
+// whitelisted strategies, and serves the API with webhooks for the indexed events:
+//
+//	go run ./cmd/eigenapi -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -from-block 1167000
+//
+// Indexed events and webhook subscriptions are kept in memory and replayed or lost on restart.
+// GET /healthz reports the health of the node, the core contracts and the indexer, as by
+// pkg/health, for the probes of orchestrators such as Kubernetes.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/health"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ledger"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/restapi"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "", "JSON-RPC URL of an Ethereum node")
+	chainID := flag.Uint64("chain-id", addresses.ChainIDMainnet, "chain ID of the node")
+	listen := flag.String("listen", ":8080", "address to serve the API on")
+	fromBlock := flag.Uint64("from-block", 0, "first block indexed, typically the deployment block of the core contracts")
+	confirmations := flag.Uint64("confirmations", 2, "number of blocks events are indexed behind the head")
+	maxIndexerLag := flag.Uint64("max-indexer-lag", health.DefaultMaxIndexerLag, "number of blocks the indexer may lag behind the confirmed head before /healthz fails")
+	syncInterval := flag.Duration("sync-interval", 12*time.Second, "interval at which the ledger and strategies are synced")
+	flag.Parse()
+	if *rpcURL == "" {
+		log.Fatal("-rpc is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	c, err := client.NewEigenLayerClient(ctx, *rpcURL, *chainID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer c.Close()
+	delegationManager, _ := c.Address(addresses.DelegationManager)
+	strategyManager, _ := c.Address(addresses.StrategyManager)
+	rewardsCoordinator, hasRewards := c.Address(addresses.RewardsCoordinator)
+	strategyFactory, _ := c.Address(addresses.StrategyFactory)
+
+	registry, err := strategies.NewRegistry(c.Backend, strategies.Config{
+		StrategyManager: strategyManager,
+		StrategyFactory: strategyFactory,
+		FromBlock:       *fromBlock,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	l, err := ledger.New(c.Backend, ledger.Config{
+		StrategyManager:   strategyManager,
+		DelegationManager: delegationManager,
+		FromBlock:         *fromBlock,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	store := indexer.NewMemoryStore()
+	sources := []indexer.Source{
+		{Contract: addresses.DelegationManager, Address: delegationManager},
+		{Contract: addresses.StrategyManager, Address: strategyManager},
+	}
+	if hasRewards {
+		sources = append(sources, indexer.Source{Contract: addresses.RewardsCoordinator, Address: rewardsCoordinator})
+	}
+	ix, err := indexer.New(c.Backend, store, indexer.Config{
+		Sources:       sources,
+		StartBlock:    *fromBlock,
+		Confirmations: *confirmations,
+		Logger:        logger,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	server := restapi.NewServer(c, restapi.Config{
+		Strategies:  registry,
+		Withdrawals: l,
+		Events:      store,
+		Logger:      logger,
+	})
+	checker := health.New(c.Backend, health.Config{
+		ChainID:       *chainID,
+		Indexer:       store,
+		MaxIndexerLag: *confirmations + *maxIndexerLag,
+	})
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", checker.Handler())
+	mux.Handle("/", server.Handler())
+	httpServer := &http.Server{Addr: *listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		if err := ix.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("indexer stopped", "err", err)
+			stop()
+		}
+	}()
+	go func() {
+		if err := server.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("webhooks stopped", "err", err)
+		}
+	}()
+	go sync(ctx, logger, *syncInterval, l, registry)
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdown)
+	}()
+
+	logger.Info("serving EigenLayer API", "addr", *listen, "chain", *chainID)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
+
+// sync keeps the ledger and the strategy registry up to date until ctx is done.
+func sync(ctx context.Context, logger *slog.Logger, interval time.Duration, l *ledger.Ledger, registry *strategies.Registry) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := l.Sync(ctx); err != nil {
+			logger.Warn("failed to sync ledger", "err", err)
+		}
+		if err := registry.Refresh(ctx); err != nil {
+			logger.Warn("failed to refresh strategies", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logpager"
+)
+
+// runBackfill indexes the historical events of core contracts into a file store, resuming from
+// its checkpoint.
+func runBackfill(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+	rpcURL := fs.String("rpc", "", "JSON-RPC URL of an Ethereum node")
+	chainID := fs.Uint64("chain-id", addresses.ChainIDMainnet, "chain ID of the node")
+	contracts := fs.String("contract", "DelegationManager,StrategyManager,RewardsCoordinator", "comma-separated core contracts whose events are indexed")
+	from := fs.Uint64("from", 0, "first block indexed when -store has no checkpoint, typically the deployment block of the contracts")
+	to := fs.Int64("to", -1, "last block indexed; defaults to the head less -confirmations")
+	confirmations := fs.Uint64("confirmations", 2, "number of blocks the default -to stays behind the head")
+	storePath := fs.String("store", "events.jsonl", "file the events and checkpoint are stored in; a backfill resumes from its checkpoint")
+	batchSize := fs.Uint64("batch-size", indexer.DefaultBatchSize, "number of blocks saved per checkpoint; ranges the node rejects as too large are split further")
+	exportDir := fs.String("export-dir", "", "directory the indexed events are also exported to, in a file per event type")
+	exportFormat := fs.String("export-format", "avro", "format of -export-dir: avro or protobuf")
+	_ = fs.Parse(args)
+	if *rpcURL == "" {
+		return errors.New("-rpc is required")
+	}
+	format, err := indexer.ParseFormat(*exportFormat)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.NewEigenLayerClient(ctx, *rpcURL, *chainID)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	var sources []indexer.Source
+	for _, name := range strings.Split(*contracts, ",") {
+		name = strings.TrimSpace(name)
+		addr, ok := c.Address(name)
+		if !ok {
+			return fmt.Errorf("unknown contract %q in -contract on chain %d", name, *chainID)
+		}
+		sources = append(sources, indexer.Source{Contract: name, Address: addr})
+	}
+
+	fileStore, err := indexer.OpenFileStore(*storePath)
+	if err != nil {
+		return err
+	}
+	defer fileStore.Close()
+	var store indexer.Store = fileStore
+	if *exportDir != "" {
+		exporter, err := indexer.NewFileExporter(*exportDir, format)
+		if err != nil {
+			return err
+		}
+		store = indexer.Exporting(store, exporter)
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	ix, err := indexer.New(pagedLogs{pager: logpager.New(c.Backend, logpager.Config{}), backend: c.Backend}, store, indexer.Config{
+		Sources:    sources,
+		StartBlock: *from,
+		BatchSize:  *batchSize,
+		Logger:     logger,
+	})
+	if err != nil {
+		return err
+	}
+
+	var toBlock uint64
+	if *to >= 0 {
+		toBlock = uint64(*to)
+	} else {
+		head, err := c.Backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get block number: %w", err)
+		}
+		if head < *confirmations {
+			return nil
+		}
+		toBlock = head - *confirmations
+	}
+	next, err := ix.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if next > toBlock {
+		logger.Info("already indexed", "to", toBlock, "next", next)
+		return nil
+	}
+	logger.Info("backfilling", "from", next, "to", toBlock, "store", *storePath)
+	if err := ix.Backfill(ctx, toBlock); err != nil {
+		if errors.Is(err, context.Canceled) {
+			return fmt.Errorf("interrupted; rerun to resume: %w", err)
+		}
+		return err
+	}
+	logger.Info("backfill complete", "to", toBlock)
+	return nil
+}
+
+// pagedLogs is an indexer.Backend that fetches logs through a logpager.Pager, so batches the node
+// rejects as too large are split instead of failing the backfill.
+type pagedLogs struct {
+	pager   *logpager.Pager
+	backend client.Backend
+}
+
+func (p pagedLogs) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return p.pager.FilterLogs(ctx, query)
+}
+
+func (p pagedLogs) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return p.pager.SubscribeFilterLogs(ctx, query, ch)
+}
+
+func (p pagedLogs) BlockNumber(ctx context.Context) (uint64, error) {
+	return p.backend.BlockNumber(ctx)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/approver"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/pausing"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/signer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/withdrawals"
+)
+
+// approverPasswordEnv is the environment variable the approver keystore password is read from
+// when -approver-password-file is not set.
+const approverPasswordEnv = "EIGENCTL_APPROVER_PASSWORD"
+
+// approverTimeout bounds a request to an approver service.
+const approverTimeout = 30 * time.Second
+
+// runDelegate delegates the signer to an operator, with an approval of the operator's delegation
+// approver if it has one.
+func runDelegate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("delegate", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	operatorFlag := fs.String("operator", "", "operator to delegate to")
+	approverKeystore := fs.String("approver-keystore", "", "keystore of the operator's delegation approver, to sign the approval with")
+	approverPasswordFile := fs.String("approver-password-file", "", "file holding the approver keystore password, instead of $"+approverPasswordEnv)
+	approverURL := fs.String("approver-url", "", "URL of the operator's approver service, to request the approval from")
+	validity := fs.Duration("approval-validity", approver.DefaultValidity, "validity of an approval signed with -approver-keystore")
+	_ = fs.Parse(args)
+	if !common.IsHexAddress(*operatorFlag) {
+		return errors.New("-operator is required")
+	}
+	operator := common.HexToAddress(*operatorFlag)
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	dm := s.client.DelegationManager
+	delegationManager, _ := s.client.Address(addresses.DelegationManager)
+	callOpts := client.CallOpts(ctx)
+
+	isOperator, err := dm.IsOperator(callOpts, operator)
+	if err != nil {
+		return fmt.Errorf("failed to fetch operator status: %w", err)
+	}
+	if !isOperator {
+		return fmt.Errorf("%w: %s", elerrors.ErrOperatorNotRegistered, operator.Hex())
+	}
+	delegatedTo, err := dm.DelegatedTo(callOpts, s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to fetch delegation: %w", err)
+	}
+	if delegatedTo != (common.Address{}) {
+		return fmt.Errorf("%w: %s is delegated to %s", elerrors.ErrAlreadyDelegated, s.opts.From.Hex(), delegatedTo.Hex())
+	}
+	if err := s.checkNotPaused(ctx, pausing.DelegationManagerNewDelegation); err != nil {
+		return err
+	}
+
+	approval := new(approver.Approval)
+	approverAddr, err := dm.DelegationApprover(callOpts, operator)
+	if err != nil {
+		return fmt.Errorf("failed to fetch delegation approver: %w", err)
+	}
+	req := approver.Request{Staker: s.opts.From, Operator: operator}
+	switch {
+	case approverAddr == (common.Address{}):
+	case *approverKeystore != "":
+		password, err := readPassword(*approverPasswordFile, approverPasswordEnv)
+		if err != nil {
+			return err
+		}
+		key, err := signer.ReadKeystore(*approverKeystore, password)
+		if err != nil {
+			return err
+		}
+		svc, err := approver.New(ctx, s.client.Backend, delegationManager, key, approver.Policy{Validity: *validity, MaxValidity: *validity})
+		if err != nil {
+			return err
+		}
+		if approval, err = svc.Approve(ctx, req); err != nil {
+			return fmt.Errorf("failed to sign approval: %w", err)
+		}
+	case *approverURL != "":
+		if approval, err = requestApproval(ctx, *approverURL, req); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("delegations to %s must be approved by %s: set -approver-keystore or -approver-url", operator.Hex(), approverAddr.Hex())
+	}
+	if approverAddr != (common.Address{}) {
+		fmt.Printf("approved by %s with salt %s until %s\n", approval.Approver.Hex(), approval.Salt.Hex(), time.Unix(int64(approval.Expiry), 0).UTC())
+	}
+
+	fmt.Printf("delegating %s to operator %s\n", s.opts.From.Hex(), operator.Hex())
+	receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return dm.DelegateTo(opts, operator, approval.SignatureWithExpiry(), approval.Salt)
+	})
+	if err != nil || receipt == nil {
+		return err
+	}
+	for _, e := range receipts.Events(receipt, delegationManager, dm.ParseStakerDelegated) {
+		fmt.Printf("StakerDelegated: staker %s, operator %s\n", e.Staker.Hex(), e.Operator.Hex())
+	}
+	return nil
+}
+
+// requestApproval requests an approval of req from the approver service at url, as served by
+// approver.Service.Handler.
+func requestApproval(ctx context.Context, url string, req approver.Request) (*approver.Approval, error) {
+	ctx, cancel := context.WithTimeout(ctx, approverTimeout)
+	defer cancel()
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request approval: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return nil, fmt.Errorf("approver responded %s: %s", resp.Status, apiErr.Error)
+	}
+	approval := new(approver.Approval)
+	if err := json.NewDecoder(resp.Body).Decode(approval); err != nil {
+		return nil, fmt.Errorf("failed to decode approval: %w", err)
+	}
+	if approval.Staker != req.Staker || approval.Operator != req.Operator {
+		return nil, fmt.Errorf("approver approved %s delegating to %s instead", approval.Staker.Hex(), approval.Operator.Hex())
+	}
+	return approval, nil
+}
+
+// runUndelegate undelegates a staker, the signer by default, from its operator, queueing
+// withdrawals of all its shares.
+func runUndelegate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("undelegate", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	stakerFlag := fs.String("staker", "", "staker to undelegate, if not the signer; only its operator or the operator's delegation approver may")
+	_ = fs.Parse(args)
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	dm := s.client.DelegationManager
+	delegationManager, _ := s.client.Address(addresses.DelegationManager)
+	callOpts := client.CallOpts(ctx)
+	staker := s.opts.From
+	if *stakerFlag != "" {
+		if !common.IsHexAddress(*stakerFlag) {
+			return fmt.Errorf("invalid -staker %q", *stakerFlag)
+		}
+		staker = common.HexToAddress(*stakerFlag)
+	}
+
+	operator, err := dm.DelegatedTo(callOpts, staker)
+	if err != nil {
+		return fmt.Errorf("failed to fetch delegation: %w", err)
+	}
+	if operator == (common.Address{}) {
+		return fmt.Errorf("%w: %s", elerrors.ErrNotDelegated, staker.Hex())
+	}
+	if operator == staker {
+		return fmt.Errorf("operator %s cannot undelegate from itself", staker.Hex())
+	}
+	if err := s.checkNotPaused(ctx, pausing.DelegationManagerEnterWithdrawalQueue); err != nil {
+		return err
+	}
+
+	fmt.Printf("undelegating %s from operator %s\n", staker.Hex(), operator.Hex())
+	receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return dm.Undelegate(opts, staker)
+	})
+	if err != nil || receipt == nil {
+		return err
+	}
+	for _, e := range receipts.Events(receipt, delegationManager, dm.ParseStakerUndelegated) {
+		fmt.Printf("StakerUndelegated: staker %s, operator %s\n", e.Staker.Hex(), e.Operator.Hex())
+	}
+	m, err := withdrawals.NewManager(s.client.Backend, delegationManager, s.opts)
+	if err != nil {
+		return err
+	}
+	queued, err := m.FromReceipt(receipt)
+	if err != nil {
+		return err
+	}
+	for _, q := range queued {
+		fmt.Printf("WithdrawalQueued: root %s, withdrawer %s, nonce %s, start block %d, strategies %d\n",
+			q.Root.Hex(), q.Withdrawal.Withdrawer.Hex(), q.Withdrawal.Nonce, q.Withdrawal.StartBlock, len(q.Withdrawal.Strategies))
+	}
+	return nil
+}
+
+// checkNotPaused fails if the DelegationManager functionality of flag is paused.
+func (s *session) checkNotPaused(ctx context.Context, flag pausing.Flag) error {
+	paused, err := s.client.DelegationManager.Paused(client.CallOpts(ctx), flag.Index)
+	if err != nil {
+		return fmt.Errorf("failed to fetch DelegationManager paused status: %w", err)
+	}
+	if paused {
+		return fmt.Errorf("%w: %s", elerrors.ErrCurrentlyPaused{Index: int(flag.Index)}, flag)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deposit"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/shares"
+)
+
+// runDeposit approves the StrategyManager if needed and deposits an amount of a token into its
+// strategy.
+func runDeposit(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("deposit", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	var sf strategyFlags
+	sf.register(fs)
+	amountFlag := fs.String("amount", "", "amount of the underlying token to deposit, such as 1.5")
+	_ = fs.Parse(args)
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	if s.strategy, err = sf.resolve(ctx, s.client); err != nil {
+		return err
+	}
+	amount, err := s.amount(*amountFlag)
+	if err != nil {
+		return err
+	}
+	strategyManager, _ := s.client.Address(addresses.StrategyManager)
+
+	v, err := deposit.NewValidator(s.client.Backend, strategyManager)
+	if err != nil {
+		return err
+	}
+	failures, err := v.ValidateDeposit(ctx, s.strategy.Address, s.strategy.Token, amount, s.opts.From)
+	if err != nil {
+		return err
+	}
+	// The allowance is raised before depositing.
+	var blocking deposit.Failures
+	for _, failure := range failures {
+		if !errors.Is(failure, deposit.ErrInsufficientAllowance) {
+			blocking = append(blocking, failure)
+		}
+	}
+	if err := blocking.Err(); err != nil {
+		return fmt.Errorf("deposit would fail: %w", err)
+	}
+	state, err := shares.Fetch(ctx, s.client.Backend, s.strategy.Address)
+	if err != nil {
+		return err
+	}
+	expected, _, err := state.Deposit(amount)
+	if err != nil {
+		return fmt.Errorf("deposit would fail: %w", err)
+	}
+	fmt.Printf("depositing %s %s from %s into strategy %s for %s shares\n",
+		s.format(amount), s.strategy.Symbol, s.opts.From.Hex(), s.strategy.Address.Hex(), s.format(expected))
+	if f.dryRun {
+		return nil
+	}
+
+	receipt, err := s.client.ApproveAndDeposit(ctx, s.opts, s.strategy.Address, s.token().Amount(amount), nil)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("transaction %s mined in block %s\n", receipt.TxHash.Hex(), receipt.BlockNumber)
+	for _, e := range receipts.Events(receipt, strategyManager, s.client.StrategyManager.ParseDeposit) {
+		fmt.Printf("Deposit: staker %s, strategy %s, token %s, shares %s\n",
+			e.Staker.Hex(), e.Strategy.Hex(), e.Token.Hex(), s.format(e.Shares))
+	}
+	return nil
+}
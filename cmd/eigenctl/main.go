@@ -0,0 +1,419 @@
+// Command eigenctl sends the staker and operator flows of EigenLayer from the command line:
+// deposits, withdrawals, operator registration, delegation and rewards claims, as well as the
+// pausing of the core contracts and EigenPod checkpoints, and shows a live dashboard of a
+// staker's position and the protocol parameters that changed between two blocks.
+//
+// Amounts are decimal amounts of the strategy's underlying token, such as 1.5, scaled by the
+// token's decimals. Strategies are named by the symbol of their token, by strategy or token
+// address, or by the name of a core strategy contract such as EigenStrategy, and resolved against
+// the strategies whitelisted in the StrategyManager since -from-block. Transactions are signed
+// with an encrypted keystore, whose password is read from -password-file or the
+// EIGENCTL_PASSWORD environment variable, or with a signer of the config file named by -config or
+// EIGENCTL_CONFIG, which also sets the endpoints, address overrides and gas policy of each chain
+// (see pkg/config):
+//
+//	eigenctl deposit -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -keystore key.json -strategy stETH -amount 1.5
+//	eigenctl withdraw -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -keystore key.json -strategy stETH -amount all -complete
+//	eigenctl deposit -config eigenlayer.toml -signer ops -strategy EIGEN -amount 100
+//	eigenctl operator register -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -keystore key.json -metadata-uri https://example.com/operator.json
+//	eigenctl delegate -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -keystore key.json -operator 0x… -approver-url https://approver.example.com/approve
+//	eigenctl rewards claim -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -keystore key.json -distribution 'https://rewards.example.com/{date}/claim-amounts.json'
+//
+//	eigenctl pause -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -from 0x… -dry-run -contract StrategyManager -flags PAUSED_DEPOSITS
+//
+// The tui command needs no signer: it redraws the strategy TVLs, the staker's shares, its queued
+// withdrawals and the recent core contract events on every refresh, until interrupted:
+//
+//	eigenctl tui -rpc https://ethereum-holesky-rpc.publicnode.com -chain-id 17000 -staker 0x… -from-block 1167000
+//
+// The rewards auto-claim command runs as a daemon that claims the rewards of -earners, whose
+// claimer is the signer, against each new root once their unclaimed amounts reach -thresholds,
+// deferring claims while gas is expensive (see pkg/rewards):
+//
+//	eigenctl rewards auto-claim -config eigenlayer.toml -signer claims -earners 0x…,0x… -distribution 'https://rewards.example.com/{date}/claim-amounts.json' -min-value-to-gas 5
+//
+// The pod checkpoint command runs as a daemon that starts the checkpoints of EigenPods when they
+// hold enough new ETH, or when a validator exited or was slashed, and submits their proofs, built
+// from the states of a beacon node (see pkg/eigenpod). With -once, it makes a single pass:
+//
+//	eigenctl pod checkpoint -config eigenlayer.toml -signer pods -beacon-url http://localhost:5052 -pods 0x…,0x… -from-block 1167000
+//
+// The pod verify-credentials command restakes validators in a pod, in as many transactions as
+// their proofs need, and resumes from -progress-file if interrupted:
+//
+//	eigenctl pod verify-credentials -config eigenlayer.toml -signer pods -beacon-url http://localhost:5052 -pod 0x… -validators @validators.txt -progress-file progress.json
+//
+// The pod reconcile command needs no signer: it compares the shares of the owners of pods with
+// the balances of their validators on the beacon chain, prints the pods whose shares are negative
+// or stale, such as after a slashing on the beacon chain, with the remediation of each finding,
+// and fails if there are any, for running as a scheduled job:
+//
+//	eigenctl pod reconcile -rpc https://eth.example.com -chain-id 1 -beacon-url http://localhost:5052 -pods 0x…,0x… -from-block 19500000
+//
+// The params command needs no signer either: it reads the governance-settable parameters of the
+// core contracts and of the whitelisted strategies at two blocks of an archive node, and prints
+// those that changed, such as across an upgrade (see pkg/params):
+//
+//	eigenctl params -rpc https://eth.example.com -chain-id 1 -before 20000000 -after 20100000 -from-block 17445563 -format json
+//
+// The backfill command syncs an indexer from history: it indexes the events of the -contract
+// core contracts into the -store file, saving a checkpoint after each batch, and resumes from it
+// when rerun after an interruption (see pkg/indexer). With -export-dir, the events are also
+// exported as Avro or Protobuf files, one per event type, for data pipelines:
+//
+//	eigenctl backfill -rpc https://eth.example.com -chain-id 1 -contract DelegationManager -from 17000000 -store events.jsonl
+//	eigenctl backfill -rpc https://eth.example.com -chain-id 1 -from 17000000 -export-dir exports -export-format avro
+//
+// Each command checks its transaction against the chain before sending it, and prints the events
+// decoded from the receipt. With -dry-run, only the checks are run, and every command but deposit
+// and withdraw prints the calldata of its transaction instead, for execution through a multisig
+// such as a Safe named with -from.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/config"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/signer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/simulate"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+)
+
+// passwordEnv is the environment variable the keystore password is read from when
+// -password-file is not set.
+const passwordEnv = "EIGENCTL_PASSWORD"
+
+// configEnv is the environment variable the config file is read from when -config is not set.
+const configEnv = "EIGENCTL_CONFIG"
+
+var commands = map[string]func(ctx context.Context, args []string) error{
+	"deposit":    runDeposit,
+	"withdraw":   runWithdraw,
+	"operator":   runOperator,
+	"delegate":   runDelegate,
+	"undelegate": runUndelegate,
+	"rewards":    runRewards,
+	"pause":      runPause,
+	"unpause":    runUnpause,
+	"tui":        runTUI,
+	"pod":        runPod,
+	"params":     runParams,
+	"backfill":   runBackfill,
+}
+
+const usage = "usage: eigenctl deposit|withdraw|operator register|operator update-metadata|delegate|undelegate|rewards claim|rewards auto-claim|pause|unpause|tui|pod checkpoint|pod verify-credentials|pod reconcile|params|backfill [flags]"
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 || commands[os.Args[1]] == nil {
+		log.Fatal(usage)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := commands[os.Args[1]](ctx, os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// commonFlags are the flags shared by every command.
+type commonFlags struct {
+	config       string
+	rpcURL       string
+	chainID      uint64
+	signer       string
+	keystore     string
+	passwordFile string
+	from         string
+	dryRun       bool
+	verbose      bool
+}
+
+func (f *commonFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.config, "config", os.Getenv(configEnv), "config file of the chains and signers, instead of $"+configEnv)
+	fs.StringVar(&f.rpcURL, "rpc", "", "JSON-RPC URL of an Ethereum node, overriding the endpoints of -config")
+	fs.Uint64Var(&f.chainID, "chain-id", 0, "chain ID of the node; defaults to the default chain of -config, or mainnet")
+	fs.StringVar(&f.signer, "signer", "", "name of the -config signer to sign with, instead of the default signer of the chain")
+	fs.StringVar(&f.keystore, "keystore", "", "path of the encrypted keystore file of the signer")
+	fs.StringVar(&f.passwordFile, "password-file", "", "file holding the keystore password, instead of $"+passwordEnv)
+	fs.StringVar(&f.from, "from", "", "address the transaction is sent from with -dry-run and no -keystore, such as a multisig")
+	fs.BoolVar(&f.dryRun, "dry-run", false, "only check the transaction and print its calldata, without sending it")
+	fs.BoolVar(&f.verbose, "v", false, "log every step of the transaction flow")
+}
+
+// strategyFlags name the strategy a command acts on.
+type strategyFlags struct {
+	strategy  string
+	fromBlock uint64
+}
+
+func (f *strategyFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.strategy, "strategy", "", "token symbol, strategy or token address, or contract name of the strategy")
+	fs.Uint64Var(&f.fromBlock, "from-block", 0, "first block searched for whitelisted strategies, typically the deployment block of the StrategyManager")
+}
+
+// session is the connection and signer a command acts with.
+type session struct {
+	client *client.EigenLayerClient
+	opts   *bind.TransactOpts
+	// key is the key of the keystore, or nil for a dry run from -from.
+	key    *ecdsa.PrivateKey
+	dryRun bool
+	// strategy is the strategy of the commands that take strategyFlags.
+	strategy strategies.Strategy
+}
+
+// connect dials the node and unlocks the signer: the keystore, or a signer of -config. A dry run
+// may name its sender with -from instead.
+func (f *commonFlags) connect(ctx context.Context) (*session, error) {
+	var cfg *config.Config
+	if f.config != "" {
+		var err error
+		if cfg, err = config.Load(f.config); err != nil {
+			return nil, err
+		}
+	}
+	chainID := f.chainID
+	if chainID == 0 {
+		chainID = addresses.ChainIDMainnet
+		if cfg != nil && cfg.DefaultChain != 0 {
+			chainID = cfg.DefaultChain
+		}
+	}
+	// The signer of the chain is only a default, so that -from can name a multisig.
+	signerName := f.signer
+	if signerName == "" && !(f.dryRun && f.from != "") && cfg != nil && cfg.Chains[chainID] != nil {
+		signerName = cfg.Chains[chainID].Signer
+	}
+
+	s := &session{dryRun: f.dryRun}
+	switch {
+	case f.keystore != "":
+		password, err := f.password()
+		if err != nil {
+			return nil, err
+		}
+		if s.key, err = signer.ReadKeystore(f.keystore, password); err != nil {
+			return nil, err
+		}
+	case signerName != "":
+		if cfg == nil || cfg.Signers[signerName] == nil {
+			return nil, fmt.Errorf("no signer %s in -config", signerName)
+		}
+		var err error
+		if s.key, err = cfg.Signers[signerName].PrivateKey(); err != nil {
+			return nil, fmt.Errorf("failed to open signer %s: %w", signerName, err)
+		}
+	case f.dryRun && common.IsHexAddress(f.from):
+		s.opts = &bind.TransactOpts{From: common.HexToAddress(f.from)}
+	default:
+		return nil, errors.New("-keystore or a -config signer is required, or -from with -dry-run")
+	}
+	if s.key != nil {
+		var err error
+		if s.opts, err = signer.FromKey(s.key, new(big.Int).SetUint64(chainID)); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		c   *client.EigenLayerClient
+		err error
+	)
+	switch {
+	case cfg != nil && (f.rpcURL != "" || cfg.Chains[chainID] != nil):
+		if f.rpcURL != "" {
+			chain := new(config.Chain)
+			if cfg.Chains[chainID] != nil {
+				*chain = *cfg.Chains[chainID]
+			}
+			chain.RPC, chain.Quorum = []string{f.rpcURL}, 0
+			if cfg.Chains == nil {
+				cfg.Chains = make(map[uint64]*config.Chain)
+			}
+			cfg.Chains[chainID] = chain
+		}
+		c, err = cfg.Client(ctx, chainID)
+	case f.rpcURL != "":
+		c, err = client.NewEigenLayerClient(ctx, f.rpcURL, chainID)
+	default:
+		return nil, fmt.Errorf("-rpc is required without endpoints of chain %d in -config", chainID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if f.verbose {
+		c.Logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
+	}
+	s.client = c
+	return s, nil
+}
+
+func (f *commonFlags) password() (string, error) {
+	return readPassword(f.passwordFile, passwordEnv)
+}
+
+// readPassword reads a keystore password from file, or from the environment variable env if file
+// is empty.
+func readPassword(file, env string) (string, error) {
+	if file == "" {
+		password, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("a password file or $%s is required", env)
+		}
+		return password, nil
+	}
+	password, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return strings.TrimRight(string(password), "\r\n"), nil
+}
+
+// resolve finds the whitelisted strategy named by f.strategy.
+func (f *strategyFlags) resolve(ctx context.Context, c *client.EigenLayerClient) (strategies.Strategy, error) {
+	if f.strategy == "" {
+		return strategies.Strategy{}, errors.New("-strategy is required")
+	}
+	strategyManager, _ := c.Address(addresses.StrategyManager)
+	strategyFactory, _ := c.Address(addresses.StrategyFactory)
+	registry, err := strategies.NewRegistry(c.Backend, strategies.Config{
+		StrategyManager: strategyManager,
+		StrategyFactory: strategyFactory,
+		FromBlock:       f.fromBlock,
+	})
+	if err != nil {
+		return strategies.Strategy{}, err
+	}
+	list, err := registry.ListStrategies(ctx)
+	if err != nil {
+		return strategies.Strategy{}, fmt.Errorf("failed to list strategies: %w", err)
+	}
+
+	name := f.strategy
+	if addr, ok := c.Address(name); ok {
+		name = addr.Hex()
+	}
+	if common.IsHexAddress(name) {
+		addr := common.HexToAddress(name)
+		for _, s := range list {
+			if s.Address == addr {
+				return s, nil
+			}
+		}
+		if s, ok := registry.StrategyForToken(addr); ok {
+			return s, nil
+		}
+		return strategies.Strategy{}, fmt.Errorf("%s is not a whitelisted strategy or the token of one", addr.Hex())
+	}
+
+	var matches []strategies.Strategy
+	for _, s := range list {
+		if strings.EqualFold(s.Symbol, name) {
+			matches = append(matches, s)
+		}
+	}
+	switch {
+	case len(matches) == 0:
+		return strategies.Strategy{}, fmt.Errorf("no whitelisted strategy holds a token with symbol %s", name)
+	case len(matches) == 1:
+		return matches[0], nil
+	}
+	// Several tokens share the symbol: only a single token is unambiguous, and its canonical
+	// strategy is used.
+	for _, s := range matches[1:] {
+		if s.Token != matches[0].Token {
+			addrs := make([]string, len(matches))
+			for i, m := range matches {
+				addrs[i] = m.Address.Hex()
+			}
+			return strategies.Strategy{}, fmt.Errorf("symbol %s is ambiguous, name one of the strategies %s", name, strings.Join(addrs, ", "))
+		}
+	}
+	s, _ := registry.StrategyForToken(matches[0].Token)
+	return s, nil
+}
+
+// amount parses a decimal amount of the strategy's underlying token.
+func (s *session) amount(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("-amount is required")
+	}
+	amount, err := amounts.ParseUnits(value, s.strategy.Decimals)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() == 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	return amount, nil
+}
+
+// format formats an amount of the strategy's underlying token, or of its shares, which have the
+// token's decimals.
+func (s *session) format(amount *big.Int) string {
+	return amounts.FormatUnits(amount, s.strategy.Decimals)
+}
+
+// token returns the underlying token of the strategy.
+func (s *session) token() amounts.Token {
+	return amounts.Token{Address: s.strategy.Token, Symbol: s.strategy.Symbol, Decimals: s.strategy.Decimals}
+}
+
+// requireKey fails for dry runs without a keystore, which cannot sign what a command needs.
+func (s *session) requireKey(what string) error {
+	if s.key == nil {
+		return fmt.Errorf("signing %s requires -keystore", what)
+	}
+	return nil
+}
+
+// send sends the transaction transact builds and waits for it to be mined. A dry run instead
+// simulates the transaction from the sender and prints its calldata, for execution through a
+// multisig, and returns a nil receipt.
+func (s *session) send(ctx context.Context, transact simulate.Transact) (*types.Receipt, error) {
+	if s.dryRun {
+		if _, err := simulate.Call(ctx, s.client.Backend, s.opts.From, transact); err != nil {
+			return nil, fmt.Errorf("transaction would fail: %w", err)
+		}
+		call, err := calldata.Build(transact)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("to: %s\nvalue: %s\ndata: %s\n", call.To.Hex(), call.Value, hexutil.Encode(call.Data))
+		return nil, nil
+	}
+	opts := *s.opts
+	opts.Context = ctx
+	tx, err := transact(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", elerrors.Decode(err))
+	}
+	receipt, err := receipts.Wait(ctx, s.client.Backend, tx, receipts.Options{})
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("transaction %s mined in block %s\n", receipt.TxHash.Hex(), receipt.BlockNumber)
+	return receipt, nil
+}
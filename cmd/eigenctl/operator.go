@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/avs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// runOperator runs the operator subcommands.
+func runOperator(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "register":
+			return runOperatorRegister(ctx, args[1:])
+		case "update-metadata":
+			return runOperatorUpdateMetadata(ctx, args[1:])
+		}
+	}
+	return errors.New(usage)
+}
+
+// avsSignature is the operator's signature of its registration to an AVS, which the AVS passes
+// to AVSDirectory.registerOperatorToAVS.
+type avsSignature struct {
+	Operator  common.Address `json:"operator"`
+	AVS       common.Address `json:"avs"`
+	Signature hexutil.Bytes  `json:"signature"`
+	Salt      common.Hash    `json:"salt"`
+	Expiry    uint64         `json:"expiry"`
+}
+
+// runOperatorRegister registers the signer as an operator in the DelegationManager and, with
+// -avs, signs its registration to an AVS.
+func runOperatorRegister(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("operator register", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	metadataURI := fs.String("metadata-uri", "", "URI of the operator's metadata JSON")
+	approverFlag := fs.String("delegation-approver", "", "address that must approve delegations to the operator, if any")
+	optOutWindow := fs.Uint("opt-out-window", 0, "number of blocks stakers have to opt out of the operator's AVS registrations")
+	avsFlag := fs.String("avs", "", "AVS to sign the operator's registration to, once registered")
+	avsExpiry := fs.Duration("avs-expiry", time.Hour, "validity of the AVS registration signature")
+	_ = fs.Parse(args)
+
+	var approverAddr, avsAddr common.Address
+	if *approverFlag != "" {
+		if !common.IsHexAddress(*approverFlag) {
+			return fmt.Errorf("invalid -delegation-approver %q", *approverFlag)
+		}
+		approverAddr = common.HexToAddress(*approverFlag)
+	}
+	if *avsFlag != "" {
+		if !common.IsHexAddress(*avsFlag) {
+			return fmt.Errorf("invalid -avs %q", *avsFlag)
+		}
+		avsAddr = common.HexToAddress(*avsFlag)
+	}
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	if avsAddr != (common.Address{}) {
+		if err := s.requireKey("the AVS registration"); err != nil {
+			return err
+		}
+	}
+	dm := s.client.DelegationManager
+
+	isOperator, err := dm.IsOperator(client.CallOpts(ctx), s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to fetch operator status: %w", err)
+	}
+	switch {
+	case isOperator && avsAddr == (common.Address{}):
+		return fmt.Errorf("%s is already an operator", s.opts.From.Hex())
+	case isOperator:
+		fmt.Printf("%s is already an operator\n", s.opts.From.Hex())
+	case *metadataURI == "":
+		return errors.New("-metadata-uri is required")
+	default:
+		details := DelegationManager.IDelegationManagerOperatorDetails{
+			DelegationApprover:       approverAddr,
+			StakerOptOutWindowBlocks: uint32(*optOutWindow),
+		}
+		fmt.Printf("registering %s as an operator with delegation approver %s and opt-out window of %d blocks\n",
+			s.opts.From.Hex(), approverAddr.Hex(), details.StakerOptOutWindowBlocks)
+		receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return dm.RegisterAsOperator(opts, details, *metadataURI)
+		})
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			printOperatorEvents(s, receipt)
+		}
+	}
+	if avsAddr == (common.Address{}) {
+		return nil
+	}
+
+	avsDirectory, ok := s.client.Address(addresses.AVSDirectory)
+	if !ok {
+		return fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, addresses.AVSDirectory, s.client.ChainID)
+	}
+	registrar, err := avs.NewRegistrar(s.client.Backend, avsDirectory, s.opts)
+	if err != nil {
+		return err
+	}
+	status, err := registrar.Status(ctx, s.opts.From, avsAddr)
+	if err != nil {
+		return err
+	}
+	if status == avs.StatusRegistered {
+		return fmt.Errorf("%w: %s", elerrors.ErrAlreadyRegisteredToAVS, avsAddr.Hex())
+	}
+	expiry := big.NewInt(time.Now().Add(*avsExpiry).Unix())
+	sig, err := registrar.SignRegistration(ctx, s.key, avsAddr, expiry)
+	if err != nil {
+		return fmt.Errorf("failed to sign AVS registration: %w", err)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(avsSignature{
+		Operator:  s.opts.From,
+		AVS:       avsAddr,
+		Signature: sig.Signature,
+		Salt:      sig.Salt,
+		Expiry:    sig.Expiry.Uint64(),
+	})
+}
+
+// runOperatorUpdateMetadata updates the metadata URI of the signer's operator.
+func runOperatorUpdateMetadata(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("operator update-metadata", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	metadataURI := fs.String("metadata-uri", "", "URI of the operator's metadata JSON")
+	_ = fs.Parse(args)
+	if *metadataURI == "" {
+		return errors.New("-metadata-uri is required")
+	}
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	dm := s.client.DelegationManager
+
+	isOperator, err := dm.IsOperator(client.CallOpts(ctx), s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to fetch operator status: %w", err)
+	}
+	if !isOperator {
+		return fmt.Errorf("%w: %s", elerrors.ErrOperatorNotRegistered, s.opts.From.Hex())
+	}
+	fmt.Printf("updating the metadata URI of operator %s to %s\n", s.opts.From.Hex(), *metadataURI)
+	receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return dm.UpdateOperatorMetadataURI(opts, *metadataURI)
+	})
+	if err != nil {
+		return err
+	}
+	if receipt != nil {
+		printOperatorEvents(s, receipt)
+	}
+	return nil
+}
+
+// printOperatorEvents prints the operator events of the DelegationManager in receipt.
+func printOperatorEvents(s *session, receipt *types.Receipt) {
+	delegationManager, _ := s.client.Address(addresses.DelegationManager)
+	dm := s.client.DelegationManager
+	for _, e := range receipts.Events(receipt, delegationManager, dm.ParseOperatorRegistered) {
+		fmt.Printf("OperatorRegistered: operator %s, delegation approver %s, opt-out window %d blocks\n",
+			e.Operator.Hex(), e.OperatorDetails.DelegationApprover.Hex(), e.OperatorDetails.StakerOptOutWindowBlocks)
+	}
+	for _, e := range receipts.Events(receipt, delegationManager, dm.ParseOperatorMetadataURIUpdated) {
+		fmt.Printf("OperatorMetadataURIUpdated: operator %s, metadata URI %s\n", e.Operator.Hex(), e.MetadataURI)
+	}
+}
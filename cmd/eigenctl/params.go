@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/params"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+)
+
+// runParams prints the protocol parameters that changed between two blocks.
+func runParams(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("params", flag.ExitOnError)
+	rpcURL := fs.String("rpc", "", "JSON-RPC URL of an archive node")
+	chainID := fs.Uint64("chain-id", addresses.ChainIDMainnet, "chain ID of the node")
+	before := fs.Uint64("before", 0, "block the parameters are read at before the change")
+	after := fs.Int64("after", -1, "block the parameters are read at after the change; defaults to the latest block")
+	fromBlock := fs.Uint64("from-block", 0, "first block searched for whitelisted strategies, typically the deployment block of the StrategyManager")
+	strategiesFlag := fs.String("strategies", "", "comma-separated strategies read in addition to those whitelisted at the latest block, such as strategies removed from the whitelist")
+	format := fs.String("format", "text", "output format: text or json")
+	_ = fs.Parse(args)
+	if *rpcURL == "" {
+		return errors.New("-rpc is required")
+	}
+	if *before == 0 {
+		return errors.New("-before is required")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown -format %q, expected text or json", *format)
+	}
+	extra, err := parseAddresses("-strategies", *strategiesFlag)
+	if err != nil {
+		return err
+	}
+
+	backend, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", *rpcURL, err)
+	}
+	defer backend.Close()
+	remoteChainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	if !remoteChainID.IsUint64() || remoteChainID.Uint64() != *chainID {
+		return fmt.Errorf("chain ID mismatch: expected %d, node reports %s", *chainID, remoteChainID)
+	}
+	deployment, err := addresses.Default.Deployment(*chainID)
+	if err != nil {
+		return err
+	}
+
+	registry, err := strategies.NewRegistry(backend, strategies.Config{
+		StrategyManager: deployment[addresses.StrategyManager],
+		StrategyFactory: deployment[addresses.StrategyFactory],
+		FromBlock:       *fromBlock,
+	})
+	if err != nil {
+		return err
+	}
+	list, err := registry.ListStrategies(ctx)
+	if err != nil {
+		return err
+	}
+	strategyAddrs := make([]common.Address, 0, len(list)+len(extra))
+	for _, s := range list {
+		strategyAddrs = append(strategyAddrs, s.Address)
+	}
+	strategyAddrs = append(strategyAddrs, extra...)
+
+	var afterBlock *big.Int
+	if *after >= 0 {
+		afterBlock = big.NewInt(*after)
+	}
+	beforeSnap, err := params.Take(ctx, backend, deployment, strategyAddrs, new(big.Int).SetUint64(*before))
+	if err != nil {
+		return fmt.Errorf("failed to read parameters at block %d: %w", *before, err)
+	}
+	afterSnap, err := params.Take(ctx, backend, deployment, strategyAddrs, afterBlock)
+	if err != nil {
+		return fmt.Errorf("failed to read parameters after the change: %w", err)
+	}
+
+	diff := params.Compare(beforeSnap, afterSnap)
+	if *format == "json" {
+		return params.WriteJSON(os.Stdout, diff)
+	}
+	return params.WriteText(os.Stdout, diff)
+}
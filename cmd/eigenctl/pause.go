@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Pausable"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/pausing"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+func runPause(ctx context.Context, args []string) error {
+	return runPausing(ctx, "pause", args)
+}
+
+func runUnpause(ctx context.Context, args []string) error {
+	return runPausing(ctx, "unpause", args)
+}
+
+// runPausing pauses or unpauses, as named by action, flags of a Pausable contract. Pausing
+// requires a pauser of the contract's PauserRegistry, and unpausing its unpauser.
+func runPausing(ctx context.Context, action string, args []string) error {
+	fs := flag.NewFlagSet(action, flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	contract := fs.String("contract", "", "contract to "+action+", such as StrategyManager, or StrategyBase with -address")
+	addressFlag := fs.String("address", "", "address of the contract, if it is not a core contract such as a strategy")
+	flagsFlag := fs.String("flags", "", "comma-separated flags to "+action+", such as PAUSED_DEPOSITS, or all")
+	_ = fs.Parse(args)
+	if *contract == "" {
+		return errors.New("-contract is required")
+	}
+	if *flagsFlag == "" {
+		return errors.New("-flags is required")
+	}
+	flags, err := parsePauseFlags(*contract, *flagsFlag)
+	if err != nil {
+		return err
+	}
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	addr, ok := s.client.Address(*contract)
+	if *addressFlag != "" {
+		if !common.IsHexAddress(*addressFlag) {
+			return fmt.Errorf("invalid -address %q", *addressFlag)
+		}
+		addr, ok = common.HexToAddress(*addressFlag), true
+	}
+	if !ok {
+		return fmt.Errorf("%s is not a core contract on chain %d: set -address", *contract, s.client.ChainID)
+	}
+	pausable, err := Pausable.NewPausable(addr, s.client.Backend)
+	if err != nil {
+		return err
+	}
+	callOpts := client.CallOpts(ctx)
+
+	registryAddr, err := pausable.PauserRegistry(callOpts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pauser registry of %s: %w", addr.Hex(), err)
+	}
+	registry, err := PauserRegistry.NewPauserRegistryCaller(registryAddr, s.client.Backend)
+	if err != nil {
+		return err
+	}
+	if action == "pause" {
+		isPauser, err := registry.IsPauser(callOpts, s.opts.From)
+		if err != nil {
+			return fmt.Errorf("failed to fetch pauser status: %w", err)
+		}
+		if !isPauser {
+			return fmt.Errorf("%s is not a pauser in the pauser registry %s", s.opts.From.Hex(), registryAddr.Hex())
+		}
+	} else {
+		unpauser, err := registry.Unpauser(callOpts)
+		if err != nil {
+			return fmt.Errorf("failed to fetch unpauser: %w", err)
+		}
+		if unpauser != s.opts.From {
+			return fmt.Errorf("%s is not the unpauser %s of the pauser registry %s", s.opts.From.Hex(), unpauser.Hex(), registryAddr.Hex())
+		}
+	}
+
+	current, err := pausable.Paused0(callOpts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch paused status: %w", err)
+	}
+	status := pausing.Pause(current, flags...)
+	if action == "unpause" {
+		status = pausing.Unpause(current, flags...)
+	}
+	if status.Cmp(current) == 0 {
+		return fmt.Errorf("%s %s already has the flags %sd", *contract, addr.Hex(), action)
+	}
+	fmt.Printf("%s %s: paused %s -> %s\n", *contract, addr.Hex(), formatPauseFlags(*contract, current), formatPauseFlags(*contract, status))
+
+	receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		if action == "pause" {
+			return pausable.Pause(opts, status)
+		}
+		return pausable.Unpause(opts, status)
+	})
+	if err != nil || receipt == nil {
+		return err
+	}
+	for _, e := range receipts.Events(receipt, addr, pausable.ParsePaused) {
+		fmt.Printf("Paused: account %s, paused %s\n", e.Account.Hex(), formatPauseFlags(*contract, e.NewPausedStatus))
+	}
+	for _, e := range receipts.Events(receipt, addr, pausable.ParseUnpaused) {
+		fmt.Printf("Unpaused: account %s, paused %s\n", e.Account.Hex(), formatPauseFlags(*contract, e.NewPausedStatus))
+	}
+	return nil
+}
+
+// parsePauseFlags parses comma-separated flags of contract, named by their PAUSED_* constant
+// with or without the prefix, or all for every flag of the contract.
+func parsePauseFlags(contract, list string) ([]pausing.Flag, error) {
+	known := pausing.Flags(contract)
+	if len(known) == 0 {
+		return nil, fmt.Errorf("%s is not a known Pausable contract", contract)
+	}
+	if list == "all" {
+		return known, nil
+	}
+	var flags []pausing.Flag
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(name)), "PAUSED_")
+		found := false
+		for _, flag := range known {
+			if strings.TrimPrefix(flag.Name, "PAUSED_") == name {
+				flags, found = append(flags, flag), true
+			}
+		}
+		if !found {
+			names := make([]string, len(known))
+			for i, flag := range known {
+				names[i] = flag.Name
+			}
+			return nil, fmt.Errorf("%s has no flag %s, only %s", contract, name, strings.Join(names, ", "))
+		}
+	}
+	return flags, nil
+}
+
+// formatPauseFlags formats the paused status of contract as its set flags.
+func formatPauseFlags(contract string, status *big.Int) string {
+	set := pausing.DecodePausedStatus(contract, status)
+	if len(set) == 0 {
+		return "none"
+	}
+	names := make([]string, len(set))
+	for i, flag := range set {
+		names[i] = flag.Name
+		if flag.Name == "" {
+			names[i] = flag.String()
+		}
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/eigenpod"
+)
+
+// runPod runs the EigenPod subcommands.
+func runPod(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "checkpoint":
+			return runPodCheckpoint(ctx, args[1:])
+		case "verify-credentials":
+			return runPodVerifyCredentials(ctx, args[1:])
+		case "reconcile":
+			return runPodReconcile(ctx, args[1:])
+		}
+	}
+	return errors.New(usage)
+}
+
+// beaconFlags name the beacon node EigenPod proofs are built from.
+type beaconFlags struct {
+	url      string
+	cacheDir string
+}
+
+func (f *beaconFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.url, "beacon-url", "", "REST API URL of a beacon node serving SSZ states")
+	fs.StringVar(&f.cacheDir, "beacon-cache-dir", "", "directory where downloaded beacon states are kept, if any")
+}
+
+func (f *beaconFlags) client() (*beacon.Client, error) {
+	if f.url == "" {
+		return nil, errors.New("-beacon-url is required")
+	}
+	return beacon.NewClient(f.url, beacon.Config{CacheDir: f.cacheDir}), nil
+}
+
+// parsePods parses a comma-separated list of pod addresses.
+func parsePods(value string) ([]common.Address, error) {
+	if value == "" {
+		return nil, errors.New("-pods is required")
+	}
+	var pods []common.Address
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if !common.IsHexAddress(field) {
+			return nil, fmt.Errorf("invalid pod address %q", field)
+		}
+		pods = append(pods, common.HexToAddress(field))
+	}
+	return pods, nil
+}
+
+// runPodCheckpoint starts and proves the checkpoints of pods, once or as a daemon.
+func runPodCheckpoint(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("pod checkpoint", flag.ExitOnError)
+	var (
+		f  commonFlags
+		bf beaconFlags
+	)
+	f.register(fs)
+	bf.register(fs)
+	podsFlag := fs.String("pods", "", "comma-separated addresses of the pods to checkpoint")
+	fromBlock := fs.Uint64("from-block", 0, "first block searched for the pods' restaked validators, typically the deployment block of the oldest pod")
+	minGain := fs.String("min-gain", "1", "ETH a pod must hold beyond its checkpointed balance for a checkpoint to be started")
+	maxAge := fs.Duration("max-age", 0, "age of the last checkpoint beyond which a checkpoint is started regardless of gain; 0 disables")
+	interval := fs.Duration("interval", eigenpod.DefaultCheckpointInterval, "interval between passes over the pods")
+	batchGasLimit := fs.Uint64("batch-gas-limit", eigenpod.DefaultBatchGasLimit, "gas limit of each transaction of proofs")
+	once := fs.Bool("once", false, "make a single pass over the pods and exit")
+	_ = fs.Parse(args)
+
+	pods, err := parsePods(*podsFlag)
+	if err != nil {
+		return err
+	}
+	minGainWei, err := amounts.ParseUnits(*minGain, 18)
+	if err != nil {
+		return fmt.Errorf("invalid -min-gain: %w", err)
+	}
+	minGainGwei := minGainWei.Div(minGainWei, gweiPerWei)
+	if minGainGwei.Sign() == 0 {
+		return errors.New("-min-gain must be at least 1 gwei")
+	}
+	bc, err := bf.client()
+	if err != nil {
+		return err
+	}
+	if f.dryRun {
+		return errors.New("pod checkpoint sends its transactions and does not support -dry-run")
+	}
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	daemon, err := eigenpod.NewCheckpointDaemon(s.client.Backend, bc, s.opts, eigenpod.CheckpointConfig{
+		Pods:          pods,
+		FromBlock:     *fromBlock,
+		Interval:      *interval,
+		MinGainGwei:   minGainGwei.Uint64(),
+		MaxAge:        *maxAge,
+		BatchGasLimit: *batchGasLimit,
+		Logger:        logger,
+	})
+	if err != nil {
+		return err
+	}
+	if !*once {
+		err := daemon.Run(ctx)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	}
+	for _, pod := range pods {
+		result, err := daemon.Checkpoint(ctx, pod)
+		if err != nil {
+			return fmt.Errorf("checkpoint of pod %s failed: %w", pod.Hex(), err)
+		}
+		printCheckpointResult(result)
+	}
+	return nil
+}
+
+// parseIndices parses a comma-separated list of validator indices, or, with a leading @, the
+// file holding them, separated by commas or white space.
+func parseIndices(value string) ([]uint64, error) {
+	if value == "" {
+		return nil, errors.New("-validators is required")
+	}
+	if file, ok := strings.CutPrefix(value, "@"); ok {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read validators: %w", err)
+		}
+		value = string(data)
+	}
+	var indices []uint64
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		index, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index %q", field)
+		}
+		indices = append(indices, index)
+	}
+	if len(indices) == 0 {
+		return nil, errors.New("no validators to verify")
+	}
+	return indices, nil
+}
+
+// runPodVerifyCredentials restakes validators in a pod by verifying their withdrawal credentials.
+func runPodVerifyCredentials(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("pod verify-credentials", flag.ExitOnError)
+	var (
+		f  commonFlags
+		bf beaconFlags
+	)
+	f.register(fs)
+	bf.register(fs)
+	podFlag := fs.String("pod", "", "address of the pod")
+	validators := fs.String("validators", "", "comma-separated validator indices, or @file of indices")
+	progressFile := fs.String("progress-file", "", "file recording the progress, to resume an interrupted run")
+	batchGasLimit := fs.Uint64("batch-gas-limit", eigenpod.DefaultBatchGasLimit, "gas limit of each transaction of proofs")
+	_ = fs.Parse(args)
+
+	if !common.IsHexAddress(*podFlag) {
+		return fmt.Errorf("invalid -pod %q", *podFlag)
+	}
+	pod := common.HexToAddress(*podFlag)
+	indices, err := parseIndices(*validators)
+	if err != nil {
+		return err
+	}
+	bc, err := bf.client()
+	if err != nil {
+		return err
+	}
+	if f.dryRun {
+		return errors.New("pod verify-credentials sends its transactions and does not support -dry-run")
+	}
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	fmt.Printf("verifying the withdrawal credentials of %d validators for pod %s\n", len(indices), pod.Hex())
+	result, err := eigenpod.VerifyWithdrawalCredentials(ctx, s.client.Backend, bc, s.opts, pod, indices, eigenpod.CredentialsConfig{
+		BatchGasLimit: *batchGasLimit,
+		ProgressFile:  *progressFile,
+		OnProgress: func(p eigenpod.CredentialsProgress) {
+			fmt.Printf("batch %d/%d mined in %s: %d/%d validators verified\n", p.Batch, p.Batches, p.Tx.Hex(), p.Verified, p.Total)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("verified %d validators against block root %s, %d already verified\n", len(result.Verified), result.BlockRoot.Hex(), len(result.Skipped))
+	return nil
+}
+
+// runPodReconcile compares the shares of pods with the balances of their validators on the
+// beacon chain and prints the pods that need a remediation. It fails if any does, so that it can
+// run as a scheduled job.
+func runPodReconcile(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("pod reconcile", flag.ExitOnError)
+	var bf beaconFlags
+	bf.register(fs)
+	rpcURL := fs.String("rpc", "", "JSON-RPC URL of an Ethereum node")
+	chainID := fs.Uint64("chain-id", addresses.ChainIDMainnet, "chain ID of the node")
+	podsFlag := fs.String("pods", "", "comma-separated addresses of the pods to reconcile")
+	fromBlock := fs.Uint64("from-block", 0, "first block searched for the pods' restaked validators, typically the deployment block of the oldest pod")
+	threshold := fs.String("stale-threshold", "1", "ETH by which a checkpoint would change a pod's shares for them to be stale")
+	grace := fs.Duration("checkpoint-grace", eigenpod.DefaultCheckpointGrace, "time a checkpoint may stay in progress before it is reported")
+	_ = fs.Parse(args)
+
+	if *rpcURL == "" {
+		return errors.New("-rpc is required")
+	}
+	pods, err := parsePods(*podsFlag)
+	if err != nil {
+		return err
+	}
+	thresholdWei, err := amounts.ParseUnits(*threshold, 18)
+	if err != nil {
+		return fmt.Errorf("invalid -stale-threshold: %w", err)
+	}
+	thresholdGwei := thresholdWei.Div(thresholdWei, gweiPerWei)
+	if thresholdGwei.Sign() == 0 {
+		return errors.New("-stale-threshold must be at least 1 gwei")
+	}
+	bc, err := bf.client()
+	if err != nil {
+		return err
+	}
+	c, err := client.NewEigenLayerClient(ctx, *rpcURL, *chainID)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	reconciliations, err := eigenpod.Reconcile(ctx, c.Backend, bc, pods, eigenpod.ReconcileConfig{
+		FromBlock:          *fromBlock,
+		StaleThresholdGwei: thresholdGwei.Uint64(),
+		CheckpointGrace:    *grace,
+	})
+	if err != nil {
+		return err
+	}
+	flagged := 0
+	for _, r := range reconciliations {
+		fmt.Printf("%s: owner %s, shares %s ETH, %d active validators, %s ETH on the beacon chain, %s ETH restaked\n",
+			r.Pod.Hex(), r.Owner.Hex(), amounts.FormatUnits(r.SharesWei, 18), len(r.Validators),
+			amounts.FormatUnits(new(big.Int).Mul(new(big.Int).SetUint64(r.BeaconGwei), gweiPerWei), 18),
+			amounts.FormatUnits(new(big.Int).Mul(new(big.Int).SetUint64(r.RestakedGwei), gweiPerWei), 18))
+		if len(r.Findings) > 0 {
+			flagged++
+		}
+		for _, f := range r.Findings {
+			fmt.Printf("  %s: %s", f.Kind, f.Detail)
+			if len(f.Validators) > 0 {
+				fmt.Printf(" (validators %s)", formatIndices(f.Validators))
+			}
+			if f.Remediation != "" {
+				fmt.Printf("; remediation: %s", f.Remediation)
+			}
+			fmt.Println()
+		}
+	}
+	if flagged > 0 {
+		return fmt.Errorf("%d of %d pods need remediation", flagged, len(reconciliations))
+	}
+	return nil
+}
+
+// formatIndices formats validator indices as a comma-separated list.
+func formatIndices(indices []uint64) string {
+	fields := make([]string, len(indices))
+	for i, index := range indices {
+		fields[i] = strconv.FormatUint(index, 10)
+	}
+	return strings.Join(fields, ",")
+}
+
+func printCheckpointResult(r *eigenpod.CheckpointResult) {
+	switch {
+	case r.CheckpointTimestamp == 0:
+		fmt.Printf("%s: no checkpoint needed\n", r.Pod.Hex())
+		return
+	case r.Started:
+		fmt.Printf("%s: started checkpoint %d: %s\n", r.Pod.Hex(), r.CheckpointTimestamp, r.Reason)
+	}
+	fmt.Printf("%s: proved %d validators of checkpoint %d, %d remaining\n", r.Pod.Hex(), r.Proven, r.CheckpointTimestamp, r.ProofsRemaining)
+	if r.Finalized {
+		fmt.Printf("%s: checkpoint %d completed, shares changed by %s ETH\n", r.Pod.Hex(), r.CheckpointTimestamp, amounts.FormatUnits(r.ShareDeltaWei, 18))
+	}
+	for _, tx := range r.Transactions {
+		fmt.Printf("  transaction %s\n", tx.Hex())
+	}
+}
+
+// gweiPerWei converts wei to gwei.
+var gweiPerWei = big.NewInt(1_000_000_000)
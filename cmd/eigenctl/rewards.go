@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prices"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewards"
+)
+
+// distributionTimeout bounds the download of a distribution file.
+const distributionTimeout = 5 * time.Minute
+
+// runRewards runs the rewards subcommands.
+func runRewards(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "claim":
+			return runRewardsClaim(ctx, args[1:])
+		case "auto-claim":
+			return runRewardsAutoClaim(ctx, args[1:])
+		}
+	}
+	return errors.New(usage)
+}
+
+// runRewardsClaim claims the rewards of an earner against the current claimable distribution
+// root, reconstructing the distribution tree from its distribution file to build the proofs.
+func runRewardsClaim(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("rewards claim", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	distribution := fs.String("distribution", "", `path or URL of the distribution file of the root, where "{date}" is replaced with its calculation end date, such as 2024-08-01`)
+	earnerFlag := fs.String("earner", "", "earner to claim for, if not the signer, which must be its claimer")
+	recipientFlag := fs.String("recipient", "", "address the rewards are paid to, if not the signer")
+	tokensFlag := fs.String("tokens", "", "comma-separated tokens to claim, instead of every token with unclaimed earnings")
+	setClaimer := fs.String("set-claimer", "", "claimer to set for the signer before claiming; claims are only sent if it is the signer")
+	_ = fs.Parse(args)
+	if *distribution == "" {
+		return errors.New("-distribution is required")
+	}
+	var tokens []common.Address
+	if *tokensFlag != "" {
+		for _, token := range strings.Split(*tokensFlag, ",") {
+			if !common.IsHexAddress(token) {
+				return fmt.Errorf("invalid token %q", token)
+			}
+			tokens = append(tokens, common.HexToAddress(token))
+		}
+	}
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	rewardsCoordinator, ok := s.client.Address(addresses.RewardsCoordinator)
+	if !ok {
+		return fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, addresses.RewardsCoordinator, s.client.ChainID)
+	}
+	rc := s.client.RewardsCoordinator
+	callOpts := client.CallOpts(ctx)
+	earner, recipient := s.opts.From, s.opts.From
+	if *earnerFlag != "" {
+		if !common.IsHexAddress(*earnerFlag) {
+			return fmt.Errorf("invalid -earner %q", *earnerFlag)
+		}
+		earner = common.HexToAddress(*earnerFlag)
+	}
+	if *recipientFlag != "" {
+		if !common.IsHexAddress(*recipientFlag) {
+			return fmt.Errorf("invalid -recipient %q", *recipientFlag)
+		}
+		recipient = common.HexToAddress(*recipientFlag)
+	}
+
+	if *setClaimer != "" {
+		if !common.IsHexAddress(*setClaimer) {
+			return fmt.Errorf("invalid -set-claimer %q", *setClaimer)
+		}
+		if earner != s.opts.From {
+			return fmt.Errorf("only the earner %s can set its claimer", earner.Hex())
+		}
+		claimer := common.HexToAddress(*setClaimer)
+		fmt.Printf("setting the claimer of %s to %s\n", earner.Hex(), claimer.Hex())
+		receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return rc.SetClaimerFor(opts, claimer)
+		})
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			for _, e := range receipts.Events(receipt, rewardsCoordinator, rc.ParseClaimerForSet) {
+				fmt.Printf("ClaimerForSet: earner %s, old claimer %s, claimer %s\n", e.Earner.Hex(), e.OldClaimer.Hex(), e.Claimer.Hex())
+			}
+		}
+		if claimer != s.opts.From || s.dryRun {
+			return nil
+		}
+	} else {
+		claimer, err := rc.ClaimerFor(callOpts, earner)
+		if err != nil {
+			return fmt.Errorf("failed to fetch claimer: %w", err)
+		}
+		if claimer == (common.Address{}) {
+			claimer = earner
+		}
+		if claimer != s.opts.From {
+			return fmt.Errorf("claims for %s must be sent by its claimer %s", earner.Hex(), claimer.Hex())
+		}
+	}
+
+	root, err := rc.GetCurrentClaimableDistributionRoot(callOpts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch claimable distribution root: %w", err)
+	}
+	if root.Root == ([32]byte{}) {
+		return errors.New("no distribution root is claimable yet")
+	}
+	rootIndex, err := rc.GetRootIndexFromHash(callOpts, root.Root)
+	if err != nil {
+		return fmt.Errorf("failed to fetch root index: %w", err)
+	}
+	endDate := time.Unix(int64(root.RewardsCalculationEndTimestamp), 0).UTC().Format(time.DateOnly)
+	fmt.Printf("claiming against root %d %s, calculated until %s\n", rootIndex, common.Hash(root.Root).Hex(), endDate)
+
+	d, err := readDistribution(ctx, strings.ReplaceAll(*distribution, "{date}", endDate))
+	if err != nil {
+		return err
+	}
+	if d.Root() != root.Root {
+		return fmt.Errorf("distribution file has root %s, not %s", d.Root().Hex(), common.Hash(root.Root).Hex())
+	}
+
+	// Only tokens with unclaimed earnings can be claimed: processClaim reverts for the others.
+	earnings := d.Earnings(earner)
+	if len(earnings) == 0 {
+		return fmt.Errorf("%s has no earnings in root %d", earner.Hex(), rootIndex)
+	}
+	for _, token := range tokens {
+		if !containsToken(earnings, token) {
+			return fmt.Errorf("%s has no earnings in token %s", earner.Hex(), token.Hex())
+		}
+	}
+	var claimable []common.Address
+	for _, leaf := range earnings {
+		if len(tokens) > 0 && !containsAddress(tokens, leaf.Token) {
+			continue
+		}
+		claimed, err := rc.CumulativeClaimed(callOpts, earner, leaf.Token)
+		if err != nil {
+			return fmt.Errorf("failed to fetch cumulative claimed: %w", err)
+		}
+		amount := new(big.Int).Sub(leaf.CumulativeEarnings, claimed)
+		fmt.Printf("token %s: cumulative earnings %s, claimed %s, claimable %s\n", leaf.Token.Hex(), leaf.CumulativeEarnings, claimed, amount)
+		if amount.Sign() > 0 {
+			claimable = append(claimable, leaf.Token)
+		} else if len(tokens) > 0 {
+			return fmt.Errorf("%s has nothing to claim in token %s", earner.Hex(), leaf.Token.Hex())
+		}
+	}
+	if len(claimable) == 0 {
+		return fmt.Errorf("%s has nothing to claim", earner.Hex())
+	}
+
+	claim, err := d.Claim(rootIndex, earner, claimable...)
+	if err != nil {
+		return err
+	}
+	if err := rewards.VerifyClaim(d.Root(), claim); err != nil {
+		return fmt.Errorf("failed to verify claim: %w", err)
+	}
+	if err := rewards.CheckClaim(ctx, &rc.RewardsCoordinatorCaller, claim); err != nil {
+		return fmt.Errorf("claim would fail: %w", err)
+	}
+	fmt.Printf("claiming %d tokens for %s to %s\n", len(claimable), earner.Hex(), recipient.Hex())
+	receipt, err := s.send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return rc.ProcessClaim(opts, claim, recipient)
+	})
+	if err != nil || receipt == nil {
+		return err
+	}
+	for _, e := range receipts.Events(receipt, rewardsCoordinator, rc.ParseRewardsClaimed) {
+		fmt.Printf("RewardsClaimed: earner %s, recipient %s, token %s, amount %s\n", e.Earner.Hex(), e.Recipient.Hex(), e.Token.Hex(), e.ClaimedAmount)
+	}
+	return nil
+}
+
+// runRewardsAutoClaim periodically claims the rewards of earners whose claimer is the signer,
+// once their unclaimed amounts reach their thresholds and are worth the gas.
+func runRewardsAutoClaim(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("rewards auto-claim", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	distribution := fs.String("distribution", "", `path or URL of the distribution file of each root, where "{date}" is replaced with its calculation end date`)
+	earnersFlag := fs.String("earners", "", "comma-separated earners to claim for, whose claimer is the signer; defaults to the signer")
+	recipientFlag := fs.String("recipient", "", "address the rewards are paid to, instead of each earner")
+	tokensFlag := fs.String("tokens", "", "comma-separated tokens to claim, instead of every token with unclaimed earnings")
+	thresholdsFlag := fs.String("thresholds", "", "comma-separated token=amount minimum unclaimed amounts, such as 0x…=100")
+	maxGasPrice := fs.Float64("max-gas-price", 0, "gas price in gwei above which claims are deferred; 0 disables")
+	minValueToGas := fs.Float64("min-value-to-gas", 0, "minimum ratio of the USD value of a claim to its gas cost, priced by CoinGecko; 0 disables")
+	coingeckoKey := fs.String("coingecko-api-key", os.Getenv("COINGECKO_API_KEY"), "CoinGecko demo API key used by -min-value-to-gas")
+	interval := fs.Duration("interval", rewards.DefaultClaimInterval, "interval between passes over the earners")
+	once := fs.Bool("once", false, "make a single pass over the earners and exit")
+	_ = fs.Parse(args)
+	if *distribution == "" {
+		return errors.New("-distribution is required")
+	}
+	if f.dryRun {
+		return errors.New("rewards auto-claim sends its transactions and does not support -dry-run")
+	}
+	earners, err := parseAddresses("-earners", *earnersFlag)
+	if err != nil {
+		return err
+	}
+	tokens, err := parseAddresses("-tokens", *tokensFlag)
+	if err != nil {
+		return err
+	}
+	var recipient common.Address
+	if *recipientFlag != "" {
+		if !common.IsHexAddress(*recipientFlag) {
+			return fmt.Errorf("invalid -recipient %q", *recipientFlag)
+		}
+		recipient = common.HexToAddress(*recipientFlag)
+	}
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	rewardsCoordinator, ok := s.client.Address(addresses.RewardsCoordinator)
+	if !ok {
+		return fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, addresses.RewardsCoordinator, s.client.ChainID)
+	}
+	thresholds, err := parseThresholds(ctx, s.client.Backend, *thresholdsFlag)
+	if err != nil {
+		return err
+	}
+	cfg := rewards.ClaimerConfig{
+		Earners:    earners,
+		Recipient:  recipient,
+		Tokens:     tokens,
+		Thresholds: thresholds,
+		Interval:   *interval,
+		Logger:     slog.New(slog.NewTextHandler(os.Stderr, nil)),
+		Distribution: func(ctx context.Context, root rewards.Root) (*rewards.Distribution, error) {
+			endDate := time.Unix(int64(root.RewardsCalculationEndTimestamp), 0).UTC().Format(time.DateOnly)
+			return readDistribution(ctx, strings.ReplaceAll(*distribution, "{date}", endDate))
+		},
+	}
+	if *maxGasPrice > 0 {
+		cfg.MaxGasPrice, _ = new(big.Float).Mul(big.NewFloat(*maxGasPrice), big.NewFloat(1e9)).Int(nil)
+	}
+	if *minValueToGas > 0 {
+		cfg.MinValueToGasCost = *minValueToGas
+		cfg.Valuer = prices.NewValuer(&prices.CoinGecko{APIKey: *coingeckoKey}, prices.Config{})
+	}
+	claimer, err := rewards.NewClaimer(s.client.Backend, rewardsCoordinator, s.opts, cfg)
+	if err != nil {
+		return err
+	}
+	if !*once {
+		err := claimer.Run(ctx)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	}
+	results, err := claimer.ClaimAll(ctx)
+	for _, result := range results {
+		printClaimResult(result)
+	}
+	return err
+}
+
+func printClaimResult(result *rewards.ClaimResult) {
+	fmt.Printf("earner %s, root %d:\n", result.Earner.Hex(), result.RootIndex)
+	for token, amount := range result.Unclaimed {
+		fmt.Printf("  token %s: unclaimed %s\n", token.Hex(), amount)
+	}
+	switch {
+	case result.Tx != (common.Hash{}):
+		fmt.Printf("  claimed %d tokens in %s\n", len(result.Claimed), result.Tx.Hex())
+	case result.Deferred != "":
+		fmt.Printf("  deferred: %s\n", result.Deferred)
+	}
+}
+
+// parseAddresses parses the comma-separated addresses of flag name.
+func parseAddresses(name, value string) ([]common.Address, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var addrs []common.Address
+	for _, addr := range strings.Split(value, ",") {
+		if addr = strings.TrimSpace(addr); !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid address %q in %s", addr, name)
+		}
+		addrs = append(addrs, common.HexToAddress(addr))
+	}
+	return addrs, nil
+}
+
+// parseThresholds parses comma-separated token=amount pairs, scaling each decimal amount by the
+// decimals of its token.
+func parseThresholds(ctx context.Context, caller bind.ContractCaller, value string) (map[common.Address]*big.Int, error) {
+	thresholds := make(map[common.Address]*big.Int)
+	if value == "" {
+		return thresholds, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		addr, amount, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid threshold %q, want token=amount", pair)
+		}
+		token, err := amounts.FetchToken(ctx, caller, common.HexToAddress(addr))
+		if err != nil {
+			return nil, err
+		}
+		threshold, err := token.Parse(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold %q: %w", pair, err)
+		}
+		thresholds[token.Address] = threshold.Units()
+	}
+	return thresholds, nil
+}
+
+// readDistribution reads the distribution file at location, a path or an http(s) URL.
+func readDistribution(ctx context.Context, location string) (*rewards.Distribution, error) {
+	ctx, cancel := context.WithTimeout(ctx, distributionTimeout)
+	defer cancel()
+	var r io.ReadCloser
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download distribution: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to download distribution: %s responded %s", location, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		file, err := os.Open(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open distribution: %w", err)
+		}
+		r = file
+	}
+	defer r.Close()
+	d, err := rewards.ReadDistribution(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read distribution %s: %w", location, err)
+	}
+	return d, nil
+}
+
+func containsToken(leaves []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, token common.Address) bool {
+	for _, leaf := range leaves {
+		if leaf.Token == token {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/cache"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/decoder"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ledger"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/snapshot"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+)
+
+// secondsPerBlock is the slot time, used to turn withdrawal delays into durations.
+const secondsPerBlock = 12
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// runTUI shows a dashboard of the restaking position of a staker, redrawn on every refresh until
+// interrupted.
+func runTUI(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("tui", flag.ExitOnError)
+	rpcURL := fs.String("rpc", "", "JSON-RPC URL of an Ethereum node")
+	chainID := fs.Uint64("chain-id", addresses.ChainIDMainnet, "chain ID of the node")
+	stakerFlag := fs.String("staker", "", "staker whose position is shown")
+	fromBlock := fs.Uint64("from-block", 0, "first block searched for strategies and withdrawals, typically the deployment block of the core contracts")
+	refresh := fs.Duration("refresh", 12*time.Second, "interval at which the dashboard is refreshed")
+	maxEvents := fs.Int("events", 10, "number of recent events shown")
+	lookback := fs.Uint64("lookback", 1000, "number of blocks before the head the event feed starts at")
+	_ = fs.Parse(args)
+	if *rpcURL == "" {
+		return errors.New("-rpc is required")
+	}
+	if !common.IsHexAddress(*stakerFlag) {
+		return errors.New("-staker is required")
+	}
+
+	c, err := client.NewEigenLayerClient(ctx, *rpcURL, *chainID)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	d, err := newDashboard(ctx, c, common.HexToAddress(*stakerFlag), *fromBlock, *lookback, *maxEvents)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(*refresh)
+	defer ticker.Stop()
+	for {
+		d.refresh(ctx)
+		// Render into a buffer first, so the screen is redrawn in a single write.
+		var buf bytes.Buffer
+		buf.WriteString(clearScreen)
+		d.render(&buf)
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// cachedBackend serves the contract calls of a client.Backend through a cache.Caller.
+type cachedBackend struct {
+	client.Backend
+	caller *cache.Caller
+}
+
+func (b *cachedBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return b.caller.CallContract(ctx, call, blockNumber)
+}
+
+// position is the staker's shares in a strategy.
+type position struct {
+	strategy   strategies.Strategy
+	shares     *big.Int
+	underlying *big.Int
+}
+
+// pendingWithdrawal is a queued withdrawal of the staker and the block it can be completed in.
+type pendingWithdrawal struct {
+	ledger.Withdrawal
+	completableAt uint64
+}
+
+// dashboard holds the state shown by the TUI, as of its last refresh.
+type dashboard struct {
+	chainID    uint64
+	staker     common.Address
+	backend    *cachedBackend
+	registry   *strategies.Registry
+	ledger     *ledger.Ledger
+	manager    *StrategyManager.StrategyManagerCaller
+	delegation *DelegationManager.DelegationManagerCaller
+	stream     *events.Stream[decoder.Decoded]
+	maxEvents  int
+
+	head        uint64
+	headTime    time.Time
+	tvl         *snapshot.Snapshot
+	positions   []position
+	withdrawals []pendingWithdrawal
+	// events holds the most recent events, oldest first.
+	events []*events.Notification[decoder.Decoded]
+	// err is the error of the last refresh, if it failed. The state of the last successful
+	// refresh is kept.
+	err error
+}
+
+func newDashboard(ctx context.Context, c *client.EigenLayerClient, staker common.Address, fromBlock, lookback uint64, maxEvents int) (*dashboard, error) {
+	backend := &cachedBackend{Backend: c.Backend, caller: cache.NewCaller(c.Backend, cache.Config{})}
+	strategyManager, _ := c.Address(addresses.StrategyManager)
+	strategyFactory, _ := c.Address(addresses.StrategyFactory)
+	delegationManager, _ := c.Address(addresses.DelegationManager)
+
+	registry, err := strategies.NewRegistry(backend, strategies.Config{
+		StrategyManager: strategyManager,
+		StrategyFactory: strategyFactory,
+		FromBlock:       fromBlock,
+	})
+	if err != nil {
+		return nil, err
+	}
+	l, err := ledger.New(backend, ledger.Config{
+		StrategyManager:   strategyManager,
+		DelegationManager: delegationManager,
+		FromBlock:         fromBlock,
+	})
+	if err != nil {
+		return nil, err
+	}
+	manager, err := StrategyManager.NewStrategyManagerCaller(strategyManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := DelegationManager.NewDelegationManagerCaller(delegationManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := decoder.NewForChain(addresses.Default, c.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.Backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	start := uint64(1)
+	if head > lookback {
+		start = head - lookback
+	}
+	// Events are finalized as soon as they are seen, so that each is reported once.
+	stream := events.NewStream(backend, ethereum.FilterQuery{
+		Addresses: []common.Address{strategyManager, delegationManager},
+	}, dec.DecodeLog, events.Config{FromBlock: start})
+
+	return &dashboard{
+		chainID:    c.ChainID,
+		staker:     staker,
+		backend:    backend,
+		registry:   registry,
+		ledger:     l,
+		manager:    manager,
+		delegation: delegation,
+		stream:     stream,
+		maxEvents:  maxEvents,
+	}, nil
+}
+
+// refresh reads the state at the chain head. A failed refresh keeps the previous state and is
+// shown as an error.
+func (d *dashboard) refresh(ctx context.Context) {
+	d.err = d.fetch(ctx)
+}
+
+func (d *dashboard) fetch(ctx context.Context) error {
+	header, err := d.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head: %w", err)
+	}
+	head := header.Number.Uint64()
+	d.backend.caller.SetHead(head)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	list, err := d.registry.ListStrategies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list strategies: %w", err)
+	}
+	tvl, err := snapshot.Take(ctx, d.backend, list, header.Number)
+	if err != nil {
+		return err
+	}
+	positions, err := d.fetchPositions(opts, list)
+	if err != nil {
+		return err
+	}
+	if _, err := d.ledger.Sync(ctx); err != nil {
+		return fmt.Errorf("failed to sync withdrawals: %w", err)
+	}
+	var withdrawals []pendingWithdrawal
+	for _, w := range d.ledger.PendingWithdrawals(d.staker) {
+		delay, err := d.delegation.GetWithdrawalDelay(opts, w.Strategies)
+		if err != nil {
+			return fmt.Errorf("failed to fetch withdrawal delay: %w", err)
+		}
+		withdrawals = append(withdrawals, pendingWithdrawal{Withdrawal: w, completableAt: uint64(w.StartBlock) + delay.Uint64()})
+	}
+	notifications, err := d.stream.Poll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to poll events: %w", err)
+	}
+
+	d.head, d.headTime = head, time.Unix(int64(header.Time), 0)
+	d.tvl, d.positions, d.withdrawals = tvl, positions, withdrawals
+	d.events = append(d.events, notifications...)
+	if len(d.events) > d.maxEvents {
+		d.events = d.events[len(d.events)-d.maxEvents:]
+	}
+	return nil
+}
+
+// fetchPositions reads the staker's shares in each strategy and their value in the underlying
+// token.
+func (d *dashboard) fetchPositions(opts *bind.CallOpts, list []strategies.Strategy) ([]position, error) {
+	byAddress := make(map[common.Address]strategies.Strategy, len(list))
+	for _, s := range list {
+		byAddress[s.Address] = s
+	}
+	addrs, shares, err := d.manager.GetDeposits(opts, d.staker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deposits: %w", err)
+	}
+	positions := make([]position, len(addrs))
+	for i, addr := range addrs {
+		s, ok := byAddress[addr]
+		if !ok {
+			// A strategy removed from the whitelist still holds the staker's shares.
+			s = strategies.Strategy{Address: addr, Symbol: "?", Decimals: 18}
+		}
+		caller, err := StrategyBase.NewStrategyBaseCaller(addr, d.backend)
+		if err != nil {
+			return nil, err
+		}
+		underlying, err := caller.SharesToUnderlyingView(opts, shares[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch underlying value of %s: %w", addr.Hex(), err)
+		}
+		positions[i] = position{strategy: s, shares: shares[i], underlying: underlying}
+	}
+	return positions, nil
+}
+
+// render draws the dashboard to w.
+func (d *dashboard) render(w io.Writer) {
+	fmt.Fprintf(w, "EigenLayer on chain %d, block %d at %s, staker %s\n", d.chainID, d.head, d.headTime.UTC().Format(time.DateTime), d.staker.Hex())
+	if d.err != nil {
+		fmt.Fprintf(w, "refresh failed: %v\n", d.err)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nSTRATEGIES\t\t\t")
+	fmt.Fprintln(tw, "SYMBOL\tSTRATEGY\tTOTAL SHARES\tTVL")
+	if d.tvl != nil {
+		for _, row := range d.tvl.Rows {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.Symbol, row.Strategy.Hex(), amounts.FormatUnits(row.TotalShares, row.Decimals), row.UnderlyingUnits())
+		}
+	}
+
+	fmt.Fprintln(tw, "\nPOSITION\t\t\t")
+	fmt.Fprintln(tw, "SYMBOL\tSTRATEGY\tSHARES\tUNDERLYING")
+	for _, p := range d.positions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", p.strategy.Symbol, p.strategy.Address.Hex(), amounts.FormatUnits(p.shares, p.strategy.Decimals), amounts.FormatUnits(p.underlying, p.strategy.Decimals))
+	}
+
+	fmt.Fprintln(tw, "\nQUEUED WITHDRAWALS\t\t\t")
+	fmt.Fprintln(tw, "ROOT\tSTRATEGIES\tCOMPLETABLE AT\tREMAINING")
+	for _, w := range d.withdrawals {
+		remaining := "ready"
+		if w.completableAt > d.head {
+			blocks := w.completableAt - d.head
+			remaining = fmt.Sprintf("%d blocks, ~%s", blocks, time.Duration(blocks*secondsPerBlock)*time.Second)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", w.Root.Hex(), len(w.Strategies), w.completableAt, remaining)
+	}
+
+	fmt.Fprintln(tw, "\nRECENT EVENTS\t\t\t")
+	fmt.Fprintln(tw, "BLOCK\tEVENT\tTRANSACTION\t")
+	for _, n := range d.events {
+		fmt.Fprintf(tw, "%d\t%s.%s\t%s\t\n", n.Log.BlockNumber, n.Event.Contract, n.Event.Name, n.Log.TxHash.Hex())
+	}
+	tw.Flush()
+}
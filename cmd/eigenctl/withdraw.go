@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/pausing"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/shares"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/withdrawals"
+)
+
+// runWithdraw queues a withdrawal of the shares worth an amount of a token from its strategy,
+// and optionally waits out the withdrawal delay and completes it.
+func runWithdraw(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("withdraw", flag.ExitOnError)
+	var f commonFlags
+	f.register(fs)
+	var sf strategyFlags
+	sf.register(fs)
+	amountFlag := fs.String("amount", "", `amount of the underlying token to withdraw, such as 1.5, or "all" for every share`)
+	complete := fs.Bool("complete", false, "wait for the withdrawal delay and complete the withdrawal, receiving the tokens")
+	_ = fs.Parse(args)
+
+	s, err := f.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.client.Close()
+	if s.strategy, err = sf.resolve(ctx, s.client); err != nil {
+		return err
+	}
+	delegationManager, _ := s.client.Address(addresses.DelegationManager)
+	callOpts := client.CallOpts(ctx)
+
+	held, err := s.client.StrategyManager.StakerStrategyShares(callOpts, s.opts.From, s.strategy.Address)
+	if err != nil {
+		return fmt.Errorf("failed to fetch staker shares: %w", err)
+	}
+	var amountShares *big.Int
+	if *amountFlag == "all" {
+		amountShares = held
+	} else {
+		amount, err := s.amount(*amountFlag)
+		if err != nil {
+			return err
+		}
+		state, err := shares.Fetch(ctx, s.client.Backend, s.strategy.Address)
+		if err != nil {
+			return err
+		}
+		if amountShares, err = state.UnderlyingToShares(amount); err != nil {
+			return err
+		}
+	}
+	switch {
+	case amountShares.Sign() == 0:
+		return fmt.Errorf("%s holds no shares of strategy %s to withdraw", s.opts.From.Hex(), s.strategy.Address.Hex())
+	case amountShares.Cmp(held) > 0:
+		return fmt.Errorf("withdrawal would fail: %s shares exceed the %s held", s.format(amountShares), s.format(held))
+	}
+	if err := s.checkNotPaused(ctx, pausing.DelegationManagerEnterWithdrawalQueue); err != nil {
+		return fmt.Errorf("withdrawal would fail: %w", err)
+	}
+	fmt.Printf("withdrawing %s shares of strategy %s (%s) from %s\n",
+		s.format(amountShares), s.strategy.Address.Hex(), s.strategy.Symbol, s.opts.From.Hex())
+	if f.dryRun {
+		return nil
+	}
+
+	m, err := withdrawals.NewManager(s.client.Backend, delegationManager, s.opts)
+	if err != nil {
+		return err
+	}
+	queued, err := m.Queue(ctx, []common.Address{s.strategy.Address}, []*big.Int{amountShares})
+	if err != nil {
+		return err
+	}
+	completableAt, err := queued.CompletableAt(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("WithdrawalQueued: root %s, withdrawer %s, nonce %s, start block %d, completable at block %d\n",
+		queued.Root.Hex(), queued.Withdrawal.Withdrawer.Hex(), queued.Withdrawal.Nonce, queued.Withdrawal.StartBlock, completableAt)
+	if !*complete {
+		return nil
+	}
+
+	receipt, err := queued.CompleteWhenReady(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("transaction %s mined in block %s\n", receipt.TxHash.Hex(), receipt.BlockNumber)
+	for _, e := range receipts.Events(receipt, delegationManager, s.client.DelegationManager.ParseWithdrawalCompleted) {
+		fmt.Printf("WithdrawalCompleted: root %s\n", common.Hash(e.WithdrawalRoot).Hex())
+	}
+	return nil
+}
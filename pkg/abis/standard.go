@@ -0,0 +1,58 @@
+package abis
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// The ABIs of standard contracts that have no binding in pkg/bindings, limited to the methods
+// used by this module.
+var (
+	// ERC20 holds the ERC-20 metadata, balance and allowance methods, and OpenZeppelin's
+	// increaseAllowance.
+	ERC20 = MustParse(`[
+{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"increaseAllowance","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"addedValue","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`)
+
+	// Ownable holds the OpenZeppelin 4.x Ownable methods.
+	Ownable = MustParse(`[
+{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[{"name":"newOwner","type":"address"}],"outputs":[]}
+]`)
+
+	// ProxyAdmin holds the OpenZeppelin 4.x ProxyAdmin methods.
+	ProxyAdmin = MustParse(`[
+{"type":"function","name":"upgrade","stateMutability":"nonpayable","inputs":[{"name":"proxy","type":"address"},{"name":"implementation","type":"address"}],"outputs":[]},
+{"type":"function","name":"upgradeAndCall","stateMutability":"payable","inputs":[{"name":"proxy","type":"address"},{"name":"implementation","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+{"type":"function","name":"getProxyImplementation","stateMutability":"view","inputs":[{"name":"proxy","type":"address"}],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"getProxyAdmin","stateMutability":"view","inputs":[{"name":"proxy","type":"address"}],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"changeProxyAdmin","stateMutability":"nonpayable","inputs":[{"name":"proxy","type":"address"},{"name":"newAdmin","type":"address"}],"outputs":[]},
+{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[{"name":"newOwner","type":"address"}],"outputs":[]}
+]`)
+
+	// UpgradeableBeacon holds the OpenZeppelin 4.x UpgradeableBeacon constructor and methods.
+	UpgradeableBeacon = MustParse(`[
+{"type":"constructor","inputs":[{"name":"implementation_","type":"address"}],"stateMutability":"nonpayable"},
+{"type":"function","name":"implementation","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"upgradeTo","stateMutability":"nonpayable","inputs":[{"name":"newImplementation","type":"address"}],"outputs":[]},
+{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[{"name":"newOwner","type":"address"}],"outputs":[]}
+]`)
+)
+
+// MustParse parses the JSON ABI s, panicking if it is invalid. It is meant for the ABI literals of
+// package-level variables.
+func MustParse(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
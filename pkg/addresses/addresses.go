@@ -0,0 +1,201 @@
+// Package addresses maps chain IDs to the canonical deployed addresses of the EigenLayer core contracts.
+package addresses
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Chain IDs of networks with known EigenLayer deployments.
+const (
+	ChainIDMainnet uint64 = 1
+	ChainIDHolesky uint64 = 17000
+	// ChainIDSepolia has no deployment of this release: Resolve returns ErrUnsupportedChain for it
+	// unless its addresses are set with Override.
+	ChainIDSepolia uint64 = 11155111
+)
+
+// Contract names accepted by Resolve. Proxied contracts resolve to their proxy address.
+const (
+	DelegationManager       = "DelegationManager"
+	StrategyManager         = "StrategyManager"
+	EigenPodManager         = "EigenPodManager"
+	AVSDirectory            = "AVSDirectory"
+	Slasher                 = "Slasher"
+	RewardsCoordinator      = "RewardsCoordinator"
+	StrategyFactory         = "StrategyFactory"
+	StrategyBeacon          = "StrategyBeacon"
+	EigenPodBeacon          = "EigenPodBeacon"
+	DelayedWithdrawalRouter = "DelayedWithdrawalRouter"
+	EigenStrategy           = "EigenStrategy"
+	Eigen                   = "Eigen"
+	BackingEigen            = "BackingEigen"
+	PauserRegistry          = "PauserRegistry"
+	ProxyAdmin              = "ProxyAdmin"
+	Timelock                = "Timelock"
+)
+
+// BeaconChainETHStrategy is the placeholder strategy address used for beacon chain ETH shares on every chain.
+// It is not a contract.
+var BeaconChainETHStrategy = common.HexToAddress("0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0")
+
+var (
+	// ErrUnknownChain is returned when no deployment is known for a chain ID.
+	ErrUnknownChain = errors.New("unknown chain")
+	// ErrUnsupportedChain is returned for a public network this release of the contracts is not
+	// deployed on, such as Sepolia.
+	ErrUnsupportedChain = errors.New("unsupported chain")
+	// ErrUnknownContract is returned when a deployment does not include the requested contract.
+	ErrUnknownContract = errors.New("unknown contract")
+)
+
+// deployments lists the canonical addresses from the deployment tables in the repository README.
+var deployments = map[uint64]map[string]common.Address{
+	ChainIDMainnet: {
+		DelegationManager:       common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+		StrategyManager:         common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A"),
+		EigenPodManager:         common.HexToAddress("0x91E677b07F7AF907ec9a428aafA9fc14a0d3A338"),
+		AVSDirectory:            common.HexToAddress("0x135DDa560e946695d6f155dACaFC6f1F25C1F5AF"),
+		Slasher:                 common.HexToAddress("0xD92145c07f8Ed1D392c1B88017934E301CC1c3Cd"),
+		RewardsCoordinator:      common.HexToAddress("0x7750d328b314EfFa365A0402CcfD489B80B0adda"),
+		StrategyFactory:         common.HexToAddress("0x5e4C39Ad7A3E881585e383dB9827EB4811f6F647"),
+		StrategyBeacon:          common.HexToAddress("0x0ed6703C298d28aE0878d1b28e88cA87F9662fE9"),
+		EigenPodBeacon:          common.HexToAddress("0x5a2a4F2F3C18f09179B6703e63D9eDD165909073"),
+		DelayedWithdrawalRouter: common.HexToAddress("0x7Fe7E9CC0F274d2435AD5d56D5fa73E47F6A23D8"),
+		EigenStrategy:           common.HexToAddress("0xaCB55C530Acdb2849e6d4f36992Cd8c9D50ED8F7"),
+		Eigen:                   common.HexToAddress("0xec53bF9167f50cDEB3Ae105f56099aaaB9061F83"),
+		BackingEigen:            common.HexToAddress("0x83E9115d334D248Ce39a6f36144aEaB5b3456e75"),
+		PauserRegistry:          common.HexToAddress("0x0c431C66F4dE941d089625E5B423D00707977060"),
+		ProxyAdmin:              common.HexToAddress("0x8b9566AdA63B64d1E1dcF1418b43fd1433b72444"),
+		Timelock:                common.HexToAddress("0xA6Db1A8C5a981d1536266D2a393c5F8dDb210EAF"),
+	},
+	ChainIDHolesky: {
+		DelegationManager:       common.HexToAddress("0xA44151489861Fe9e3055d95adC98FbD462B948e7"),
+		StrategyManager:         common.HexToAddress("0xdfB5f6CE42aAA7830E94ECFCcAd411beF4d4D5b6"),
+		EigenPodManager:         common.HexToAddress("0x30770d7E3e71112d7A6b7259542D1f680a70e315"),
+		AVSDirectory:            common.HexToAddress("0x055733000064333CaDDbC92763c58BF0192fFeBf"),
+		Slasher:                 common.HexToAddress("0xcAe751b75833ef09627549868A04E32679386e7C"),
+		RewardsCoordinator:      common.HexToAddress("0xAcc1fb458a1317E886dB376Fc8141540537E68fE"),
+		StrategyFactory:         common.HexToAddress("0x9c01252B580efD11a05C00Aa42Dd3ac1Ec52DF6d"),
+		StrategyBeacon:          common.HexToAddress("0xd3c6C6BA4E40dB9288c6a2077e5635344F8aFA4F"),
+		EigenPodBeacon:          common.HexToAddress("0x7261C2bd75a7ACE1762f6d7FAe8F63215581832D"),
+		DelayedWithdrawalRouter: common.HexToAddress("0x642c646053eaf2254f088e9019ACD73d9AE0FA32"),
+		EigenStrategy:           common.HexToAddress("0x43252609bff8a13dFe5e057097f2f45A24387a84"),
+		Eigen:                   common.HexToAddress("0x3B78576F7D6837500bA3De27A60c7f594934027E"),
+		BackingEigen:            common.HexToAddress("0x275cCf9Be51f4a6C94aBa6114cdf2a4c45B9cb27"),
+		PauserRegistry:          common.HexToAddress("0x85Ef7299F8311B25642679edBF02B62FA2212F06"),
+		ProxyAdmin:              common.HexToAddress("0xDB023566064246399b4AE851197a97729C93A6cf"),
+		Timelock:                common.HexToAddress("0xcF19CE0561052a7A7Ff21156730285997B350A7D"),
+	},
+}
+
+// unsupportedChains names the public networks without a deployment of this release, which the
+// README's deployment tables do not list.
+var unsupportedChains = map[uint64]string{
+	ChainIDSepolia: "Sepolia",
+}
+
+// unknownChainError returns the error for chainID, which has neither a deployment nor overrides.
+func unknownChainError(chainID uint64) error {
+	if name, ok := unsupportedChains[chainID]; ok {
+		return fmt.Errorf("%w %d (%s): no EigenLayer deployment of this release, set its addresses with Override", ErrUnsupportedChain, chainID, name)
+	}
+	return fmt.Errorf("%w %d", ErrUnknownChain, chainID)
+}
+
+// Registry resolves contract addresses, consulting overrides before the canonical deployments.
+// It is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	overrides map[uint64]map[string]common.Address
+}
+
+// NewRegistry returns a Registry with no overrides.
+func NewRegistry() *Registry {
+	return &Registry{overrides: make(map[uint64]map[string]common.Address)}
+}
+
+// Default is the Registry used by the package-level functions.
+var Default = NewRegistry()
+
+// Override sets the address of contractName on chainID, taking precedence over any canonical deployment.
+// It is intended for local devnets and forks.
+func (r *Registry) Override(chainID uint64, contractName string, addr common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.overrides[chainID] == nil {
+		r.overrides[chainID] = make(map[string]common.Address)
+	}
+	r.overrides[chainID][contractName] = addr
+}
+
+// Resolve returns the address of contractName on chainID.
+func (r *Registry) Resolve(chainID uint64, contractName string) (common.Address, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if addr, ok := r.overrides[chainID][contractName]; ok {
+		return addr, nil
+	}
+	deployment, ok := deployments[chainID]
+	if !ok {
+		if _, overridden := r.overrides[chainID]; overridden {
+			return common.Address{}, fmt.Errorf("%w %s on chain %d", ErrUnknownContract, contractName, chainID)
+		}
+		return common.Address{}, unknownChainError(chainID)
+	}
+	addr, ok := deployment[contractName]
+	if !ok {
+		return common.Address{}, fmt.Errorf("%w %s on chain %d", ErrUnknownContract, contractName, chainID)
+	}
+	return addr, nil
+}
+
+// Deployment returns the addresses of every contract known on chainID, including overrides.
+func (r *Registry) Deployment(chainID uint64) (map[string]common.Address, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, known := deployments[chainID]
+	_, overridden := r.overrides[chainID]
+	if !known && !overridden {
+		return nil, unknownChainError(chainID)
+	}
+	out := make(map[string]common.Address, len(deployments[chainID])+len(r.overrides[chainID]))
+	for name, addr := range deployments[chainID] {
+		out[name] = addr
+	}
+	for name, addr := range r.overrides[chainID] {
+		out[name] = addr
+	}
+	return out, nil
+}
+
+// Chains returns the chain IDs with a canonical deployment or overrides, in increasing order.
+func (r *Registry) Chains() []uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	chainIDs := make([]uint64, 0, len(deployments)+len(r.overrides))
+	for chainID := range deployments {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range r.overrides {
+		if _, known := deployments[chainID]; !known {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	return chainIDs
+}
+
+// Override sets an override on the Default registry.
+func Override(chainID uint64, contractName string, addr common.Address) {
+	Default.Override(chainID, contractName, addr)
+}
+
+// Resolve resolves contractName on chainID using the Default registry.
+func Resolve(chainID uint64, contractName string) (common.Address, error) {
+	return Default.Resolve(chainID, contractName)
+}
@@ -0,0 +1,127 @@
+package addresses
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type override struct {
+	chainID  uint64
+	contract string
+	addr     common.Address
+}
+
+func TestResolve(t *testing.T) {
+	devnet := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	fork := common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512")
+
+	tests := []struct {
+		name      string
+		overrides []override
+		chainID   uint64
+		contract  string
+		want      common.Address
+		wantErr   error
+	}{
+		{
+			name:     "mainnet",
+			chainID:  ChainIDMainnet,
+			contract: DelegationManager,
+			want:     common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+		},
+		{
+			name:     "holesky",
+			chainID:  ChainIDHolesky,
+			contract: StrategyManager,
+			want:     common.HexToAddress("0xdfB5f6CE42aAA7830E94ECFCcAd411beF4d4D5b6"),
+		},
+		{
+			name:     "unknown contract",
+			chainID:  ChainIDMainnet,
+			contract: "RegistryCoordinator",
+			wantErr:  ErrUnknownContract,
+		},
+		{
+			name:     "unknown chain",
+			chainID:  31337,
+			contract: DelegationManager,
+			wantErr:  ErrUnknownChain,
+		},
+		{
+			name:     "sepolia",
+			chainID:  ChainIDSepolia,
+			contract: DelegationManager,
+			wantErr:  ErrUnsupportedChain,
+		},
+		{
+			name:      "override of a deployment",
+			overrides: []override{{ChainIDMainnet, DelegationManager, fork}},
+			chainID:   ChainIDMainnet,
+			contract:  DelegationManager,
+			want:      fork,
+		},
+		{
+			name:      "override leaves other contracts",
+			overrides: []override{{ChainIDMainnet, DelegationManager, fork}},
+			chainID:   ChainIDMainnet,
+			contract:  StrategyManager,
+			want:      common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A"),
+		},
+		{
+			name:      "override of a devnet",
+			overrides: []override{{31337, DelegationManager, devnet}},
+			chainID:   31337,
+			contract:  DelegationManager,
+			want:      devnet,
+		},
+		{
+			name:      "contract missing from a devnet",
+			overrides: []override{{31337, DelegationManager, devnet}},
+			chainID:   31337,
+			contract:  StrategyManager,
+			wantErr:   ErrUnknownContract,
+		},
+		{
+			name:      "override of sepolia",
+			overrides: []override{{ChainIDSepolia, DelegationManager, devnet}},
+			chainID:   ChainIDSepolia,
+			contract:  DelegationManager,
+			want:      devnet,
+		},
+		{
+			name:      "last override wins",
+			overrides: []override{{31337, DelegationManager, devnet}, {31337, DelegationManager, fork}},
+			chainID:   31337,
+			contract:  DelegationManager,
+			want:      fork,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewRegistry()
+			for _, o := range test.overrides {
+				r.Override(o.chainID, o.contract, o.addr)
+			}
+			got, err := r.Resolve(test.chainID, test.contract)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Resolve(%d, %s) error = %v, want %v", test.chainID, test.contract, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("Resolve(%d, %s) = %s, want %s", test.chainID, test.contract, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSepoliaUnsupported(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.Deployment(ChainIDSepolia); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("Deployment(Sepolia) error = %v, want %v", err, ErrUnsupportedChain)
+	}
+	if chains := r.Chains(); !reflect.DeepEqual(chains, []uint64{ChainIDMainnet, ChainIDHolesky}) {
+		t.Errorf("Chains = %v, want mainnet and holesky", chains)
+	}
+}
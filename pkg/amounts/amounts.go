@@ -0,0 +1,284 @@
+// Package amounts provides amounts of tokens and of strategy shares that carry the decimals of
+// their token, so that they are parsed and formatted in the right units and cannot be mixed up.
+//
+// TokenAmount and Shares are distinct types: an amount of a token cannot be passed where shares
+// are expected, or the other way around, without an explicit conversion through the strategy.
+// Their arithmetic fails with ErrMismatch for amounts of different tokens or strategies:
+//
+//	amount, err := amounts.Parse("1.5 stETH", tokens)
+//	fmt.Println(amount)         // 1.5 stETH
+//	fmt.Println(amount.Units()) // 1500000000000000000
+package amounts
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrMismatch is returned by the arithmetic of amounts of different tokens or strategies.
+var ErrMismatch = errors.New("amounts of different tokens")
+
+// Token is the metadata of an ERC-20 token.
+type Token struct {
+	Address  common.Address
+	Symbol   string
+	Decimals uint8
+}
+
+// ETH is native ether, the token of beacon chain ETH shares. It has no address.
+var ETH = Token{Symbol: "ETH", Decimals: 18}
+
+// String returns the symbol of the token, or its address if it has none.
+func (t Token) String() string {
+	if t.Symbol != "" {
+		return t.Symbol
+	}
+	return t.Address.Hex()
+}
+
+// Amount returns the amount of units base units of the token.
+func (t Token) Amount(units *big.Int) TokenAmount {
+	return TokenAmount{token: t, units: copyInt(units)}
+}
+
+// Parse parses a decimal amount of the token, such as "1.5", optionally followed by its symbol.
+func (t Token) Parse(s string) (TokenAmount, error) {
+	number, unit, _ := strings.Cut(strings.TrimSpace(s), " ")
+	if unit = strings.TrimSpace(unit); unit != "" && !strings.EqualFold(unit, t.Symbol) && !strings.EqualFold(unit, t.Address.Hex()) {
+		return TokenAmount{}, fmt.Errorf("%w: %q is not an amount of %s", ErrMismatch, s, t)
+	}
+	units, err := ParseUnits(number, t.Decimals)
+	if err != nil {
+		return TokenAmount{}, err
+	}
+	return TokenAmount{token: t, units: units}, nil
+}
+
+// TokenAmount is an amount of a token. The zero value is zero of no token.
+type TokenAmount struct {
+	token Token
+	units *big.Int
+}
+
+// Token returns the token of the amount.
+func (a TokenAmount) Token() Token {
+	return a.token
+}
+
+// Units returns the amount in base units of the token.
+func (a TokenAmount) Units() *big.Int {
+	return copyInt(a.units)
+}
+
+// Sign returns -1, 0 or 1 for negative, zero and positive amounts.
+func (a TokenAmount) Sign() int {
+	if a.units == nil {
+		return 0
+	}
+	return a.units.Sign()
+}
+
+// Add returns a + b.
+func (a TokenAmount) Add(b TokenAmount) (TokenAmount, error) {
+	if err := a.check(b); err != nil {
+		return TokenAmount{}, err
+	}
+	return TokenAmount{token: a.token, units: new(big.Int).Add(a.Units(), b.Units())}, nil
+}
+
+// Sub returns a - b.
+func (a TokenAmount) Sub(b TokenAmount) (TokenAmount, error) {
+	if err := a.check(b); err != nil {
+		return TokenAmount{}, err
+	}
+	return TokenAmount{token: a.token, units: new(big.Int).Sub(a.Units(), b.Units())}, nil
+}
+
+// Cmp compares a and b, returning -1, 0 or 1 as by big.Int.Cmp.
+func (a TokenAmount) Cmp(b TokenAmount) (int, error) {
+	if err := a.check(b); err != nil {
+		return 0, err
+	}
+	return a.Units().Cmp(b.Units()), nil
+}
+
+func (a TokenAmount) check(b TokenAmount) error {
+	if a.token.Address != b.token.Address {
+		return fmt.Errorf("%w: %s and %s", ErrMismatch, a.token, b.token)
+	}
+	return nil
+}
+
+// Decimal formats the amount as a decimal in whole tokens, such as 1.5.
+func (a TokenAmount) Decimal() string {
+	return FormatUnits(a.Units(), a.token.Decimals)
+}
+
+// String formats the amount in whole tokens followed by the token, such as 1.5 stETH.
+func (a TokenAmount) String() string {
+	return a.Decimal() + " " + a.token.String()
+}
+
+// Shares is an amount of shares of a strategy. Shares have the decimals of the strategy's
+// underlying token. The zero value is zero shares of no strategy.
+type Shares struct {
+	strategy common.Address
+	decimals uint8
+	units    *big.Int
+}
+
+// NewShares returns units base units of shares of strategy, whose token has decimals.
+func NewShares(strategy common.Address, decimals uint8, units *big.Int) Shares {
+	return Shares{strategy: strategy, decimals: decimals, units: copyInt(units)}
+}
+
+// ParseShares parses a decimal amount of shares of strategy, whose token has decimals, such as
+// "1.5", optionally followed by "shares".
+func ParseShares(s string, strategy common.Address, decimals uint8) (Shares, error) {
+	number, unit, _ := strings.Cut(strings.TrimSpace(s), " ")
+	if unit = strings.TrimSpace(unit); unit != "" && unit != "shares" {
+		return Shares{}, fmt.Errorf("%q is not an amount of shares", s)
+	}
+	units, err := ParseUnits(number, decimals)
+	if err != nil {
+		return Shares{}, err
+	}
+	return Shares{strategy: strategy, decimals: decimals, units: units}, nil
+}
+
+// Strategy returns the strategy of the shares.
+func (s Shares) Strategy() common.Address {
+	return s.strategy
+}
+
+// Decimals returns the decimals of the shares.
+func (s Shares) Decimals() uint8 {
+	return s.decimals
+}
+
+// Units returns the amount in base units of shares.
+func (s Shares) Units() *big.Int {
+	return copyInt(s.units)
+}
+
+// Sign returns -1, 0 or 1 for negative, zero and positive amounts.
+func (s Shares) Sign() int {
+	if s.units == nil {
+		return 0
+	}
+	return s.units.Sign()
+}
+
+// Add returns s + t.
+func (s Shares) Add(t Shares) (Shares, error) {
+	if err := s.check(t); err != nil {
+		return Shares{}, err
+	}
+	return Shares{strategy: s.strategy, decimals: s.decimals, units: new(big.Int).Add(s.Units(), t.Units())}, nil
+}
+
+// Sub returns s - t.
+func (s Shares) Sub(t Shares) (Shares, error) {
+	if err := s.check(t); err != nil {
+		return Shares{}, err
+	}
+	return Shares{strategy: s.strategy, decimals: s.decimals, units: new(big.Int).Sub(s.Units(), t.Units())}, nil
+}
+
+// Cmp compares s and t, returning -1, 0 or 1 as by big.Int.Cmp.
+func (s Shares) Cmp(t Shares) (int, error) {
+	if err := s.check(t); err != nil {
+		return 0, err
+	}
+	return s.Units().Cmp(t.Units()), nil
+}
+
+func (s Shares) check(t Shares) error {
+	if s.strategy != t.strategy {
+		return fmt.Errorf("%w: shares of %s and %s", ErrMismatch, s.strategy.Hex(), t.strategy.Hex())
+	}
+	return nil
+}
+
+// Decimal formats the shares as a decimal in whole shares, such as 1.5.
+func (s Shares) Decimal() string {
+	return FormatUnits(s.Units(), s.decimals)
+}
+
+// String formats the shares in whole shares, such as 1.5 shares.
+func (s Shares) String() string {
+	return s.Decimal() + " shares"
+}
+
+// Parse parses an amount of one of tokens, such as "1.5 stETH", where the token is named by its
+// symbol, matched case-insensitively, or by its address.
+func Parse(s string, tokens []Token) (TokenAmount, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return TokenAmount{}, fmt.Errorf("invalid amount %q: expected an amount and a token, such as 1.5 stETH", s)
+	}
+	var matches []Token
+	for _, token := range tokens {
+		if strings.EqualFold(token.Symbol, fields[1]) || (common.IsHexAddress(fields[1]) && token.Address == common.HexToAddress(fields[1])) {
+			matches = append(matches, token)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return TokenAmount{}, fmt.Errorf("unknown token %s", fields[1])
+	case 1:
+		return matches[0].Parse(fields[0])
+	}
+	return TokenAmount{}, fmt.Errorf("token symbol %s is ambiguous, name the token by its address", fields[1])
+}
+
+// ParseUnits parses a decimal amount of a token with decimals, such as "1.5", into its base
+// units. It fails if the amount has more fractional digits than the token.
+func ParseUnits(s string, decimals uint8) (*big.Int, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" && frac == "" {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	if len(frac) > int(decimals) {
+		return nil, fmt.Errorf("amount %q has more than %d decimals", s, decimals)
+	}
+	digits := whole + frac + strings.Repeat("0", int(decimals)-len(frac))
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid amount %q", s)
+		}
+	}
+	amount, _ := new(big.Int).SetString(digits, 10)
+	return amount, nil
+}
+
+// FormatUnits formats an amount of base units of a token with decimals as a decimal, without
+// trailing zeros.
+func FormatUnits(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return "0"
+	}
+	s := new(big.Int).Abs(amount).String()
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if amount.Sign() < 0 {
+		whole = "-" + whole
+	}
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+func copyInt(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(x)
+}
@@ -0,0 +1,30 @@
+package amounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// FetchToken reads the symbol and decimals of the ERC-20 token at addr.
+func FetchToken(ctx context.Context, caller bind.ContractCaller, addr common.Address) (Token, error) {
+	erc20 := bind.NewBoundContract(addr, abis.ERC20, caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+	var symbolOut, decimalsOut []interface{}
+	if err := erc20.Call(opts, &symbolOut, "symbol"); err != nil {
+		return Token{}, fmt.Errorf("failed to fetch symbol of %s: %w", addr.Hex(), err)
+	}
+	if err := erc20.Call(opts, &decimalsOut, "decimals"); err != nil {
+		return Token{}, fmt.Errorf("failed to fetch decimals of %s: %w", addr.Hex(), err)
+	}
+	return Token{
+		Address:  addr,
+		Symbol:   *abi.ConvertType(symbolOut[0], new(string)).(*string),
+		Decimals: *abi.ConvertType(decimalsOut[0], new(uint8)).(*uint8),
+	}, nil
+}
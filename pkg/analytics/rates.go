@@ -0,0 +1,206 @@
+// Package analytics tracks the exchange rates of strategies over time and derives their yield.
+//
+// A Sampler records each strategy's sharesToUnderlyingView(1e18), the underlying token per 1e18
+// shares, into a Store, either on a schedule with Run or at past blocks with SampleAt. Samples
+// can also be taken from ExchangeRateEmitted events indexed by pkg/indexer. Trailing computes
+// the APR and APY of a strategy over a window of its samples:
+//
+//	sampler := analytics.NewSampler(client, analytics.NewMemoryStore(), strategies)
+//	go sampler.Run(ctx, time.Hour)
+//	...
+//	yields, _ := analytics.TrailingAll(ctx, store, strategies, 7*24*time.Hour)
+//	analytics.WriteJSON(os.Stdout, yields)
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// OneShare is the amount of shares whose value in underlying token is sampled, 1e18.
+var OneShare = big.NewInt(1e18)
+
+// Sample is the exchange rate of a strategy at a block.
+type Sample struct {
+	Strategy    common.Address `json:"strategy"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Time        time.Time      `json:"time"`
+	// Rate is the underlying token per 1e18 shares.
+	Rate *big.Int `json:"rate"`
+}
+
+// Store persists samples.
+type Store interface {
+	// Add stores samples. Adding a sample of a strategy at a block that is already stored
+	// replaces it.
+	Add(ctx context.Context, samples ...Sample) error
+	// Samples returns the samples of strategy taken at or after since, ordered by block.
+	Samples(ctx context.Context, strategy common.Address, since time.Time) ([]Sample, error)
+}
+
+// MemoryStore is a Store that keeps samples in memory.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	series map[common.Address][]Sample
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{series: make(map[common.Address][]Sample)}
+}
+
+// Add implements Store.
+func (s *MemoryStore) Add(_ context.Context, samples ...Sample) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sample := range samples {
+		series := s.series[sample.Strategy]
+		i := sort.Search(len(series), func(i int) bool { return series[i].BlockNumber >= sample.BlockNumber })
+		if i < len(series) && series[i].BlockNumber == sample.BlockNumber {
+			series[i] = sample
+			continue
+		}
+		series = append(series, Sample{})
+		copy(series[i+1:], series[i:])
+		series[i] = sample
+		s.series[sample.Strategy] = series
+	}
+	return nil
+}
+
+// Samples implements Store.
+func (s *MemoryStore) Samples(_ context.Context, strategy common.Address, since time.Time) ([]Sample, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []Sample
+	for _, sample := range s.series[strategy] {
+		if !sample.Time.Before(since) {
+			out = append(out, sample)
+		}
+	}
+	return out, nil
+}
+
+// Backend is the chain access required by Sampler.
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Sampler samples the exchange rates of a set of strategies into a Store.
+type Sampler struct {
+	backend    Backend
+	store      Store
+	strategies []common.Address
+}
+
+// NewSampler returns a Sampler of strategies.
+func NewSampler(backend Backend, store Store, strategies []common.Address) *Sampler {
+	return &Sampler{backend: backend, store: store, strategies: strategies}
+}
+
+// SampleAt samples every strategy at blockNumber, or the latest block if nil, in a single
+// multicall, and stores the samples.
+func (s *Sampler) SampleAt(ctx context.Context, blockNumber *big.Int) ([]Sample, error) {
+	header, err := s.backend.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header: %w", err)
+	}
+	batch := multicall.NewBatch(s.backend)
+	results := make([]*multicall.Result[*big.Int], len(s.strategies))
+	for i, addr := range s.strategies {
+		caller, err := StrategyBase.NewStrategyBaseCaller(addr, batch)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return caller.SharesToUnderlyingView(opts, OneShare)
+		})
+	}
+	if err := batch.Execute(&bind.CallOpts{Context: ctx, BlockNumber: header.Number}); err != nil {
+		return nil, err
+	}
+
+	samples := make([]Sample, len(s.strategies))
+	for i, result := range results {
+		rate, err := result.Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to sample %s: %w", s.strategies[i].Hex(), err)
+		}
+		samples[i] = Sample{
+			Strategy:    s.strategies[i],
+			BlockNumber: header.Number.Uint64(),
+			Time:        time.Unix(int64(header.Time), 0).UTC(),
+			Rate:        rate,
+		}
+	}
+	if err := s.store.Add(ctx, samples...); err != nil {
+		return nil, fmt.Errorf("failed to store samples: %w", err)
+	}
+	return samples, nil
+}
+
+// Run samples every strategy at the latest block every interval, until ctx is done or sampling
+// fails.
+func (s *Sampler) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.SampleAt(ctx, nil); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddIndexedRates stores a sample for each ExchangeRateEmitted event in events, as indexed by
+// pkg/indexer from a StrategyBase source, and skips every other event. The rate emitted by
+// StrategyBase is computed like sharesToUnderlyingView(1e18) from the strategy's state after
+// the deposit or withdrawal, so the two kinds of samples can be mixed. Block times are read
+// from the backend.
+func (s *Sampler) AddIndexedRates(ctx context.Context, events []indexer.Event) error {
+	times := make(map[uint64]time.Time)
+	var samples []Sample
+	for _, e := range events {
+		if e.Name != "ExchangeRateEmitted" {
+			continue
+		}
+		rate, ok := e.Args["rate"].(*big.Int)
+		if !ok {
+			return fmt.Errorf("ExchangeRateEmitted of %s at block %d has no rate", e.Address.Hex(), e.BlockNumber)
+		}
+		t, ok := times[e.BlockNumber]
+		if !ok {
+			header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(e.BlockNumber))
+			if err != nil {
+				return fmt.Errorf("failed to fetch header %d: %w", e.BlockNumber, err)
+			}
+			t = time.Unix(int64(header.Time), 0).UTC()
+			times[e.BlockNumber] = t
+		}
+		samples = append(samples, Sample{Strategy: e.Address, BlockNumber: e.BlockNumber, Time: t, Rate: rate})
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+	if err := s.store.Add(ctx, samples...); err != nil {
+		return fmt.Errorf("failed to store samples: %w", err)
+	}
+	return nil
+}
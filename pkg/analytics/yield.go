@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Year is the length of the year yields are annualized over.
+const Year = 365 * 24 * time.Hour
+
+// ErrNotEnoughSamples is returned when a window holds fewer than two samples at distinct times.
+var ErrNotEnoughSamples = errors.New("not enough samples")
+
+// Yield is the trailing yield of a strategy between two samples.
+type Yield struct {
+	Strategy common.Address `json:"strategy"`
+	From     Sample         `json:"from"`
+	To       Sample         `json:"to"`
+	// APR is the simple annualized growth of the exchange rate, and APY the compounded one,
+	// both as fractions: 0.035 is 3.5%.
+	APR float64 `json:"apr"`
+	APY float64 `json:"apy"`
+}
+
+// Trailing returns the yield of strategy over the window ending at its latest sample, from the
+// earliest sample within window of it.
+func Trailing(ctx context.Context, store Store, strategy common.Address, window time.Duration) (*Yield, error) {
+	// Samples are ordered by block, so the window is bounded by the latest one.
+	all, err := store.Samples(ctx, strategy, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if len(all) < 2 {
+		return nil, fmt.Errorf("%w for %s", ErrNotEnoughSamples, strategy.Hex())
+	}
+	to := all[len(all)-1]
+	start := to.Time.Add(-window)
+	for _, from := range all {
+		if from.Time.Before(start) {
+			continue
+		}
+		if !from.Time.Before(to.Time) {
+			break
+		}
+		return yieldBetween(strategy, from, to), nil
+	}
+	return nil, fmt.Errorf("%w for %s within %s", ErrNotEnoughSamples, strategy.Hex(), window)
+}
+
+// TrailingAll returns the trailing yield of each of strategies over window, skipping strategies
+// without enough samples.
+func TrailingAll(ctx context.Context, store Store, strategies []common.Address, window time.Duration) ([]Yield, error) {
+	var yields []Yield
+	for _, strategy := range strategies {
+		y, err := Trailing(ctx, store, strategy, window)
+		if errors.Is(err, ErrNotEnoughSamples) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		yields = append(yields, *y)
+	}
+	return yields, nil
+}
+
+func yieldBetween(strategy common.Address, from, to Sample) *Yield {
+	growth, _ := new(big.Float).Quo(new(big.Float).SetInt(to.Rate), new(big.Float).SetInt(from.Rate)).Float64()
+	years := float64(to.Time.Sub(from.Time)) / float64(Year)
+	return &Yield{
+		Strategy: strategy,
+		From:     from,
+		To:       to,
+		APR:      (growth - 1) / years,
+		APY:      math.Pow(growth, 1/years) - 1,
+	}
+}
+
+// WriteJSON writes v, such as a []Yield or []Sample, to w as indented JSON.
+func WriteJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
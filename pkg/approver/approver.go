@@ -0,0 +1,238 @@
+// Package approver runs an operator's delegation approver: it holds the approver key and signs
+// the approvals stakers pass to DelegationManager.delegateTo.
+//
+// A Service checks that it is the delegation approver of the operator, applies its Policy to the
+// request, picks a salt the approver has not spent and signs the approval. It can be used from Go
+// or served over HTTP with Handler:
+//
+//	svc, _ := approver.New(ctx, client, delegationManager, approverKey, approver.Policy{
+//		Validity: 15 * time.Minute,
+//	})
+//	http.Handle("/approve", svc.Handler())
+package approver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/sigutils"
+)
+
+// Defaults of Policy.
+const (
+	DefaultValidity    = time.Hour
+	DefaultMaxValidity = 24 * time.Hour
+)
+
+// maxSaltAttempts bounds the number of random salts drawn before giving up.
+const maxSaltAttempts = 8
+
+var (
+	// ErrNotApprover is returned when the service's key is not the delegation approver of the
+	// operator.
+	ErrNotApprover = errors.New("not the operator's delegation approver")
+	// ErrExpiry is returned when a requested expiry is in the past or beyond the policy's
+	// MaxValidity.
+	ErrExpiry = errors.New("expiry not allowed by policy")
+	// ErrDenied is returned when the operator is not among the policy's Operators or its Allow
+	// rejects a request.
+	ErrDenied = errors.New("approval denied by policy")
+)
+
+// Backend is the chain access required by Service.
+type Backend interface {
+	bind.ContractCaller
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Policy decides which approvals a Service signs.
+type Policy struct {
+	// Validity is how long approvals are valid for when the request sets no expiry. It defaults
+	// to DefaultValidity.
+	Validity time.Duration
+	// MaxValidity bounds the expiry a request may set. It defaults to DefaultMaxValidity.
+	MaxValidity time.Duration
+	// Operators, if set, limits approvals to delegations to these operators.
+	Operators []common.Address
+	// Allow, if set, is called for every request and rejects it by returning an error, such as
+	// for stakers that are not on an allowlist.
+	Allow func(ctx context.Context, staker, operator common.Address) error
+}
+
+// Request is a request to approve staker delegating to operator.
+type Request struct {
+	Staker   common.Address `json:"staker"`
+	Operator common.Address `json:"operator"`
+	// Expiry is the unix time the approval expires at. If zero, the policy's Validity is used.
+	Expiry uint64 `json:"expiry,omitempty"`
+}
+
+// Approval is a signed delegation approval.
+type Approval struct {
+	Staker    common.Address `json:"staker"`
+	Operator  common.Address `json:"operator"`
+	Approver  common.Address `json:"approver"`
+	Salt      common.Hash    `json:"salt"`
+	Expiry    uint64         `json:"expiry"`
+	Signature hexutil.Bytes  `json:"signature"`
+}
+
+// SignatureWithExpiry returns the approverSignatureAndExpiry argument of delegateTo. The
+// approverSalt argument is a.Salt.
+func (a *Approval) SignatureWithExpiry() DelegationManager.ISignatureUtilsSignatureWithExpiry {
+	return DelegationManager.ISignatureUtilsSignatureWithExpiry{
+		Signature: a.Signature,
+		Expiry:    new(big.Int).SetUint64(a.Expiry),
+	}
+}
+
+// Service signs delegation approvals with the approver key.
+type Service struct {
+	key               *ecdsa.PrivateKey
+	approver          common.Address
+	policy            Policy
+	address           common.Address
+	delegationManager *DelegationManager.DelegationManagerCaller
+	chainID           *big.Int
+	now               func() time.Time
+
+	mu sync.Mutex
+	// issued holds the salts handed out, so that approvals not yet used are never given the
+	// same salt.
+	issued map[[32]byte]struct{}
+}
+
+// New returns a Service signing approvals with key for delegations through the DelegationManager
+// at delegationManager.
+func New(ctx context.Context, backend Backend, delegationManager common.Address, key *ecdsa.PrivateKey, policy Policy) (*Service, error) {
+	if policy.Validity == 0 {
+		policy.Validity = DefaultValidity
+	}
+	if policy.MaxValidity == 0 {
+		policy.MaxValidity = DefaultMaxValidity
+	}
+	if policy.Validity > policy.MaxValidity {
+		return nil, fmt.Errorf("validity %s exceeds max validity %s", policy.Validity, policy.MaxValidity)
+	}
+	caller, err := DelegationManager.NewDelegationManagerCaller(delegationManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	return &Service{
+		key:               key,
+		approver:          crypto.PubkeyToAddress(key.PublicKey),
+		policy:            policy,
+		address:           delegationManager,
+		delegationManager: caller,
+		chainID:           chainID,
+		now:               time.Now,
+		issued:            make(map[[32]byte]struct{}),
+	}, nil
+}
+
+// Approver returns the address of the approver key.
+func (s *Service) Approver() common.Address {
+	return s.approver
+}
+
+// Approve signs an approval for req after checking that the service is the operator's delegation
+// approver and that the policy allows it.
+func (s *Service) Approve(ctx context.Context, req Request) (*Approval, error) {
+	if len(s.policy.Operators) > 0 && !contains(s.policy.Operators, req.Operator) {
+		return nil, fmt.Errorf("%w: operator %s is not served", ErrDenied, req.Operator.Hex())
+	}
+	now := s.now()
+	expiry := req.Expiry
+	if expiry == 0 {
+		expiry = uint64(now.Add(s.policy.Validity).Unix())
+	}
+	if expiry <= uint64(now.Unix()) || expiry > uint64(now.Add(s.policy.MaxValidity).Unix()) {
+		return nil, fmt.Errorf("%w: %s", ErrExpiry, time.Unix(int64(expiry), 0).UTC())
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	approver, err := s.delegationManager.DelegationApprover(opts, req.Operator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch delegation approver: %w", err)
+	}
+	if approver != s.approver {
+		return nil, fmt.Errorf("%w of %s: approver is %s", ErrNotApprover, req.Operator.Hex(), approver.Hex())
+	}
+	if s.policy.Allow != nil {
+		if err := s.policy.Allow(ctx, req.Staker, req.Operator); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDenied, err)
+		}
+	}
+
+	salt, err := s.newSalt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := sigutils.SignDelegationApproval(s.key, s.chainID, s.address, req.Staker, req.Operator, salt, new(big.Int).SetUint64(expiry))
+	if err != nil {
+		return nil, err
+	}
+	return &Approval{
+		Staker:    req.Staker,
+		Operator:  req.Operator,
+		Approver:  s.approver,
+		Salt:      salt,
+		Expiry:    expiry,
+		Signature: sig.Signature,
+	}, nil
+}
+
+// newSalt returns a random salt the approver has neither spent in the DelegationManager nor
+// issued.
+func (s *Service) newSalt(ctx context.Context) ([32]byte, error) {
+	for i := 0; i < maxSaltAttempts; i++ {
+		var salt [32]byte
+		if _, err := rand.Read(salt[:]); err != nil {
+			return salt, fmt.Errorf("failed to generate salt: %w", err)
+		}
+		spent, err := s.delegationManager.DelegationApproverSaltIsSpent(&bind.CallOpts{Context: ctx}, s.approver, salt)
+		if err != nil {
+			return salt, fmt.Errorf("failed to check salt: %w", err)
+		}
+		if !spent && s.issue(salt) {
+			return salt, nil
+		}
+	}
+	return [32]byte{}, fmt.Errorf("failed to find an unspent salt after %d attempts", maxSaltAttempts)
+}
+
+// issue records salt as issued, and reports whether it was not already.
+func (s *Service) issue(salt [32]byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.issued[salt]; ok {
+		return false
+	}
+	s.issued[salt] = struct{}{}
+	return true
+}
+
+func contains(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package approver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// maxRequestBytes bounds the size of a request body.
+const maxRequestBytes = 4096
+
+// Handler returns an HTTP handler that approves delegations. It accepts a POST of a JSON Request
+// and responds with the JSON Approval. Requests the policy rejects fail with 403, and malformed
+// requests with 400.
+func (s *Service) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		var req Request
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		approval, err := s.Approve(r.Context(), req)
+		switch {
+		case errors.Is(err, ErrNotApprover), errors.Is(err, ErrDenied), errors.Is(err, ErrExpiry):
+			writeError(w, http.StatusForbidden, err)
+		case err != nil:
+			writeError(w, http.StatusInternalServerError, err)
+		default:
+			writeJSON(w, http.StatusOK, approval)
+		}
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,254 @@
+// Package avs registers operators to AVSs, and deregisters them, through the AVSDirectory.
+//
+// The AVSDirectory records registrations made by the AVS itself: registerOperatorToAVS must be
+// sent by the AVS, carrying a signature of the operator over a fresh salt. A Registrar signs with
+// the operator's key, picks salts the operator has not spent, and sends the transaction from the
+// AVS account of its TransactOpts:
+//
+//	registrar, _ := avs.NewRegistrar(client, avsDirectory, avsOpts)
+//	reg, err := registrar.RegisterOperatorToAVS(ctx, operatorKey, avsOpts.From, expiry)
+//
+// AVSs that are contracts forward the signature from their own registration entrypoint instead;
+// SignRegistration produces it without sending anything.
+//
+// An Explorer reads the AVSDirectory events of an indexer.Store to enumerate which operators are
+// registered with which AVSs and since when, and writes them to tables for dashboards.
+package avs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/sigutils"
+)
+
+// Values of the AVSDirectory's OperatorAVSRegistrationStatus.
+const (
+	StatusUnregistered uint8 = 0
+	StatusRegistered   uint8 = 1
+)
+
+// maxSaltAttempts bounds the number of random salts drawn before giving up.
+const maxSaltAttempts = 8
+
+// Backend is the chain access required by Registrar.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Registration is the outcome of a registration or deregistration.
+type Registration struct {
+	Operator common.Address
+	AVS      common.Address
+	// Status is the operator's status with the AVS after the transaction.
+	Status uint8
+	// Salt and Expiry are those of the operator's signature. They are zero for deregistrations.
+	Salt    [32]byte
+	Expiry  *big.Int
+	Receipt *types.Receipt
+}
+
+// Registrar registers operators to AVSs through the AVSDirectory, sending transactions with
+// opts. opts.From is the AVS.
+type Registrar struct {
+	backend   Backend
+	opts      *bind.TransactOpts
+	address   common.Address
+	directory *AVSDirectory.AVSDirectory
+
+	mu      sync.Mutex
+	chainID *big.Int
+	// issued holds the salts handed out per operator, so that signatures not yet submitted
+	// are never given the same salt.
+	issued map[common.Address]map[[32]byte]struct{}
+}
+
+// NewRegistrar returns a Registrar for the AVSDirectory at avsDirectory.
+func NewRegistrar(backend Backend, avsDirectory common.Address, opts *bind.TransactOpts) (*Registrar, error) {
+	directory, err := AVSDirectory.NewAVSDirectory(avsDirectory, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Registrar{
+		backend:   backend,
+		opts:      opts,
+		address:   avsDirectory,
+		directory: directory,
+		issued:    make(map[common.Address]map[[32]byte]struct{}),
+	}, nil
+}
+
+// Status returns the registration status of operator with avs.
+func (r *Registrar) Status(ctx context.Context, operator, avs common.Address) (uint8, error) {
+	status, err := r.directory.AvsOperatorStatus(&bind.CallOpts{Context: ctx}, avs, operator)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch registration status: %w", err)
+	}
+	return status, nil
+}
+
+// NewSalt returns a random salt that operator has neither spent in the AVSDirectory nor been
+// issued by this Registrar.
+func (r *Registrar) NewSalt(ctx context.Context, operator common.Address) ([32]byte, error) {
+	for i := 0; i < maxSaltAttempts; i++ {
+		var salt [32]byte
+		if _, err := rand.Read(salt[:]); err != nil {
+			return salt, fmt.Errorf("failed to generate salt: %w", err)
+		}
+		spent, err := r.directory.OperatorSaltIsSpent(&bind.CallOpts{Context: ctx}, operator, salt)
+		if err != nil {
+			return salt, fmt.Errorf("failed to check salt: %w", err)
+		}
+		if !spent && r.issue(operator, salt) {
+			return salt, nil
+		}
+	}
+	return [32]byte{}, fmt.Errorf("failed to find an unspent salt after %d attempts", maxSaltAttempts)
+}
+
+// issue records salt as issued to operator, and reports whether it was not already.
+func (r *Registrar) issue(operator common.Address, salt [32]byte) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	salts, ok := r.issued[operator]
+	if !ok {
+		salts = make(map[[32]byte]struct{})
+		r.issued[operator] = salts
+	}
+	if _, ok := salts[salt]; ok {
+		return false
+	}
+	salts[salt] = struct{}{}
+	return true
+}
+
+// SignRegistration signs the registration of the operator controlling operatorKey to avs, valid
+// until expiry, over a fresh salt.
+func (r *Registrar) SignRegistration(ctx context.Context, operatorKey *ecdsa.PrivateKey, avs common.Address, expiry *big.Int) (AVSDirectory.ISignatureUtilsSignatureWithSaltAndExpiry, error) {
+	var sig AVSDirectory.ISignatureUtilsSignatureWithSaltAndExpiry
+	chainID, err := r.chain(ctx)
+	if err != nil {
+		return sig, err
+	}
+	salt, err := r.NewSalt(ctx, crypto.PubkeyToAddress(operatorKey.PublicKey))
+	if err != nil {
+		return sig, err
+	}
+	return sigutils.SignOperatorAVSRegistration(operatorKey, chainID, r.address, avs, salt, expiry)
+}
+
+// RegisterOperatorToAVS registers the operator controlling operatorKey to avs, which must be the
+// sender of the Registrar's TransactOpts, with a signature valid until expiry. It waits for the
+// transaction to be mined. It returns errors.ErrAlreadyRegisteredToAVS from pkg/errors without
+// sending anything if the operator is already registered.
+func (r *Registrar) RegisterOperatorToAVS(ctx context.Context, operatorKey *ecdsa.PrivateKey, avs common.Address, expiry *big.Int) (*Registration, error) {
+	if avs != r.opts.From {
+		return nil, fmt.Errorf("registrations to %s must be sent by the AVS, not %s", avs.Hex(), r.opts.From.Hex())
+	}
+	operator := crypto.PubkeyToAddress(operatorKey.PublicKey)
+	status, err := r.Status(ctx, operator, avs)
+	if err != nil {
+		return nil, err
+	}
+	if status == StatusRegistered {
+		return nil, elerrors.ErrAlreadyRegisteredToAVS
+	}
+	sig, err := r.SignRegistration(ctx, operatorKey, avs, expiry)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.directory.RegisterOperatorToAVS(r.txOpts(ctx), operator, sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register operator: %w", elerrors.Decode(err))
+	}
+	reg, err := r.registration(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	reg.Salt, reg.Expiry = sig.Salt, sig.Expiry
+	return reg, nil
+}
+
+// DeregisterOperatorFromAVS deregisters operator from avs, which must be the sender of the
+// Registrar's TransactOpts, and waits for the transaction to be mined. It returns
+// errors.ErrNotRegisteredToAVS from pkg/errors without sending anything if the operator is not
+// registered.
+func (r *Registrar) DeregisterOperatorFromAVS(ctx context.Context, operator, avs common.Address) (*Registration, error) {
+	if avs != r.opts.From {
+		return nil, fmt.Errorf("deregistrations from %s must be sent by the AVS, not %s", avs.Hex(), r.opts.From.Hex())
+	}
+	status, err := r.Status(ctx, operator, avs)
+	if err != nil {
+		return nil, err
+	}
+	if status != StatusRegistered {
+		return nil, elerrors.ErrNotRegisteredToAVS
+	}
+	tx, err := r.directory.DeregisterOperatorFromAVS(r.txOpts(ctx), operator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deregister operator: %w", elerrors.Decode(err))
+	}
+	return r.registration(ctx, tx)
+}
+
+// registration waits for tx and returns the registration status update it emitted.
+func (r *Registrar) registration(ctx context.Context, tx *types.Transaction) (*Registration, error) {
+	receipt, err := r.waitMined(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range receipt.Logs {
+		if log.Address != r.address {
+			continue
+		}
+		ev, err := r.directory.ParseOperatorAVSRegistrationStatusUpdated(*log)
+		if err != nil {
+			continue
+		}
+		return &Registration{Operator: ev.Operator, AVS: ev.Avs, Status: ev.Status, Receipt: receipt}, nil
+	}
+	return nil, fmt.Errorf("no OperatorAVSRegistrationStatusUpdated event in %s", tx.Hash())
+}
+
+func (r *Registrar) chain(ctx context.Context) (*big.Int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.chainID == nil {
+		chainID, err := r.backend.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+		}
+		r.chainID = chainID
+	}
+	return r.chainID, nil
+}
+
+func (r *Registrar) txOpts(ctx context.Context) *bind.TransactOpts {
+	opts := *r.opts
+	opts.Context = ctx
+	return &opts
+}
+
+func (r *Registrar) waitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, r.backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,340 @@
+package avs
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+)
+
+// RegistrationEvent is an OperatorAVSRegistrationStatusUpdated event of the AVSDirectory.
+type RegistrationEvent struct {
+	Operator    common.Address
+	AVS         common.Address
+	Status      uint8
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint
+}
+
+// OperatorRegistration is the registration of an operator with an AVS, as of the latest event
+// indexed.
+type OperatorRegistration struct {
+	Operator common.Address
+	AVS      common.Address
+	// Status is StatusRegistered or StatusUnregistered.
+	Status uint8
+	// RegisteredBlock is the block of the latest registration, and UpdatedBlock and UpdatedTx
+	// those of the latest status update, which is the registration unless the operator has
+	// since deregistered.
+	RegisteredBlock uint64
+	UpdatedBlock    uint64
+	UpdatedTx       common.Hash
+	// Registrations is the number of times the operator registered with the AVS.
+	Registrations int
+}
+
+// Registered reports whether the operator is registered with the AVS.
+func (r *OperatorRegistration) Registered() bool {
+	return r.Status == StatusRegistered
+}
+
+// AVSInfo summarizes an AVS: its latest metadata URI and its registered operators.
+type AVSInfo struct {
+	AVS           common.Address
+	MetadataURI   string
+	MetadataBlock uint64
+	// Operators is the number of operators registered with the AVS.
+	Operators int
+	// FirstRegistrationBlock is the block of the first registration of any operator, zero if
+	// none has registered.
+	FirstRegistrationBlock uint64
+}
+
+// RegistrationFilter selects the registrations returned by an Explorer. Zero-valued fields
+// match every registration.
+type RegistrationFilter struct {
+	Operator common.Address
+	AVS      common.Address
+	// ToBlock, if non-zero, returns the registrations as of that block, inclusive.
+	ToBlock uint64
+	// IncludeDeregistered also returns the operators that have deregistered since.
+	IncludeDeregistered bool
+}
+
+func (f RegistrationFilter) matches(e *RegistrationEvent) bool {
+	return (f.Operator == common.Address{} || e.Operator == f.Operator) &&
+		(f.AVS == common.Address{} || e.AVS == f.AVS)
+}
+
+// Explorer answers which operators are registered with which AVSs, and since when, from the
+// AVSDirectory events indexed into an indexer.Store by a source with Contract "AVSDirectory".
+//
+// This deployment predates the AllocationManager, so there are no operator sets or allocations
+// to report: registrations are those of the AVSDirectory, to the AVS as a whole.
+type Explorer struct {
+	store        indexer.Store
+	avsDirectory common.Address
+}
+
+// NewExplorer returns an Explorer of the events of the AVSDirectory at avsDirectory stored in
+// store. A zero avsDirectory reads the events of every indexed AVSDirectory.
+func NewExplorer(store indexer.Store, avsDirectory common.Address) *Explorer {
+	return &Explorer{store: store, avsDirectory: avsDirectory}
+}
+
+// History returns the registration and deregistration events matching filter, oldest first.
+func (x *Explorer) History(ctx context.Context, filter RegistrationFilter) ([]RegistrationEvent, error) {
+	events, err := x.store.Events(ctx, indexer.Filter{
+		Contract: "AVSDirectory",
+		Address:  x.avsDirectory,
+		Name:     "OperatorAVSRegistrationStatusUpdated",
+		ToBlock:  filter.ToBlock,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registrations: %w", err)
+	}
+	var history []RegistrationEvent
+	for _, e := range events {
+		operator, okOperator := argAddress(e.Args["operator"])
+		avs, okAVS := argAddress(e.Args["avs"])
+		status, okStatus := argUint8(e.Args["status"])
+		if !okOperator || !okAVS || !okStatus {
+			return nil, fmt.Errorf("OperatorAVSRegistrationStatusUpdated in tx %s has malformed arguments", e.TxHash.Hex())
+		}
+		event := RegistrationEvent{
+			Operator:    operator,
+			AVS:         avs,
+			Status:      status,
+			BlockNumber: e.BlockNumber,
+			TxHash:      e.TxHash,
+			LogIndex:    e.LogIndex,
+		}
+		if filter.matches(&event) {
+			history = append(history, event)
+		}
+	}
+	return history, nil
+}
+
+// Registrations returns the registrations matching filter, sorted by AVS then operator.
+func (x *Explorer) Registrations(ctx context.Context, filter RegistrationFilter) ([]OperatorRegistration, error) {
+	history, err := x.History(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return registrations(history, filter.IncludeDeregistered), nil
+}
+
+// OperatorAVSs returns the registrations of operator that are current, sorted by AVS.
+func (x *Explorer) OperatorAVSs(ctx context.Context, operator common.Address) ([]OperatorRegistration, error) {
+	return x.Registrations(ctx, RegistrationFilter{Operator: operator})
+}
+
+// AVSOperators returns the operators registered with avs, sorted by address.
+func (x *Explorer) AVSOperators(ctx context.Context, avs common.Address) ([]OperatorRegistration, error) {
+	return x.Registrations(ctx, RegistrationFilter{AVS: avs})
+}
+
+// AVSs returns every AVS that set a metadata URI or had an operator register, as of toBlock if
+// non-zero, sorted by number of operators, most first, then address.
+func (x *Explorer) AVSs(ctx context.Context, toBlock uint64) ([]AVSInfo, error) {
+	history, err := x.History(ctx, RegistrationFilter{ToBlock: toBlock})
+	if err != nil {
+		return nil, err
+	}
+	uris, err := x.store.Events(ctx, indexer.Filter{
+		Contract: "AVSDirectory",
+		Address:  x.avsDirectory,
+		Name:     "AVSMetadataURIUpdated",
+		ToBlock:  toBlock,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata URIs: %w", err)
+	}
+
+	infos := make(map[common.Address]*AVSInfo)
+	info := func(avs common.Address) *AVSInfo {
+		if infos[avs] == nil {
+			infos[avs] = &AVSInfo{AVS: avs}
+		}
+		return infos[avs]
+	}
+	for _, e := range uris {
+		avs, ok := argAddress(e.Args["avs"])
+		uri, okURI := e.Args["metadataURI"].(string)
+		if !ok || !okURI {
+			return nil, fmt.Errorf("AVSMetadataURIUpdated in tx %s has malformed arguments", e.TxHash.Hex())
+		}
+		i := info(avs)
+		i.MetadataURI, i.MetadataBlock = uri, e.BlockNumber
+	}
+	for _, e := range history {
+		if i := info(e.AVS); e.Status == StatusRegistered && i.FirstRegistrationBlock == 0 {
+			i.FirstRegistrationBlock = e.BlockNumber
+		}
+	}
+	for _, r := range registrations(history, false) {
+		info(r.AVS).Operators++
+	}
+
+	out := make([]AVSInfo, 0, len(infos))
+	for _, i := range infos {
+		out = append(out, *i)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Operators != out[j].Operators {
+			return out[i].Operators > out[j].Operators
+		}
+		return out[i].AVS.Hex() < out[j].AVS.Hex()
+	})
+	return out, nil
+}
+
+// WriteTables writes the registrations and AVSs known to the explorer to the tables
+// avs_registrations and avs_directory of db, creating them if they do not exist, for
+// dashboards that query the database of an indexer.SQLStore directly. The tables are
+// rewritten in a single transaction, so call it after each indexed range to keep them current.
+func (x *Explorer) WriteTables(ctx context.Context, db *sql.DB, dialect indexer.Dialect) error {
+	regs, err := x.Registrations(ctx, RegistrationFilter{IncludeDeregistered: true})
+	if err != nil {
+		return err
+	}
+	avss, err := x.AVSs(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	schema := []string{
+		`CREATE TABLE IF NOT EXISTS avs_registrations (
+			avs              TEXT NOT NULL,
+			operator         TEXT NOT NULL,
+			status           SMALLINT NOT NULL,
+			registered_block BIGINT NOT NULL,
+			updated_block    BIGINT NOT NULL,
+			updated_tx       TEXT NOT NULL,
+			registrations    BIGINT NOT NULL,
+			PRIMARY KEY (avs, operator)
+		)`,
+		`CREATE INDEX IF NOT EXISTS avs_registrations_operator ON avs_registrations (operator)`,
+		`CREATE TABLE IF NOT EXISTS avs_directory (
+			avs                      TEXT PRIMARY KEY,
+			metadata_uri             TEXT NOT NULL,
+			metadata_block           BIGINT NOT NULL,
+			operators                BIGINT NOT NULL,
+			first_registration_block BIGINT NOT NULL
+		)`,
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create AVS tables: %w", err)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range []string{`DELETE FROM avs_registrations`, `DELETE FROM avs_directory`} {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to clear AVS tables: %w", err)
+		}
+	}
+	insert := fmt.Sprintf(
+		`INSERT INTO avs_registrations (avs, operator, status, registered_block, updated_block, updated_tx, registrations)
+		VALUES (%s)`,
+		placeholders(dialect, 7),
+	)
+	for _, r := range regs {
+		if _, err := tx.ExecContext(ctx, insert,
+			r.AVS.Hex(), r.Operator.Hex(), int64(r.Status), int64(r.RegisteredBlock), int64(r.UpdatedBlock),
+			r.UpdatedTx.Hex(), int64(r.Registrations),
+		); err != nil {
+			return fmt.Errorf("failed to insert registration: %w", err)
+		}
+	}
+	insert = fmt.Sprintf(
+		`INSERT INTO avs_directory (avs, metadata_uri, metadata_block, operators, first_registration_block)
+		VALUES (%s)`,
+		placeholders(dialect, 5),
+	)
+	for _, a := range avss {
+		if _, err := tx.ExecContext(ctx, insert,
+			a.AVS.Hex(), a.MetadataURI, int64(a.MetadataBlock), int64(a.Operators), int64(a.FirstRegistrationBlock),
+		); err != nil {
+			return fmt.Errorf("failed to insert AVS: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// registrations folds history, oldest first, into the latest registration of each operator
+// with each AVS.
+func registrations(history []RegistrationEvent, includeDeregistered bool) []OperatorRegistration {
+	type key struct{ operator, avs common.Address }
+	latest := make(map[key]*OperatorRegistration)
+	for _, e := range history {
+		k := key{e.Operator, e.AVS}
+		r := latest[k]
+		if r == nil {
+			r = &OperatorRegistration{Operator: e.Operator, AVS: e.AVS}
+			latest[k] = r
+		}
+		r.Status, r.UpdatedBlock, r.UpdatedTx = e.Status, e.BlockNumber, e.TxHash
+		if e.Status == StatusRegistered {
+			r.RegisteredBlock = e.BlockNumber
+			r.Registrations++
+		}
+	}
+	var out []OperatorRegistration
+	for _, r := range latest {
+		if includeDeregistered || r.Registered() {
+			out = append(out, *r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].AVS != out[j].AVS {
+			return out[i].AVS.Hex() < out[j].AVS.Hex()
+		}
+		return out[i].Operator.Hex() < out[j].Operator.Hex()
+	})
+	return out
+}
+
+func placeholders(dialect indexer.Dialect, n int) string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = dialect.Placeholder(i + 1)
+	}
+	return strings.Join(out, ", ")
+}
+
+// argAddress returns an address argument of an indexed event, decoded by the indexer or read
+// back from the JSON of a SQLStore.
+func argAddress(v interface{}) (common.Address, bool) {
+	switch v := v.(type) {
+	case common.Address:
+		return v, true
+	case string:
+		return common.HexToAddress(v), common.IsHexAddress(v)
+	}
+	return common.Address{}, false
+}
+
+// argUint8 returns a uint8 argument of an indexed event, decoded by the indexer or read back
+// from the JSON of a SQLStore.
+func argUint8(v interface{}) (uint8, bool) {
+	switch v := v.(type) {
+	case uint8:
+		return v, true
+	case float64:
+		return uint8(v), v >= 0 && v <= 255 && v == float64(uint8(v))
+	}
+	return 0, false
+}
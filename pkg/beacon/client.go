@@ -0,0 +1,302 @@
+// Package beacon is a client of the standard beacon node REST API, for generating EigenPod proofs
+// directly from a beacon node.
+//
+// Client reads block headers and validators, and downloads SSZ-encoded beacon states, which
+// ProverAt turns into a beaconproofs.Prover:
+//
+//	bc := beacon.NewClient(beaconURL, beacon.Config{CacheDir: "/var/cache/eigenlayer"})
+//	prover, err := bc.ProverAt(ctx, "finalized")
+//	proofs, err := prover.WithdrawalCredentialProofs(indices)
+//
+// Checkpoint proofs must be built from the state of the block root recorded when the checkpoint
+// was started, which CheckpointProver looks up.
+//
+// States of mainnet are several hundred megabytes. They are cached in memory, keyed by state
+// root, and on disk when Config.CacheDir is set. Downloads are written to disk as they arrive
+// and, when the node supports range requests, an interrupted download is resumed from where it
+// stopped instead of starting over.
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+)
+
+// Defaults of Config.
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultStateTimeout = 10 * time.Minute
+	DefaultCachedStates = 2
+)
+
+// ErrNotFound is returned for blocks, states and validators the node does not know.
+var ErrNotFound = errors.New("not found")
+
+// Config configures a Client.
+type Config struct {
+	// Timeout bounds each request other than state downloads, DefaultTimeout if zero.
+	Timeout time.Duration
+	// StateTimeout bounds each state download, DefaultStateTimeout if zero.
+	StateTimeout time.Duration
+	// Headers are added to every request, such as an API key of a hosted node.
+	Headers map[string]string
+	// CacheDir is the directory where downloaded states are kept. States are not kept on disk
+	// if empty.
+	CacheDir string
+	// CachedStates is the number of provers kept in memory, DefaultCachedStates if zero.
+	// Negative disables the memory cache.
+	CachedStates int
+	// HTTPClient sends the requests, a new client if nil.
+	HTTPClient *http.Client
+}
+
+// Client is a client of a beacon node.
+type Client struct {
+	url string
+	cfg Config
+
+	mu      sync.Mutex
+	provers []cachedProver
+}
+
+type cachedProver struct {
+	stateRoot common.Hash
+	prover    *beaconproofs.Prover
+}
+
+// NewClient returns a client of the beacon node at url.
+func NewClient(url string, cfg Config) *Client {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.StateTimeout == 0 {
+		cfg.StateTimeout = DefaultStateTimeout
+	}
+	if cfg.CachedStates == 0 {
+		cfg.CachedStates = DefaultCachedStates
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+	return &Client{url: strings.TrimRight(url, "/"), cfg: cfg}
+}
+
+// Genesis is the genesis of the beacon chain.
+type Genesis struct {
+	Time                  uint64
+	GenesisValidatorsRoot common.Hash
+	ForkVersion           [4]byte
+}
+
+// Genesis returns the genesis of the chain.
+func (c *Client) Genesis(ctx context.Context) (*Genesis, error) {
+	var resp struct {
+		Data struct {
+			GenesisTime           string        `json:"genesis_time"`
+			GenesisValidatorsRoot common.Hash   `json:"genesis_validators_root"`
+			GenesisForkVersion    hexutil.Bytes `json:"genesis_fork_version"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "/eth/v1/beacon/genesis", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch genesis: %w", err)
+	}
+	genesisTime, err := strconv.ParseUint(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis time %q", resp.Data.GenesisTime)
+	}
+	genesis := &Genesis{Time: genesisTime, GenesisValidatorsRoot: resp.Data.GenesisValidatorsRoot}
+	copy(genesis.ForkVersion[:], resp.Data.GenesisForkVersion)
+	return genesis, nil
+}
+
+// Header is a beacon block header.
+type Header struct {
+	// Root is the block root.
+	Root          common.Hash
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    common.Hash
+	StateRoot     common.Hash
+	BodyRoot      common.Hash
+	Canonical     bool
+	Finalized     bool
+}
+
+// Header returns the header of the block identified by blockID: "head", "finalized",
+// "genesis", a slot or a 0x-prefixed block root. It returns ErrNotFound for missed slots.
+func (c *Client) Header(ctx context.Context, blockID string) (*Header, error) {
+	var resp struct {
+		Finalized bool `json:"finalized"`
+		Data      struct {
+			Root      common.Hash `json:"root"`
+			Canonical bool        `json:"canonical"`
+			Header    struct {
+				Message struct {
+					Slot          string      `json:"slot"`
+					ProposerIndex string      `json:"proposer_index"`
+					ParentRoot    common.Hash `json:"parent_root"`
+					StateRoot     common.Hash `json:"state_root"`
+					BodyRoot      common.Hash `json:"body_root"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "/eth/v1/beacon/headers/"+url.PathEscape(blockID), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch header of block %s: %w", blockID, err)
+	}
+	msg := resp.Data.Header.Message
+	slot, err := strconv.ParseUint(msg.Slot, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slot %q in header of block %s", msg.Slot, blockID)
+	}
+	proposer, err := strconv.ParseUint(msg.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposer index %q in header of block %s", msg.ProposerIndex, blockID)
+	}
+	return &Header{
+		Root:          resp.Data.Root,
+		Slot:          slot,
+		ProposerIndex: proposer,
+		ParentRoot:    msg.ParentRoot,
+		StateRoot:     msg.StateRoot,
+		BodyRoot:      msg.BodyRoot,
+		Canonical:     resp.Data.Canonical,
+		Finalized:     resp.Finalized,
+	}, nil
+}
+
+// Validator is a validator as reported by the node.
+type Validator struct {
+	Index                 uint64
+	Balance               uint64 // gwei
+	Status                string
+	Pubkey                hexutil.Bytes
+	WithdrawalCredentials common.Hash
+	EffectiveBalance      uint64 // gwei
+	Slashed               bool
+	ActivationEpoch       uint64
+	ExitEpoch             uint64
+	WithdrawableEpoch     uint64
+}
+
+// Validators returns the validators of the state identified by stateID, named by index or
+// 0x-prefixed public key. Unknown validators are omitted.
+func (c *Client) Validators(ctx context.Context, stateID string, ids ...string) ([]Validator, error) {
+	var resp struct {
+		Data []struct {
+			Index     string `json:"index"`
+			Balance   string `json:"balance"`
+			Status    string `json:"status"`
+			Validator struct {
+				Pubkey                hexutil.Bytes `json:"pubkey"`
+				WithdrawalCredentials common.Hash   `json:"withdrawal_credentials"`
+				EffectiveBalance      string        `json:"effective_balance"`
+				Slashed               bool          `json:"slashed"`
+				ActivationEpoch       string        `json:"activation_epoch"`
+				ExitEpoch             string        `json:"exit_epoch"`
+				WithdrawableEpoch     string        `json:"withdrawable_epoch"`
+			} `json:"validator"`
+		} `json:"data"`
+	}
+	query := url.Values{}
+	if len(ids) > 0 {
+		query.Set("id", strings.Join(ids, ","))
+	}
+	if err := c.get(ctx, "/eth/v1/beacon/states/"+url.PathEscape(stateID)+"/validators", query, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch validators at state %s: %w", stateID, err)
+	}
+	validators := make([]Validator, len(resp.Data))
+	for i, v := range resp.Data {
+		var (
+			u   [6]uint64
+			err error
+		)
+		for j, s := range []string{v.Index, v.Balance, v.Validator.EffectiveBalance, v.Validator.ActivationEpoch, v.Validator.ExitEpoch, v.Validator.WithdrawableEpoch} {
+			if u[j], err = strconv.ParseUint(s, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid validator %s: %q is not a number", v.Index, s)
+			}
+		}
+		validators[i] = Validator{
+			Index:                 u[0],
+			Balance:               u[1],
+			Status:                v.Status,
+			Pubkey:                v.Validator.Pubkey,
+			WithdrawalCredentials: v.Validator.WithdrawalCredentials,
+			EffectiveBalance:      u[2],
+			Slashed:               v.Validator.Slashed,
+			ActivationEpoch:       u[3],
+			ExitEpoch:             u[4],
+			WithdrawableEpoch:     u[5],
+		}
+	}
+	return validators, nil
+}
+
+// get fetches a JSON endpoint into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	endpoint := c.url + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := c.newRequest(ctx, endpoint, "application/json")
+	if err != nil {
+		return err
+	}
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(ctx context.Context, endpoint, accept string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	for name, value := range c.cfg.Headers {
+		req.Header.Set(name, value)
+	}
+	return req, nil
+}
+
+// responseError returns the error of a failed response, with the message of the node's error
+// body if it has one.
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var apiErr struct {
+		Message string `json:"message"`
+	}
+	msg := strings.TrimSpace(string(body))
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+		msg = apiErr.Message
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotFound, msg)
+	}
+	return fmt.Errorf("beacon node returned %s: %s", resp.Status, msg)
+}
@@ -0,0 +1,222 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+)
+
+const (
+	// supportedFork is the fork of the states beaconproofs decodes.
+	supportedFork = "deneb"
+	// maxResumes is the number of times an interrupted state download is resumed.
+	maxResumes = 3
+)
+
+// ErrUnsupportedFork is returned for states of forks beaconproofs cannot decode.
+var ErrUnsupportedFork = errors.New("unsupported fork")
+
+// StateRoot returns the root of the state identified by stateID: "head", "finalized",
+// "genesis", a slot or a 0x-prefixed state root.
+func (c *Client) StateRoot(ctx context.Context, stateID string) (common.Hash, error) {
+	var resp struct {
+		Data struct {
+			Root common.Hash `json:"root"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "/eth/v1/beacon/states/"+url.PathEscape(stateID)+"/root", nil, &resp); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to fetch root of state %s: %w", stateID, err)
+	}
+	return resp.Data.Root, nil
+}
+
+// ProverAt returns a prover of the post-state of the block identified by blockID, as accepted by
+// Header.
+func (c *Client) ProverAt(ctx context.Context, blockID string) (*beaconproofs.Prover, error) {
+	header, err := c.Header(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	prover, err := c.Prover(ctx, header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	if prover.BlockRoot() != header.Root {
+		return nil, fmt.Errorf("state %s does not belong to block %s", header.StateRoot.Hex(), header.Root.Hex())
+	}
+	return prover, nil
+}
+
+// CheckpointProver returns a prover of the state of blockRoot, the beacon block root recorded by
+// an EigenPod when its current checkpoint was started.
+func (c *Client) CheckpointProver(ctx context.Context, blockRoot common.Hash) (*beaconproofs.Prover, error) {
+	return c.ProverAt(ctx, blockRoot.Hex())
+}
+
+// Prover returns a prover of the state with stateRoot, from the caches or downloaded from the
+// node.
+func (c *Client) Prover(ctx context.Context, stateRoot common.Hash) (*beaconproofs.Prover, error) {
+	if prover, ok := c.cachedProver(stateRoot); ok {
+		return prover, nil
+	}
+	state, err := c.State(ctx, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+	prover, err := beaconproofs.NewProver(state)
+	if err != nil {
+		c.removeCachedState(stateRoot)
+		return nil, fmt.Errorf("failed to decode state %s: %w", stateRoot.Hex(), err)
+	}
+	if prover.StateRoot() != stateRoot {
+		c.removeCachedState(stateRoot)
+		return nil, fmt.Errorf("downloaded state has root %x, expected %s", prover.StateRoot(), stateRoot.Hex())
+	}
+	c.cacheProver(stateRoot, prover)
+	return prover, nil
+}
+
+// State returns the SSZ-encoded state with stateRoot, from the disk cache or downloaded from the
+// node.
+func (c *Client) State(ctx context.Context, stateRoot common.Hash) ([]byte, error) {
+	if c.cfg.CacheDir == "" {
+		var buf bytes.Buffer
+		if err := c.download(ctx, stateRoot, &buf, 0); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	path := c.statePath(stateRoot)
+	if state, err := os.ReadFile(path); err == nil {
+		return state, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read cached state: %w", err)
+	}
+	if err := os.MkdirAll(c.cfg.CacheDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create state cache: %w", err)
+	}
+	part := path + ".part"
+	var err error
+	for attempt := 0; attempt <= maxResumes; attempt++ {
+		if err = c.downloadFile(ctx, stateRoot, part); err == nil || ctx.Err() != nil || !errors.Is(err, errInterrupted) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(part, path); err != nil {
+		return nil, fmt.Errorf("failed to cache state: %w", err)
+	}
+	return os.ReadFile(path)
+}
+
+// errInterrupted marks downloads that failed after the response started and may be resumed.
+var errInterrupted = errors.New("download interrupted")
+
+// downloadFile downloads the state into the file at part, resuming from its current size.
+func (c *Client) downloadFile(ctx context.Context, stateRoot common.Hash, part string) error {
+	f, err := os.OpenFile(part, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open state download: %w", err)
+	}
+	defer f.Close()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	return c.download(ctx, stateRoot, f, offset)
+}
+
+// download writes the state to w. With offset above zero, it requests the state from offset
+// and, if the node ignores the range, truncates w and writes the whole state when w is a file.
+func (c *Client) download(ctx context.Context, stateRoot common.Hash, w io.Writer, offset int64) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.StateTimeout)
+	defer cancel()
+	req, err := c.newRequest(ctx, c.url+"/eth/v2/debug/beacon/states/"+stateRoot.Hex(), "application/octet-stream")
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download state %s: %w", stateRoot.Hex(), err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+	case resp.StatusCode == http.StatusOK:
+		if offset > 0 {
+			f, ok := w.(*os.File)
+			if !ok {
+				return fmt.Errorf("failed to restart download of state %s", stateRoot.Hex())
+			}
+			if err := f.Truncate(0); err != nil {
+				return err
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("failed to download state %s: %w", stateRoot.Hex(), responseError(resp))
+	}
+	if fork := resp.Header.Get("Eth-Consensus-Version"); fork != "" && fork != supportedFork {
+		return fmt.Errorf("%w: state %s is of fork %s, expected %s", ErrUnsupportedFork, stateRoot.Hex(), fork, supportedFork)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		if resp.Header.Get("Accept-Ranges") == "bytes" || resp.StatusCode == http.StatusPartialContent {
+			return fmt.Errorf("failed to download state %s: %w: %v", stateRoot.Hex(), errInterrupted, err)
+		}
+		return fmt.Errorf("failed to download state %s: %w", stateRoot.Hex(), err)
+	}
+	return nil
+}
+
+func (c *Client) statePath(stateRoot common.Hash) string {
+	return filepath.Join(c.cfg.CacheDir, stateRoot.Hex()+".ssz")
+}
+
+func (c *Client) removeCachedState(stateRoot common.Hash) {
+	if c.cfg.CacheDir != "" {
+		os.Remove(c.statePath(stateRoot))
+	}
+}
+
+func (c *Client) cachedProver(stateRoot common.Hash) (*beaconproofs.Prover, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, cached := range c.provers {
+		if cached.stateRoot == stateRoot {
+			copy(c.provers[1:i+1], c.provers[:i])
+			c.provers[0] = cached
+			return cached.prover, true
+		}
+	}
+	return nil, false
+}
+
+func (c *Client) cacheProver(stateRoot common.Hash, prover *beaconproofs.Prover) {
+	if c.cfg.CachedStates < 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.provers = append([]cachedProver{{stateRoot: stateRoot, prover: prover}}, c.provers...)
+	if len(c.provers) > c.cfg.CachedStates {
+		c.provers = c.provers[:c.cfg.CachedStates]
+	}
+}
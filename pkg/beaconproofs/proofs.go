@@ -0,0 +1,266 @@
+// Package beaconproofs generates the beacon chain merkle proofs consumed by EigenPods from an
+// SSZ-encoded Deneb BeaconState.
+//
+// All proofs are against the root of the beacon block whose post-state is the supplied state.
+// EigenPods look that root up through EIP-4788 as the parent block root of a beacon timestamp,
+// so proofs built from the state at slot N are submitted with the timestamp of slot N+1 (see
+// Prover.BeaconTimestamp). For checkpoint proofs, use the state whose block root was recorded
+// when the checkpoint was started.
+package beaconproofs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+)
+
+// SecondsPerSlot is the beacon chain slot duration.
+const SecondsPerSlot = 12
+
+// WithdrawalCredentialProofs holds the arguments of EigenPod.verifyWithdrawalCredentials
+// other than beaconTimestamp.
+type WithdrawalCredentialProofs struct {
+	StateRootProof        EigenPod.BeaconChainProofsStateRootProof
+	ValidatorIndices      []*big.Int
+	ValidatorFieldsProofs [][]byte
+	ValidatorFields       [][][32]byte
+}
+
+// CheckpointProofs holds the arguments of EigenPod.verifyCheckpointProofs.
+type CheckpointProofs struct {
+	BalanceContainerProof EigenPod.BeaconChainProofsBalanceContainerProof
+	BalanceProofs         []EigenPod.BeaconChainProofsBalanceProof
+}
+
+// StaleBalanceProof holds the arguments of EigenPod.verifyStaleBalance other than beaconTimestamp.
+type StaleBalanceProof struct {
+	StateRootProof EigenPod.BeaconChainProofsStateRootProof
+	ValidatorProof EigenPod.BeaconChainProofsValidatorProof
+}
+
+// Prover builds proofs from a single beacon state.
+type Prover struct {
+	state *beaconState
+}
+
+// NewProver decodes and merkleizes the SSZ-encoded Deneb BeaconState in state.
+func NewProver(state []byte) (*Prover, error) {
+	s, err := parseBeaconState(state)
+	if err != nil {
+		return nil, err
+	}
+	return &Prover{state: s}, nil
+}
+
+// Slot returns the slot of the state.
+func (p *Prover) Slot() uint64 {
+	return p.state.slot
+}
+
+// StateRoot returns the hash tree root of the state.
+func (p *Prover) StateRoot() [32]byte {
+	return p.state.stateTree.root()
+}
+
+// BlockRoot returns the root of the block whose post-state is the state. Every proof built by
+// the Prover is against this root.
+func (p *Prover) BlockRoot() [32]byte {
+	return blockHeaderRoot(p.state.header)
+}
+
+// BeaconTimestamp returns the timestamp of the slot after the state's, whose EIP-4788 parent
+// block root is BlockRoot. If that slot was missed, use the timestamp of the next block instead.
+func (p *Prover) BeaconTimestamp() uint64 {
+	return p.state.genesisTime + (p.state.slot+1)*SecondsPerSlot
+}
+
+// NumValidators returns the number of validators in the state.
+func (p *Prover) NumValidators() uint64 {
+	return p.state.numValidators
+}
+
+// ValidatorFields returns the eight field roots of the validator at index, as passed to EigenPods.
+func (p *Prover) ValidatorFields(index uint64) ([][32]byte, error) {
+	if index >= p.state.numValidators {
+		return nil, fmt.Errorf("validator index %d out of range [0, %d)", index, p.state.numValidators)
+	}
+	return validatorFields(p.state.validator(index)), nil
+}
+
+// StateRootProof proves the state root against BlockRoot.
+func (p *Prover) StateRootProof() EigenPod.BeaconChainProofsStateRootProof {
+	return EigenPod.BeaconChainProofsStateRootProof{
+		BeaconStateRoot: p.StateRoot(),
+		Proof:           flatten(p.headerProof()),
+	}
+}
+
+// ValidatorProof proves the fields of the validator at index against the state root.
+func (p *Prover) ValidatorProof(index uint64) (EigenPod.BeaconChainProofsValidatorProof, error) {
+	fields, err := p.ValidatorFields(index)
+	if err != nil {
+		return EigenPod.BeaconChainProofsValidatorProof{}, err
+	}
+	proof := flatten(
+		p.state.validatorTree.proof(index),
+		[][32]byte{uint64Chunk(p.state.numValidators)},
+		p.state.stateTree.proof(validatorContainerIndex),
+	)
+	return EigenPod.BeaconChainProofsValidatorProof{ValidatorFields: fields, Proof: proof}, nil
+}
+
+// WithdrawalCredentialProofs builds the proofs for verifyWithdrawalCredentials for the validators
+// at indices.
+func (p *Prover) WithdrawalCredentialProofs(indices []uint64) (*WithdrawalCredentialProofs, error) {
+	proofs := &WithdrawalCredentialProofs{StateRootProof: p.StateRootProof()}
+	for _, index := range indices {
+		validatorProof, err := p.ValidatorProof(index)
+		if err != nil {
+			return nil, err
+		}
+		proofs.ValidatorIndices = append(proofs.ValidatorIndices, new(big.Int).SetUint64(index))
+		proofs.ValidatorFieldsProofs = append(proofs.ValidatorFieldsProofs, validatorProof.Proof)
+		proofs.ValidatorFields = append(proofs.ValidatorFields, validatorProof.ValidatorFields)
+	}
+	return proofs, nil
+}
+
+// StaleBalanceProof builds the proofs for verifyStaleBalance for the validator at index.
+func (p *Prover) StaleBalanceProof(index uint64) (*StaleBalanceProof, error) {
+	validatorProof, err := p.ValidatorProof(index)
+	if err != nil {
+		return nil, err
+	}
+	return &StaleBalanceProof{StateRootProof: p.StateRootProof(), ValidatorProof: validatorProof}, nil
+}
+
+// BalanceContainerProof proves the state's balances container root against BlockRoot.
+func (p *Prover) BalanceContainerProof() EigenPod.BeaconChainProofsBalanceContainerProof {
+	return EigenPod.BeaconChainProofsBalanceContainerProof{
+		BalanceContainerRoot: p.state.balanceRoot,
+		Proof:                flatten(p.state.stateTree.proof(balanceContainerIndex), p.headerProof()),
+	}
+}
+
+// BalanceProof proves the balance of the validator at index against the balances container root.
+func (p *Prover) BalanceProof(index uint64) (EigenPod.BeaconChainProofsBalanceProof, error) {
+	if index >= p.state.numValidators || index >= p.state.numBalances {
+		return EigenPod.BeaconChainProofsBalanceProof{}, fmt.Errorf("validator index %d out of range [0, %d)", index, p.state.numBalances)
+	}
+	chunk := index / 4
+	return EigenPod.BeaconChainProofsBalanceProof{
+		PubkeyHash:  pubkeyRoot(p.state.validator(index)[:blsPubkeySize]),
+		BalanceRoot: p.state.balanceTree.layers[0][chunk],
+		Proof: flatten(
+			p.state.balanceTree.proof(chunk),
+			[][32]byte{uint64Chunk(p.state.numBalances)},
+		),
+	}, nil
+}
+
+// CheckpointProofs builds the proofs for verifyCheckpointProofs for the validators at indices.
+func (p *Prover) CheckpointProofs(indices []uint64) (*CheckpointProofs, error) {
+	proofs := &CheckpointProofs{BalanceContainerProof: p.BalanceContainerProof()}
+	for _, index := range indices {
+		balanceProof, err := p.BalanceProof(index)
+		if err != nil {
+			return nil, err
+		}
+		proofs.BalanceProofs = append(proofs.BalanceProofs, balanceProof)
+	}
+	return proofs, nil
+}
+
+// Balance returns the balance in gwei of the validator at index.
+func (p *Prover) Balance(index uint64) (uint64, error) {
+	if index >= p.state.numBalances {
+		return 0, fmt.Errorf("validator index %d out of range [0, %d)", index, p.state.numBalances)
+	}
+	return binary.LittleEndian.Uint64(p.state.balances[8*index:]), nil
+}
+
+// headerProof proves the state root against the block root.
+func (p *Prover) headerProof() [][32]byte {
+	h := p.state.header
+	fields := [][32]byte{
+		bytesChunk(h[0:8]),
+		bytesChunk(h[8:16]),
+		bytesChunk(h[16:48]),
+		bytesChunk(h[48:80]),
+		bytesChunk(h[80:112]),
+	}
+	tree, err := newMerkleTree(fields, beaconBlockHeaderHeight)
+	if err != nil {
+		// The header always fits in a tree of beaconBlockHeaderHeight.
+		panic(err)
+	}
+	return tree.proof(stateRootIndex)
+}
+
+// VerifyInclusion reports whether proof proves leaf at index under root, matching
+// Merkle.verifyInclusionSha256.
+func VerifyInclusion(root, leaf [32]byte, index *big.Int, proof []byte) bool {
+	if len(proof) == 0 || len(proof)%32 != 0 {
+		return false
+	}
+	idx := new(big.Int).Set(index)
+	computed := leaf
+	buf := make([]byte, 64)
+	for i := 0; i < len(proof); i += 32 {
+		if idx.Bit(0) == 0 {
+			copy(buf[:32], computed[:])
+			copy(buf[32:], proof[i:i+32])
+		} else {
+			copy(buf[:32], proof[i:i+32])
+			copy(buf[32:], computed[:])
+		}
+		computed = sha256.Sum256(buf)
+		idx.Rsh(idx, 1)
+	}
+	return computed == root
+}
+
+// VerifyValidatorFields checks a validator fields proof against a state root as
+// BeaconChainProofs.verifyValidatorFields does.
+func VerifyValidatorFields(stateRoot [32]byte, fields [][32]byte, proof []byte, validatorIndex uint64) bool {
+	if len(fields) != validatorFieldsLength || len(proof) != 32*(validatorTreeHeight+1+beaconStateTreeHeight) {
+		return false
+	}
+	index := new(big.Int).Lsh(big.NewInt(validatorContainerIndex), validatorTreeHeight+1)
+	index.Or(index, new(big.Int).SetUint64(validatorIndex))
+	return VerifyInclusion(stateRoot, containerRoot(fields...), index, proof)
+}
+
+// VerifyStateRoot checks a state root proof against a block root as BeaconChainProofs.verifyStateRoot does.
+func VerifyStateRoot(blockRoot [32]byte, proof EigenPod.BeaconChainProofsStateRootProof) bool {
+	if len(proof.Proof) != 32*beaconBlockHeaderHeight {
+		return false
+	}
+	return VerifyInclusion(blockRoot, proof.BeaconStateRoot, big.NewInt(stateRootIndex), proof.Proof)
+}
+
+// VerifyBalanceContainer checks a balance container proof against a block root as
+// BeaconChainProofs.verifyBalanceContainer does.
+func VerifyBalanceContainer(blockRoot [32]byte, proof EigenPod.BeaconChainProofsBalanceContainerProof) bool {
+	if len(proof.Proof) != 32*(beaconBlockHeaderHeight+beaconStateTreeHeight) {
+		return false
+	}
+	index := big.NewInt(stateRootIndex<<beaconStateTreeHeight | balanceContainerIndex)
+	return VerifyInclusion(blockRoot, proof.BalanceContainerRoot, index, proof.Proof)
+}
+
+// VerifyValidatorBalance checks a balance proof against a balance container root as
+// BeaconChainProofs.verifyValidatorBalance does, returning the proven balance in gwei.
+func VerifyValidatorBalance(balanceContainerRoot [32]byte, validatorIndex uint64, proof EigenPod.BeaconChainProofsBalanceProof) (uint64, bool) {
+	if len(proof.Proof) != 32*(balanceTreeHeight+1) {
+		return 0, false
+	}
+	if !VerifyInclusion(balanceContainerRoot, proof.BalanceRoot, new(big.Int).SetUint64(validatorIndex/4), proof.Proof) {
+		return 0, false
+	}
+	offset := 8 * (validatorIndex % 4)
+	return binary.LittleEndian.Uint64(proof.BalanceRoot[offset : offset+8]), true
+}
@@ -0,0 +1,153 @@
+package beaconproofs
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// maxDepth bounds the depth of any tree merkleized by this package.
+const maxDepth = 64
+
+// zeroHashes[i] is the root of a tree of depth i whose leaves are all zero.
+var zeroHashes [maxDepth + 1][32]byte
+
+func init() {
+	for i := 1; i <= maxDepth; i++ {
+		zeroHashes[i] = hashPair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+// merkleTree is an SSZ merkle tree of a fixed depth. Only the leaves that were supplied are
+// stored; the rest of each layer is implied to be zero subtrees.
+type merkleTree struct {
+	layers [][][32]byte
+	depth  int
+}
+
+func newMerkleTree(leaves [][32]byte, depth int) (*merkleTree, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("tree depth %d exceeds %d", depth, maxDepth)
+	}
+	if depth < 63 && uint64(len(leaves)) > uint64(1)<<depth {
+		return nil, fmt.Errorf("%d leaves exceed the capacity of a tree of depth %d", len(leaves), depth)
+	}
+
+	layers := make([][][32]byte, 0, depth+1)
+	layers = append(layers, leaves)
+	layer := leaves
+	for level := 0; level < depth; level++ {
+		next := make([][32]byte, (len(layer)+1)/2)
+		for i := range next {
+			right := zeroHashes[level]
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = hashPair(layer[2*i], right)
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+	return &merkleTree{layers: layers, depth: depth}, nil
+}
+
+func (t *merkleTree) root() [32]byte {
+	top := t.layers[t.depth]
+	if len(top) == 0 {
+		return zeroHashes[t.depth]
+	}
+	return top[0]
+}
+
+// proof returns the sibling of each node on the path from the leaf at index to the root,
+// starting at the leaf.
+func (t *merkleTree) proof(index uint64) [][32]byte {
+	proof := make([][32]byte, t.depth)
+	for level := 0; level < t.depth; level++ {
+		sibling := index ^ 1
+		if sibling < uint64(len(t.layers[level])) {
+			proof[level] = t.layers[level][sibling]
+		} else {
+			proof[level] = zeroHashes[level]
+		}
+		index >>= 1
+	}
+	return proof
+}
+
+// merkleize returns the root of leaves in a tree of the given depth.
+func merkleize(leaves [][32]byte, depth int) ([32]byte, error) {
+	tree, err := newMerkleTree(leaves, depth)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return tree.root(), nil
+}
+
+// containerRoot returns the hash tree root of a container with the given field roots.
+func containerRoot(fields ...[32]byte) [32]byte {
+	root, err := merkleize(fields, depthFor(len(fields)))
+	if err != nil {
+		// depthFor always returns a depth large enough for fields.
+		panic(err)
+	}
+	return root
+}
+
+// depthFor returns the depth of the smallest tree with at least n leaves.
+func depthFor(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	return hashPair(root, uint64Chunk(length))
+}
+
+func uint64Chunk(v uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], v)
+	return chunk
+}
+
+func bytesChunk(b []byte) [32]byte {
+	var chunk [32]byte
+	copy(chunk[:], b)
+	return chunk
+}
+
+// packChunks packs b into 32-byte chunks, zero padding the last.
+func packChunks(b []byte) [][32]byte {
+	chunks := make([][32]byte, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[32*i:])
+	}
+	return chunks
+}
+
+// pubkeyRoot returns the hash tree root of a 48-byte BLS public key, which is also the
+// pubkey hash EigenPods key validators by.
+func pubkeyRoot(pubkey []byte) [32]byte {
+	return hashPair(bytesChunk(pubkey[:32]), bytesChunk(pubkey[32:48]))
+}
+
+// flatten concatenates proof into the byte layout consumed by Merkle.verifyInclusionSha256.
+func flatten(proof ...[][32]byte) []byte {
+	var out []byte
+	for _, p := range proof {
+		for _, node := range p {
+			out = append(out, node[:]...)
+		}
+	}
+	return out
+}
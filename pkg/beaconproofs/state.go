@@ -0,0 +1,349 @@
+package beaconproofs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Indices of the fields of the Deneb BeaconState container used by EigenPod proofs.
+const (
+	stateFieldCount         = 28
+	latestBlockHeaderIndex  = 4
+	validatorContainerIndex = 11
+	balanceContainerIndex   = 12
+	beaconStateTreeHeight   = 5
+	validatorTreeHeight     = 40
+	balanceTreeHeight       = 38
+	beaconBlockHeaderHeight = 3
+	stateRootIndex          = 3
+	validatorFieldsLength   = 8
+	validatorSize           = 121
+	blockHeaderSize         = 112
+	syncCommitteeSize       = 512
+	blsPubkeySize           = 48
+	eth1DataSize            = 72
+	historicalSummarySize   = 64
+)
+
+// Deneb BeaconState list limits, expressed as tree depths of their packed chunks.
+const (
+	historicalRootsDepth       = 24
+	eth1DataVotesDepth         = 11
+	participationDepth         = 35 // 2^40 uint8s packed 32 per chunk
+	inactivityScoresDepth      = 38 // 2^40 uint64s packed 4 per chunk
+	historicalSummariesDepth   = 24
+	blockRootsDepth            = 13
+	randaoMixesDepth           = 16
+	slashingsDepth             = 11 // 8192 uint64s packed 4 per chunk
+	syncCommitteePubkeysDepth  = 9
+	logsBloomDepth             = 3
+	executionHeaderFieldsCount = 17
+)
+
+// stateFieldSizes lists the size of each fixed-size field of the Deneb BeaconState, and 0 for
+// variable-size fields, which are encoded as a 4-byte offset in the fixed part.
+var stateFieldSizes = [stateFieldCount]int{
+	8,                                    // genesis_time
+	32,                                   // genesis_validators_root
+	8,                                    // slot
+	16,                                   // fork
+	blockHeaderSize,                      // latest_block_header
+	32 * 8192,                            // block_roots
+	32 * 8192,                            // state_roots
+	0,                                    // historical_roots
+	eth1DataSize,                         // eth1_data
+	0,                                    // eth1_data_votes
+	8,                                    // eth1_deposit_index
+	0,                                    // validators
+	0,                                    // balances
+	32 * 65536,                           // randao_mixes
+	8 * 8192,                             // slashings
+	0,                                    // previous_epoch_participation
+	0,                                    // current_epoch_participation
+	1,                                    // justification_bits
+	40,                                   // previous_justified_checkpoint
+	40,                                   // current_justified_checkpoint
+	40,                                   // finalized_checkpoint
+	0,                                    // inactivity_scores
+	syncCommitteeSize*blsPubkeySize + 48, // current_sync_committee
+	syncCommitteeSize*blsPubkeySize + 48, // next_sync_committee
+	0,                                    // latest_execution_payload_header
+	8,                                    // next_withdrawal_index
+	8,                                    // next_withdrawal_validator_index
+	0,                                    // historical_summaries
+}
+
+// beaconState holds the parts of a Deneb BeaconState needed to build EigenPod proofs.
+type beaconState struct {
+	genesisTime uint64
+	slot        uint64
+	// header is latest_block_header with its state_root filled in.
+	header     []byte
+	fieldRoots [stateFieldCount][32]byte
+	stateTree  *merkleTree
+
+	validators     []byte
+	validatorTree  *merkleTree
+	numValidators  uint64
+	balances       []byte
+	balanceTree    *merkleTree
+	numBalances    uint64
+	balanceRoot    [32]byte
+	validatorsRoot [32]byte
+}
+
+// parseBeaconState decodes an SSZ-encoded Deneb BeaconState and merkleizes it.
+func parseBeaconState(data []byte) (*beaconState, error) {
+	fields, err := splitContainer(data, stateFieldSizes[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid BeaconState: %w", err)
+	}
+
+	s := &beaconState{
+		genesisTime: binary.LittleEndian.Uint64(fields[0]),
+		slot:        binary.LittleEndian.Uint64(fields[2]),
+		validators:  fields[validatorContainerIndex],
+		balances:    fields[balanceContainerIndex],
+	}
+	if len(s.validators)%validatorSize != 0 {
+		return nil, errors.New("invalid BeaconState: validators length is not a multiple of the validator size")
+	}
+	if len(s.balances)%8 != 0 {
+		return nil, errors.New("invalid BeaconState: balances length is not a multiple of 8")
+	}
+	s.numValidators = uint64(len(s.validators) / validatorSize)
+	s.numBalances = uint64(len(s.balances) / 8)
+
+	roots := &s.fieldRoots
+	roots[0] = uint64Chunk(s.genesisTime)
+	roots[1] = bytesChunk(fields[1])
+	roots[2] = uint64Chunk(s.slot)
+	roots[3] = containerRoot(bytesChunk(fields[3][0:4]), bytesChunk(fields[3][4:8]), uint64Chunk(binary.LittleEndian.Uint64(fields[3][8:16])))
+	if roots[5], err = merkleize(packChunks(fields[5]), blockRootsDepth); err != nil {
+		return nil, err
+	}
+	if roots[6], err = merkleize(packChunks(fields[6]), blockRootsDepth); err != nil {
+		return nil, err
+	}
+	if roots[7], err = listRoot(packChunks(fields[7]), historicalRootsDepth, uint64(len(fields[7])/32)); err != nil {
+		return nil, fmt.Errorf("invalid historical_roots: %w", err)
+	}
+	roots[8] = eth1DataRoot(fields[8])
+	if roots[9], err = elementListRoot(fields[9], eth1DataSize, eth1DataVotesDepth, eth1DataRoot); err != nil {
+		return nil, fmt.Errorf("invalid eth1_data_votes: %w", err)
+	}
+	roots[10] = uint64Chunk(binary.LittleEndian.Uint64(fields[10]))
+
+	validatorLeaves := make([][32]byte, s.numValidators)
+	for i := range validatorLeaves {
+		validatorLeaves[i] = containerRoot(validatorFields(s.validator(uint64(i)))...)
+	}
+	if s.validatorTree, err = newMerkleTree(validatorLeaves, validatorTreeHeight); err != nil {
+		return nil, fmt.Errorf("invalid validators: %w", err)
+	}
+	s.validatorsRoot = mixInLength(s.validatorTree.root(), s.numValidators)
+	roots[11] = s.validatorsRoot
+
+	if s.balanceTree, err = newMerkleTree(packChunks(s.balances), balanceTreeHeight); err != nil {
+		return nil, fmt.Errorf("invalid balances: %w", err)
+	}
+	s.balanceRoot = mixInLength(s.balanceTree.root(), s.numBalances)
+	roots[12] = s.balanceRoot
+
+	if roots[13], err = merkleize(packChunks(fields[13]), randaoMixesDepth); err != nil {
+		return nil, err
+	}
+	if roots[14], err = merkleize(packChunks(fields[14]), slashingsDepth); err != nil {
+		return nil, err
+	}
+	if roots[15], err = listRoot(packChunks(fields[15]), participationDepth, uint64(len(fields[15]))); err != nil {
+		return nil, fmt.Errorf("invalid previous_epoch_participation: %w", err)
+	}
+	if roots[16], err = listRoot(packChunks(fields[16]), participationDepth, uint64(len(fields[16]))); err != nil {
+		return nil, fmt.Errorf("invalid current_epoch_participation: %w", err)
+	}
+	roots[17] = bytesChunk(fields[17])
+	roots[18] = checkpointRoot(fields[18])
+	roots[19] = checkpointRoot(fields[19])
+	roots[20] = checkpointRoot(fields[20])
+	if len(fields[21])%8 != 0 {
+		return nil, errors.New("invalid inactivity_scores: length is not a multiple of 8")
+	}
+	if roots[21], err = listRoot(packChunks(fields[21]), inactivityScoresDepth, uint64(len(fields[21])/8)); err != nil {
+		return nil, fmt.Errorf("invalid inactivity_scores: %w", err)
+	}
+	if roots[22], err = syncCommitteeRoot(fields[22]); err != nil {
+		return nil, err
+	}
+	if roots[23], err = syncCommitteeRoot(fields[23]); err != nil {
+		return nil, err
+	}
+	if roots[24], err = executionPayloadHeaderRoot(fields[24]); err != nil {
+		return nil, fmt.Errorf("invalid latest_execution_payload_header: %w", err)
+	}
+	roots[25] = uint64Chunk(binary.LittleEndian.Uint64(fields[25]))
+	roots[26] = uint64Chunk(binary.LittleEndian.Uint64(fields[26]))
+	if roots[27], err = elementListRoot(fields[27], historicalSummarySize, historicalSummariesDepth, func(b []byte) [32]byte {
+		return hashPair(bytesChunk(b[:32]), bytesChunk(b[32:64]))
+	}); err != nil {
+		return nil, fmt.Errorf("invalid historical_summaries: %w", err)
+	}
+
+	roots[latestBlockHeaderIndex] = blockHeaderRoot(fields[latestBlockHeaderIndex])
+	if s.stateTree, err = newMerkleTree(roots[:], beaconStateTreeHeight); err != nil {
+		return nil, err
+	}
+
+	// The state stores the header of the block that produced it with a zero state_root, which is
+	// only filled in when the next slot is processed.
+	s.header = make([]byte, blockHeaderSize)
+	copy(s.header, fields[latestBlockHeaderIndex])
+	if bytesChunk(s.header[48:80]) == ([32]byte{}) {
+		stateRoot := s.stateTree.root()
+		copy(s.header[48:80], stateRoot[:])
+	}
+	return s, nil
+}
+
+// validator returns the SSZ encoding of the validator at index.
+func (s *beaconState) validator(index uint64) []byte {
+	return s.validators[index*validatorSize : (index+1)*validatorSize]
+}
+
+// splitContainer splits an SSZ container into its fields. sizes holds the size of each fixed-size
+// field and 0 for each variable-size field.
+func splitContainer(data []byte, sizes []int) ([][]byte, error) {
+	fields := make([][]byte, len(sizes))
+	var variable []int
+	var offsets []int
+	pos := 0
+	for i, size := range sizes {
+		if size == 0 {
+			if pos+4 > len(data) {
+				return nil, errors.New("data too short")
+			}
+			variable = append(variable, i)
+			offsets = append(offsets, int(binary.LittleEndian.Uint32(data[pos:])))
+			pos += 4
+			continue
+		}
+		if pos+size > len(data) {
+			return nil, errors.New("data too short")
+		}
+		fields[i] = data[pos : pos+size]
+		pos += size
+	}
+	if len(variable) == 0 {
+		if pos != len(data) {
+			return nil, errors.New("unexpected trailing data")
+		}
+		return fields, nil
+	}
+	if offsets[0] != pos {
+		return nil, fmt.Errorf("first offset %d does not match fixed size %d", offsets[0], pos)
+	}
+	for j, i := range variable {
+		end := len(data)
+		if j+1 < len(offsets) {
+			end = offsets[j+1]
+		}
+		if offsets[j] > end || end > len(data) {
+			return nil, errors.New("invalid offsets")
+		}
+		fields[i] = data[offsets[j]:end]
+	}
+	return fields, nil
+}
+
+// validatorFields returns the eight field roots of an SSZ-encoded Validator.
+func validatorFields(v []byte) [][32]byte {
+	fields := make([][32]byte, validatorFieldsLength)
+	fields[0] = pubkeyRoot(v[0:48])
+	fields[1] = bytesChunk(v[48:80])
+	fields[2] = bytesChunk(v[80:88])
+	fields[3] = bytesChunk(v[88:89])
+	fields[4] = bytesChunk(v[89:97])
+	fields[5] = bytesChunk(v[97:105])
+	fields[6] = bytesChunk(v[105:113])
+	fields[7] = bytesChunk(v[113:121])
+	return fields
+}
+
+func blockHeaderRoot(h []byte) [32]byte {
+	return containerRoot(
+		bytesChunk(h[0:8]),
+		bytesChunk(h[8:16]),
+		bytesChunk(h[16:48]),
+		bytesChunk(h[48:80]),
+		bytesChunk(h[80:112]),
+	)
+}
+
+func eth1DataRoot(b []byte) [32]byte {
+	return containerRoot(bytesChunk(b[0:32]), bytesChunk(b[32:40]), bytesChunk(b[40:72]))
+}
+
+func checkpointRoot(b []byte) [32]byte {
+	return containerRoot(bytesChunk(b[0:8]), bytesChunk(b[8:40]))
+}
+
+func syncCommitteeRoot(b []byte) ([32]byte, error) {
+	pubkeys := make([][32]byte, syncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = pubkeyRoot(b[i*blsPubkeySize : (i+1)*blsPubkeySize])
+	}
+	pubkeysRoot, err := merkleize(pubkeys, syncCommitteePubkeysDepth)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return containerRoot(pubkeysRoot, pubkeyRoot(b[syncCommitteeSize*blsPubkeySize:])), nil
+}
+
+// executionPayloadHeaderRoot returns the hash tree root of a Deneb ExecutionPayloadHeader.
+func executionPayloadHeaderRoot(b []byte) ([32]byte, error) {
+	sizes := []int{32, 20, 32, 32, 256, 32, 8, 8, 8, 8, 0, 32, 32, 32, 32, 8, 8}
+	fields, err := splitContainer(b, sizes)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(fields[10]) > 32 {
+		return [32]byte{}, errors.New("extra_data exceeds 32 bytes")
+	}
+
+	roots := make([][32]byte, executionHeaderFieldsCount)
+	for i, field := range fields {
+		switch i {
+		case 4:
+			if roots[i], err = merkleize(packChunks(field), logsBloomDepth); err != nil {
+				return [32]byte{}, err
+			}
+		case 10:
+			roots[i] = mixInLength(bytesChunk(field), uint64(len(field)))
+		default:
+			roots[i] = bytesChunk(field)
+		}
+	}
+	return containerRoot(roots...), nil
+}
+
+// listRoot returns the hash tree root of a list of basic values packed into chunks.
+func listRoot(chunks [][32]byte, depth int, length uint64) ([32]byte, error) {
+	root, err := merkleize(chunks, depth)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(root, length), nil
+}
+
+// elementListRoot returns the hash tree root of a list of fixed-size containers.
+func elementListRoot(b []byte, size, depth int, root func([]byte) [32]byte) ([32]byte, error) {
+	if len(b)%size != 0 {
+		return [32]byte{}, fmt.Errorf("length %d is not a multiple of %d", len(b), size)
+	}
+	leaves := make([][32]byte, len(b)/size)
+	for i := range leaves {
+		leaves[i] = root(b[i*size : (i+1)*size])
+	}
+	return listRoot(leaves, depth, uint64(len(leaves)))
+}
@@ -31,8 +31,8 @@ var (
 
 // StrategyBaseTVLLimitsMetaData contains all meta data concerning the StrategyBaseTVLLimits contract.
 var StrategyBaseTVLLimitsMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_strategyManager\",\"type\":\"address\",\"internalType\":\"contractIStrategyManager\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"newShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"explanation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getTVLLimits\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_maxPerDeposit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_maxTotalDeposits\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_underlyingToken\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"_pauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_underlyingToken\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"_pauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"maxPerDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxTotalDeposits\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"pauseAll\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pauserRegistry\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setPauserRegistry\",\"inputs\":[{\"name\":\"newPauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTVLLimits\",\"inputs\":[{\"name\":\"newMaxPerDeposit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"newMaxTotalDeposits\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"shares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sharesToUnderlying\",\"inputs\":[{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sharesToUnderlyingView\",\"inputs\":[{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"strategyManager\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIStrategyManager\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToShares\",\"inputs\":[{\"name\":\"amountUnderlying\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToSharesView\",\"inputs\":[{\"name\":\"amountUnderlying\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC20\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"userUnderlying\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"userUnderlyingView\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ExchangeRateEmitted\",\"inputs\":[{\"name\":\"rate\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"uint8\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MaxPerDepositUpdated\",\"inputs\":[{\"name\":\"previousValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MaxTotalDepositsUpdated\",\"inputs\":[{\"name\":\"previousValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PauserRegistrySet\",\"inputs\":[{\"name\":\"pauserRegistry\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIPauserRegistry\"},{\"name\":\"newPauserRegistry\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIPauserRegistry\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StrategyTokenSet\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIERC20\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"uint8\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false}]",
-	Bin: "0x60a06040523480156200001157600080fd5b5060405162001f4d38038062001f4d833981016040819052620000349162000116565b6001600160a01b038116608052806200004c62000054565b505062000148565b600054610100900460ff1615620000c15760405162461bcd60e51b815260206004820152602760248201527f496e697469616c697a61626c653a20636f6e747261637420697320696e697469604482015266616c697a696e6760c81b606482015260840160405180910390fd5b60005460ff908116101562000114576000805460ff191660ff9081179091556040519081527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b565b6000602082840312156200012957600080fd5b81516001600160a01b03811681146200014157600080fd5b9392505050565b608051611dd46200017960003960008181610216015281816107a901528181610be70152610cb20152611dd46000f3fe608060405234801561001057600080fd5b506004361061018e5760003560e01c80635c975abb116100de578063ab5921e111610097578063df6fadc111610071578063df6fadc114610366578063e3dae51c14610381578063f3e7387514610394578063fabc1cbc146103a757600080fd5b8063ab5921e11461032b578063ce7c2ac214610340578063d9caed121461035357600080fd5b80635c975abb146102c857806361b01b5d146102d05780637a8b2637146102d9578063886f1195146102ec5780638c871019146103055780638f6a62401461031857600080fd5b80633a98ef391161014b578063485cc95511610125578063485cc9551461026b578063553ca5f81461027e578063595c6a67146102915780635ac86ab71461029957600080fd5b80633a98ef391461023857806343fe08b01461024f57806347e7ef241461025857600080fd5b8063019e27291461019357806310d67a2f146101a857806311c70c9d146101bb578063136439dd146101ce5780632495a599146101e157806339b70e3814610211575b600080fd5b6101a66101a1366004611983565b6103ba565b005b6101a66101b63660046119cd565b61049d565b6101a66101c93660046119ea565b610550565b6101a66101dc366004611a0c565b610605565b6032546101f4906001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b6101f47f000000000000000000000000000000000000000000000000000000000000000081565b61024160335481565b604051908152602001610208565b61024160645481565b610241610266366004611a25565b610749565b6101a6610279366004611a51565b61098d565b61024161028c3660046119cd565b610a5b565b6101a6610a6f565b6102b86102a7366004611a99565b6001805460ff9092161b9081161490565b6040519015158152602001610208565b600154610241565b61024160655481565b6102416102e7366004611a0c565b610b3b565b6000546101f4906201000090046001600160a01b031681565b610241610313366004611a0c565b610b86565b6102416103263660046119cd565b610b91565b610333610b9f565b6040516102089190611ae6565b61024161034e3660046119cd565b610bbf565b6101a6610361366004611b19565b610c54565b60645460655460408051928352602083019190915201610208565b61024161038f366004611a0c565b610e3a565b6102416103a2366004611a0c565b610e73565b6101a66103b5366004611a0c565b610e7e565b600054610100900460ff16158080156103da5750600054600160ff909116105b806103f45750303b1580156103f4575060005460ff166001145b6104195760405162461bcd60e51b815260040161041090611b5a565b60405180910390fd5b6000805460ff19166001179055801561043c576000805461ff0019166101001790555b6104468585610fda565b61045083836110e7565b8015610496576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b5050505050565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156104f0573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105149190611ba8565b6001600160a01b0316336001600160a01b0316146105445760405162461bcd60e51b815260040161041090611bc5565b61054d8161123a565b50565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156105a3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105c79190611ba8565b6001600160a01b0316336001600160a01b0316146105f75760405162461bcd60e51b815260040161041090611bc5565b6106018282610fda565b5050565b60005460405163237dfb4760e11b8152336004820152620100009091046001600160a01b0316906346fbf68e90602401602060405180830381865afa158015610652573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106769190611c0f565b6106925760405162461bcd60e51b815260040161041090611c31565b6001548181161461070b5760405162461bcd60e51b815260206004820152603860248201527f5061757361626c652e70617573653a20696e76616c696420617474656d70742060448201527f746f20756e70617573652066756e6374696f6e616c69747900000000000000006064820152608401610410565b600181905560405181815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d906020015b60405180910390a250565b6001805460009182918116141561079e5760405162461bcd60e51b815260206004820152601960248201527814185d5cd8589b194e881a5b99195e081a5cc81c185d5cd959603a1b6044820152606401610410565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146108165760405162461bcd60e51b815260206004820181905260248201527f5374726174656779426173652e6f6e6c7953747261746567794d616e616765726044820152606401610410565b610820848461133f565b60335460006108316103e883611c8f565b905060006103e8610840611421565b61084a9190611c8f565b905060006108588783611ca7565b9050806108658489611cbe565b61086f9190611cdd565b9550856108d55760405162461bcd60e51b815260206004820152602e60248201527f5374726174656779426173652e6465706f7369743a206e65775368617265732060448201526d63616e6e6f74206265207a65726f60901b6064820152608401610410565b6108df8685611c8f565b60338190556f4b3b4ca85a86c47a098a223fffffffff10156109695760405162461bcd60e51b815260206004820152603c60248201527f5374726174656779426173652e6465706f7369743a20746f74616c536861726560448201527f73206578636565647320604d41585f544f54414c5f53484152455360000000006064820152608401610410565b610982826103e860335461097d9190611c8f565b611493565b505050505092915050565b600054610100900460ff16158080156109ad5750600054600160ff909116105b806109c75750303b1580156109c7575060005460ff166001145b6109e35760405162461bcd60e51b815260040161041090611b5a565b6000805460ff191660011790558015610a06576000805461ff0019166101001790555b610a1083836110e7565b8015610a56576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b505050565b6000610a696102e783610bbf565b92915050565b60005460405163237dfb4760e11b8152336004820152620100009091046001600160a01b0316906346fbf68e90602401602060405180830381865afa158015610abc573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ae09190611c0f565b610afc5760405162461bcd60e51b815260040161041090611c31565b600019600181905560405190815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d9060200160405180910390a2565b6000806103e8603354610b4e9190611c8f565b905060006103e8610b5d611421565b610b679190611c8f565b905081610b748583611cbe565b610b7e9190611cdd565b949350505050565b6000610a6982610e3a565b6000610a696103a283610bbf565b60606040518060800160405280604d8152602001611d52604d9139905090565b604051633d3f06c960e11b81526001600160a01b0382811660048301523060248301526000917f000000000000000000000000000000000000000000000000000000000000000090911690637a7e0d9290604401602060405180830381865afa158015610c30573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610a699190611cff565b6001805460029081161415610ca75760405162461bcd60e51b815260206004820152601960248201527814185d5cd8589b194e881a5b99195e081a5cc81c185d5cd959603a1b6044820152606401610410565b336001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614610d1f5760405162461bcd60e51b815260206004820181905260248201527f5374726174656779426173652e6f6e6c7953747261746567794d616e616765726044820152606401610410565b610d2a8484846114df565b60335480831115610db95760405162461bcd60e51b815260206004820152604d60248201527f5374726174656779426173652e77697468647261773a20616d6f756e7453686160448201527f726573206d757374206265206c657373207468616e206f7220657175616c207460648201526c6f20746f74616c53686172657360981b608482015260a401610410565b6000610dc76103e883611c8f565b905060006103e8610dd6611421565b610de09190611c8f565b9050600082610def8784611cbe565b610df99190611cdd565b9050610e058685611ca7565b603355610e25610e158284611ca7565b6103e860335461097d9190611c8f565b610e30888883611562565b5050505050505050565b6000806103e8603354610e4d9190611c8f565b905060006103e8610e5c611421565b610e669190611c8f565b905080610b748386611cbe565b6000610a6982610b3b565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610ed1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ef59190611ba8565b6001600160a01b0316336001600160a01b031614610f255760405162461bcd60e51b815260040161041090611bc5565b600154198119600154191614610fa35760405162461bcd60e51b815260206004820152603860248201527f5061757361626c652e756e70617573653a20696e76616c696420617474656d7060448201527f7420746f2070617573652066756e6374696f6e616c69747900000000000000006064820152608401610410565b600181905560405181815233907f3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c9060200161073e565b60645460408051918252602082018490527ff97ed4e083acac67830025ecbc756d8fe847cdbdca4cee3fe1e128e98b54ecb5910160405180910390a160655460408051918252602082018390527f6ab181e0440bfbf4bacdf2e99674735ce6638005490688c5f994f5399353e452910160405180910390a1808211156110dc5760405162461bcd60e51b815260206004820152604b60248201527f53747261746567794261736554564c4c696d6974732e5f73657454564c4c696d60448201527f6974733a206d61785065724465706f7369742065786365656473206d6178546f60648201526a74616c4465706f7369747360a81b608482015260a401610410565b606491909155606555565b600054610100900460ff166111525760405162461bcd60e51b815260206004820152602b60248201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960448201526a6e697469616c697a696e6760a81b6064820152608401610410565b603280546001600160a01b0319166001600160a01b038416179055611178816000611576565b7f1c540707b00eb5427b6b774fc799d756516a54aee108b64b327acc55af557507603260009054906101000a90046001600160a01b0316836001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa1580156111ed573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906112119190611d18565b604080516001600160a01b03909316835260ff9091166020830152015b60405180910390a15050565b6001600160a01b0381166112c85760405162461bcd60e51b815260206004820152604960248201527f5061757361626c652e5f73657450617573657252656769737472793a206e657760448201527f50617573657252656769737472792063616e6e6f7420626520746865207a65726064820152686f206164647265737360b81b608482015260a401610410565b600054604080516001600160a01b03620100009093048316815291831660208301527f6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6910160405180910390a1600080546001600160a01b03909216620100000262010000600160b01b0319909216919091179055565b6064548111156113a95760405162461bcd60e51b815260206004820152602f60248201527f53747261746567794261736554564c4c696d6974733a206d617820706572206460448201526e195c1bdcda5d08195e18d959591959608a1b6064820152608401610410565b6065546113b4611421565b11156114175760405162461bcd60e51b815260206004820152602c60248201527f53747261746567794261736554564c4c696d6974733a206d6178206465706f7360448201526b1a5d1cc8195e18d95959195960a21b6064820152608401610410565b6106018282611662565b6032546040516370a0823160e01b81523060048201526000916001600160a01b0316906370a0823190602401602060405180830381865afa15801561146a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061148e9190611cff565b905090565b7fd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8816114c784670de0b6b3a7640000611cbe565b6114d19190611cdd565b60405190815260200161122e565b6032546001600160a01b03838116911614610a565760405162461bcd60e51b815260206004820152603b60248201527f5374726174656779426173652e77697468647261773a2043616e206f6e6c792060448201527f77697468647261772074686520737472617465677920746f6b656e00000000006064820152608401610410565b610a566001600160a01b03831684836116de565b6000546201000090046001600160a01b031615801561159d57506001600160a01b03821615155b61161f5760405162461bcd60e51b815260206004820152604760248201527f5061757361626c652e5f696e697469616c697a655061757365723a205f696e6960448201527f7469616c697a6550617573657228292063616e206f6e6c792062652063616c6c6064820152666564206f6e636560c81b608482015260a401610410565b600181905560405181815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d9060200160405180910390a26106018261123a565b6032546001600160a01b038381169116146106015760405162461bcd60e51b815260206004820152603660248201527f5374726174656779426173652e6465706f7369743a2043616e206f6e6c79206460448201527532b837b9b4ba103ab73232b9363cb4b733aa37b5b2b760511b6064820152608401610410565b604080516001600160a01b03848116602483015260448083018590528351808403909101815260649092018352602080830180516001600160e01b031663a9059cbb60e01b17905283518085019094528084527f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c656490840152610a569286929160009161176e9185169084906117eb565b805190915015610a56578080602001905181019061178c9190611c0f565b610a565760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b6064820152608401610410565b60606117fa8484600085611804565b90505b9392505050565b6060824710156118655760405162461bcd60e51b815260206004820152602660248201527f416464726573733a20696e73756666696369656e742062616c616e636520666f6044820152651c8818d85b1b60d21b6064820152608401610410565b6001600160a01b0385163b6118bc5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000006044820152606401610410565b600080866001600160a01b031685876040516118d89190611d35565b60006040518083038185875af1925050503d8060008114611915576040519150601f19603f3d011682016040523d82523d6000602084013e61191a565b606091505b509150915061192a828286611935565b979650505050505050565b606083156119445750816117fd565b8251156119545782518084602001fd5b8160405162461bcd60e51b81526004016104109190611ae6565b6001600160a01b038116811461054d57600080fd5b6000806000806080858703121561199957600080fd5b843593506020850135925060408501356119b28161196e565b915060608501356119c28161196e565b939692955090935050565b6000602082840312156119df57600080fd5b81356117fd8161196e565b600080604083850312156119fd57600080fd5b50508035926020909101359150565b600060208284031215611a1e57600080fd5b5035919050565b60008060408385031215611a3857600080fd5b8235611a438161196e565b946020939093013593505050565b60008060408385031215611a6457600080fd5b8235611a6f8161196e565b91506020830135611a7f8161196e565b809150509250929050565b60ff8116811461054d57600080fd5b600060208284031215611aab57600080fd5b81356117fd81611a8a565b60005b83811015611ad1578181015183820152602001611ab9565b83811115611ae0576000848401525b50505050565b6020815260008251806020840152611b05816040850160208701611ab6565b601f01601f19169190910160400192915050565b600080600060608486031215611b2e57600080fd5b8335611b398161196e565b92506020840135611b498161196e565b929592945050506040919091013590565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b600060208284031215611bba57600080fd5b81516117fd8161196e565b6020808252602a908201527f6d73672e73656e646572206973206e6f74207065726d697373696f6e6564206160408201526939903ab73830bab9b2b960b11b606082015260800190565b600060208284031215611c2157600080fd5b815180151581146117fd57600080fd5b60208082526028908201527f6d73672e73656e646572206973206e6f74207065726d697373696f6e6564206160408201526739903830bab9b2b960c11b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b60008219821115611ca257611ca2611c79565b500190565b600082821015611cb957611cb9611c79565b500390565b6000816000190483118215151615611cd857611cd8611c79565b500290565b600082611cfa57634e487b7160e01b600052601260045260246000fd5b500490565b600060208284031215611d1157600080fd5b5051919050565b600060208284031215611d2a57600080fd5b81516117fd81611a8a565b60008251611d47818460208701611ab6565b919091019291505056fe4261736520537472617465677920696d706c656d656e746174696f6e20746f20696e68657269742066726f6d20666f72206d6f726520636f6d706c657820696d706c656d656e746174696f6e73a2646970667358221220ae191a686b20435062f14027adbcd93bce8ba8dab6896d1d893b5478c9d9197f64736f6c634300080c0033",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_strategyManager\",\"type\":\"address\",\"internalType\":\"contractIStrategyManager\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"capChangeTimestamp\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"capVeto\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"checkInvariants\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"newShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"explanation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"freezeShares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"frozenShares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLLimits\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_maxPerDeposit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_maxTotalDeposits\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_underlyingToken\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"_pauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_underlyingToken\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"_pauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isFrozen\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxPerDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxTotalDeposits\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"pauseAll\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pauserRegistry\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previousMaxPerDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previousMaxTotalDeposits\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setCapVeto\",\"inputs\":[{\"name\":\"newCapVeto\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"newVetoWindow\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPauserRegistry\",\"inputs\":[{\"name\":\"newPauserRegistry\",\"type\":\"address\",\"internalType\":\"contractIPauserRegistry\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTVLLimits\",\"inputs\":[{\"name\":\"newMaxPerDeposit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"newMaxTotalDeposits\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"shares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sharesToUnderlying\",\"inputs\":[{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"sharesToUnderlyingView\",\"inputs\":[{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"strategyManager\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIStrategyManager\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToShares\",\"inputs\":[{\"name\":\"amountUnderlying\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToSharesView\",\"inputs\":[{\"name\":\"amountUnderlying\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"underlyingToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC20\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unfreezeShares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"userUnderlying\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"userUnderlyingView\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vetoCapChange\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vetoWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"contractIERC20\"},{\"name\":\"amountShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CapChangeVetoed\",\"inputs\":[{\"name\":\"restoredMaxPerDeposit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"restoredMaxTotalDeposits\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CapVetoSet\",\"inputs\":[{\"name\":\"previousCapVeto\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"newCapVeto\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"newVetoWindow\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ExchangeRateEmitted\",\"inputs\":[{\"name\":\"rate\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"uint8\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MaxPerDepositUpdated\",\"inputs\":[{\"name\":\"previousValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MaxTotalDepositsUpdated\",\"inputs\":[{\"name\":\"previousValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newValue\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PauserRegistrySet\",\"inputs\":[{\"name\":\"pauserRegistry\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIPauserRegistry\"},{\"name\":\"newPauserRegistry\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIPauserRegistry\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SharesFrozen\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SharesUnfrozen\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StrategyTokenSet\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"contractIERC20\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"uint8\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newPausedStatus\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false}]",
+	Bin: "0x60a06040523480156200001157600080fd5b506040516200292438038062002924833981016040819052620000349162000116565b6001600160a01b038116608052806200004c62000054565b505062000148565b600054610100900460ff1615620000c15760405162461bcd60e51b815260206004820152602760248201527f496e697469616c697a61626c653a20636f6e747261637420697320696e697469604482015266616c697a696e6760c81b606482015260840160405180910390fd5b60005460ff908116101562000114576000805460ff191660ff9081179091556040519081527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b565b6000602082840312156200012957600080fd5b81516001600160a01b03811681146200014157600080fd5b9392505050565b6080516127ab62000179600039600081816102c201528181610b6c0152818161127901526114c101526127ab6000f3fe608060405234801561001057600080fd5b50600436106102325760003560e01c806377a94c1111610130578063ce7c2ac2116100b8578063e3dae51c1161007c578063e3dae51c146104b0578063e5839836146104c3578063f3e73875146104e6578063f637c328146104f9578063fabc1cbc1461050157600080fd5b8063ce7c2ac214610453578063cf744d2814610466578063cfe3b65814610479578063d9caed1214610482578063df6fadc11461049557600080fd5b8063886f1195116100ff578063886f1195146103ec5780638c871019146104055780638f6a62401461041857806397e179c21461042b578063ab5921e11461043e57600080fd5b806377a94c11146103aa5780637a8b2637146103bd57806384a1d515146103d057806386c6cd0a146103d957600080fd5b806347e7ef24116101be5780635ac86ab7116101825780635ac86ab71461034e5780635c975abb1461037d57806361b01b5d1461038557806374e36ab31461038e57806375e65842146103a157600080fd5b806347e7ef2414610304578063485cc95514610317578063514194f31461032a578063553ca5f814610333578063595c6a671461034657600080fd5b8063136439dd11610205578063136439dd1461027a5780632495a5991461028d57806339b70e38146102bd5780633a98ef39146102e457806343fe08b0146102fb57600080fd5b8063019e2729146102375780630343dfa01461024c57806310d67a2f1461025457806311c70c9d14610267575b600080fd5b61024a61024536600461235e565b610514565b005b61024a6105f7565b61024a6102623660046123a8565b6107e5565b61024a6102753660046123c5565b610895565b61024a6102883660046123e7565b6109c9565b6032546102a0906001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b6102a07f000000000000000000000000000000000000000000000000000000000000000081565b6102ed60335481565b6040519081526020016102b4565b6102ed60645481565b6102ed610312366004612400565b610b0d565b61024a61032536600461242c565b610d53565b6102ed60675481565b6102ed6103413660046123a8565b610e20565b61024a610e34565b61036d61035c366004612474565b6001805460ff9092161b9081161490565b60405190151581526020016102b4565b6001546102ed565b6102ed60655481565b6102ed61039c3660046123a8565b610f00565b6102ed60695481565b6066546102a0906001600160a01b031681565b6102ed6103cb3660046123e7565b610f30565b6102ed60685481565b61024a6103e7366004612400565b610f7b565b6000546102a0906201000090046001600160a01b031681565b6102ed6104133660046123e7565b611096565b6102ed6104263660046123a8565b6110a1565b61024a6104393660046123a8565b6110af565b610446611231565b6040516102b491906124b5565b6102ed6104613660046123a8565b611251565b61024a6104743660046123a8565b6112e6565b6102ed606a5481565b61024a6104903660046124e8565b611464565b606454606554604080519283526020830191909152016102b4565b6102ed6104be3660046123e7565b611649565b61036d6104d13660046123a8565b606b6020526000908152604090205460ff1681565b6102ed6104f43660046123e7565b611682565b61024a61168d565b61024a61050f3660046123e7565b611855565b600054610100900460ff16158080156105345750600054600160ff909116105b8061054e5750303b15801561054e575060005460ff166001145b6105735760405162461bcd60e51b815260040161056a90612529565b60405180910390fd5b6000805460ff191660011790558015610596576000805461ff0019166101001790555b6105a085856119b1565b6105aa8383611abe565b80156105f0576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a15b5050505050565b60645415806106065750606554155b80610615575060655460645411155b61069d5760405162461bcd60e51b815260206004820152604d60248201527f53747261746567794261736554564c4c696d6974732e636865636b496e76617260448201527f69616e74733a206d61785065724465706f7369742065786365656473206d617860648201526c546f74616c4465706f7369747360981b608482015260a40161056a565b6106a5611c11565b6106b0603354610f30565b11156107355760405162461bcd60e51b815260206004820152604860248201527f53747261746567794261736554564c4c696d6974732e636865636b496e76617260448201527f69616e74733a20746f74616c536861726573206578636565647320746f6b656e6064820152672062616c616e636560c01b608482015260a40161056a565b600061074060015490565b9050600019811480610760575061075860018061258d565b60ff1681901c155b6107e25760405162461bcd60e51b815260206004820152604760248201527f53747261746567794261736554564c4c696d6974732e636865636b496e76617260448201527f69616e74733a20706175736564206269742065786365656473206d61782070616064820152661d5cd948189a5d60ca1b608482015260a40161056a565b50565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610838573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061085c91906125a6565b6001600160a01b0316336001600160a01b03161461088c5760405162461bcd60e51b815260040161056a906125c3565b6107e281611c83565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156108e8573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061090c91906125a6565b6001600160a01b0316336001600160a01b03161461093c5760405162461bcd60e51b815260040161056a906125c3565b6000606a546000141580156109605750606754606a5461095c919061260d565b4211155b9050606454831180610973575060655482115b15610993578061098a576064546068556065546069555b42606a556109ba565b8015806109af575060685483111580156109af57506069548211155b156109ba576000606a555b6109c483836119b1565b505050565b60005460405163237dfb4760e11b8152336004820152620100009091046001600160a01b0316906346fbf68e90602401602060405180830381865afa158015610a16573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610a3a9190612620565b610a565760405162461bcd60e51b815260040161056a90612642565b60015481811614610acf5760405162461bcd60e51b815260206004820152603860248201527f5061757361626c652e70617573653a20696e76616c696420617474656d70742060448201527f746f20756e70617573652066756e6374696f6e616c6974790000000000000000606482015260840161056a565b600181905560405181815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d906020015b60405180910390a250565b600180546000918291811603610b615760405162461bcd60e51b815260206004820152601960248201527814185d5cd8589b194e881a5b99195e081a5cc81c185d5cd959603a1b604482015260640161056a565b336001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614610bd95760405162461bcd60e51b815260206004820181905260248201527f5374726174656779426173652e6f6e6c7953747261746567794d616e61676572604482015260640161056a565b610be38484611d88565b6033546000610bf46103e88361260d565b905060006103e8610c03611c11565b610c0d919061260d565b90506000610c1b878361268a565b905080610c28848961269d565b610c3291906126b4565b955085600003610c9b5760405162461bcd60e51b815260206004820152602e60248201527f5374726174656779426173652e6465706f7369743a206e65775368617265732060448201526d63616e6e6f74206265207a65726f60901b606482015260840161056a565b610ca5868561260d565b60338190556f4b3b4ca85a86c47a098a223fffffffff1015610d2f5760405162461bcd60e51b815260206004820152603c60248201527f5374726174656779426173652e6465706f7369743a20746f74616c536861726560448201527f73206578636565647320604d41585f544f54414c5f5348415245536000000000606482015260840161056a565b610d48826103e8603354610d43919061260d565b611e6e565b505050505092915050565b600054610100900460ff1615808015610d735750600054600160ff909116105b80610d8d5750303b158015610d8d575060005460ff166001145b610da95760405162461bcd60e51b815260040161056a90612529565b6000805460ff191660011790558015610dcc576000805461ff0019166101001790555b610dd68383611abe565b80156109c4576000805461ff0019169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a1505050565b6000610e2e6103cb83611251565b92915050565b60005460405163237dfb4760e11b8152336004820152620100009091046001600160a01b0316906346fbf68e90602401602060405180830381865afa158015610e81573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ea59190612620565b610ec15760405162461bcd60e51b815260040161056a90612642565b600019600181905560405190815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d9060200160405180910390a2565b6001600160a01b0381166000908152606b602052604081205460ff16610f27576000610e2e565b610e2e82611251565b6000806103e8603354610f43919061260d565b905060006103e8610f52611c11565b610f5c919061260d565b905081610f69858361269d565b610f7391906126b4565b949350505050565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610fce573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610ff291906125a6565b6001600160a01b0316336001600160a01b0316146110225760405162461bcd60e51b815260040161056a906125c3565b606654604080516001600160a01b039283168152918416602083015281018290527fbc08b7585d4b60491bdcdd947ce64408878da2d4255c3f6bb6b9d9457a7e2c029060600160405180910390a1606680546001600160a01b0319166001600160a01b039390931692909217909155606755565b6000610e2e82611649565b6000610e2e6104f483611251565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611102573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061112691906125a6565b6001600160a01b0316336001600160a01b0316146111565760405162461bcd60e51b815260040161056a906125c3565b6001600160a01b0381166000908152606b602052604090205460ff16156111e55760405162461bcd60e51b815260206004820152603e60248201527f53747261746567794261736554564c4c696d6974732e667265657a655368617260448201527f65733a20757365722073686172657320616c72656164792066726f7a656e0000606482015260840161056a565b6001600160a01b0381166000818152606b6020526040808220805460ff19166001179055517f85e35225b1d2d75b8a6cd4db71ea66b7d6f984a65ee7fbbdbf2c92f0fa0bf9189190a250565b60606040518060800160405280604d8152602001612729604d9139905090565b604051633d3f06c960e11b81526001600160a01b0382811660048301523060248301526000917f000000000000000000000000000000000000000000000000000000000000000090911690637a7e0d9290604401602060405180830381865afa1580156112c2573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610e2e91906126d6565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611339573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061135d91906125a6565b6001600160a01b0316336001600160a01b03161461138d5760405162461bcd60e51b815260040161056a906125c3565b6001600160a01b0381166000908152606b602052604090205460ff1661141b5760405162461bcd60e51b815260206004820152603c60248201527f53747261746567794261736554564c4c696d6974732e756e667265657a65536860448201527f617265733a207573657220736861726573206e6f742066726f7a656e00000000606482015260840161056a565b6001600160a01b0381166000818152606b6020526040808220805460ff19169055517f7487664b8931552d0450d7ee076c1263d3f711b4a0f639b2b29c56a1a406d31d9190a250565b600180546002908116036114b65760405162461bcd60e51b815260206004820152601960248201527814185d5cd8589b194e881a5b99195e081a5cc81c185d5cd959603a1b604482015260640161056a565b336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461152e5760405162461bcd60e51b815260206004820181905260248201527f5374726174656779426173652e6f6e6c7953747261746567794d616e61676572604482015260640161056a565b611539848484611eba565b603354808311156115c85760405162461bcd60e51b815260206004820152604d60248201527f5374726174656779426173652e77697468647261773a20616d6f756e7453686160448201527f726573206d757374206265206c657373207468616e206f7220657175616c207460648201526c6f20746f74616c53686172657360981b608482015260a40161056a565b60006115d66103e88361260d565b905060006103e86115e5611c11565b6115ef919061260d565b90506000826115fe878461269d565b61160891906126b4565b9050611614868561268a565b603355611634611624828461268a565b6103e8603354610d43919061260d565b61163f888883611f3d565b5050505050505050565b6000806103e860335461165c919061260d565b905060006103e861166b611c11565b611675919061260d565b905080610f69838661269d565b6000610e2e82610f30565b6066546001600160a01b031633146116fe5760405162461bcd60e51b815260206004820152602e60248201527f53747261746567794261736554564c4c696d6974732e6f6e6c7943617056657460448201526d6f3a206e6f74206361705665746f60901b606482015260840161056a565b606a546000036117765760405162461bcd60e51b815260206004820152603c60248201527f53747261746567794261736554564c4c696d6974732e7665746f43617043686160448201527f6e67653a206e6f2063617020696e63726561736520746f207665746f00000000606482015260840161056a565b606754606a54611786919061260d565b4211156117fb5760405162461bcd60e51b815260206004820152603b60248201527f53747261746567794261736554564c4c696d6974732e7665746f43617043686160448201527f6e67653a207665746f2077696e646f7720686173207061737365640000000000606482015260840161056a565b6000606a556068546069546040517fd30c030c55e50cd0e26a5077412fd5d5af55c913c857da269a43e4621092fcc19261183d92908252602082015260400190565b60405180910390a16118536068546069546119b1565b565b600060029054906101000a90046001600160a01b03166001600160a01b031663eab66d7a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156118a8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906118cc91906125a6565b6001600160a01b0316336001600160a01b0316146118fc5760405162461bcd60e51b815260040161056a906125c3565b60015419811960015419161461197a5760405162461bcd60e51b815260206004820152603860248201527f5061757361626c652e756e70617573653a20696e76616c696420617474656d7060448201527f7420746f2070617573652066756e6374696f6e616c6974790000000000000000606482015260840161056a565b600181905560405181815233907f3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c90602001610b02565b60645460408051918252602082018490527ff97ed4e083acac67830025ecbc756d8fe847cdbdca4cee3fe1e128e98b54ecb5910160405180910390a160655460408051918252602082018390527f6ab181e0440bfbf4bacdf2e99674735ce6638005490688c5f994f5399353e452910160405180910390a180821115611ab35760405162461bcd60e51b815260206004820152604b60248201527f53747261746567794261736554564c4c696d6974732e5f73657454564c4c696d60448201527f6974733a206d61785065724465706f7369742065786365656473206d6178546f60648201526a74616c4465706f7369747360a81b608482015260a40161056a565b606491909155606555565b600054610100900460ff16611b295760405162461bcd60e51b815260206004820152602b60248201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960448201526a6e697469616c697a696e6760a81b606482015260840161056a565b603280546001600160a01b0319166001600160a01b038416179055611b4f816000611f51565b7f1c540707b00eb5427b6b774fc799d756516a54aee108b64b327acc55af557507603260009054906101000a90046001600160a01b0316836001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015611bc4573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611be891906126ef565b604080516001600160a01b03909316835260ff9091166020830152015b60405180910390a15050565b6032546040516370a0823160e01b81523060048201526000916001600160a01b0316906370a0823190602401602060405180830381865afa158015611c5a573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611c7e91906126d6565b905090565b6001600160a01b038116611d115760405162461bcd60e51b815260206004820152604960248201527f5061757361626c652e5f73657450617573657252656769737472793a206e657760448201527f50617573657252656769737472792063616e6e6f7420626520746865207a65726064820152686f206164647265737360b81b608482015260a40161056a565b600054604080516001600160a01b03620100009093048316815291831660208301527f6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6910160405180910390a1600080546001600160a01b03909216620100000262010000600160b01b0319909216919091179055565b606454811115611df25760405162461bcd60e51b815260206004820152602f60248201527f53747261746567794261736554564c4c696d6974733a206d617820706572206460448201526e195c1bdcda5d08195e18d959591959608a1b606482015260840161056a565b606554611dfd611c11565b1115611e605760405162461bcd60e51b815260206004820152602c60248201527f53747261746567794261736554564c4c696d6974733a206d6178206465706f7360448201526b1a5d1cc8195e18d95959195960a21b606482015260840161056a565b611e6a828261203d565b5050565b7fd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be881611ea284670de0b6b3a764000061269d565b611eac91906126b4565b604051908152602001611c05565b6032546001600160a01b038381169116146109c45760405162461bcd60e51b815260206004820152603b60248201527f5374726174656779426173652e77697468647261773a2043616e206f6e6c792060448201527f77697468647261772074686520737472617465677920746f6b656e0000000000606482015260840161056a565b6109c46001600160a01b03831684836120b9565b6000546201000090046001600160a01b0316158015611f7857506001600160a01b03821615155b611ffa5760405162461bcd60e51b815260206004820152604760248201527f5061757361626c652e5f696e697469616c697a655061757365723a205f696e6960448201527f7469616c697a6550617573657228292063616e206f6e6c792062652063616c6c6064820152666564206f6e636560c81b608482015260a40161056a565b600181905560405181815233907fab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d9060200160405180910390a2611e6a82611c83565b6032546001600160a01b03838116911614611e6a5760405162461bcd60e51b815260206004820152603660248201527f5374726174656779426173652e6465706f7369743a2043616e206f6e6c79206460448201527532b837b9b4ba103ab73232b9363cb4b733aa37b5b2b760511b606482015260840161056a565b604080516001600160a01b03848116602483015260448083018590528351808403909101815260649092018352602080830180516001600160e01b031663a9059cbb60e01b17905283518085019094528084527f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564908401526109c4928692916000916121499185169084906121c6565b8051909150156109c457808060200190518101906121679190612620565b6109c45760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b606482015260840161056a565b60606121d584846000856121df565b90505b9392505050565b6060824710156122405760405162461bcd60e51b815260206004820152602660248201527f416464726573733a20696e73756666696369656e742062616c616e636520666f6044820152651c8818d85b1b60d21b606482015260840161056a565b6001600160a01b0385163b6122975760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e7472616374000000604482015260640161056a565b600080866001600160a01b031685876040516122b3919061270c565b60006040518083038185875af1925050503d80600081146122f0576040519150601f19603f3d011682016040523d82523d6000602084013e6122f5565b606091505b5091509150612305828286612310565b979650505050505050565b6060831561231f5750816121d8565b82511561232f5782518084602001fd5b8160405162461bcd60e51b815260040161056a91906124b5565b6001600160a01b03811681146107e257600080fd5b6000806000806080858703121561237457600080fd5b8435935060208501359250604085013561238d81612349565b9150606085013561239d81612349565b939692955090935050565b6000602082840312156123ba57600080fd5b81356121d881612349565b600080604083850312156123d857600080fd5b50508035926020909101359150565b6000602082840312156123f957600080fd5b5035919050565b6000806040838503121561241357600080fd5b823561241e81612349565b946020939093013593505050565b6000806040838503121561243f57600080fd5b823561244a81612349565b9150602083013561245a81612349565b809150509250929050565b60ff811681146107e257600080fd5b60006020828403121561248657600080fd5b81356121d881612465565b60005b838110156124ac578181015183820152602001612494565b50506000910152565b60208152600082518060208401526124d4816040850160208701612491565b601f01601f19169190910160400192915050565b6000806000606084860312156124fd57600080fd5b833561250881612349565b9250602084013561251881612349565b929592945050506040919091013590565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b60ff8181168382160190811115610e2e57610e2e612577565b6000602082840312156125b857600080fd5b81516121d881612349565b6020808252602a908201527f6d73672e73656e646572206973206e6f74207065726d697373696f6e6564206160408201526939903ab73830bab9b2b960b11b606082015260800190565b80820180821115610e2e57610e2e612577565b60006020828403121561263257600080fd5b815180151581146121d857600080fd5b60208082526028908201527f6d73672e73656e646572206973206e6f74207065726d697373696f6e6564206160408201526739903830bab9b2b960c11b606082015260800190565b81810381811115610e2e57610e2e612577565b8082028115828204841417610e2e57610e2e612577565b6000826126d157634e487b7160e01b600052601260045260246000fd5b500490565b6000602082840312156126e857600080fd5b5051919050565b60006020828403121561270157600080fd5b81516121d881612465565b6000825161271e818460208701612491565b919091019291505056fe4261736520537472617465677920696d706c656d656e746174696f6e20746f20696e68657269742066726f6d20666f72206d6f726520636f6d706c657820696d706c656d656e746174696f6e73a2646970667358221220b7aefe27f353729285d2e5961d5f7a680f619d719f135e9d0dc6851b97f465f664736f6c63430008150033",
 }
 
 // StrategyBaseTVLLimitsABI is the input ABI used to generate the binding from.
@@ -202,6 +202,97 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorRaw) Transact(opts
 	return _StrategyBaseTVLLimits.Contract.contract.Transact(opts, method, params...)
 }
 
+// CapChangeTimestamp is a free data retrieval call binding the contract method 0xcfe3b658.
+//
+// Solidity: function capChangeTimestamp() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) CapChangeTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "capChangeTimestamp")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CapChangeTimestamp is a free data retrieval call binding the contract method 0xcfe3b658.
+//
+// Solidity: function capChangeTimestamp() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) CapChangeTimestamp() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.CapChangeTimestamp(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// CapChangeTimestamp is a free data retrieval call binding the contract method 0xcfe3b658.
+//
+// Solidity: function capChangeTimestamp() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) CapChangeTimestamp() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.CapChangeTimestamp(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// CapVeto is a free data retrieval call binding the contract method 0x77a94c11.
+//
+// Solidity: function capVeto() view returns(address)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) CapVeto(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "capVeto")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CapVeto is a free data retrieval call binding the contract method 0x77a94c11.
+//
+// Solidity: function capVeto() view returns(address)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) CapVeto() (common.Address, error) {
+	return _StrategyBaseTVLLimits.Contract.CapVeto(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// CapVeto is a free data retrieval call binding the contract method 0x77a94c11.
+//
+// Solidity: function capVeto() view returns(address)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) CapVeto() (common.Address, error) {
+	return _StrategyBaseTVLLimits.Contract.CapVeto(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// CheckInvariants is a free data retrieval call binding the contract method 0x0343dfa0.
+//
+// Solidity: function checkInvariants() view returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) CheckInvariants(opts *bind.CallOpts) error {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "checkInvariants")
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// CheckInvariants is a free data retrieval call binding the contract method 0x0343dfa0.
+//
+// Solidity: function checkInvariants() view returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) CheckInvariants() error {
+	return _StrategyBaseTVLLimits.Contract.CheckInvariants(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// CheckInvariants is a free data retrieval call binding the contract method 0x0343dfa0.
+//
+// Solidity: function checkInvariants() view returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) CheckInvariants() error {
+	return _StrategyBaseTVLLimits.Contract.CheckInvariants(&_StrategyBaseTVLLimits.CallOpts)
+}
+
 // Explanation is a free data retrieval call binding the contract method 0xab5921e1.
 //
 // Solidity: function explanation() pure returns(string)
@@ -233,6 +324,37 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) Explanation()
 	return _StrategyBaseTVLLimits.Contract.Explanation(&_StrategyBaseTVLLimits.CallOpts)
 }
 
+// FrozenShares is a free data retrieval call binding the contract method 0x74e36ab3.
+//
+// Solidity: function frozenShares(address user) view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) FrozenShares(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "frozenShares", user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FrozenShares is a free data retrieval call binding the contract method 0x74e36ab3.
+//
+// Solidity: function frozenShares(address user) view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) FrozenShares(user common.Address) (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.FrozenShares(&_StrategyBaseTVLLimits.CallOpts, user)
+}
+
+// FrozenShares is a free data retrieval call binding the contract method 0x74e36ab3.
+//
+// Solidity: function frozenShares(address user) view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) FrozenShares(user common.Address) (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.FrozenShares(&_StrategyBaseTVLLimits.CallOpts, user)
+}
+
 // GetTVLLimits is a free data retrieval call binding the contract method 0xdf6fadc1.
 //
 // Solidity: function getTVLLimits() view returns(uint256, uint256)
@@ -265,6 +387,37 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) GetTVLLimits()
 	return _StrategyBaseTVLLimits.Contract.GetTVLLimits(&_StrategyBaseTVLLimits.CallOpts)
 }
 
+// IsFrozen is a free data retrieval call binding the contract method 0xe5839836.
+//
+// Solidity: function isFrozen(address ) view returns(bool)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) IsFrozen(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "isFrozen", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsFrozen is a free data retrieval call binding the contract method 0xe5839836.
+//
+// Solidity: function isFrozen(address ) view returns(bool)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) IsFrozen(arg0 common.Address) (bool, error) {
+	return _StrategyBaseTVLLimits.Contract.IsFrozen(&_StrategyBaseTVLLimits.CallOpts, arg0)
+}
+
+// IsFrozen is a free data retrieval call binding the contract method 0xe5839836.
+//
+// Solidity: function isFrozen(address ) view returns(bool)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) IsFrozen(arg0 common.Address) (bool, error) {
+	return _StrategyBaseTVLLimits.Contract.IsFrozen(&_StrategyBaseTVLLimits.CallOpts, arg0)
+}
+
 // MaxPerDeposit is a free data retrieval call binding the contract method 0x43fe08b0.
 //
 // Solidity: function maxPerDeposit() view returns(uint256)
@@ -420,6 +573,68 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) PauserRegistry
 	return _StrategyBaseTVLLimits.Contract.PauserRegistry(&_StrategyBaseTVLLimits.CallOpts)
 }
 
+// PreviousMaxPerDeposit is a free data retrieval call binding the contract method 0x84a1d515.
+//
+// Solidity: function previousMaxPerDeposit() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) PreviousMaxPerDeposit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "previousMaxPerDeposit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviousMaxPerDeposit is a free data retrieval call binding the contract method 0x84a1d515.
+//
+// Solidity: function previousMaxPerDeposit() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) PreviousMaxPerDeposit() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.PreviousMaxPerDeposit(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// PreviousMaxPerDeposit is a free data retrieval call binding the contract method 0x84a1d515.
+//
+// Solidity: function previousMaxPerDeposit() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) PreviousMaxPerDeposit() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.PreviousMaxPerDeposit(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// PreviousMaxTotalDeposits is a free data retrieval call binding the contract method 0x75e65842.
+//
+// Solidity: function previousMaxTotalDeposits() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) PreviousMaxTotalDeposits(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "previousMaxTotalDeposits")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviousMaxTotalDeposits is a free data retrieval call binding the contract method 0x75e65842.
+//
+// Solidity: function previousMaxTotalDeposits() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) PreviousMaxTotalDeposits() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.PreviousMaxTotalDeposits(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// PreviousMaxTotalDeposits is a free data retrieval call binding the contract method 0x75e65842.
+//
+// Solidity: function previousMaxTotalDeposits() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) PreviousMaxTotalDeposits() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.PreviousMaxTotalDeposits(&_StrategyBaseTVLLimits.CallOpts)
+}
+
 // Shares is a free data retrieval call binding the contract method 0xce7c2ac2.
 //
 // Solidity: function shares(address user) view returns(uint256)
@@ -699,6 +914,37 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) UserUnderlying
 	return _StrategyBaseTVLLimits.Contract.UserUnderlyingView(&_StrategyBaseTVLLimits.CallOpts, user)
 }
 
+// VetoWindow is a free data retrieval call binding the contract method 0x514194f3.
+//
+// Solidity: function vetoWindow() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCaller) VetoWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StrategyBaseTVLLimits.contract.Call(opts, &out, "vetoWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VetoWindow is a free data retrieval call binding the contract method 0x514194f3.
+//
+// Solidity: function vetoWindow() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) VetoWindow() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.VetoWindow(&_StrategyBaseTVLLimits.CallOpts)
+}
+
+// VetoWindow is a free data retrieval call binding the contract method 0x514194f3.
+//
+// Solidity: function vetoWindow() view returns(uint256)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsCallerSession) VetoWindow() (*big.Int, error) {
+	return _StrategyBaseTVLLimits.Contract.VetoWindow(&_StrategyBaseTVLLimits.CallOpts)
+}
+
 // Deposit is a paid mutator transaction binding the contract method 0x47e7ef24.
 //
 // Solidity: function deposit(address token, uint256 amount) returns(uint256 newShares)
@@ -720,6 +966,27 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) Deposit(to
 	return _StrategyBaseTVLLimits.Contract.Deposit(&_StrategyBaseTVLLimits.TransactOpts, token, amount)
 }
 
+// FreezeShares is a paid mutator transaction binding the contract method 0x97e179c2.
+//
+// Solidity: function freezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactor) FreezeShares(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.contract.Transact(opts, "freezeShares", user)
+}
+
+// FreezeShares is a paid mutator transaction binding the contract method 0x97e179c2.
+//
+// Solidity: function freezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) FreezeShares(user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.FreezeShares(&_StrategyBaseTVLLimits.TransactOpts, user)
+}
+
+// FreezeShares is a paid mutator transaction binding the contract method 0x97e179c2.
+//
+// Solidity: function freezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) FreezeShares(user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.FreezeShares(&_StrategyBaseTVLLimits.TransactOpts, user)
+}
+
 // Initialize is a paid mutator transaction binding the contract method 0x019e2729.
 //
 // Solidity: function initialize(uint256 _maxPerDeposit, uint256 _maxTotalDeposits, address _underlyingToken, address _pauserRegistry) returns()
@@ -804,6 +1071,27 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) PauseAll()
 	return _StrategyBaseTVLLimits.Contract.PauseAll(&_StrategyBaseTVLLimits.TransactOpts)
 }
 
+// SetCapVeto is a paid mutator transaction binding the contract method 0x86c6cd0a.
+//
+// Solidity: function setCapVeto(address newCapVeto, uint256 newVetoWindow) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactor) SetCapVeto(opts *bind.TransactOpts, newCapVeto common.Address, newVetoWindow *big.Int) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.contract.Transact(opts, "setCapVeto", newCapVeto, newVetoWindow)
+}
+
+// SetCapVeto is a paid mutator transaction binding the contract method 0x86c6cd0a.
+//
+// Solidity: function setCapVeto(address newCapVeto, uint256 newVetoWindow) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) SetCapVeto(newCapVeto common.Address, newVetoWindow *big.Int) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.SetCapVeto(&_StrategyBaseTVLLimits.TransactOpts, newCapVeto, newVetoWindow)
+}
+
+// SetCapVeto is a paid mutator transaction binding the contract method 0x86c6cd0a.
+//
+// Solidity: function setCapVeto(address newCapVeto, uint256 newVetoWindow) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) SetCapVeto(newCapVeto common.Address, newVetoWindow *big.Int) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.SetCapVeto(&_StrategyBaseTVLLimits.TransactOpts, newCapVeto, newVetoWindow)
+}
+
 // SetPauserRegistry is a paid mutator transaction binding the contract method 0x10d67a2f.
 //
 // Solidity: function setPauserRegistry(address newPauserRegistry) returns()
@@ -846,6 +1134,27 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) SetTVLLimi
 	return _StrategyBaseTVLLimits.Contract.SetTVLLimits(&_StrategyBaseTVLLimits.TransactOpts, newMaxPerDeposit, newMaxTotalDeposits)
 }
 
+// UnfreezeShares is a paid mutator transaction binding the contract method 0xcf744d28.
+//
+// Solidity: function unfreezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactor) UnfreezeShares(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.contract.Transact(opts, "unfreezeShares", user)
+}
+
+// UnfreezeShares is a paid mutator transaction binding the contract method 0xcf744d28.
+//
+// Solidity: function unfreezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) UnfreezeShares(user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.UnfreezeShares(&_StrategyBaseTVLLimits.TransactOpts, user)
+}
+
+// UnfreezeShares is a paid mutator transaction binding the contract method 0xcf744d28.
+//
+// Solidity: function unfreezeShares(address user) returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) UnfreezeShares(user common.Address) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.UnfreezeShares(&_StrategyBaseTVLLimits.TransactOpts, user)
+}
+
 // Unpause is a paid mutator transaction binding the contract method 0xfabc1cbc.
 //
 // Solidity: function unpause(uint256 newPausedStatus) returns()
@@ -888,6 +1197,27 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) UserUnderl
 	return _StrategyBaseTVLLimits.Contract.UserUnderlying(&_StrategyBaseTVLLimits.TransactOpts, user)
 }
 
+// VetoCapChange is a paid mutator transaction binding the contract method 0xf637c328.
+//
+// Solidity: function vetoCapChange() returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactor) VetoCapChange(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.contract.Transact(opts, "vetoCapChange")
+}
+
+// VetoCapChange is a paid mutator transaction binding the contract method 0xf637c328.
+//
+// Solidity: function vetoCapChange() returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsSession) VetoCapChange() (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.VetoCapChange(&_StrategyBaseTVLLimits.TransactOpts)
+}
+
+// VetoCapChange is a paid mutator transaction binding the contract method 0xf637c328.
+//
+// Solidity: function vetoCapChange() returns()
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) VetoCapChange() (*types.Transaction, error) {
+	return _StrategyBaseTVLLimits.Contract.VetoCapChange(&_StrategyBaseTVLLimits.TransactOpts)
+}
+
 // Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
 //
 // Solidity: function withdraw(address recipient, address token, uint256 amountShares) returns()
@@ -909,9 +1239,9 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsTransactorSession) Withdraw(r
 	return _StrategyBaseTVLLimits.Contract.Withdraw(&_StrategyBaseTVLLimits.TransactOpts, recipient, token, amountShares)
 }
 
-// StrategyBaseTVLLimitsExchangeRateEmittedIterator is returned from FilterExchangeRateEmitted and is used to iterate over the raw logs and unpacked data for ExchangeRateEmitted events raised by the StrategyBaseTVLLimits contract.
-type StrategyBaseTVLLimitsExchangeRateEmittedIterator struct {
-	Event *StrategyBaseTVLLimitsExchangeRateEmitted // Event containing the contract specifics and raw log
+// StrategyBaseTVLLimitsCapChangeVetoedIterator is returned from FilterCapChangeVetoed and is used to iterate over the raw logs and unpacked data for CapChangeVetoed events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsCapChangeVetoedIterator struct {
+	Event *StrategyBaseTVLLimitsCapChangeVetoed // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -925,7 +1255,7 @@ type StrategyBaseTVLLimitsExchangeRateEmittedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Next() bool {
+func (it *StrategyBaseTVLLimitsCapChangeVetoedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -934,7 +1264,7 @@ func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(StrategyBaseTVLLimitsExchangeRateEmitted)
+			it.Event = new(StrategyBaseTVLLimitsCapChangeVetoed)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -949,7 +1279,7 @@ func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(StrategyBaseTVLLimitsExchangeRateEmitted)
+		it.Event = new(StrategyBaseTVLLimitsCapChangeVetoed)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -965,41 +1295,42 @@ func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Error() error {
+func (it *StrategyBaseTVLLimitsCapChangeVetoedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Close() error {
+func (it *StrategyBaseTVLLimitsCapChangeVetoedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// StrategyBaseTVLLimitsExchangeRateEmitted represents a ExchangeRateEmitted event raised by the StrategyBaseTVLLimits contract.
-type StrategyBaseTVLLimitsExchangeRateEmitted struct {
-	Rate *big.Int
-	Raw  types.Log // Blockchain specific contextual infos
+// StrategyBaseTVLLimitsCapChangeVetoed represents a CapChangeVetoed event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsCapChangeVetoed struct {
+	RestoredMaxPerDeposit    *big.Int
+	RestoredMaxTotalDeposits *big.Int
+	Raw                      types.Log // Blockchain specific contextual infos
 }
 
-// FilterExchangeRateEmitted is a free log retrieval operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+// FilterCapChangeVetoed is a free log retrieval operation binding the contract event 0xd30c030c55e50cd0e26a5077412fd5d5af55c913c857da269a43e4621092fcc1.
 //
-// Solidity: event ExchangeRateEmitted(uint256 rate)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterExchangeRateEmitted(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsExchangeRateEmittedIterator, error) {
+// Solidity: event CapChangeVetoed(uint256 restoredMaxPerDeposit, uint256 restoredMaxTotalDeposits)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterCapChangeVetoed(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsCapChangeVetoedIterator, error) {
 
-	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "ExchangeRateEmitted")
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "CapChangeVetoed")
 	if err != nil {
 		return nil, err
 	}
-	return &StrategyBaseTVLLimitsExchangeRateEmittedIterator{contract: _StrategyBaseTVLLimits.contract, event: "ExchangeRateEmitted", logs: logs, sub: sub}, nil
+	return &StrategyBaseTVLLimitsCapChangeVetoedIterator{contract: _StrategyBaseTVLLimits.contract, event: "CapChangeVetoed", logs: logs, sub: sub}, nil
 }
 
-// WatchExchangeRateEmitted is a free log subscription operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+// WatchCapChangeVetoed is a free log subscription operation binding the contract event 0xd30c030c55e50cd0e26a5077412fd5d5af55c913c857da269a43e4621092fcc1.
 //
-// Solidity: event ExchangeRateEmitted(uint256 rate)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsExchangeRateEmitted) (event.Subscription, error) {
+// Solidity: event CapChangeVetoed(uint256 restoredMaxPerDeposit, uint256 restoredMaxTotalDeposits)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchCapChangeVetoed(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsCapChangeVetoed) (event.Subscription, error) {
 
-	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "ExchangeRateEmitted")
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "CapChangeVetoed")
 	if err != nil {
 		return nil, err
 	}
@@ -1009,8 +1340,8 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchExchangeRateEm
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(StrategyBaseTVLLimitsExchangeRateEmitted)
-				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "ExchangeRateEmitted", log); err != nil {
+				event := new(StrategyBaseTVLLimitsCapChangeVetoed)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "CapChangeVetoed", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1031,21 +1362,21 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchExchangeRateEm
 	}), nil
 }
 
-// ParseExchangeRateEmitted is a log parse operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+// ParseCapChangeVetoed is a log parse operation binding the contract event 0xd30c030c55e50cd0e26a5077412fd5d5af55c913c857da269a43e4621092fcc1.
 //
-// Solidity: event ExchangeRateEmitted(uint256 rate)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseExchangeRateEmitted(log types.Log) (*StrategyBaseTVLLimitsExchangeRateEmitted, error) {
-	event := new(StrategyBaseTVLLimitsExchangeRateEmitted)
-	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "ExchangeRateEmitted", log); err != nil {
+// Solidity: event CapChangeVetoed(uint256 restoredMaxPerDeposit, uint256 restoredMaxTotalDeposits)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseCapChangeVetoed(log types.Log) (*StrategyBaseTVLLimitsCapChangeVetoed, error) {
+	event := new(StrategyBaseTVLLimitsCapChangeVetoed)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "CapChangeVetoed", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// StrategyBaseTVLLimitsInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the StrategyBaseTVLLimits contract.
-type StrategyBaseTVLLimitsInitializedIterator struct {
-	Event *StrategyBaseTVLLimitsInitialized // Event containing the contract specifics and raw log
+// StrategyBaseTVLLimitsCapVetoSetIterator is returned from FilterCapVetoSet and is used to iterate over the raw logs and unpacked data for CapVetoSet events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsCapVetoSetIterator struct {
+	Event *StrategyBaseTVLLimitsCapVetoSet // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1059,7 +1390,7 @@ type StrategyBaseTVLLimitsInitializedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *StrategyBaseTVLLimitsInitializedIterator) Next() bool {
+func (it *StrategyBaseTVLLimitsCapVetoSetIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1068,7 +1399,7 @@ func (it *StrategyBaseTVLLimitsInitializedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(StrategyBaseTVLLimitsInitialized)
+			it.Event = new(StrategyBaseTVLLimitsCapVetoSet)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1083,7 +1414,7 @@ func (it *StrategyBaseTVLLimitsInitializedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(StrategyBaseTVLLimitsInitialized)
+		it.Event = new(StrategyBaseTVLLimitsCapVetoSet)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1099,41 +1430,43 @@ func (it *StrategyBaseTVLLimitsInitializedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *StrategyBaseTVLLimitsInitializedIterator) Error() error {
+func (it *StrategyBaseTVLLimitsCapVetoSetIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *StrategyBaseTVLLimitsInitializedIterator) Close() error {
+func (it *StrategyBaseTVLLimitsCapVetoSetIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// StrategyBaseTVLLimitsInitialized represents a Initialized event raised by the StrategyBaseTVLLimits contract.
-type StrategyBaseTVLLimitsInitialized struct {
-	Version uint8
-	Raw     types.Log // Blockchain specific contextual infos
+// StrategyBaseTVLLimitsCapVetoSet represents a CapVetoSet event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsCapVetoSet struct {
+	PreviousCapVeto common.Address
+	NewCapVeto      common.Address
+	NewVetoWindow   *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
 }
 
-// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+// FilterCapVetoSet is a free log retrieval operation binding the contract event 0xbc08b7585d4b60491bdcdd947ce64408878da2d4255c3f6bb6b9d9457a7e2c02.
 //
-// Solidity: event Initialized(uint8 version)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterInitialized(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsInitializedIterator, error) {
+// Solidity: event CapVetoSet(address previousCapVeto, address newCapVeto, uint256 newVetoWindow)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterCapVetoSet(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsCapVetoSetIterator, error) {
 
-	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "Initialized")
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "CapVetoSet")
 	if err != nil {
 		return nil, err
 	}
-	return &StrategyBaseTVLLimitsInitializedIterator{contract: _StrategyBaseTVLLimits.contract, event: "Initialized", logs: logs, sub: sub}, nil
+	return &StrategyBaseTVLLimitsCapVetoSetIterator{contract: _StrategyBaseTVLLimits.contract, event: "CapVetoSet", logs: logs, sub: sub}, nil
 }
 
-// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+// WatchCapVetoSet is a free log subscription operation binding the contract event 0xbc08b7585d4b60491bdcdd947ce64408878da2d4255c3f6bb6b9d9457a7e2c02.
 //
-// Solidity: event Initialized(uint8 version)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsInitialized) (event.Subscription, error) {
+// Solidity: event CapVetoSet(address previousCapVeto, address newCapVeto, uint256 newVetoWindow)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchCapVetoSet(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsCapVetoSet) (event.Subscription, error) {
 
-	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "Initialized")
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "CapVetoSet")
 	if err != nil {
 		return nil, err
 	}
@@ -1143,8 +1476,8 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchInitialized(op
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(StrategyBaseTVLLimitsInitialized)
-				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "Initialized", log); err != nil {
+				event := new(StrategyBaseTVLLimitsCapVetoSet)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "CapVetoSet", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1165,21 +1498,21 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchInitialized(op
 	}), nil
 }
 
-// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+// ParseCapVetoSet is a log parse operation binding the contract event 0xbc08b7585d4b60491bdcdd947ce64408878da2d4255c3f6bb6b9d9457a7e2c02.
 //
-// Solidity: event Initialized(uint8 version)
-func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseInitialized(log types.Log) (*StrategyBaseTVLLimitsInitialized, error) {
-	event := new(StrategyBaseTVLLimitsInitialized)
-	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "Initialized", log); err != nil {
+// Solidity: event CapVetoSet(address previousCapVeto, address newCapVeto, uint256 newVetoWindow)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseCapVetoSet(log types.Log) (*StrategyBaseTVLLimitsCapVetoSet, error) {
+	event := new(StrategyBaseTVLLimitsCapVetoSet)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "CapVetoSet", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator is returned from FilterMaxPerDepositUpdated and is used to iterate over the raw logs and unpacked data for MaxPerDepositUpdated events raised by the StrategyBaseTVLLimits contract.
-type StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator struct {
-	Event *StrategyBaseTVLLimitsMaxPerDepositUpdated // Event containing the contract specifics and raw log
+// StrategyBaseTVLLimitsExchangeRateEmittedIterator is returned from FilterExchangeRateEmitted and is used to iterate over the raw logs and unpacked data for ExchangeRateEmitted events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsExchangeRateEmittedIterator struct {
+	Event *StrategyBaseTVLLimitsExchangeRateEmitted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1193,7 +1526,7 @@ type StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Next() bool {
+func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1202,7 +1535,7 @@ func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(StrategyBaseTVLLimitsMaxPerDepositUpdated)
+			it.Event = new(StrategyBaseTVLLimitsExchangeRateEmitted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1217,7 +1550,7 @@ func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(StrategyBaseTVLLimitsMaxPerDepositUpdated)
+		it.Event = new(StrategyBaseTVLLimitsExchangeRateEmitted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1233,13 +1566,281 @@ func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Error() error {
+func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Close() error {
+func (it *StrategyBaseTVLLimitsExchangeRateEmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StrategyBaseTVLLimitsExchangeRateEmitted represents a ExchangeRateEmitted event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsExchangeRateEmitted struct {
+	Rate *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterExchangeRateEmitted is a free log retrieval operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+//
+// Solidity: event ExchangeRateEmitted(uint256 rate)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterExchangeRateEmitted(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsExchangeRateEmittedIterator, error) {
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "ExchangeRateEmitted")
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyBaseTVLLimitsExchangeRateEmittedIterator{contract: _StrategyBaseTVLLimits.contract, event: "ExchangeRateEmitted", logs: logs, sub: sub}, nil
+}
+
+// WatchExchangeRateEmitted is a free log subscription operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+//
+// Solidity: event ExchangeRateEmitted(uint256 rate)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsExchangeRateEmitted) (event.Subscription, error) {
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "ExchangeRateEmitted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StrategyBaseTVLLimitsExchangeRateEmitted)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "ExchangeRateEmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExchangeRateEmitted is a log parse operation binding the contract event 0xd2494f3479e5da49d386657c292c610b5b01df313d07c62eb0cfa49924a31be8.
+//
+// Solidity: event ExchangeRateEmitted(uint256 rate)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseExchangeRateEmitted(log types.Log) (*StrategyBaseTVLLimitsExchangeRateEmitted, error) {
+	event := new(StrategyBaseTVLLimitsExchangeRateEmitted)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "ExchangeRateEmitted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StrategyBaseTVLLimitsInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsInitializedIterator struct {
+	Event *StrategyBaseTVLLimitsInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StrategyBaseTVLLimitsInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StrategyBaseTVLLimitsInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StrategyBaseTVLLimitsInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StrategyBaseTVLLimitsInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StrategyBaseTVLLimitsInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StrategyBaseTVLLimitsInitialized represents a Initialized event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterInitialized(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsInitializedIterator, error) {
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyBaseTVLLimitsInitializedIterator{contract: _StrategyBaseTVLLimits.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StrategyBaseTVLLimitsInitialized)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseInitialized(log types.Log) (*StrategyBaseTVLLimitsInitialized, error) {
+	event := new(StrategyBaseTVLLimitsInitialized)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator is returned from FilterMaxPerDepositUpdated and is used to iterate over the raw logs and unpacked data for MaxPerDepositUpdated events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator struct {
+	Event *StrategyBaseTVLLimitsMaxPerDepositUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StrategyBaseTVLLimitsMaxPerDepositUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StrategyBaseTVLLimitsMaxPerDepositUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
@@ -1727,6 +2328,294 @@ func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParsePauserRegistry
 	return event, nil
 }
 
+// StrategyBaseTVLLimitsSharesFrozenIterator is returned from FilterSharesFrozen and is used to iterate over the raw logs and unpacked data for SharesFrozen events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsSharesFrozenIterator struct {
+	Event *StrategyBaseTVLLimitsSharesFrozen // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StrategyBaseTVLLimitsSharesFrozenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StrategyBaseTVLLimitsSharesFrozen)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StrategyBaseTVLLimitsSharesFrozen)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StrategyBaseTVLLimitsSharesFrozenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StrategyBaseTVLLimitsSharesFrozenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StrategyBaseTVLLimitsSharesFrozen represents a SharesFrozen event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsSharesFrozen struct {
+	User common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSharesFrozen is a free log retrieval operation binding the contract event 0x85e35225b1d2d75b8a6cd4db71ea66b7d6f984a65ee7fbbdbf2c92f0fa0bf918.
+//
+// Solidity: event SharesFrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterSharesFrozen(opts *bind.FilterOpts, user []common.Address) (*StrategyBaseTVLLimitsSharesFrozenIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "SharesFrozen", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyBaseTVLLimitsSharesFrozenIterator{contract: _StrategyBaseTVLLimits.contract, event: "SharesFrozen", logs: logs, sub: sub}, nil
+}
+
+// WatchSharesFrozen is a free log subscription operation binding the contract event 0x85e35225b1d2d75b8a6cd4db71ea66b7d6f984a65ee7fbbdbf2c92f0fa0bf918.
+//
+// Solidity: event SharesFrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchSharesFrozen(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsSharesFrozen, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "SharesFrozen", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StrategyBaseTVLLimitsSharesFrozen)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "SharesFrozen", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSharesFrozen is a log parse operation binding the contract event 0x85e35225b1d2d75b8a6cd4db71ea66b7d6f984a65ee7fbbdbf2c92f0fa0bf918.
+//
+// Solidity: event SharesFrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseSharesFrozen(log types.Log) (*StrategyBaseTVLLimitsSharesFrozen, error) {
+	event := new(StrategyBaseTVLLimitsSharesFrozen)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "SharesFrozen", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StrategyBaseTVLLimitsSharesUnfrozenIterator is returned from FilterSharesUnfrozen and is used to iterate over the raw logs and unpacked data for SharesUnfrozen events raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsSharesUnfrozenIterator struct {
+	Event *StrategyBaseTVLLimitsSharesUnfrozen // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StrategyBaseTVLLimitsSharesUnfrozenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StrategyBaseTVLLimitsSharesUnfrozen)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StrategyBaseTVLLimitsSharesUnfrozen)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StrategyBaseTVLLimitsSharesUnfrozenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StrategyBaseTVLLimitsSharesUnfrozenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StrategyBaseTVLLimitsSharesUnfrozen represents a SharesUnfrozen event raised by the StrategyBaseTVLLimits contract.
+type StrategyBaseTVLLimitsSharesUnfrozen struct {
+	User common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSharesUnfrozen is a free log retrieval operation binding the contract event 0x7487664b8931552d0450d7ee076c1263d3f711b4a0f639b2b29c56a1a406d31d.
+//
+// Solidity: event SharesUnfrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) FilterSharesUnfrozen(opts *bind.FilterOpts, user []common.Address) (*StrategyBaseTVLLimitsSharesUnfrozenIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.FilterLogs(opts, "SharesUnfrozen", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyBaseTVLLimitsSharesUnfrozenIterator{contract: _StrategyBaseTVLLimits.contract, event: "SharesUnfrozen", logs: logs, sub: sub}, nil
+}
+
+// WatchSharesUnfrozen is a free log subscription operation binding the contract event 0x7487664b8931552d0450d7ee076c1263d3f711b4a0f639b2b29c56a1a406d31d.
+//
+// Solidity: event SharesUnfrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) WatchSharesUnfrozen(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsSharesUnfrozen, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _StrategyBaseTVLLimits.contract.WatchLogs(opts, "SharesUnfrozen", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StrategyBaseTVLLimitsSharesUnfrozen)
+				if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "SharesUnfrozen", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSharesUnfrozen is a log parse operation binding the contract event 0x7487664b8931552d0450d7ee076c1263d3f711b4a0f639b2b29c56a1a406d31d.
+//
+// Solidity: event SharesUnfrozen(address indexed user)
+func (_StrategyBaseTVLLimits *StrategyBaseTVLLimitsFilterer) ParseSharesUnfrozen(log types.Log) (*StrategyBaseTVLLimitsSharesUnfrozen, error) {
+	event := new(StrategyBaseTVLLimitsSharesUnfrozen)
+	if err := _StrategyBaseTVLLimits.contract.UnpackLog(event, "SharesUnfrozen", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // StrategyBaseTVLLimitsStrategyTokenSetIterator is returned from FilterStrategyTokenSet and is used to iterate over the raw logs and unpacked data for StrategyTokenSet events raised by the StrategyBaseTVLLimits contract.
 type StrategyBaseTVLLimitsStrategyTokenSetIterator struct {
 	Event *StrategyBaseTVLLimitsStrategyTokenSet // Event containing the contract specifics and raw log
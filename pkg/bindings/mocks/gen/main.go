@@ -0,0 +1,283 @@
+// Command gen generates pkg/bindings/mocks from the bindings in pkg/bindings. It is run by
+// bin/compile-bindings.sh after abigen, or from the repository root with:
+//
+//	go run ./pkg/bindings/mocks/gen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// bindingsPackage is the import path of the bindings.
+const bindingsPackage = "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+
+// roles are the binding types mocked for each contract.
+var roles = []string{"Caller", "Transactor", "Filterer"}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name, typ string
+}
+
+type contract struct {
+	name    string
+	methods map[string][]method
+	imports map[string]string
+	// used holds the packages referred to by the mocked methods.
+	used map[string]bool
+}
+
+func main() {
+	bindingsDir := flag.String("bindings", "pkg/bindings", "directory of the bindings")
+	outDir := flag.String("out", "pkg/bindings/mocks", "directory to write the mocks to")
+	flag.Parse()
+
+	entries, err := os.ReadDir(*bindingsDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, entry := range entries {
+		path := filepath.Join(*bindingsDir, entry.Name(), "binding.go")
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		c, err := parse(entry.Name(), path)
+		if err != nil {
+			log.Fatalf("failed to parse %s: %v", path, err)
+		}
+		src, err := c.generate()
+		if err != nil {
+			log.Fatalf("failed to generate mocks of %s: %v", c.name, err)
+		}
+		if err := os.WriteFile(filepath.Join(*outDir, c.name+".go"), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func parse(name, path string) (*contract, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	c := &contract{name: name, methods: make(map[string][]method), imports: make(map[string]string), used: make(map[string]bool)}
+	for _, imp := range file.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		alias := filepath.Base(importPath)
+		if imp.Name != nil {
+			alias = imp.Name.Name
+		}
+		c.imports[alias] = importPath
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+			continue
+		}
+		star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		recv, ok := star.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		role := strings.TrimPrefix(recv.Name, name)
+		if role == recv.Name || !isRole(role) {
+			continue
+		}
+
+		m := method{name: fn.Name.Name}
+		for i, field := range fn.Type.Params.List {
+			typ := c.typeString(fset, field.Type)
+			if len(field.Names) == 0 {
+				m.params = append(m.params, param{name: fmt.Sprintf("arg%d", i), typ: typ})
+			}
+			for _, n := range field.Names {
+				m.params = append(m.params, param{name: n.Name, typ: typ})
+			}
+		}
+		if fn.Type.Results != nil {
+			for _, field := range fn.Type.Results.List {
+				typ := c.typeString(fset, field.Type)
+				for i := 0; i < max(1, len(field.Names)); i++ {
+					m.results = append(m.results, typ)
+				}
+			}
+		}
+		c.methods[role] = append(c.methods[role], m)
+	}
+	for _, methods := range c.methods {
+		sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+	}
+	return c, nil
+}
+
+func isRole(role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// typeString prints expr with the binding's own types qualified by its package name.
+func (c *contract) typeString(fset *token.FileSet, expr ast.Expr) string {
+	qualified := c.qualify(expr)
+	ast.Inspect(qualified, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				c.used[pkg.Name] = true
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, qualified); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func (c *contract) qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent(c.name), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: c.qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: c.qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: c.qualify(e.Key), Value: c.qualify(e.Value)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: c.qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: c.qualify(e.Elt)}
+	case *ast.StructType:
+		fields := &ast.FieldList{}
+		for _, f := range e.Fields.List {
+			fields.List = append(fields.List, &ast.Field{Names: f.Names, Type: c.qualify(f.Type), Tag: f.Tag})
+		}
+		return &ast.StructType{Fields: fields}
+	default:
+		return expr
+	}
+}
+
+func (c *contract) generate() ([]byte, error) {
+	var body bytes.Buffer
+	for _, role := range roles {
+		iface := c.name + role
+		mock := "Mock" + iface
+		methods := c.methods[role]
+
+		fmt.Fprintf(&body, "// %s is implemented by %s.%s and %s.\n", iface, c.name, iface, mock)
+		fmt.Fprintf(&body, "type %s interface {\n", iface)
+		for _, m := range methods {
+			fmt.Fprintf(&body, "\t%s(%s) (%s)\n", m.name, m.paramList(), strings.Join(m.results, ", "))
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		fmt.Fprintf(&body, "var (\n\t_ %s = (*%s.%s)(nil)\n\t_ %s = (*%s)(nil)\n)\n\n", iface, c.name, iface, iface, mock)
+
+		fmt.Fprintf(&body, "// %s is a %s whose methods call the function in the field named after them.\n", mock, iface)
+		fmt.Fprintf(&body, "// Methods whose function is nil return ErrNotStubbed.\n")
+		fmt.Fprintf(&body, "type %s struct {\n", mock)
+		for _, m := range methods {
+			fmt.Fprintf(&body, "\t%sFunc func(%s) (%s)\n", m.name, m.paramList(), strings.Join(m.results, ", "))
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		for _, m := range methods {
+			fmt.Fprintf(&body, "// %s calls %sFunc.\n", m.name, m.name)
+			fmt.Fprintf(&body, "func (m *%s) %s(%s) (%s) {\n", mock, m.name, m.paramList(), m.namedResults())
+			fmt.Fprintf(&body, "\tif m.%sFunc == nil {\n", m.name)
+			fmt.Fprintf(&body, "\t\terr = notStubbed(%q)\n\t\treturn\n\t}\n", iface+"."+m.name)
+			fmt.Fprintf(&body, "\treturn m.%sFunc(%s)\n}\n\n", m.name, m.argList())
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by pkg/bindings/mocks/gen - DO NOT EDIT.\n\npackage mocks\n\nimport (\n")
+	var std, external []string
+	imports := map[string]string{c.name: bindingsPackage + "/" + c.name}
+	for alias, path := range c.imports {
+		if c.used[alias] {
+			imports[alias] = path
+		}
+	}
+	for alias, path := range imports {
+		spec := strconv.Quote(path)
+		if filepath.Base(path) != alias {
+			spec = alias + " " + spec
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			external = append(external, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(external)
+	fmt.Fprintf(&out, "\t%s\n\n\t%s\n", strings.Join(std, "\n\t"), strings.Join(external, "\n\t"))
+	fmt.Fprintf(&out, ")\n\n")
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+func (m method) paramList() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.typ
+	}
+	return strings.Join(params, ", ")
+}
+
+func (m method) argList() string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+	}
+	return strings.Join(args, ", ")
+}
+
+// namedResults names the results so that unstubbed methods can return their zero values. The
+// last result of every binding method is an error.
+func (m method) namedResults() string {
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		if i == len(m.results)-1 {
+			results[i] = "err " + r
+		} else {
+			results[i] = fmt.Sprintf("ret%d %s", i, r)
+		}
+	}
+	return strings.Join(results, ", ")
+}
@@ -0,0 +1,27 @@
+// Package mocks provides interfaces over the Caller, Transactor and Filterer of every binding in
+// pkg/bindings, and mocks implementing them, so that code using the bindings can be unit tested
+// without a chain:
+//
+//	var strategy mocks.StrategyBaseTVLLimitsCaller = &mocks.MockStrategyBaseTVLLimitsCaller{
+//		GetTVLLimitsFunc: func(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+//			return maxPerDeposit, maxTotalDeposits, nil
+//		},
+//	}
+//
+// The bindings' own types satisfy the interfaces, so code should accept the interfaces instead
+// of the binding types. Everything but this file is generated by ./gen.
+package mocks
+
+//go:generate go run ./gen -bindings ../ -out .
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotStubbed is returned by the methods of mocks whose function field is nil.
+var ErrNotStubbed = errors.New("method not stubbed")
+
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
@@ -0,0 +1,231 @@
+// Package cache memoizes contract calls, for dashboards and monitors that poll the same views
+// of many contracts.
+//
+// A Caller caches three kinds of calls differently:
+//
+//   - calls of immutable getters, such as underlyingToken, are cached forever;
+//   - calls at an explicit block number are cached forever, since the state of a block does not
+//     change once it is final;
+//   - calls at the latest block are cached until the TTL passes or the head advances, whichever
+//     comes first. The head only advances when SetHead is called, typically from a subscription
+//     to new heads.
+//
+// Failed calls are never cached:
+//
+//	caller := cache.NewCaller(ethClient, cache.Config{})
+//	strategy, _ := StrategyBase.NewStrategyBaseCaller(addr, caller)
+package cache
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// DefaultTTL is the default lifetime of calls at the latest block, one slot.
+	DefaultTTL = 12 * time.Second
+	// DefaultMaxEntries is the default number of calls cached of each kind.
+	DefaultMaxEntries = 10_000
+)
+
+// ImmutableSignatures are the getters of the core contracts and strategies that return
+// immutables or values set once at initialization.
+var ImmutableSignatures = []string{
+	"underlyingToken()",
+	"strategyManager()",
+	"pauserRegistry()",
+	"delegation()",
+	"eigenPodManager()",
+	"slasher()",
+	"ethPOS()",
+	"beaconChainETHStrategy()",
+	"eigenPodBeacon()",
+	"strategyBeacon()",
+	"GENESIS_REWARDS_TIMESTAMP()",
+	"CALCULATION_INTERVAL_SECONDS()",
+	"MAX_REWARDS_DURATION()",
+	"MAX_RETROACTIVE_LENGTH()",
+	"MAX_FUTURE_LENGTH()",
+}
+
+// Config configures a Caller.
+type Config struct {
+	// TTL is how long calls at the latest block are cached. It defaults to DefaultTTL.
+	TTL time.Duration
+	// MaxEntries is the number of calls cached of each kind. A cache that is full is cleared. It
+	// defaults to DefaultMaxEntries.
+	MaxEntries int
+	// Immutable are the selectors of the calls cached forever. They default to the selectors of
+	// ImmutableSignatures.
+	Immutable map[[4]byte]bool
+}
+
+// Selectors returns the selectors of signatures, for use as Config.Immutable.
+func Selectors(signatures ...string) map[[4]byte]bool {
+	selectors := make(map[[4]byte]bool, len(signatures))
+	for _, signature := range signatures {
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(signature)))
+		selectors[selector] = true
+	}
+	return selectors
+}
+
+type key struct {
+	to   common.Address
+	from common.Address
+	data string
+	// block is the decimal block number of calls at a block.
+	block string
+}
+
+type entry struct {
+	out     []byte
+	expires time.Time
+}
+
+// Caller is a bind.ContractCaller that caches the calls made through it. It is safe for
+// concurrent use.
+type Caller struct {
+	bind.ContractCaller
+	cfg Config
+
+	mu        sync.Mutex
+	head      uint64
+	immutable map[key][]byte
+	atBlock   map[key][]byte
+	latest    map[key]entry
+}
+
+var _ bind.ContractCaller = (*Caller)(nil)
+
+// NewCaller returns caller with its calls cached.
+func NewCaller(caller bind.ContractCaller, cfg Config) *Caller {
+	if cfg.TTL == 0 {
+		cfg.TTL = DefaultTTL
+	}
+	if cfg.MaxEntries == 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+	if cfg.Immutable == nil {
+		cfg.Immutable = Selectors(ImmutableSignatures...)
+	}
+	return &Caller{
+		ContractCaller: caller,
+		cfg:            cfg,
+		immutable:      make(map[key][]byte),
+		atBlock:        make(map[key][]byte),
+		latest:         make(map[key]entry),
+	}
+}
+
+// CallContract returns the cached result of call if there is one, and otherwise makes the call
+// and caches its result.
+func (c *Caller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || call.Value != nil && call.Value.Sign() != 0 {
+		return c.ContractCaller.CallContract(ctx, call, blockNumber)
+	}
+	k := key{to: *call.To, from: call.From, data: string(call.Data)}
+	immutable := c.isImmutable(call.Data)
+	if immutable {
+		// immutables do not depend on the caller or the block
+		k.from = common.Address{}
+	} else if blockNumber != nil {
+		k.block = blockNumber.String()
+	}
+
+	if out, ok := c.get(k, immutable); ok {
+		return out, nil
+	}
+	out, err := c.ContractCaller.CallContract(ctx, call, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	c.put(k, immutable, out)
+	return out, nil
+}
+
+func (c *Caller) isImmutable(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	var selector [4]byte
+	copy(selector[:], data)
+	return c.cfg.Immutable[selector]
+}
+
+func (c *Caller) get(k key, immutable bool) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case immutable:
+		out, ok := c.immutable[k]
+		return out, ok
+	case k.block != "":
+		out, ok := c.atBlock[k]
+		return out, ok
+	default:
+		e, ok := c.latest[k]
+		if !ok || time.Now().After(e.expires) {
+			return nil, false
+		}
+		return e.out, true
+	}
+}
+
+func (c *Caller) put(k key, immutable bool, out []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case immutable:
+		if len(c.immutable) >= c.cfg.MaxEntries {
+			c.immutable = make(map[key][]byte)
+		}
+		c.immutable[k] = out
+	case k.block != "":
+		if len(c.atBlock) >= c.cfg.MaxEntries {
+			c.atBlock = make(map[key][]byte)
+		}
+		c.atBlock[k] = out
+	default:
+		if len(c.latest) >= c.cfg.MaxEntries {
+			c.latest = make(map[key]entry)
+		}
+		c.latest[k] = entry{out: out, expires: time.Now().Add(c.cfg.TTL)}
+	}
+}
+
+// SetHead records the chain head. Calls at the latest block cached before head was reached are
+// dropped.
+func (c *Caller) SetHead(head uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if head > c.head {
+		c.head = head
+		c.latest = make(map[key]entry)
+	}
+}
+
+// Invalidate drops the calls at the latest block, such as after sending a transaction that
+// changes the state read.
+func (c *Caller) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.latest = make(map[key]entry)
+}
+
+// Reset drops every cached call, such as after a contract upgrade changes its immutables.
+func (c *Caller) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.immutable = make(map[key][]byte)
+	c.atBlock = make(map[key][]byte)
+	c.latest = make(map[key]entry)
+}
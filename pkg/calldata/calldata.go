@@ -0,0 +1,111 @@
+// Package calldata builds the raw calls made by binding transactor methods without a signer, for
+// admin actions proposed through a multisig such as a Gnosis Safe rather than sent from an EOA.
+//
+// Calls can be batched into a single Safe transaction through the Safe MultiSendCallOnly contract:
+//
+//	pause, _ := calldata.Build(func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return strategyManager.Pause(opts, big.NewInt(1))
+//	})
+//	limits, _ := calldata.Pack(strategy, "StrategyBaseTVLLimits", "setTVLLimits", perDeposit, total)
+//	batch, _ := calldata.MultiSend(calldata.MultiSendCallOnlyAddress, pause, limits)
+package calldata
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/simulate"
+)
+
+// MultiSendCallOnlyAddress is the address of the Safe v1.3.0 MultiSendCallOnly contract, which
+// is deployed at the same address on every chain Safe supports.
+var MultiSendCallOnlyAddress = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+
+const multiSendABI = `[{"type":"function","name":"multiSend","stateMutability":"payable","inputs":[{"name":"transactions","type":"bytes"}],"outputs":[]}]`
+
+var parsedMultiSendABI = abis.MustParse(multiSendABI)
+
+// Operation is the operation a Safe executes a transaction with.
+type Operation uint8
+
+const (
+	// OpCall executes the transaction as a call.
+	OpCall Operation = 0
+	// OpDelegateCall executes the transaction as a delegatecall.
+	OpDelegateCall Operation = 1
+)
+
+// Call is a transaction to propose to a multisig.
+type Call struct {
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+	Operation Operation
+}
+
+// Build returns the call transact would make, for any transactor method of the bindings.
+func Build(transact simulate.Transact) (Call, error) {
+	tx, err := simulate.Build(context.Background(), common.Address{}, transact)
+	if err != nil {
+		return Call{}, err
+	}
+	if tx.To() == nil {
+		return Call{}, errors.New("contract creations are not supported")
+	}
+	return Call{To: *tx.To(), Value: tx.Value(), Data: tx.Data()}, nil
+}
+
+// Pack returns a call of method on the contract deployed at to, where contract is the name of
+// its binding in pkg/bindings. Overloaded methods use the binding's suffixed names, such as
+// "paused" and "paused0".
+func Pack(to common.Address, contract, method string, args ...interface{}) (Call, error) {
+	parsed, err := abis.ABI(contract)
+	if err != nil {
+		return Call{}, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return Call{}, fmt.Errorf("failed to pack %s.%s: %w", contract, method, err)
+	}
+	return Call{To: to, Value: new(big.Int), Data: data}, nil
+}
+
+// EncodeMultiSend packs calls into the transactions argument of MultiSend.multiSend: for each
+// call, its operation, to, value, data length and data, tightly packed.
+func EncodeMultiSend(calls []Call) []byte {
+	var out []byte
+	for _, call := range calls {
+		out = append(out, byte(call.Operation))
+		out = append(out, call.To.Bytes()...)
+		value := call.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		out = append(out, common.LeftPadBytes(value.Bytes(), 32)...)
+		var length [32]byte
+		binary.BigEndian.PutUint64(length[24:], uint64(len(call.Data)))
+		out = append(out, length[:]...)
+		out = append(out, call.Data...)
+	}
+	return out
+}
+
+// MultiSend returns a call of multiSend on the MultiSend contract deployed at multiSend that
+// executes calls in order, all or none. The returned call is a delegatecall, as MultiSend must
+// be executed in the context of the Safe. MultiSendCallOnly rejects delegatecalls among calls.
+func MultiSend(multiSend common.Address, calls ...Call) (Call, error) {
+	if len(calls) == 0 {
+		return Call{}, errors.New("no calls to batch")
+	}
+	data, err := parsedMultiSendABI.Pack("multiSend", EncodeMultiSend(calls))
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: multiSend, Value: new(big.Int), Data: data, Operation: OpDelegateCall}, nil
+}
@@ -0,0 +1,225 @@
+// Package client provides EigenLayerClient, a facade over the generated bindings for the EigenLayer core contracts.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+// Backend is the chain access required by EigenLayerClient. Both *ethclient.Client and
+// the simulated backend's client satisfy it. It is defined in pkg/ethbackend, so that the
+// backends wrapping it need not import this package.
+type Backend = ethbackend.Backend
+
+// EigenLayerClient wraps the bindings for the core contracts deployed on a single chain.
+type EigenLayerClient struct {
+	ChainID uint64
+	Backend Backend
+
+	DelegationManager  *DelegationManager.DelegationManager
+	StrategyManager    *StrategyManager.StrategyManager
+	EigenPodManager    *EigenPodManager.EigenPodManager
+	AVSDirectory       *AVSDirectory.AVSDirectory
+	RewardsCoordinator *RewardsCoordinator.RewardsCoordinator
+
+	// Logger receives the steps of the multi-transaction flows of the client, such as
+	// ApproveAndDeposit. It defaults to logging.Nop. Wrap Backend with logging.NewBackend to also
+	// log every transaction and receipt.
+	Logger logging.Logger
+
+	addrs map[string]common.Address
+	close func()
+
+	tokensMu sync.Mutex
+	// tokens caches token metadata by address, and underlyingTokens the underlying token of each
+	// strategy.
+	tokens           map[common.Address]amounts.Token
+	underlyingTokens map[common.Address]common.Address
+}
+
+// NewEigenLayerClient dials rpcURL and binds the core contracts deployed on chainID,
+// as resolved by addresses.Default. It fails if the node reports a different chain ID.
+func NewEigenLayerClient(ctx context.Context, rpcURL string, chainID uint64) (*EigenLayerClient, error) {
+	ethClient, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+
+	remoteChainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		ethClient.Close()
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	if !remoteChainID.IsUint64() || remoteChainID.Uint64() != chainID {
+		ethClient.Close()
+		return nil, fmt.Errorf("chain ID mismatch: expected %d, node reports %s", chainID, remoteChainID)
+	}
+
+	c, err := NewEigenLayerClientWithBackend(ethClient, chainID, addresses.Default)
+	if err != nil {
+		ethClient.Close()
+		return nil, err
+	}
+	c.close = ethClient.Close
+	return c, nil
+}
+
+// NewEigenLayerClientWithBackend binds the core contracts deployed on chainID, as resolved
+// by registry, using an existing backend.
+func NewEigenLayerClientWithBackend(backend Backend, chainID uint64, registry *addresses.Registry) (*EigenLayerClient, error) {
+	addrs, err := registry.Deployment(chainID)
+	if err != nil {
+		return nil, err
+	}
+	resolve := func(name string) (common.Address, error) {
+		addr, ok := addrs[name]
+		if !ok {
+			return common.Address{}, fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, name, chainID)
+		}
+		return addr, nil
+	}
+
+	c := &EigenLayerClient{
+		ChainID: chainID,
+		Backend: backend,
+		Logger:  logging.Nop,
+		addrs:   addrs,
+	}
+
+	addr, err := resolve(addresses.DelegationManager)
+	if err != nil {
+		return nil, err
+	}
+	if c.DelegationManager, err = DelegationManager.NewDelegationManager(addr, backend); err != nil {
+		return nil, err
+	}
+
+	if addr, err = resolve(addresses.StrategyManager); err != nil {
+		return nil, err
+	}
+	if c.StrategyManager, err = StrategyManager.NewStrategyManager(addr, backend); err != nil {
+		return nil, err
+	}
+
+	if addr, err = resolve(addresses.EigenPodManager); err != nil {
+		return nil, err
+	}
+	if c.EigenPodManager, err = EigenPodManager.NewEigenPodManager(addr, backend); err != nil {
+		return nil, err
+	}
+
+	if addr, err = resolve(addresses.AVSDirectory); err != nil {
+		return nil, err
+	}
+	if c.AVSDirectory, err = AVSDirectory.NewAVSDirectory(addr, backend); err != nil {
+		return nil, err
+	}
+
+	if addr, err = resolve(addresses.RewardsCoordinator); err != nil {
+		return nil, err
+	}
+	if c.RewardsCoordinator, err = RewardsCoordinator.NewRewardsCoordinator(addr, backend); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Close releases the underlying connection if the client dialed it.
+func (c *EigenLayerClient) Close() {
+	if c.close != nil {
+		c.close()
+	}
+}
+
+// SetCloser makes Close call close, to release a backend the caller dialed for the client, such
+// as those of pkg/config.
+func (c *EigenLayerClient) SetCloser(close func()) {
+	c.close = close
+}
+
+// Address returns the address of contractName that this client was bound with.
+func (c *EigenLayerClient) Address(contractName string) (common.Address, bool) {
+	addr, ok := c.addrs[contractName]
+	return addr, ok
+}
+
+// Strategy binds the strategy at addr. The StrategyBaseTVLLimits binding is a superset of
+// StrategyBase, so it can be used for either; TVL-limit methods revert on a plain StrategyBase.
+func (c *EigenLayerClient) Strategy(addr common.Address) (*StrategyBaseTVLLimits.StrategyBaseTVLLimits, error) {
+	return StrategyBaseTVLLimits.NewStrategyBaseTVLLimits(addr, c.Backend)
+}
+
+// DepositIntoStrategy deposits amount into strategy on behalf of opts.From. The StrategyManager
+// must already be approved to transfer amount.
+func (c *EigenLayerClient) DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, amount amounts.TokenAmount) (*types.Transaction, error) {
+	return c.StrategyManager.DepositIntoStrategy(opts, strategy, amount.Token().Address, amount.Units())
+}
+
+// QueueWithdrawal queues a withdrawal of shares, each of its own strategy, for opts.From, who is
+// also the withdrawer.
+func (c *EigenLayerClient) QueueWithdrawal(opts *bind.TransactOpts, shares []amounts.Shares) (*types.Transaction, error) {
+	params := DelegationManager.IDelegationManagerQueuedWithdrawalParams{
+		Strategies: make([]common.Address, len(shares)),
+		Shares:     make([]*big.Int, len(shares)),
+		Withdrawer: opts.From,
+	}
+	for i, s := range shares {
+		params.Strategies[i], params.Shares[i] = s.Strategy(), s.Units()
+	}
+	return c.DelegationManager.QueueWithdrawals(opts, []DelegationManager.IDelegationManagerQueuedWithdrawalParams{params})
+}
+
+// CompleteQueuedWithdrawal completes withdrawal, receiving tokens if receiveAsTokens is set and shares otherwise.
+// tokens must list the underlying token of each strategy in the withdrawal when receiving tokens.
+func (c *EigenLayerClient) CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal DelegationManager.IDelegationManagerWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error) {
+	// middlewareTimesIndex is unused by the DelegationManager
+	return c.DelegationManager.CompleteQueuedWithdrawal(opts, withdrawal, tokens, big.NewInt(0), receiveAsTokens)
+}
+
+// DelegateTo delegates opts.From to operator. approverSignature and approverSalt are only checked
+// if the operator has a delegation approver; pass zero values otherwise.
+func (c *EigenLayerClient) DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignature DelegationManager.ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	if approverSignature.Expiry == nil {
+		approverSignature.Expiry = big.NewInt(0)
+	}
+	return c.DelegationManager.DelegateTo(opts, operator, approverSignature, approverSalt)
+}
+
+// Undelegate undelegates staker from its operator, queuing withdrawals of all of its shares.
+func (c *EigenLayerClient) Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error) {
+	return c.DelegationManager.Undelegate(opts, staker)
+}
+
+// GetDeposits returns the shares staker holds in each strategy it has deposited into.
+func (c *EigenLayerClient) GetDeposits(ctx context.Context, staker common.Address, options ...CallOption) ([]amounts.Shares, error) {
+	strategies, units, err := c.StrategyManager.GetDeposits(CallOpts(ctx, options...), staker)
+	if err != nil {
+		return nil, err
+	}
+	deposits := make([]amounts.Shares, len(strategies))
+	for i, strategy := range strategies {
+		if deposits[i], err = c.shares(ctx, strategy, units[i]); err != nil {
+			return nil, err
+		}
+	}
+	return deposits, nil
+}
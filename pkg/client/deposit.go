@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/permit"
+)
+
+// ApproveAndDepositOptions configures how ApproveAndDeposit raises the StrategyManager's
+// allowance when it is below the deposit amount.
+type ApproveAndDepositOptions struct {
+	// IncreaseAllowance raises the allowance with increaseAllowance by the shortfall instead of
+	// setting it to the deposit amount with approve.
+	IncreaseAllowance bool
+	// PermitKey, if set, is the key of opts.From, used to sign an EIP-2612 permit for the deposit
+	// amount instead of calling approve. The token must implement permit.
+	PermitKey *ecdsa.PrivateKey
+	// PermitDeadline is the deadline of the permit. It defaults to the maximum uint256.
+	PermitDeadline *big.Int
+}
+
+// ApproveAndDeposit deposits amount into strategy on behalf of opts.From, first approving the
+// StrategyManager to transfer amount if its current allowance is lower. Each
+// transaction is waited for, and the deposit's receipt is returned. A nil depositOpts
+// approves with approve. See permit.DepositWithPermit for a permit flow that does not wait for
+// the permit to be mined.
+func (c *EigenLayerClient) ApproveAndDeposit(ctx context.Context, opts *bind.TransactOpts, strategy common.Address, amount amounts.TokenAmount, depositOpts *ApproveAndDepositOptions) (*types.Receipt, error) {
+	if depositOpts == nil {
+		depositOpts = &ApproveAndDepositOptions{}
+	}
+	strategyManager, ok := c.Address(addresses.StrategyManager)
+	if !ok {
+		return nil, fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, addresses.StrategyManager, c.ChainID)
+	}
+	token, units := amount.Token().Address, amount.Units()
+	erc20 := bind.NewBoundContract(token, abis.ERC20, c.Backend, c.Backend, c.Backend)
+	txOpts := *opts
+	txOpts.Context = ctx
+
+	allowance, err := uint256Call(ctx, erc20, "allowance", opts.From, strategyManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch allowance: %w", err)
+	}
+	if allowance.Cmp(units) < 0 {
+		c.logger().Info("approving StrategyManager", "token", token.Hex(), "owner", opts.From.Hex(), "allowance", allowance, "amount", units,
+			"permit", depositOpts.PermitKey != nil, "increaseAllowance", depositOpts.IncreaseAllowance)
+		var tx *types.Transaction
+		switch {
+		case depositOpts.PermitKey != nil:
+			var p *permit.Permit
+			if p, err = permit.Sign(ctx, c.Backend, depositOpts.PermitKey, token, strategyManager, units, depositOpts.PermitDeadline); err == nil {
+				tx, err = permit.Submit(&txOpts, c.Backend, p)
+			}
+		case depositOpts.IncreaseAllowance:
+			tx, err = erc20.Transact(&txOpts, "increaseAllowance", strategyManager, new(big.Int).Sub(units, allowance))
+		default:
+			tx, err = erc20.Transact(&txOpts, "approve", strategyManager, units)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to approve StrategyManager: %w", err)
+		}
+		if _, err := c.waitMined(ctx, tx); err != nil {
+			return nil, err
+		}
+		if txOpts.Nonce != nil {
+			txOpts.Nonce = new(big.Int).Add(txOpts.Nonce, big.NewInt(1))
+		}
+	}
+
+	c.logger().Info("depositing into strategy", "strategy", strategy.Hex(), "token", token.Hex(), "staker", opts.From.Hex(), "amount", units)
+	tx, err := c.DepositIntoStrategy(&txOpts, strategy, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", err)
+	}
+	return c.waitMined(ctx, tx)
+}
+
+func (c *EigenLayerClient) waitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, c.Backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		c.logger().Error("transaction reverted", "tx", tx.Hash().Hex(), "block", receipt.BlockNumber)
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	c.logger().Debug("transaction mined", "tx", tx.Hash().Hex(), "block", receipt.BlockNumber, "gasUsed", receipt.GasUsed)
+	return receipt, nil
+}
+
+// logger returns c.Logger, or logging.Nop if it was cleared.
+func (c *EigenLayerClient) logger() logging.Logger {
+	return logging.OrNop(c.Logger)
+}
+
+func uint256Call(ctx context.Context, contract *bind.BoundContract, method string, args ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, method, args...); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrDuplicateIntent is returned by SubmitOnce when the intent was already submitted.
+var ErrDuplicateIntent = errors.New("intent already submitted")
+
+// IntentStatus is the status of a submitted intent.
+type IntentStatus string
+
+const (
+	// IntentPending intents are being submitted, or were sent and are not mined yet.
+	IntentPending IntentStatus = "pending"
+	// IntentMined intents were mined successfully and are never submitted again.
+	IntentMined IntentStatus = "mined"
+	// IntentFailed intents were mined but reverted, and may be submitted again.
+	IntentFailed IntentStatus = "failed"
+)
+
+// Intent is an action submitted by an automation job, such as completing a withdrawal or
+// processing a rewards claim.
+type Intent struct {
+	// Key identifies the action, such as WithdrawalIntent of the withdrawal's root.
+	Key    string       `json:"key"`
+	Status IntentStatus `json:"status"`
+	// TxHash is the hash of the transaction sent for the intent, zero until it is sent.
+	TxHash    common.Hash `json:"txHash"`
+	CreatedAt time.Time   `json:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
+// WithdrawalIntent returns the key of the intent completing the withdrawal with root.
+func WithdrawalIntent(root common.Hash) string {
+	return "withdrawal:" + root.Hex()
+}
+
+// ClaimIntent returns the key of the intent processing the rewards claim with hash.
+func ClaimIntent(hash common.Hash) string {
+	return "claim:" + hash.Hex()
+}
+
+// IntentStore records submitted intents.
+type IntentStore interface {
+	// Get returns the intent recorded for key, or nil.
+	Get(ctx context.Context, key string) (*Intent, error)
+	// Create records intent, failing with ErrDuplicateIntent if an intent is already recorded
+	// for its key. The check and the write must be atomic.
+	Create(ctx context.Context, intent Intent) error
+	// Update replaces the intent recorded for intent.Key.
+	Update(ctx context.Context, intent Intent) error
+	// Delete removes the intent recorded for key.
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryIntentStore is an IntentStore that keeps intents in memory, for long-running processes.
+type MemoryIntentStore struct {
+	mu      sync.Mutex
+	intents map[string]Intent
+}
+
+// NewMemoryIntentStore returns an empty MemoryIntentStore.
+func NewMemoryIntentStore() *MemoryIntentStore {
+	return &MemoryIntentStore{intents: make(map[string]Intent)}
+}
+
+// Get implements IntentStore.
+func (s *MemoryIntentStore) Get(_ context.Context, key string) (*Intent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	intent, ok := s.intents[key]
+	if !ok {
+		return nil, nil
+	}
+	return &intent, nil
+}
+
+// Create implements IntentStore.
+func (s *MemoryIntentStore) Create(_ context.Context, intent Intent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.intents[intent.Key]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateIntent, intent.Key)
+	}
+	s.intents[intent.Key] = intent
+	return nil
+}
+
+// Update implements IntentStore.
+func (s *MemoryIntentStore) Update(_ context.Context, intent Intent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.intents[intent.Key] = intent
+	return nil
+}
+
+// Delete implements IntentStore.
+func (s *MemoryIntentStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.intents, key)
+	return nil
+}
+
+// FileIntentStore is an IntentStore persisting intents in a JSON file, for jobs run
+// periodically, such as from cron. The file is rewritten atomically on every change. Jobs
+// sharing a file must not run concurrently.
+type FileIntentStore struct {
+	path string
+	mem  *MemoryIntentStore
+}
+
+// NewFileIntentStore returns a FileIntentStore persisting intents at path, loading the intents
+// already recorded there.
+func NewFileIntentStore(path string) (*FileIntentStore, error) {
+	s := &FileIntentStore{path: path, mem: NewMemoryIntentStore()}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read intents: %w", err)
+	}
+	var intents []Intent
+	if err := json.Unmarshal(data, &intents); err != nil {
+		return nil, fmt.Errorf("failed to decode intents in %s: %w", path, err)
+	}
+	for _, intent := range intents {
+		s.mem.intents[intent.Key] = intent
+	}
+	return s, nil
+}
+
+// Get implements IntentStore.
+func (s *FileIntentStore) Get(ctx context.Context, key string) (*Intent, error) {
+	return s.mem.Get(ctx, key)
+}
+
+// Create implements IntentStore.
+func (s *FileIntentStore) Create(ctx context.Context, intent Intent) error {
+	return s.write(func() error { return s.mem.Create(ctx, intent) })
+}
+
+// Update implements IntentStore.
+func (s *FileIntentStore) Update(ctx context.Context, intent Intent) error {
+	return s.write(func() error { return s.mem.Update(ctx, intent) })
+}
+
+// Delete implements IntentStore.
+func (s *FileIntentStore) Delete(ctx context.Context, key string) error {
+	return s.write(func() error { return s.mem.Delete(ctx, key) })
+}
+
+// write applies change to the intents in memory and persists them.
+func (s *FileIntentStore) write(change func() error) error {
+	if err := change(); err != nil {
+		return err
+	}
+	s.mem.mu.Lock()
+	intents := make([]Intent, 0, len(s.mem.intents))
+	for _, intent := range s.mem.intents {
+		intents = append(intents, intent)
+	}
+	s.mem.mu.Unlock()
+	data, err := json.MarshalIndent(intents, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write intents: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write intents: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write intents: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write intents: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write intents: %w", err)
+	}
+	return nil
+}
+
+// SubmitOnce sends the transaction of the intent with key through send, unless it was already
+// submitted, waits for it to be mined and returns its receipt. It fails with
+// ErrDuplicateIntent if the intent was mined, or is pending: sent and not mined yet, or
+// interrupted before its transaction hash was recorded. Intents whose transaction reverted are
+// submitted again. An intent left pending by a transaction that was dropped must be deleted
+// from the store to be submitted again.
+func (c *EigenLayerClient) SubmitOnce(ctx context.Context, store IntentStore, key string, send func(ctx context.Context) (*types.Transaction, error)) (*types.Receipt, error) {
+	existing, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch intent %s: %w", key, err)
+	}
+	if existing != nil {
+		if err := c.resolveIntent(ctx, store, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	intent := Intent{Key: key, Status: IntentPending, CreatedAt: now, UpdatedAt: now}
+	if err := store.Create(ctx, intent); err != nil {
+		return nil, err
+	}
+	tx, err := send(ctx)
+	if err != nil {
+		if deleteErr := store.Delete(ctx, key); deleteErr != nil {
+			c.logger().Error("failed to release intent", "intent", key, "err", deleteErr)
+		}
+		return nil, err
+	}
+	intent.TxHash, intent.UpdatedAt = tx.Hash(), time.Now()
+	if err := store.Update(ctx, intent); err != nil {
+		return nil, fmt.Errorf("failed to record intent %s sent in %s: %w", key, tx.Hash(), err)
+	}
+	c.logger().Info("submitted intent", "intent", key, "tx", tx.Hash().Hex())
+
+	receipt, err := c.waitMined(ctx, tx)
+	if receipt == nil {
+		return nil, err
+	}
+	intent.Status, intent.UpdatedAt = IntentMined, time.Now()
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		intent.Status = IntentFailed
+	}
+	if updateErr := store.Update(ctx, intent); updateErr != nil {
+		return receipt, fmt.Errorf("failed to record intent %s mined in %s: %w", key, tx.Hash(), updateErr)
+	}
+	return receipt, err
+}
+
+// resolveIntent returns ErrDuplicateIntent unless the recorded intent failed, in which case it
+// is deleted so that it can be submitted again. A pending intent whose transaction was mined
+// meanwhile is updated first.
+func (c *EigenLayerClient) resolveIntent(ctx context.Context, store IntentStore, intent *Intent) error {
+	if intent.Status == IntentPending && intent.TxHash != (common.Hash{}) {
+		receipt, err := c.Backend.TransactionReceipt(ctx, intent.TxHash)
+		switch {
+		case errors.Is(err, ethereum.NotFound):
+		case err != nil:
+			return fmt.Errorf("failed to fetch receipt of %s: %w", intent.TxHash, err)
+		default:
+			intent.Status, intent.UpdatedAt = IntentMined, time.Now()
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				intent.Status = IntentFailed
+			}
+			if err := store.Update(ctx, *intent); err != nil {
+				return fmt.Errorf("failed to update intent %s: %w", intent.Key, err)
+			}
+		}
+	}
+	if intent.Status != IntentFailed {
+		return fmt.Errorf("%w: %s is %s (tx %s)", ErrDuplicateIntent, intent.Key, intent.Status, intent.TxHash)
+	}
+	if err := store.Delete(ctx, intent.Key); err != nil {
+		return fmt.Errorf("failed to delete intent %s: %w", intent.Key, err)
+	}
+	return nil
+}
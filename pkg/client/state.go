@@ -0,0 +1,320 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/withdrawals"
+)
+
+// avsOperatorStatusRegistered is the REGISTERED value of the AVSDirectory's OperatorAVSRegistrationStatus.
+const avsOperatorStatusRegistered = 1
+
+// StrategyShares is an amount of shares in a strategy.
+type StrategyShares struct {
+	Strategy common.Address
+	Shares   *big.Int
+}
+
+// OperatorState is the delegation state of an operator at a block.
+type OperatorState struct {
+	Operator    common.Address
+	BlockNumber uint64
+	// IsOperator reports whether the operator has registered with the DelegationManager. The
+	// remaining fields are zero if it has not.
+	IsOperator         bool
+	Details            DelegationManager.IDelegationManagerOperatorDetails
+	DelegationApprover common.Address
+	// Shares holds the shares delegated to the operator in each strategy that was queried,
+	// including the beacon chain ETH strategy, in the order queried.
+	Shares []StrategyShares
+	// AVSs holds the AVSs that were queried that the operator is registered with.
+	AVSs []common.Address
+}
+
+// OperatorState returns the state of operator, read in a single multicall at the latest block or
+// the block selected by options. Delegated shares are read for each of strategies and the beacon
+// chain ETH strategy, and AVS registrations for each of avss; the contracts do not enumerate
+// either, so callers pass lists such as the strategies of a strategies.Registry.
+//
+// This deployment predates the AllocationManager, so no allocations are reported.
+func (c *EigenLayerClient) OperatorState(ctx context.Context, operator common.Address, strategies, avss []common.Address, options ...CallOption) (*OperatorState, error) {
+	opts, err := c.pinnedCallOpts(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	strategies = withBeaconChainETHStrategy(strategies)
+
+	batch := c.NewBatch()
+	delegation, err := DelegationManager.NewDelegationManagerCaller(c.addrs[addresses.DelegationManager], batch)
+	if err != nil {
+		return nil, err
+	}
+	directory, err := AVSDirectory.NewAVSDirectoryCaller(c.addrs[addresses.AVSDirectory], batch)
+	if err != nil {
+		return nil, err
+	}
+	isOperator := multicall.Add(batch, func(opts *bind.CallOpts) (bool, error) {
+		return delegation.IsOperator(opts, operator)
+	})
+	details := multicall.Add(batch, func(opts *bind.CallOpts) (DelegationManager.IDelegationManagerOperatorDetails, error) {
+		return delegation.OperatorDetails(opts, operator)
+	})
+	approver := multicall.Add(batch, func(opts *bind.CallOpts) (common.Address, error) {
+		return delegation.DelegationApprover(opts, operator)
+	})
+	shares := multicall.Add(batch, func(opts *bind.CallOpts) ([]*big.Int, error) {
+		return delegation.GetOperatorShares(opts, operator, strategies)
+	})
+	statuses := make([]*multicall.Result[uint8], len(avss))
+	for i, avs := range avss {
+		avs := avs
+		statuses[i] = multicall.Add(batch, func(opts *bind.CallOpts) (uint8, error) {
+			return directory.AvsOperatorStatus(opts, avs, operator)
+		})
+	}
+	if err := batch.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	state := &OperatorState{Operator: operator, BlockNumber: opts.BlockNumber.Uint64()}
+	if state.IsOperator, err = isOperator.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch operator status: %w", err)
+	}
+	if state.Details, err = details.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch operator details: %w", err)
+	}
+	if state.DelegationApprover, err = approver.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch delegation approver: %w", err)
+	}
+	amounts, err := shares.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch operator shares: %w", err)
+	}
+	state.Shares = make([]StrategyShares, len(strategies))
+	for i, strategy := range strategies {
+		state.Shares[i] = StrategyShares{Strategy: strategy, Shares: amounts[i]}
+	}
+	for i, status := range statuses {
+		s, err := status.Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch registration status with AVS %s: %w", avss[i].Hex(), err)
+		}
+		if s == avsOperatorStatusRegistered {
+			state.AVSs = append(state.AVSs, avss[i])
+		}
+	}
+	return state, nil
+}
+
+// pinnedCallOpts returns CallOpts for ctx with options applied, pinned to the latest block if
+// options select none, so that the block the state was read at can be reported.
+func (c *EigenLayerClient) pinnedCallOpts(ctx context.Context, options []CallOption) (*bind.CallOpts, error) {
+	opts := CallOpts(ctx, options...)
+	if opts.BlockHash != (common.Hash{}) {
+		return nil, fmt.Errorf("state cannot be read at block hash %s, only at a block number", opts.BlockHash.Hex())
+	}
+	if opts.BlockNumber == nil {
+		head, err := c.Backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch block number: %w", err)
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(head)
+	}
+	return opts, nil
+}
+
+// withBeaconChainETHStrategy returns strategies with the beacon chain ETH strategy appended, unless
+// it is already listed.
+func withBeaconChainETHStrategy(strategies []common.Address) []common.Address {
+	for _, strategy := range strategies {
+		if strategy == addresses.BeaconChainETHStrategy {
+			return strategies
+		}
+	}
+	return append(append([]common.Address(nil), strategies...), addresses.BeaconChainETHStrategy)
+}
+
+// PendingWithdrawal is a queued withdrawal that has not been completed.
+type PendingWithdrawal struct {
+	Root       common.Hash
+	Withdrawal DelegationManager.IDelegationManagerWithdrawal
+	// UnlockBlock is the first block the withdrawal can be completed at: its start block plus the
+	// longest withdrawal delay of its strategies.
+	UnlockBlock uint64
+}
+
+// RewardClaim is the rewards a staker can claim in a token.
+type RewardClaim struct {
+	Token              common.Address
+	CumulativeEarnings *big.Int
+	CumulativeClaimed  *big.Int
+	// Claimable is CumulativeEarnings less CumulativeClaimed, or zero if already claimed.
+	Claimable *big.Int
+}
+
+// StakerState is the restaking position of a staker at a block.
+type StakerState struct {
+	Staker      common.Address
+	BlockNumber uint64
+	// DelegatedTo is the operator the staker is delegated to, or the zero address.
+	DelegatedTo common.Address
+	// Deposits holds the staker's shares in each strategy of the StrategyManager it has deposited
+	// into. Beacon chain ETH shares are reported as PodOwnerShares.
+	Deposits []StrategyShares
+	// Withdrawals holds the withdrawals that were queried that are still pending.
+	Withdrawals []PendingWithdrawal
+	// EigenPod is the staker's EigenPod, which is only deployed if HasPod is set.
+	EigenPod       common.Address
+	HasPod         bool
+	PodOwnerShares *big.Int
+	// Claimer is the address allowed to claim the staker's rewards, or the zero address if only
+	// the staker is.
+	Claimer common.Address
+	Rewards []RewardClaim
+}
+
+// StakerState returns the state of staker, read in a single multicall at the latest block or the
+// block selected by options. The contracts do not enumerate queued withdrawals or rewards, so
+// callers pass the withdrawals queued by the staker, such as those of a ledger.Ledger or a
+// WithdrawalQueued receipt, and its cumulative earnings in the latest distribution, such as those
+// returned by rewards.Distribution.Earnings. Withdrawals that are no longer pending are dropped.
+func (c *EigenLayerClient) StakerState(ctx context.Context, staker common.Address, queued []DelegationManager.IDelegationManagerWithdrawal, earnings []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, options ...CallOption) (*StakerState, error) {
+	opts, err := c.pinnedCallOpts(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]common.Hash, len(queued))
+	for i, w := range queued {
+		if roots[i], err = withdrawals.Root(w); err != nil {
+			return nil, err
+		}
+	}
+
+	batch := c.NewBatch()
+	delegation, err := DelegationManager.NewDelegationManagerCaller(c.addrs[addresses.DelegationManager], batch)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := StrategyManager.NewStrategyManagerCaller(c.addrs[addresses.StrategyManager], batch)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := EigenPodManager.NewEigenPodManagerCaller(c.addrs[addresses.EigenPodManager], batch)
+	if err != nil {
+		return nil, err
+	}
+	coordinator, err := RewardsCoordinator.NewRewardsCoordinatorCaller(c.addrs[addresses.RewardsCoordinator], batch)
+	if err != nil {
+		return nil, err
+	}
+	delegatedTo := multicall.Add(batch, func(opts *bind.CallOpts) (common.Address, error) {
+		return delegation.DelegatedTo(opts, staker)
+	})
+	deposits := multicall.Add2(batch, func(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+		return manager.GetDeposits(opts, staker)
+	})
+	pending := make([]*multicall.Result[bool], len(queued))
+	delays := make([]*multicall.Result[*big.Int], len(queued))
+	for i, w := range queued {
+		root, strategies := roots[i], w.Strategies
+		pending[i] = multicall.Add(batch, func(opts *bind.CallOpts) (bool, error) {
+			return delegation.PendingWithdrawals(opts, root)
+		})
+		delays[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return delegation.GetWithdrawalDelay(opts, strategies)
+		})
+	}
+	pod := multicall.Add(batch, func(opts *bind.CallOpts) (common.Address, error) {
+		return pods.GetPod(opts, staker)
+	})
+	hasPod := multicall.Add(batch, func(opts *bind.CallOpts) (bool, error) {
+		return pods.HasPod(opts, staker)
+	})
+	podShares := multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+		return pods.PodOwnerShares(opts, staker)
+	})
+	claimer := multicall.Add(batch, func(opts *bind.CallOpts) (common.Address, error) {
+		return coordinator.ClaimerFor(opts, staker)
+	})
+	claimed := make([]*multicall.Result[*big.Int], len(earnings))
+	for i, leaf := range earnings {
+		token := leaf.Token
+		claimed[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return coordinator.CumulativeClaimed(opts, staker, token)
+		})
+	}
+	if err := batch.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	state := &StakerState{Staker: staker, BlockNumber: opts.BlockNumber.Uint64()}
+	if state.DelegatedTo, err = delegatedTo.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch delegated operator: %w", err)
+	}
+	strategies, shares, err := deposits.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch deposits: %w", err)
+	}
+	for i, strategy := range strategies {
+		state.Deposits = append(state.Deposits, StrategyShares{Strategy: strategy, Shares: shares[i]})
+	}
+	for i, w := range queued {
+		ok, err := pending[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch status of withdrawal %s: %w", roots[i].Hex(), err)
+		}
+		if !ok {
+			continue
+		}
+		delay, err := delays[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch delay of withdrawal %s: %w", roots[i].Hex(), err)
+		}
+		state.Withdrawals = append(state.Withdrawals, PendingWithdrawal{
+			Root:        roots[i],
+			Withdrawal:  w,
+			UnlockBlock: uint64(w.StartBlock) + delay.Uint64(),
+		})
+	}
+	if state.EigenPod, err = pod.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch EigenPod: %w", err)
+	}
+	if state.HasPod, err = hasPod.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch EigenPod status: %w", err)
+	}
+	if state.PodOwnerShares, err = podShares.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch pod owner shares: %w", err)
+	}
+	if state.Claimer, err = claimer.Get(); err != nil {
+		return nil, fmt.Errorf("failed to fetch rewards claimer: %w", err)
+	}
+	for i, leaf := range earnings {
+		done, err := claimed[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch rewards claimed in %s: %w", leaf.Token.Hex(), err)
+		}
+		claimable := new(big.Int).Sub(leaf.CumulativeEarnings, done)
+		if claimable.Sign() < 0 {
+			claimable.SetInt64(0)
+		}
+		state.Rewards = append(state.Rewards, RewardClaim{
+			Token:              leaf.Token,
+			CumulativeEarnings: leaf.CumulativeEarnings,
+			CumulativeClaimed:  done,
+			Claimable:          claimable,
+		})
+	}
+	return state, nil
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyFactory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// ErrStrategyExists is returned by DeployStrategyForToken when the StrategyFactory already
+// deployed a strategy for the token.
+var ErrStrategyExists = errors.New("strategy already deployed for token")
+
+// DeployStrategyOptions configures DeployStrategyForToken.
+type DeployStrategyOptions struct {
+	// Whitelist sends the transaction whitelisting the new strategy for deposits from opts.From
+	// if the StrategyFactory did not whitelist it, as it does while it is the StrategyManager's
+	// strategy whitelister. opts.From must then be the whitelister.
+	Whitelist bool
+}
+
+// DeployedStrategy is a strategy deployed by DeployStrategyForToken.
+type DeployedStrategy struct {
+	Strategy common.Address
+	Contract *StrategyBase.StrategyBase
+	Receipt  *types.Receipt
+	// Whitelisted reports whether deposits into the strategy are allowed.
+	Whitelisted bool
+	// WhitelistCall is the StrategyManager call whitelisting the strategy, set when it is not
+	// whitelisted, to be proposed to the strategy whitelister's multisig.
+	WhitelistCall *calldata.Call
+}
+
+// DeployStrategyForToken deploys a strategy for token through the StrategyFactory, waits for it,
+// and binds the new strategy. It fails with ErrStrategyExists if the factory already deployed one.
+func (c *EigenLayerClient) DeployStrategyForToken(ctx context.Context, opts *bind.TransactOpts, token common.Address, deployOpts *DeployStrategyOptions) (*DeployedStrategy, error) {
+	if deployOpts == nil {
+		deployOpts = &DeployStrategyOptions{}
+	}
+	factoryAddr, ok := c.Address(addresses.StrategyFactory)
+	if !ok {
+		return nil, fmt.Errorf("%w %s on chain %d", addresses.ErrUnknownContract, addresses.StrategyFactory, c.ChainID)
+	}
+	factory, err := StrategyFactory.NewStrategyFactory(factoryAddr, c.Backend)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := factory.DeployedStrategies(&bind.CallOpts{Context: ctx}, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch strategy of %s: %w", token, err)
+	}
+	if existing != (common.Address{}) {
+		return nil, fmt.Errorf("%w %s: %s", ErrStrategyExists, token, existing)
+	}
+
+	txOpts := *opts
+	txOpts.Context = ctx
+	c.logger().Info("deploying strategy", "token", token.Hex(), "factory", factoryAddr.Hex())
+	tx, err := factory.DeployNewStrategy(&txOpts, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy strategy for %s: %w", token, elerrors.Decode(err))
+	}
+	receipt, err := c.waitMined(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	d := &DeployedStrategy{Receipt: receipt}
+	for _, log := range receipt.Logs {
+		if log.Address != factoryAddr {
+			continue
+		}
+		if ev, err := factory.ParseStrategySetForToken(*log); err == nil && ev.Token == token {
+			d.Strategy = ev.Strategy
+			break
+		}
+	}
+	if d.Strategy == (common.Address{}) {
+		return nil, fmt.Errorf("no StrategySetForToken event in %s", tx.Hash())
+	}
+	if d.Contract, err = StrategyBase.NewStrategyBase(d.Strategy, c.Backend); err != nil {
+		return nil, err
+	}
+
+	if d.Whitelisted, err = c.StrategyManager.StrategyIsWhitelistedForDeposit(&bind.CallOpts{Context: ctx}, d.Strategy); err != nil {
+		return nil, fmt.Errorf("failed to fetch whitelist status of %s: %w", d.Strategy, err)
+	}
+	if d.Whitelisted {
+		return d, nil
+	}
+	strategyManager, _ := c.Address(addresses.StrategyManager)
+	call, err := calldata.Pack(strategyManager, "StrategyManager", "addStrategiesToDepositWhitelist", []common.Address{d.Strategy}, []bool{false})
+	if err != nil {
+		return nil, err
+	}
+	d.WhitelistCall = &call
+	if !deployOpts.Whitelist {
+		return d, nil
+	}
+	c.logger().Info("whitelisting strategy", "strategy", d.Strategy.Hex())
+	if txOpts.Nonce != nil {
+		txOpts.Nonce = new(big.Int).Add(txOpts.Nonce, big.NewInt(1))
+	}
+	tx, err = c.StrategyManager.AddStrategiesToDepositWhitelist(&txOpts, []common.Address{d.Strategy}, []bool{false})
+	if err != nil {
+		return nil, fmt.Errorf("failed to whitelist %s: %w", d.Strategy, elerrors.Decode(err))
+	}
+	if _, err := c.waitMined(ctx, tx); err != nil {
+		return nil, err
+	}
+	d.Whitelisted = true
+	d.WhitelistCall = nil
+	return d, nil
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+)
+
+// Token returns the metadata of the ERC-20 token at addr. Metadata is cached for the lifetime of
+// the client.
+func (c *EigenLayerClient) Token(ctx context.Context, addr common.Address) (amounts.Token, error) {
+	c.tokensMu.Lock()
+	token, ok := c.tokens[addr]
+	c.tokensMu.Unlock()
+	if ok {
+		return token, nil
+	}
+	token, err := amounts.FetchToken(ctx, c.Backend, addr)
+	if err != nil {
+		return amounts.Token{}, err
+	}
+	c.tokensMu.Lock()
+	defer c.tokensMu.Unlock()
+	if c.tokens == nil {
+		c.tokens = make(map[common.Address]amounts.Token)
+	}
+	c.tokens[addr] = token
+	return token, nil
+}
+
+// StrategyToken returns the metadata of the underlying token of strategy, which is amounts.ETH
+// for the beacon chain ETH strategy.
+func (c *EigenLayerClient) StrategyToken(ctx context.Context, strategy common.Address) (amounts.Token, error) {
+	if strategy == addresses.BeaconChainETHStrategy {
+		return amounts.ETH, nil
+	}
+	c.tokensMu.Lock()
+	addr, ok := c.underlyingTokens[strategy]
+	c.tokensMu.Unlock()
+	if !ok {
+		caller, err := StrategyBase.NewStrategyBaseCaller(strategy, c.Backend)
+		if err != nil {
+			return amounts.Token{}, err
+		}
+		if addr, err = caller.UnderlyingToken(CallOpts(ctx)); err != nil {
+			return amounts.Token{}, fmt.Errorf("failed to fetch underlying token of %s: %w", strategy.Hex(), err)
+		}
+		c.tokensMu.Lock()
+		if c.underlyingTokens == nil {
+			c.underlyingTokens = make(map[common.Address]common.Address)
+		}
+		c.underlyingTokens[strategy] = addr
+		c.tokensMu.Unlock()
+	}
+	return c.Token(ctx, addr)
+}
+
+// shares returns units of shares of strategy, with the decimals of its underlying token.
+func (c *EigenLayerClient) shares(ctx context.Context, strategy common.Address, units *big.Int) (amounts.Shares, error) {
+	token, err := c.StrategyToken(ctx, strategy)
+	if err != nil {
+		return amounts.Shares{}, err
+	}
+	return amounts.NewShares(strategy, token.Decimals, units), nil
+}
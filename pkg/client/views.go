@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// CallOption configures the CallOpts of a view.
+type CallOption func(opts *bind.CallOpts)
+
+// At queries a view at blockNumber instead of the latest block. The node must retain the state
+// of that block, which usually requires an archive node for blocks older than 128.
+func At(blockNumber uint64) CallOption {
+	return func(opts *bind.CallOpts) {
+		opts.BlockNumber = new(big.Int).SetUint64(blockNumber)
+	}
+}
+
+// AtHash queries a view at the block with hash blockHash. The backend must support calls by
+// block hash, as *ethclient.Client does.
+func AtHash(blockHash common.Hash) CallOption {
+	return func(opts *bind.CallOpts) {
+		opts.BlockHash = blockHash
+	}
+}
+
+// CallOpts returns CallOpts for ctx with options applied, for calling binding views directly or
+// executing a multicall.Batch:
+//
+//	batch := c.NewBatch()
+//	...
+//	err := batch.Execute(client.CallOpts(ctx, client.At(blockNumber)))
+func CallOpts(ctx context.Context, options ...CallOption) *bind.CallOpts {
+	opts := &bind.CallOpts{Context: ctx}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// NewBatch returns a multicall.Batch over the client's backend.
+func (c *EigenLayerClient) NewBatch() *multicall.Batch {
+	return multicall.NewBatch(c.Backend)
+}
+
+// StakerShares returns the shares staker holds in strategy.
+func (c *EigenLayerClient) StakerShares(ctx context.Context, staker, strategy common.Address, options ...CallOption) (amounts.Shares, error) {
+	units, err := c.StrategyManager.StakerStrategyShares(CallOpts(ctx, options...), staker, strategy)
+	if err != nil {
+		return amounts.Shares{}, err
+	}
+	return c.shares(ctx, strategy, units)
+}
+
+// OperatorShares returns the shares delegated to operator in strategy.
+func (c *EigenLayerClient) OperatorShares(ctx context.Context, operator, strategy common.Address, options ...CallOption) (amounts.Shares, error) {
+	units, err := c.DelegationManager.OperatorShares(CallOpts(ctx, options...), operator, strategy)
+	if err != nil {
+		return amounts.Shares{}, err
+	}
+	return c.shares(ctx, strategy, units)
+}
+
+// TotalShares returns the total shares of strategy.
+func (c *EigenLayerClient) TotalShares(ctx context.Context, strategy common.Address, options ...CallOption) (amounts.Shares, error) {
+	caller, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(strategy, c.Backend)
+	if err != nil {
+		return amounts.Shares{}, err
+	}
+	units, err := caller.TotalShares(CallOpts(ctx, options...))
+	if err != nil {
+		return amounts.Shares{}, err
+	}
+	return c.shares(ctx, strategy, units)
+}
+
+// TVLLimits returns the maxPerDeposit and maxTotalDeposits of strategy. It reverts for a
+// StrategyBase without TVL limits.
+func (c *EigenLayerClient) TVLLimits(ctx context.Context, strategy common.Address, options ...CallOption) (maxPerDeposit, maxTotalDeposits amounts.TokenAmount, err error) {
+	caller, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(strategy, c.Backend)
+	if err != nil {
+		return amounts.TokenAmount{}, amounts.TokenAmount{}, err
+	}
+	perDeposit, total, err := caller.GetTVLLimits(CallOpts(ctx, options...))
+	if err != nil {
+		return amounts.TokenAmount{}, amounts.TokenAmount{}, err
+	}
+	token, err := c.StrategyToken(ctx, strategy)
+	if err != nil {
+		return amounts.TokenAmount{}, amounts.TokenAmount{}, err
+	}
+	return token.Amount(perDeposit), token.Amount(total), nil
+}
+
+// TotalSharesBatch returns the total shares of each of strategies, read in a single multicall.
+func (c *EigenLayerClient) TotalSharesBatch(ctx context.Context, strategies []common.Address, options ...CallOption) ([]amounts.Shares, error) {
+	batch := c.NewBatch()
+	results := make([]*multicall.Result[*big.Int], len(strategies))
+	for i, strategy := range strategies {
+		caller, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(strategy, batch)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = multicall.Add(batch, caller.TotalShares)
+	}
+	if err := batch.Execute(CallOpts(ctx, options...)); err != nil {
+		return nil, err
+	}
+	totals := make([]amounts.Shares, len(strategies))
+	for i, result := range results {
+		total, err := result.Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch total shares of %s: %w", strategies[i].Hex(), err)
+		}
+		if totals[i], err = c.shares(ctx, strategies[i], total); err != nil {
+			return nil, err
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,194 @@
+package compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+)
+
+// ErrUnsupportedVersion is returned when an adapter is created for a contract of no known
+// release.
+var ErrUnsupportedVersion = errors.New("unsupported contract version")
+
+// slashingABI holds the slashing release functions whose signature differs from M2. The tree has
+// no bindings for that release.
+const slashingABI = `[
+{"type":"function","name":"completeQueuedWithdrawal","stateMutability":"nonpayable","inputs":[{"name":"withdrawal","type":"tuple","components":[{"name":"staker","type":"address"},{"name":"delegatedTo","type":"address"},{"name":"withdrawer","type":"address"},{"name":"nonce","type":"uint256"},{"name":"startBlock","type":"uint32"},{"name":"strategies","type":"address[]"},{"name":"scaledShares","type":"uint256[]"}]},{"name":"tokens","type":"address[]"},{"name":"receiveAsTokens","type":"bool"}],"outputs":[]},
+{"type":"function","name":"stakerDepositShares","stateMutability":"view","inputs":[{"name":"staker","type":"address"},{"name":"strategy","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+var parsedSlashingABI = abis.MustParse(slashingABI)
+
+// slashingWithdrawal is the Withdrawal struct of the slashing release.
+type slashingWithdrawal struct {
+	Staker       common.Address
+	DelegatedTo  common.Address
+	Withdrawer   common.Address
+	Nonce        *big.Int
+	StartBlock   uint32
+	Strategies   []common.Address
+	ScaledShares []*big.Int
+}
+
+// Withdrawal is a queued withdrawal in either release. Shares are the withdrawn shares in M2,
+// and the scaled shares in the slashing release; both hash into the withdrawal root the same
+// way.
+type Withdrawal struct {
+	Staker      common.Address
+	DelegatedTo common.Address
+	Withdrawer  common.Address
+	Nonce       *big.Int
+	StartBlock  uint32
+	Strategies  []common.Address
+	Shares      []*big.Int
+}
+
+// WithdrawalFromM2 converts a withdrawal of the M2 bindings.
+func WithdrawalFromM2(w DelegationManager.IDelegationManagerWithdrawal) Withdrawal {
+	return Withdrawal(w)
+}
+
+// M2 converts w to the withdrawal of the M2 bindings.
+func (w Withdrawal) M2() DelegationManager.IDelegationManagerWithdrawal {
+	return DelegationManager.IDelegationManagerWithdrawal(w)
+}
+
+func (w Withdrawal) slashing() slashingWithdrawal {
+	return slashingWithdrawal{
+		Staker:       w.Staker,
+		DelegatedTo:  w.DelegatedTo,
+		Withdrawer:   w.Withdrawer,
+		Nonce:        w.Nonce,
+		StartBlock:   w.StartBlock,
+		Strategies:   w.Strategies,
+		ScaledShares: w.Shares,
+	}
+}
+
+// AdapterBackend is the chain access required by the adapters.
+type AdapterBackend interface {
+	bind.ContractBackend
+	deploy.StorageReader
+}
+
+// DelegationManagerAdapter exposes the DelegationManager functions common to both releases,
+// routed to the encoding of the release detected on-chain.
+type DelegationManagerAdapter struct {
+	Version  Version
+	m2       *DelegationManager.DelegationManager
+	slashing *bind.BoundContract
+}
+
+// NewDelegationManagerAdapter detects the release of the DelegationManager at addr and returns
+// an adapter for it, or ErrUnsupportedVersion.
+func NewDelegationManagerAdapter(ctx context.Context, backend AdapterBackend, addr common.Address) (*DelegationManagerAdapter, error) {
+	version, err := DetectDelegationManager(ctx, backend, addr)
+	if err != nil {
+		return nil, err
+	}
+	if version == VersionUnknown {
+		return nil, fmt.Errorf("%w: DelegationManager at %s", ErrUnsupportedVersion, addr)
+	}
+	m2, err := DelegationManager.NewDelegationManager(addr, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DelegationManagerAdapter{
+		Version:  version,
+		m2:       m2,
+		slashing: bind.NewBoundContract(addr, parsedSlashingABI, backend, backend, backend),
+	}, nil
+}
+
+// DelegatedTo returns the operator staker is delegated to.
+func (a *DelegationManagerAdapter) DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error) {
+	return a.m2.DelegatedTo(opts, staker)
+}
+
+// IsOperator reports whether operator is registered as an operator.
+func (a *DelegationManagerAdapter) IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	return a.m2.IsOperator(opts, operator)
+}
+
+// OperatorShares returns the shares of strategy delegated to operator.
+func (a *DelegationManagerAdapter) OperatorShares(opts *bind.CallOpts, operator, strategy common.Address) (*big.Int, error) {
+	return a.m2.OperatorShares(opts, operator, strategy)
+}
+
+// QueueWithdrawals queues withdrawals of the sender's shares. Both releases encode the call the
+// same way; the slashing release ignores the withdrawer of params.
+func (a *DelegationManagerAdapter) QueueWithdrawals(opts *bind.TransactOpts, params []DelegationManager.IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error) {
+	return a.m2.QueueWithdrawals(opts, params)
+}
+
+// CompleteQueuedWithdrawal completes w. In M2 the middlewareTimesIndex argument, unused by the
+// contracts, is zero.
+func (a *DelegationManagerAdapter) CompleteQueuedWithdrawal(opts *bind.TransactOpts, w Withdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error) {
+	if a.Version == VersionSlashing {
+		return a.slashing.Transact(opts, "completeQueuedWithdrawal", w.slashing(), tokens, receiveAsTokens)
+	}
+	return a.m2.CompleteQueuedWithdrawal(opts, w.M2(), tokens, new(big.Int), receiveAsTokens)
+}
+
+// StrategyManagerAdapter exposes the StrategyManager functions common to both releases, routed
+// to the encoding of the release detected on-chain.
+type StrategyManagerAdapter struct {
+	Version  Version
+	m2       *StrategyManager.StrategyManager
+	slashing *bind.BoundContract
+}
+
+// NewStrategyManagerAdapter detects the release of the StrategyManager at addr and returns an
+// adapter for it, or ErrUnsupportedVersion.
+func NewStrategyManagerAdapter(ctx context.Context, backend AdapterBackend, addr common.Address) (*StrategyManagerAdapter, error) {
+	version, err := DetectStrategyManager(ctx, backend, addr)
+	if err != nil {
+		return nil, err
+	}
+	if version == VersionUnknown {
+		return nil, fmt.Errorf("%w: StrategyManager at %s", ErrUnsupportedVersion, addr)
+	}
+	m2, err := StrategyManager.NewStrategyManager(addr, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManagerAdapter{
+		Version:  version,
+		m2:       m2,
+		slashing: bind.NewBoundContract(addr, parsedSlashingABI, backend, backend, backend),
+	}, nil
+}
+
+// StakerShares returns the shares staker deposited into strategy: stakerStrategyShares in M2,
+// and stakerDepositShares in the slashing release.
+func (a *StrategyManagerAdapter) StakerShares(opts *bind.CallOpts, staker, strategy common.Address) (*big.Int, error) {
+	if a.Version == VersionSlashing {
+		var out []interface{}
+		if err := a.slashing.Call(opts, &out, "stakerDepositShares", staker, strategy); err != nil {
+			return nil, err
+		}
+		return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+	}
+	return a.m2.StakerStrategyShares(opts, staker, strategy)
+}
+
+// GetDeposits returns the strategies staker has deposited into and its shares in each.
+func (a *StrategyManagerAdapter) GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	return a.m2.GetDeposits(opts, staker)
+}
+
+// DepositIntoStrategy deposits amount of token into strategy.
+func (a *StrategyManagerAdapter) DepositIntoStrategy(opts *bind.TransactOpts, strategy, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return a.m2.DepositIntoStrategy(opts, strategy, token, amount)
+}
@@ -0,0 +1,188 @@
+// Package compat detects which protocol release a deployed core contract runs, so that clients
+// can tell the M2 contracts these bindings are generated from apart from the slashing release,
+// whose DelegationManager and StrategyManager have a different API.
+//
+// Detection reads the contract's code, following its proxy, and looks for the function
+// selectors its dispatcher compares calldata against:
+//
+//	version, err := compat.DetectDelegationManager(ctx, client, delegationManager)
+//	if version != compat.VersionM2 {
+//		return fmt.Errorf("unsupported DelegationManager version %s", version)
+//	}
+package compat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+)
+
+// Version is a release of the core contracts.
+type Version int
+
+const (
+	// VersionUnknown is returned for code that has the markers of no known release.
+	VersionUnknown Version = iota
+	// VersionM2 is the release the bindings in pkg/bindings are generated from.
+	VersionM2
+	// VersionSlashing is the slashing release, which adds the AllocationManager.
+	VersionSlashing
+)
+
+func (v Version) String() string {
+	switch v {
+	case VersionM2:
+		return "m2"
+	case VersionSlashing:
+		return "slashing"
+	default:
+		return "unknown"
+	}
+}
+
+// Opcodes of the PUSH1 and PUSH32 instructions.
+const (
+	opPush1  = 0x60
+	opPush32 = 0x7f
+)
+
+// ErrNoCode is returned when there is no code at the address probed.
+var ErrNoCode = errors.New("no code deployed")
+
+// Selector returns the function selector of signature, such as "allocationManager()".
+func Selector(signature string) [4]byte {
+	var selector [4]byte
+	copy(selector[:], crypto.Keccak256([]byte(signature)))
+	return selector
+}
+
+// Markers are the selectors that identify the releases of a contract. A contract is of the
+// first release with a selector present in its code.
+type Markers []struct {
+	Version   Version
+	Selectors [][4]byte
+}
+
+var (
+	// DelegationManagerMarkers identify the releases of the DelegationManager. The slashing
+	// release drops the middlewareTimesIndex argument of completeQueuedWithdrawal and adds the
+	// allocationManager getter.
+	DelegationManagerMarkers = Markers{
+		{VersionSlashing, [][4]byte{
+			Selector("allocationManager()"),
+			Selector("completeQueuedWithdrawal((address,address,address,uint256,uint32,address[],uint256[]),address[],bool)"),
+		}},
+		{VersionM2, [][4]byte{bindingSelector(DelegationManager.DelegationManagerMetaData, "completeQueuedWithdrawal")}},
+	}
+	// StrategyManagerMarkers identify the releases of the StrategyManager. The slashing release
+	// adds burnable shares and drops third party transfer restrictions.
+	StrategyManagerMarkers = Markers{
+		{VersionSlashing, [][4]byte{Selector("getBurnableShares(address)")}},
+		{VersionM2, [][4]byte{bindingSelector(StrategyManager.StrategyManagerMetaData, "thirdPartyTransfersForbidden")}},
+	}
+)
+
+func bindingSelector(metadata *bind.MetaData, method string) [4]byte {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	var selector [4]byte
+	copy(selector[:], parsed.Methods[method].ID)
+	return selector
+}
+
+// Backend is the chain access required to probe contracts.
+type Backend interface {
+	bind.ContractCaller
+	deploy.StorageReader
+}
+
+// DetectDelegationManager returns the release of the DelegationManager at addr.
+func DetectDelegationManager(ctx context.Context, backend Backend, addr common.Address) (Version, error) {
+	return Detect(ctx, backend, addr, DelegationManagerMarkers)
+}
+
+// DetectStrategyManager returns the release of the StrategyManager at addr.
+func DetectStrategyManager(ctx context.Context, backend Backend, addr common.Address) (Version, error) {
+	return Detect(ctx, backend, addr, StrategyManagerMarkers)
+}
+
+// Detect returns the release of the contract at addr according to markers.
+func Detect(ctx context.Context, backend Backend, addr common.Address, markers Markers) (Version, error) {
+	selectors, err := ContractSelectors(ctx, backend, addr)
+	if err != nil {
+		return VersionUnknown, err
+	}
+	for _, marker := range markers {
+		for _, selector := range marker.Selectors {
+			if selectors[selector] {
+				return marker.Version, nil
+			}
+		}
+	}
+	return VersionUnknown, nil
+}
+
+// Supports reports whether the contract at addr has all of selectors.
+func Supports(ctx context.Context, backend Backend, addr common.Address, selectors ...[4]byte) (bool, error) {
+	present, err := ContractSelectors(ctx, backend, addr)
+	if err != nil {
+		return false, err
+	}
+	for _, selector := range selectors {
+		if !present[selector] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ContractSelectors returns the candidate selectors in the code at addr, or in the code of its
+// implementation if addr is an EIP-1967 proxy.
+func ContractSelectors(ctx context.Context, backend Backend, addr common.Address) (map[[4]byte]bool, error) {
+	impl, err := deploy.Implementation(ctx, backend, addr)
+	if err != nil {
+		return nil, err
+	}
+	if impl != (common.Address{}) {
+		addr = impl
+	}
+	code, err := backend.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch code of %s: %w", addr, err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w at %s", ErrNoCode, addr)
+	}
+	return Selectors(code), nil
+}
+
+// Selectors returns the values pushed by the PUSH1 to PUSH4 instructions of code, which include
+// the selectors of its functions. solc pushes selectors with leading zero bytes with shorter
+// instructions.
+func Selectors(code []byte) map[[4]byte]bool {
+	selectors := make(map[[4]byte]bool)
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < opPush1 || op > opPush32 {
+			continue
+		}
+		size := int(op-opPush1) + 1
+		if size <= 4 && pc+size < len(code) {
+			var selector [4]byte
+			copy(selector[4-size:], code[pc+1:pc+1+size])
+			selectors[selector] = true
+		}
+		pc += size
+	}
+	return selectors
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/monitor"
+)
+
+// Alert destination types.
+const (
+	AlertWebhook   = "webhook"
+	AlertSlack     = "slack"
+	AlertPagerDuty = "pagerduty"
+)
+
+// AlertDestination is the config of a destination of monitor alerts.
+type AlertDestination struct {
+	// Type is one of the alert destination types, such as AlertSlack.
+	Type string `json:"type"`
+	// URL is the URL of webhooks, the incoming webhook of Slack, and the Events API endpoint of
+	// PagerDuty, which defaults to monitor.PagerDutyEventsURL.
+	URL string `json:"url"`
+	// RoutingKey and Severity configure PagerDuty, as by monitor.PagerDutyAlerter.
+	RoutingKey string `json:"routing_key"`
+	Severity   string `json:"severity"`
+}
+
+func (a *AlertDestination) validate() error {
+	if a == nil {
+		return errors.New("empty alert destination")
+	}
+	switch a.Type {
+	case AlertWebhook, AlertSlack:
+		if a.URL == "" {
+			return fmt.Errorf("url is required for %s destinations", a.Type)
+		}
+	case AlertPagerDuty:
+		if a.RoutingKey == "" {
+			return errors.New("routing_key is required for pagerduty destinations")
+		}
+		switch a.Severity {
+		case "", "critical", "error", "warning", "info":
+		default:
+			return fmt.Errorf("unknown severity %q", a.Severity)
+		}
+	case "":
+		return errors.New("type is required")
+	default:
+		return fmt.Errorf("unknown type %q", a.Type)
+	}
+	if a.URL != "" {
+		u, err := url.Parse(a.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid url %q", a.URL)
+		}
+	}
+	return nil
+}
+
+// Alerter returns the alerter delivering to the destination.
+func (a *AlertDestination) Alerter() monitor.Alerter {
+	switch a.Type {
+	case AlertSlack:
+		return &monitor.SlackAlerter{WebhookURL: a.URL}
+	case AlertPagerDuty:
+		return &monitor.PagerDutyAlerter{RoutingKey: a.RoutingKey, Severity: a.Severity, URL: a.URL}
+	}
+	return &monitor.WebhookAlerter{URL: a.URL}
+}
+
+// Alerters returns the alerters of the named destinations, or of every destination if no names
+// are given.
+func (cfg *Config) Alerters(names ...string) (monitor.Alerters, error) {
+	if len(names) == 0 {
+		names = sortedKeys(cfg.Alerts)
+	}
+	alerters := make(monitor.Alerters, 0, len(names))
+	for _, name := range names {
+		a := cfg.Alerts[name]
+		if a == nil {
+			return nil, fmt.Errorf("no alert destination %s", name)
+		}
+		alerters = append(alerters, a.Alerter())
+	}
+	return alerters, nil
+}
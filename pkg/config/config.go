@@ -0,0 +1,403 @@
+// Package config loads the configuration shared by the client and the commands: the RPC
+// endpoints of each chain, address overrides, gas policies, signers, and the destinations of
+// monitor alerts.
+//
+// Config files are written in TOML, or in JSON if their name ends in .json. String values may
+// reference environment variables as ${NAME}, or ${NAME:-default} to fall back to a default
+// when NAME is unset, so that secrets such as passwords and webhook URLs stay out of the file;
+// $$ is a literal dollar sign:
+//
+//	default_chain = 17000
+//
+//	[chains.17000]
+//	rpc = ["https://ethereum-holesky-rpc.publicnode.com", "${HOLESKY_RPC_URL}"]
+//	signer = "ops"
+//	addresses = { StrategyManager = "0xdfB5f6CE42aAA7830E94ECFCcAd411beF4d4D5b6" }
+//	gas = { policy = "percentile", percentile = 50, blocks = 10, max_fee_cap = "200 gwei" }
+//
+//	[signers.ops]
+//	type = "keystore"
+//	path = "/secrets/ops.json"
+//	password = "${OPS_KEYSTORE_PASSWORD}"
+//
+//	[alerts.oncall]
+//	type = "pagerduty"
+//	routing_key = "${PAGERDUTY_ROUTING_KEY}"
+//
+// Load validates the config, so that mistakes are reported before anything is dialed:
+//
+//	cfg, err := config.Load("eigenlayer.toml")
+//	c, err := cfg.Client(ctx, 0)
+//	defer c.Close()
+//	opts, err := cfg.Signers["ops"].TransactOpts(new(big.Int).SetUint64(c.ChainID))
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/failover"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/gas"
+)
+
+// ErrUnknownChain is returned for chains a config has no endpoints for.
+var ErrUnknownChain = errors.New("no config for chain")
+
+// Config is the root of a config file.
+type Config struct {
+	// DefaultChain is the chain ID used when none is given.
+	DefaultChain uint64 `json:"default_chain"`
+	// Chains holds the config of each chain, by chain ID.
+	Chains map[uint64]*Chain `json:"chains"`
+	// Signers holds the signers by name.
+	Signers map[string]*Signer `json:"signers"`
+	// Alerts holds the destinations of monitor alerts by name.
+	Alerts map[string]*AlertDestination `json:"alerts"`
+}
+
+// Chain is the config of a chain.
+type Chain struct {
+	// RPC holds the JSON-RPC URLs of the chain's nodes, in order of preference. With more than
+	// one, requests fail over between them.
+	RPC []string `json:"rpc"`
+	// Quorum, if greater than one, is the number of endpoints each eth_call is sent to, as by
+	// failover.Config.Quorum.
+	Quorum int `json:"quorum"`
+	// Addresses overrides the addresses of contracts by name, such as StrategyManager, for
+	// devnets and forks.
+	Addresses map[string]common.Address `json:"addresses"`
+	// Gas is the gas policy of transactions sent on the chain. The fees suggested by the node
+	// are used without one.
+	Gas *Gas `json:"gas"`
+	// Signer names the signer used on the chain by default.
+	Signer string `json:"signer"`
+}
+
+// Load reads and validates the config file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	var cfg *Config
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		cfg, err = ParseJSON(data)
+	} else {
+		cfg, err = ParseTOML(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseTOML parses and validates a TOML config.
+func ParseTOML(data []byte) (*Config, error) {
+	tree, err := parseTOML(data)
+	if err != nil {
+		return nil, err
+	}
+	return decode(tree)
+}
+
+// ParseJSON parses and validates a JSON config.
+func ParseJSON(data []byte) (*Config, error) {
+	var tree map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+	return decode(tree)
+}
+
+// decode interpolates the environment variables of the strings of tree, and decodes it into a
+// Config, rejecting unknown keys.
+func decode(tree map[string]interface{}) (*Config, error) {
+	expanded, err := interpolate(tree, "", os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(expanded)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	cfg := new(Config)
+	if err := dec.Decode(cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// interpolate expands the environment variables of the strings in value, found at path.
+func interpolate(value interface{}, path string, lookup func(string) (string, bool)) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		s, err := expand(v, lookup)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return s, nil
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			expanded, err := interpolate(elem, joinPath(path, key), lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = expanded
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, elem := range v {
+			expanded, err := interpolate(elem, fmt.Sprintf("%s[%d]", path, i), lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = expanded
+		}
+		return out, nil
+	}
+	return value, nil
+}
+
+// expand replaces ${NAME} and ${NAME:-default} in s with the value of the environment variable
+// NAME, and $$ with $. It fails for unset variables without a default.
+func expand(s string, lookup func(string) (string, bool)) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, '$')
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+		switch {
+		case strings.HasPrefix(s, "$$"):
+			b.WriteByte('$')
+			s = s[2:]
+		case strings.HasPrefix(s, "${"):
+			end := strings.IndexByte(s, '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated variable reference %q", s)
+			}
+			name, fallback, hasDefault := strings.Cut(s[2:end], ":-")
+			if name == "" {
+				return "", errors.New("empty variable reference")
+			}
+			value, ok := lookup(name)
+			switch {
+			case ok && value != "":
+			case hasDefault:
+				value = fallback
+			case !ok:
+				return "", fmt.Errorf("environment variable %s is not set", name)
+			}
+			b.WriteString(value)
+			s = s[end+1:]
+		default:
+			return "", fmt.Errorf("invalid variable reference %q: use ${NAME}, or $$ for a dollar sign", s)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// Validate checks the config, returning every problem found.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.DefaultChain != 0 && cfg.Chains[cfg.DefaultChain] == nil {
+		errs = append(errs, fmt.Errorf("default_chain: %w %d", ErrUnknownChain, cfg.DefaultChain))
+	}
+	for _, chainID := range cfg.chainIDs() {
+		chain := cfg.Chains[chainID]
+		path := fmt.Sprintf("chains.%d", chainID)
+		if chainID == 0 {
+			errs = append(errs, fmt.Errorf("%s: invalid chain ID", path))
+		}
+		if chain == nil {
+			errs = append(errs, fmt.Errorf("%s: empty chain", path))
+			continue
+		}
+		if len(chain.RPC) == 0 {
+			errs = append(errs, fmt.Errorf("%s.rpc: no endpoints", path))
+		}
+		for i, rpc := range chain.RPC {
+			if err := validateRPC(rpc); err != nil {
+				errs = append(errs, fmt.Errorf("%s.rpc[%d]: %w", path, i, err))
+			}
+		}
+		if chain.Quorum < 0 || chain.Quorum > len(chain.RPC) {
+			errs = append(errs, fmt.Errorf("%s.quorum: %d exceeds the %d endpoints", path, chain.Quorum, len(chain.RPC)))
+		}
+		for name, addr := range chain.Addresses {
+			if addr == (common.Address{}) {
+				errs = append(errs, fmt.Errorf("%s.addresses.%s: zero address", path, name))
+			}
+		}
+		if chain.Gas != nil {
+			if err := chain.Gas.validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%s.gas: %w", path, err))
+			}
+		}
+		if chain.Signer != "" && cfg.Signers[chain.Signer] == nil {
+			errs = append(errs, fmt.Errorf("%s.signer: no signer %s", path, chain.Signer))
+		}
+	}
+	for _, name := range sortedKeys(cfg.Signers) {
+		if err := cfg.Signers[name].validate(); err != nil {
+			errs = append(errs, fmt.Errorf("signers.%s: %w", name, err))
+		}
+	}
+	for _, name := range sortedKeys(cfg.Alerts) {
+		if err := cfg.Alerts[name].validate(); err != nil {
+			errs = append(errs, fmt.Errorf("alerts.%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateRPC(rpc string) error {
+	u, err := url.Parse(rpc)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+		if u.Host == "" {
+			return fmt.Errorf("%s has no host", rpc)
+		}
+	case "":
+		// An IPC socket path.
+		if rpc == "" {
+			return errors.New("empty endpoint")
+		}
+	default:
+		return fmt.Errorf("%s: unsupported scheme %s", rpc, u.Scheme)
+	}
+	return nil
+}
+
+func (cfg *Config) chainIDs() []uint64 {
+	chainIDs := make([]uint64, 0, len(cfg.Chains))
+	for chainID := range cfg.Chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	return chainIDs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Chain returns the config of chainID, or of DefaultChain if chainID is zero.
+func (cfg *Config) Chain(chainID uint64) (uint64, *Chain, error) {
+	if chainID == 0 {
+		chainID = cfg.DefaultChain
+	}
+	chain := cfg.Chains[chainID]
+	if chain == nil {
+		return 0, nil, fmt.Errorf("%w %d", ErrUnknownChain, chainID)
+	}
+	return chainID, chain, nil
+}
+
+// Registry returns a registry with the address overrides of every chain.
+func (cfg *Config) Registry() *addresses.Registry {
+	registry := addresses.NewRegistry()
+	for chainID, chain := range cfg.Chains {
+		for name, addr := range chain.Addresses {
+			registry.Override(chainID, name, addr)
+		}
+	}
+	return registry
+}
+
+// Client dials the endpoints of chainID, or of DefaultChain if chainID is zero, and binds the
+// core contracts, as resolved by Registry. Transactions sent through the client's backend follow
+// the gas policy of the chain. Close the client to close the endpoints.
+func (cfg *Config) Client(ctx context.Context, chainID uint64) (*client.EigenLayerClient, error) {
+	chainID, chain, err := cfg.Chain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		backend client.Backend
+		close   func()
+	)
+	if len(chain.RPC) == 1 && chain.Quorum <= 1 {
+		ethClient, err := ethclient.DialContext(ctx, chain.RPC[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial %s: %w", chain.RPC[0], err)
+		}
+		backend, close = ethClient, ethClient.Close
+	} else {
+		fb, err := failover.Dial(ctx, chain.RPC, failover.Config{Quorum: chain.Quorum})
+		if err != nil {
+			return nil, err
+		}
+		backend, close = fb, fb.Close
+	}
+
+	remoteChainID, err := backend.ChainID(ctx)
+	if err != nil {
+		close()
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	if !remoteChainID.IsUint64() || remoteChainID.Uint64() != chainID {
+		close()
+		return nil, fmt.Errorf("chain ID mismatch: expected %d, node reports %s", chainID, remoteChainID)
+	}
+	if chain.Gas != nil {
+		gasConfig, err := chain.Gas.Config()
+		if err != nil {
+			close()
+			return nil, err
+		}
+		if backend, err = gas.NewBackend(backend, gasConfig); err != nil {
+			close()
+			return nil, err
+		}
+	}
+	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, cfg.Registry())
+	if err != nil {
+		close()
+		return nil, err
+	}
+	c.SetCloser(close)
+	return c, nil
+}
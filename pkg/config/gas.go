@@ -0,0 +1,140 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/gas"
+)
+
+// Gas policies.
+const (
+	// GasSuggested uses the tip suggested by the node. It is the default.
+	GasSuggested = "suggested"
+	// GasConservative and GasFast are gas.Conservative and gas.Fast.
+	GasConservative = "conservative"
+	GasFast         = "fast"
+	// GasPercentile takes the tip from a percentile of the recent fee history, as by
+	// gas.Percentile.
+	GasPercentile = "percentile"
+	// GasFixed uses a fixed tip and fee cap.
+	GasFixed = "fixed"
+)
+
+// Gas is the config of a gas policy. Amounts of wei are decimal strings with an optional unit of
+// wei, gwei or ether, such as "1.5 gwei".
+type Gas struct {
+	// Policy is one of the gas policies, such as GasPercentile. It defaults to GasSuggested.
+	Policy string `json:"policy"`
+	// Percentile, Blocks and BaseFeeMultiplier configure GasPercentile, as by gas.Percentile.
+	Percentile        float64 `json:"percentile"`
+	Blocks            uint64  `json:"blocks"`
+	BaseFeeMultiplier int64   `json:"base_fee_multiplier"`
+	// MinTip is the minimum tip of the fee history policies.
+	MinTip string `json:"min_tip"`
+	// TipCap and FeeCap are the fees of GasFixed.
+	TipCap string `json:"tip_cap"`
+	FeeCap string `json:"fee_cap"`
+	// MaxFeeCap, if set, refuses to send transactions with a higher fee cap.
+	MaxFeeCap string `json:"max_fee_cap"`
+	// Padding and MethodPadding raise gas estimates, as by gas.Config.
+	Padding       float64            `json:"padding"`
+	MethodPadding map[string]float64 `json:"method_padding"`
+}
+
+func (g *Gas) validate() error {
+	_, err := g.Config()
+	return err
+}
+
+// Config returns the gas.Config of the policy.
+func (g *Gas) Config() (gas.Config, error) {
+	minTip, err := parseWei("min_tip", g.MinTip)
+	if err != nil {
+		return gas.Config{}, err
+	}
+	maxFeeCap, err := parseWei("max_fee_cap", g.MaxFeeCap)
+	if err != nil {
+		return gas.Config{}, err
+	}
+	if g.Padding < 0 {
+		return gas.Config{}, fmt.Errorf("negative padding %v", g.Padding)
+	}
+	cfg := gas.Config{Padding: g.Padding, MethodPadding: g.MethodPadding, MaxFeeCap: maxFeeCap}
+
+	switch g.Policy {
+	case "", GasSuggested:
+		cfg.Policy = gas.Suggested{}
+	case GasConservative, GasFast:
+		policy := gas.Conservative
+		if g.Policy == GasFast {
+			policy = gas.Fast
+		}
+		policy.MinTip = minTip
+		cfg.Policy = policy
+	case GasPercentile:
+		if g.Percentile <= 0 || g.Percentile > 100 {
+			return gas.Config{}, fmt.Errorf("percentile %v is not between 0 and 100", g.Percentile)
+		}
+		if g.Blocks == 0 {
+			return gas.Config{}, errors.New("blocks is required for the percentile policy")
+		}
+		multiplier := g.BaseFeeMultiplier
+		if multiplier == 0 {
+			multiplier = 2
+		}
+		if multiplier < 1 {
+			return gas.Config{}, fmt.Errorf("invalid base_fee_multiplier %d", multiplier)
+		}
+		cfg.Policy = gas.Percentile{Percentile: g.Percentile, Blocks: g.Blocks, BaseFeeMultiplier: multiplier, MinTip: minTip}
+	case GasFixed:
+		tipCap, err := parseWei("tip_cap", g.TipCap)
+		if err != nil {
+			return gas.Config{}, err
+		}
+		feeCap, err := parseWei("fee_cap", g.FeeCap)
+		if err != nil {
+			return gas.Config{}, err
+		}
+		if tipCap == nil || feeCap == nil {
+			return gas.Config{}, errors.New("tip_cap and fee_cap are required for the fixed policy")
+		}
+		if feeCap.Cmp(tipCap) < 0 {
+			return gas.Config{}, errors.New("fee_cap is below tip_cap")
+		}
+		cfg.Policy = gas.Fixed{TipCap: tipCap, FeeCap: feeCap}
+	default:
+		return gas.Config{}, fmt.Errorf("unknown policy %q", g.Policy)
+	}
+	return cfg, nil
+}
+
+// weiUnits are the decimals of the units amounts of wei may be given in.
+var weiUnits = map[string]int{"wei": 0, "gwei": 9, "ether": 18}
+
+// parseWei parses the amount of wei of the field name, nil if it is empty.
+func parseWei(name, s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	number, unit := s, "wei"
+	if i := strings.IndexFunc(s, func(r rune) bool { return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' }); i >= 0 {
+		number, unit = strings.TrimSpace(s[:i]), strings.ToLower(s[i:])
+	}
+	decimals, ok := weiUnits[unit]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown unit %q", name, unit)
+	}
+	amount, ok := new(big.Rat).SetString(number)
+	if !ok || amount.Sign() < 0 || strings.ContainsAny(number, "eE/") {
+		return nil, fmt.Errorf("%s: invalid amount %q", name, s)
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("%s: %q is not a whole number of wei", name, s)
+	}
+	return amount.Num(), nil
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/signer"
+)
+
+// Signer types.
+const (
+	// SignerKeystore signs with an encrypted keystore file.
+	SignerKeystore = "keystore"
+	// SignerPrivateKey signs with a hex private key.
+	SignerPrivateKey = "private_key"
+	// SignerMnemonic signs with a key derived from a BIP-39 mnemonic.
+	SignerMnemonic = "mnemonic"
+	// SignerAWSKMS signs with an AWS KMS key.
+	SignerAWSKMS = "aws_kms"
+	// SignerGCPKMS signs with a Cloud KMS key.
+	SignerGCPKMS = "gcp_kms"
+	// SignerHardware signs with a hardware wallet.
+	SignerHardware = "hardware"
+)
+
+// ErrExternalSigner is returned for the keys of KMS and hardware signers, which never leave
+// their service or device.
+var ErrExternalSigner = errors.New("signer holds no local key")
+
+// Signer is the config of a signer.
+type Signer struct {
+	// Type is one of the signer types, such as SignerKeystore.
+	Type string `json:"type"`
+	// Address, if set, is the address the signer must sign as.
+	Address *common.Address `json:"address"`
+
+	// Path is the path of the keystore file.
+	Path string `json:"path"`
+	// Password is the password of the keystore, or PasswordFile the file holding it.
+	Password     string `json:"password"`
+	PasswordFile string `json:"password_file"`
+	// Key is the hex private key.
+	Key string `json:"key"`
+	// Mnemonic and Passphrase are the BIP-39 mnemonic and its optional passphrase.
+	Mnemonic   string `json:"mnemonic"`
+	Passphrase string `json:"passphrase"`
+	// DerivationPath is the BIP-32 path of mnemonic and hardware signers. It defaults to
+	// signer.DefaultDerivationPath.
+	DerivationPath string `json:"derivation_path"`
+	// KeyID identifies the key of KMS signers: the key ID or ARN for AWS KMS, and the resource
+	// name of the key version for Cloud KMS.
+	KeyID string `json:"key_id"`
+}
+
+func (s *Signer) validate() error {
+	if s == nil {
+		return errors.New("empty signer")
+	}
+	var required map[string]string
+	switch s.Type {
+	case SignerKeystore:
+		required = map[string]string{"path": s.Path}
+		if (s.Password == "") == (s.PasswordFile == "") {
+			return errors.New("set either password or password_file")
+		}
+	case SignerPrivateKey:
+		required = map[string]string{"key": s.Key}
+	case SignerMnemonic:
+		required = map[string]string{"mnemonic": s.Mnemonic}
+	case SignerAWSKMS, SignerGCPKMS:
+		required = map[string]string{"key_id": s.KeyID}
+	case SignerHardware:
+	case "":
+		return errors.New("type is required")
+	default:
+		return fmt.Errorf("unknown type %q", s.Type)
+	}
+	for _, name := range sortedKeys(required) {
+		if required[name] == "" {
+			return fmt.Errorf("%s is required for %s signers", name, s.Type)
+		}
+	}
+	if s.DerivationPath != "" {
+		if _, err := accounts.ParseDerivationPath(s.DerivationPath); err != nil {
+			return fmt.Errorf("invalid derivation_path %q: %w", s.DerivationPath, err)
+		}
+	}
+	return nil
+}
+
+// PrivateKey returns the key of keystore, private key and mnemonic signers, and fails with
+// ErrExternalSigner for the others, which are opened with signer.FromKMS and signer.FromWallet.
+func (s *Signer) PrivateKey() (*ecdsa.PrivateKey, error) {
+	var (
+		key *ecdsa.PrivateKey
+		err error
+	)
+	switch s.Type {
+	case SignerKeystore:
+		password := s.Password
+		if s.PasswordFile != "" {
+			data, err := os.ReadFile(s.PasswordFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read password: %w", err)
+			}
+			password = strings.TrimRight(string(data), "\r\n")
+		}
+		key, err = signer.ReadKeystore(s.Path, password)
+	case SignerPrivateKey:
+		key, err = crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(s.Key), "0x"))
+		if err != nil {
+			err = fmt.Errorf("invalid private key: %w", err)
+		}
+	case SignerMnemonic:
+		key, err = signer.DeriveKey(s.Mnemonic, s.Passphrase, s.derivationPath())
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrExternalSigner, s.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if addr := crypto.PubkeyToAddress(key.PublicKey); s.Address != nil && addr != *s.Address {
+		return nil, fmt.Errorf("signer key is for %s, not %s", addr.Hex(), s.Address.Hex())
+	}
+	return key, nil
+}
+
+// TransactOpts returns a transactor signing with the key of the signer for chainID. See
+// PrivateKey for the supported types.
+func (s *Signer) TransactOpts(chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := s.PrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return signer.FromKey(key, chainID)
+}
+
+func (s *Signer) derivationPath() string {
+	if s.DerivationPath == "" {
+		return signer.DefaultDerivationPath
+	}
+	return s.DerivationPath
+}
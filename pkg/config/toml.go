@@ -0,0 +1,447 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// parseTOML parses the subset of TOML config files are written in: tables, arrays of tables,
+// bare, quoted and dotted keys, basic and literal strings, integers, floats, booleans, arrays and
+// inline tables. Multi-line strings and dates are not supported.
+func parseTOML(data []byte) (map[string]interface{}, error) {
+	if !utf8.Valid(data) {
+		return nil, errors.New("config is not valid UTF-8")
+	}
+	p := &tomlParser{src: string(data), line: 1, root: make(map[string]interface{}), defined: make(map[string]bool)}
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", p.line, err)
+	}
+	return p.root, nil
+}
+
+type tomlParser struct {
+	src  string
+	pos  int
+	line int
+
+	root map[string]interface{}
+	// table is the table key-value pairs are added to, as opened by the last header.
+	table map[string]interface{}
+	// defined holds the paths of the tables opened by a header, which cannot be opened again.
+	defined map[string]bool
+}
+
+func (p *tomlParser) parse() error {
+	p.table = p.root
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return nil
+		}
+		var err error
+		if p.peek() == '[' {
+			err = p.parseHeader()
+		} else {
+			err = p.parseKeyValue(p.table)
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.endOfLine(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *tomlParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *tomlParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skipSpace skips whitespace and comments, and newlines if newlines is set.
+func (p *tomlParser) skipSpace(newlines bool) {
+	for !p.eof() {
+		switch c := p.peek(); {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case c == '\n' && newlines:
+			p.pos++
+			p.line++
+		case c == '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// endOfLine consumes the rest of the line, which must be blank or a comment.
+func (p *tomlParser) endOfLine() error {
+	p.skipSpace(false)
+	if p.eof() {
+		return nil
+	}
+	if p.peek() != '\n' {
+		return fmt.Errorf("unexpected %q after value", p.peek())
+	}
+	p.pos++
+	p.line++
+	return nil
+}
+
+func (p *tomlParser) expect(c byte) error {
+	if p.peek() != c {
+		if p.eof() {
+			return fmt.Errorf("expected %q, found end of file", c)
+		}
+		return fmt.Errorf("expected %q, found %q", c, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+// parseHeader parses a [table] or [[array of tables]] header and makes it the current table.
+func (p *tomlParser) parseHeader() error {
+	p.pos++
+	array := p.peek() == '['
+	if array {
+		p.pos++
+	}
+	p.skipSpace(false)
+	path, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+	p.skipSpace(false)
+	if err := p.expect(']'); err != nil {
+		return err
+	}
+	if array {
+		if err := p.expect(']'); err != nil {
+			return err
+		}
+	}
+
+	parent, err := p.walk(p.root, path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	name := path[len(path)-1]
+	if array {
+		table := make(map[string]interface{})
+		switch existing := parent[name].(type) {
+		case nil:
+			parent[name] = []interface{}{table}
+		case []interface{}:
+			if len(existing) > 0 {
+				if _, ok := existing[0].(map[string]interface{}); !ok {
+					return fmt.Errorf("%s is not an array of tables", strings.Join(path, "."))
+				}
+			}
+			parent[name] = append(existing, table)
+		default:
+			return fmt.Errorf("%s is already defined", strings.Join(path, "."))
+		}
+		p.table = table
+		return nil
+	}
+
+	key := strings.Join(path, "\x00")
+	if p.defined[key] {
+		return fmt.Errorf("table %s is defined twice", strings.Join(path, "."))
+	}
+	p.defined[key] = true
+	switch existing := parent[name].(type) {
+	case nil:
+		table := make(map[string]interface{})
+		parent[name] = table
+		p.table = table
+	case map[string]interface{}:
+		// The table was implicitly created by a header of one of its subtables.
+		p.table = existing
+	default:
+		return fmt.Errorf("%s is already defined", strings.Join(path, "."))
+	}
+	return nil
+}
+
+// walk returns the table at path below table, creating missing tables. Arrays of tables resolve
+// to their last table.
+func (p *tomlParser) walk(table map[string]interface{}, path []string) (map[string]interface{}, error) {
+	for i, name := range path {
+		switch next := table[name].(type) {
+		case nil:
+			child := make(map[string]interface{})
+			table[name] = child
+			table = child
+		case map[string]interface{}:
+			table = next
+		case []interface{}:
+			var last map[string]interface{}
+			if len(next) > 0 {
+				last, _ = next[len(next)-1].(map[string]interface{})
+			}
+			if last == nil {
+				return nil, fmt.Errorf("%s is not a table", strings.Join(path[:i+1], "."))
+			}
+			table = last
+		default:
+			return nil, fmt.Errorf("%s is not a table", strings.Join(path[:i+1], "."))
+		}
+	}
+	return table, nil
+}
+
+// parseKeyValue parses a key = value pair into table.
+func (p *tomlParser) parseKeyValue(table map[string]interface{}) error {
+	path, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+	p.skipSpace(false)
+	if err := p.expect('='); err != nil {
+		return err
+	}
+	p.skipSpace(false)
+	value, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+	parent, err := p.walk(table, path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	name := path[len(path)-1]
+	if _, ok := parent[name]; ok {
+		return fmt.Errorf("duplicate key %s", strings.Join(path, "."))
+	}
+	parent[name] = value
+	return nil
+}
+
+// parseKey parses a dotted key into its parts.
+func (p *tomlParser) parseKey() ([]string, error) {
+	var path []string
+	for {
+		p.skipSpace(false)
+		var part string
+		switch c := p.peek(); {
+		case c == '"':
+			s, err := p.parseBasicString()
+			if err != nil {
+				return nil, err
+			}
+			part = s
+		case c == '\'':
+			s, err := p.parseLiteralString()
+			if err != nil {
+				return nil, err
+			}
+			part = s
+		default:
+			start := p.pos
+			for !p.eof() && isBareKeyChar(p.peek()) {
+				p.pos++
+			}
+			if p.pos == start {
+				if p.eof() {
+					return nil, errors.New("expected key, found end of file")
+				}
+				return nil, fmt.Errorf("expected key, found %q", p.peek())
+			}
+			part = p.src[start:p.pos]
+		}
+		path = append(path, part)
+		p.skipSpace(false)
+		if p.peek() != '.' {
+			return path, nil
+		}
+		p.pos++
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) parseValue() (interface{}, error) {
+	switch c := p.peek(); {
+	case c == '"':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			return nil, errors.New("multi-line strings are not supported")
+		}
+		return p.parseBasicString()
+	case c == '\'':
+		if strings.HasPrefix(p.src[p.pos:], `'''`) {
+			return nil, errors.New("multi-line strings are not supported")
+		}
+		return p.parseLiteralString()
+	case c == '[':
+		return p.parseArray()
+	case c == '{':
+		return p.parseInlineTable()
+	case strings.HasPrefix(p.src[p.pos:], "true"):
+		p.pos += len("true")
+		return true, nil
+	case strings.HasPrefix(p.src[p.pos:], "false"):
+		p.pos += len("false")
+		return false, nil
+	case p.eof() || c == '\n':
+		return nil, errors.New("expected value")
+	}
+	return p.parseNumber()
+}
+
+func (p *tomlParser) parseBasicString() (string, error) {
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return "", errors.New("unterminated string")
+		}
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", errors.New("unterminated string")
+			}
+			e := p.src[p.pos]
+			p.pos++
+			switch e {
+			case '"', '\\':
+				b.WriteByte(e)
+			case 'b':
+				b.WriteByte('\b')
+			case 't':
+				b.WriteByte('\t')
+			case 'n':
+				b.WriteByte('\n')
+			case 'f':
+				b.WriteByte('\f')
+			case 'r':
+				b.WriteByte('\r')
+			case 'u', 'U':
+				n := 4
+				if e == 'U' {
+					n = 8
+				}
+				if p.pos+n > len(p.src) {
+					return "", errors.New("invalid unicode escape")
+				}
+				code, err := strconv.ParseUint(p.src[p.pos:p.pos+n], 16, 32)
+				if err != nil || !utf8.ValidRune(rune(code)) {
+					return "", fmt.Errorf("invalid unicode escape \\%c%s", e, p.src[p.pos:p.pos+n])
+				}
+				p.pos += n
+				b.WriteRune(rune(code))
+			default:
+				return "", fmt.Errorf("invalid escape \\%c", e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+}
+
+func (p *tomlParser) parseLiteralString() (string, error) {
+	p.pos++
+	end := strings.IndexAny(p.src[p.pos:], "'\n")
+	if end < 0 || p.src[p.pos+end] != '\'' {
+		return "", errors.New("unterminated string")
+	}
+	s := p.src[p.pos : p.pos+end]
+	p.pos += end + 1
+	return s, nil
+}
+
+// parseArray parses an array, which may span several lines.
+func (p *tomlParser) parseArray() ([]interface{}, error) {
+	p.pos++
+	values := []interface{}{}
+	for {
+		p.skipSpace(true)
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		p.skipSpace(true)
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		if err := p.expect(']'); err != nil {
+			return nil, err
+		}
+		return values, nil
+	}
+}
+
+// parseInlineTable parses an inline table, which must fit on a line.
+func (p *tomlParser) parseInlineTable() (map[string]interface{}, error) {
+	p.pos++
+	table := make(map[string]interface{})
+	p.skipSpace(false)
+	if p.peek() == '}' {
+		p.pos++
+		return table, nil
+	}
+	for {
+		p.skipSpace(false)
+		if err := p.parseKeyValue(table); err != nil {
+			return nil, err
+		}
+		p.skipSpace(false)
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		if err := p.expect('}'); err != nil {
+			return nil, err
+		}
+		return table, nil
+	}
+}
+
+// parseNumber parses an integer, as an int64, or a float.
+func (p *tomlParser) parseNumber() (interface{}, error) {
+	start := p.pos
+	for !p.eof() && (isBareKeyChar(p.peek()) || strings.IndexByte("+.:", p.peek()) >= 0) {
+		p.pos++
+	}
+	raw := p.src[start:p.pos]
+	if raw == "" {
+		return nil, fmt.Errorf("unexpected %q", p.peek())
+	}
+	s := strings.ReplaceAll(raw, "_", "")
+	if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+		if len(s) > 1 && s[0] == '0' && s[1] >= '0' && s[1] <= '9' {
+			return nil, fmt.Errorf("invalid number %s: leading zeros are not allowed", raw)
+		}
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) || strings.ContainsAny(s, "xob") {
+		return nil, fmt.Errorf("invalid value %s", raw)
+	}
+	return f, nil
+}
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want map[string]interface{}
+	}{
+		{
+			name: "empty",
+			src:  "\n# only a comment\n\n",
+			want: map[string]interface{}{},
+		},
+		{
+			name: "strings",
+			src: `basic = "a \"quoted\" \\ value"
+literal = 'C:\Users\node # not a comment'
+escapes = "\b\t\n\f\r"
+unicode = "\u00e9\U0001F600"
+empty = ""
+comment = "value" # trailing comment
+`,
+			want: map[string]interface{}{
+				"basic":   `a "quoted" \ value`,
+				"literal": `C:\Users\node # not a comment`,
+				"escapes": "\b\t\n\f\r",
+				"unicode": "é😀",
+				"empty":   "",
+				"comment": "value",
+			},
+		},
+		{
+			name: "numbers and booleans",
+			src: `int = 42
+negative = -17
+positive = +3
+underscores = 1_000_000
+hex = 0xdead_beef
+octal = 0o755
+binary = 0b1010
+zero = 0
+float = 3.14
+exponent = -2e-3
+yes = true
+no = false
+`,
+			want: map[string]interface{}{
+				"int":         int64(42),
+				"negative":    int64(-17),
+				"positive":    int64(3),
+				"underscores": int64(1000000),
+				"hex":         int64(0xdeadbeef),
+				"octal":       int64(0o755),
+				"binary":      int64(10),
+				"zero":        int64(0),
+				"float":       3.14,
+				"exponent":    -2e-3,
+				"yes":         true,
+				"no":          false,
+			},
+		},
+		{
+			name: "arrays",
+			src: `empty = []
+mixed = [1, "two", 3.0, true]
+nested = [[1, 2], ["a"]]
+multiline = [
+  "0x1", # first
+  "0x2",
+]
+`,
+			want: map[string]interface{}{
+				"empty":     []interface{}{},
+				"mixed":     []interface{}{int64(1), "two", 3.0, true},
+				"nested":    []interface{}{[]interface{}{int64(1), int64(2)}, []interface{}{"a"}},
+				"multiline": []interface{}{"0x1", "0x2"},
+			},
+		},
+		{
+			name: "tables and keys",
+			src: `top = 1
+
+[network]
+chain-id = 17000
+rpc.url = "http://localhost:8545"
+"quoted key" = 'q'
+
+[network.gas]
+max_fee = "30 gwei"
+
+[ signer . 'keys' ]
+path = "key.json"
+`,
+			want: map[string]interface{}{
+				"top": int64(1),
+				"network": map[string]interface{}{
+					"chain-id":   int64(17000),
+					"rpc":        map[string]interface{}{"url": "http://localhost:8545"},
+					"quoted key": "q",
+					"gas":        map[string]interface{}{"max_fee": "30 gwei"},
+				},
+				"signer": map[string]interface{}{
+					"keys": map[string]interface{}{"path": "key.json"},
+				},
+			},
+		},
+		{
+			name: "subtable before its parent",
+			src: `[a.b]
+x = 1
+[a]
+y = 2
+`,
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": map[string]interface{}{"x": int64(1)},
+					"y": int64(2),
+				},
+			},
+		},
+		{
+			name: "arrays of tables",
+			src: `[[alerts]]
+name = "paused"
+[alerts.labels]
+severity = "page"
+
+[[alerts]]
+name = "tvl"
+`,
+			want: map[string]interface{}{
+				"alerts": []interface{}{
+					map[string]interface{}{"name": "paused", "labels": map[string]interface{}{"severity": "page"}},
+					map[string]interface{}{"name": "tvl"},
+				},
+			},
+		},
+		{
+			name: "inline tables",
+			src: `empty = {}
+point = { x = 1, y.z = "two", nested = { ok = true } }
+`,
+			want: map[string]interface{}{
+				"empty": map[string]interface{}{},
+				"point": map[string]interface{}{
+					"x":      int64(1),
+					"y":      map[string]interface{}{"z": "two"},
+					"nested": map[string]interface{}{"ok": true},
+				},
+			},
+		},
+		{
+			name: "windows line endings",
+			src:  "a = 1\r\n[t]\r\nb = \"x\"\r\n",
+			want: map[string]interface{}{
+				"a": int64(1),
+				"t": map[string]interface{}{"b": "x"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTOML([]byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTOML() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTOMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"invalid UTF-8", "a = \"\xff\"", "config is not valid UTF-8"},
+		{"missing equals", "a = 1\nb 2", `line 2: expected '=', found '2'`},
+		{"missing value", "\n\na =\n", "line 3: expected value"},
+		{"missing key", "= 1", `line 1: expected key, found '='`},
+		{"value after value", "a = 1 2", `line 1: unexpected '2' after value`},
+		{"duplicate key", "a = 1\na = 2", "line 2: duplicate key a"},
+		{"duplicate dotted key", "a.b = 1\n[x]\ny = 1\n[a]\nb = 2", "line 5: duplicate key b"},
+		{"table defined twice", "[t]\na = 1\n\n[t]\n", "line 4: table t is defined twice"},
+		{"table over value", "t = 1\n[t]", "line 2: t is already defined"},
+		{"key through value", "a = 1\na.b = 2", "line 2: a is not a table"},
+		{"array of tables over table", "[t]\n[[t]]", "line 2: t is already defined"},
+		{"array of tables over array", "t = [1]\n[[t]]", "line 2: t is not an array of tables"},
+		{"unclosed header", "[t\na = 1", `line 1: expected ']', found '\n'`},
+		{"unclosed array of tables header", "[[t]\n", `line 1: expected ']', found '\n'`},
+		{"unterminated basic string", "a = \"abc\nb = 1", "line 1: unterminated string"},
+		{"unterminated literal string", "a = 'abc", "line 1: unterminated string"},
+		{"invalid escape", `a = "\x41"`, `line 1: invalid escape \x`},
+		{"invalid unicode escape", `a = "\uZZZZ"`, `line 1: invalid unicode escape \uZZZZ`},
+		{"surrogate escape", `a = "\uD800"`, `line 1: invalid unicode escape \uD800`},
+		{"truncated unicode escape", `a = "\u12`, "line 1: invalid unicode escape"},
+		{"multi-line basic string", `a = """x"""`, "line 1: multi-line strings are not supported"},
+		{"multi-line literal string", `a = '''x'''`, "line 1: multi-line strings are not supported"},
+		{"unclosed array", "a = [1, 2\nb = 3", `line 2: expected ']', found 'b'`},
+		{"array missing comma", "a = [1 2]", `line 1: expected ']', found '2'`},
+		{"unclosed inline table", "a = { x = 1", `line 1: expected '}', found end of file`},
+		{"inline table trailing comma", "a = { x = 1, }", `line 1: expected key, found '}'`},
+		{"leading zeros", "\n\n\na = 007", "line 4: invalid number 007: leading zeros are not allowed"},
+		{"date", "a = 1979-05-27", "line 1: invalid value 1979-05-27"},
+		{"infinity", "a = inf", "line 1: invalid value inf"},
+		{"hex float", "a = 0x1p3", "line 1: invalid value 0x1p3"},
+		{"bare word", "a = yes", "line 1: invalid value yes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTOML([]byte(tt.src))
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("parseTOML(%q) error = %v, want %s", tt.src, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Package decoder decodes calldata and logs of any contract in pkg/bindings without knowing
+// which contract they belong to, for explorers, monitors and forensic tooling.
+//
+// Candidates are looked up by selector or event topic across the ABIs indexed by pkg/abis. When
+// the contract at an address is known, its ABI is tried first; otherwise concrete contracts
+// are preferred over their interfaces and storage contracts:
+//
+//	d, _ := decoder.NewForChain(addresses.Default, addresses.ChainIDMainnet)
+//	call, err := d.DecodeCalldata(*tx.To(), tx.Data())
+//	fmt.Println(call.Contract, call.Name, call.Args["strategy"])
+package decoder
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+)
+
+var (
+	// ErrUnknownSelector is returned for calldata whose selector is in no ABI, or that none of
+	// the candidate methods can unpack.
+	ErrUnknownSelector = errors.New("unknown function selector")
+	// ErrUnknownEvent is returned for logs whose topic is in no ABI, or that none of the
+	// candidate events can unpack.
+	ErrUnknownEvent = errors.New("unknown event")
+)
+
+// Decoded is a decoded call or event.
+type Decoded struct {
+	// Contract is the name of the binding whose ABI decoded the data.
+	Contract string
+	// Name is the method or event name, and Signature its canonical signature.
+	Name      string
+	Signature string
+	// Args maps argument names to their values, as unpacked by go-ethereum.
+	Args map[string]interface{}
+}
+
+type methodEntry struct {
+	contract string
+	method   abi.Method
+}
+
+type eventEntry struct {
+	contract string
+	event    abi.Event
+}
+
+// Decoder decodes calldata and logs against every ABI in pkg/abis.
+type Decoder struct {
+	contracts map[common.Address]string
+	methods   map[[4]byte][]methodEntry
+	events    map[common.Hash][]eventEntry
+}
+
+// New returns a Decoder that prefers the ABI of contracts[addr] for calldata sent to, and logs
+// emitted by, addr. contracts may be nil.
+func New(contracts map[common.Address]string) *Decoder {
+	d := &Decoder{
+		contracts: contracts,
+		methods:   make(map[[4]byte][]methodEntry),
+		events:    make(map[common.Hash][]eventEntry),
+	}
+	names := make([]string, 0, len(abis.MetaData))
+	for name := range abis.MetaData {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if ri, rj := rank(names[i]), rank(names[j]); ri != rj {
+			return ri < rj
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		parsed, err := abis.ABI(name)
+		if err != nil {
+			continue
+		}
+		for _, method := range parsed.Methods {
+			var selector [4]byte
+			copy(selector[:], method.ID)
+			d.methods[selector] = append(d.methods[selector], methodEntry{contract: name, method: method})
+		}
+		for _, event := range parsed.Events {
+			if event.Anonymous {
+				continue
+			}
+			d.events[event.ID] = append(d.events[event.ID], eventEntry{contract: name, event: event})
+		}
+	}
+	return d
+}
+
+// NewForChain returns a Decoder that knows the contracts of the deployment of chainID in
+// registry.
+func NewForChain(registry *addresses.Registry, chainID uint64) (*Decoder, error) {
+	deployment, err := registry.Deployment(chainID)
+	if err != nil {
+		return nil, err
+	}
+	contracts := make(map[common.Address]string, len(deployment))
+	for name, addr := range deployment {
+		if _, ok := abis.MetaData[name]; ok {
+			contracts[addr] = name
+		}
+	}
+	return New(contracts), nil
+}
+
+// rank orders contracts so that implementations are tried before the interfaces and storage
+// contracts sharing their selectors.
+func rank(name string) int {
+	switch {
+	case strings.HasSuffix(name, "Storage"):
+		return 1
+	case len(name) > 1 && name[0] == 'I' && name[1] >= 'A' && name[1] <= 'Z':
+		return 2
+	default:
+		return 0
+	}
+}
+
+// DecodeCalldata decodes data sent to to.
+func (d *Decoder) DecodeCalldata(to common.Address, data []byte) (*Decoded, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrUnknownSelector)
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	candidates := d.methods[selector]
+	for _, e := range preferred(candidates, d.contracts[to], func(e methodEntry) string { return e.contract }) {
+		args := make(map[string]interface{})
+		if err := e.method.Inputs.UnpackIntoMap(args, data[4:]); err != nil {
+			continue
+		}
+		return &Decoded{Contract: e.contract, Name: e.method.Name, Signature: e.method.Sig, Args: args}, nil
+	}
+	return nil, fmt.Errorf("%w 0x%x", ErrUnknownSelector, selector)
+}
+
+// DecodeLog decodes log, including its indexed arguments. Indexed arguments of dynamic types
+// are only available as the hash in their topic.
+func (d *Decoder) DecodeLog(log types.Log) (*Decoded, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: log has no topics", ErrUnknownEvent)
+	}
+	candidates := d.events[log.Topics[0]]
+	for _, e := range preferred(candidates, d.contracts[log.Address], func(e eventEntry) string { return e.contract }) {
+		var indexed abi.Arguments
+		for _, arg := range e.event.Inputs {
+			if arg.Indexed {
+				indexed = append(indexed, arg)
+			}
+		}
+		if len(indexed) != len(log.Topics)-1 {
+			continue
+		}
+		args := make(map[string]interface{})
+		if err := e.event.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+			continue
+		}
+		if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+			continue
+		}
+		return &Decoded{Contract: e.contract, Name: e.event.Name, Signature: e.event.Sig, Args: args}, nil
+	}
+	return nil, fmt.Errorf("%w %s", ErrUnknownEvent, log.Topics[0])
+}
+
+// preferred returns candidates with those of contract first.
+func preferred[E any](candidates []E, contract string, name func(E) string) []E {
+	if contract == "" {
+		return candidates
+	}
+	ordered := make([]E, 0, len(candidates))
+	for _, c := range candidates {
+		if name(c) == contract {
+			ordered = append(ordered, c)
+		}
+	}
+	for _, c := range candidates {
+		if name(c) != contract {
+			ordered = append(ordered, c)
+		}
+	}
+	return ordered
+}
+
+var (
+	defaultDecoderOnce sync.Once
+	defaultDecoder     *Decoder
+)
+
+// getDefaultDecoder returns the Decoder that knows no addresses, built on first use.
+func getDefaultDecoder() *Decoder {
+	defaultDecoderOnce.Do(func() {
+		defaultDecoder = New(nil)
+	})
+	return defaultDecoder
+}
+
+// DecodeCalldata decodes data sent to to with a Decoder that knows no addresses.
+func DecodeCalldata(to common.Address, data []byte) (*Decoded, error) {
+	return getDefaultDecoder().DecodeCalldata(to, data)
+}
+
+// DecodeLog decodes log with a Decoder that knows no addresses.
+func DecodeLog(log types.Log) (*Decoded, error) {
+	return getDefaultDecoder().DecodeLog(log)
+}
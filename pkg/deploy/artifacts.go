@@ -0,0 +1,88 @@
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// transparentProxyABI holds the OpenZeppelin 4.x TransparentUpgradeableProxy constructor, and
+// the functions only its admin may call.
+const transparentProxyABI = `[
+{"type":"constructor","inputs":[{"name":"_logic","type":"address"},{"name":"admin_","type":"address"},{"name":"_data","type":"bytes"}],"stateMutability":"payable"},
+{"type":"function","name":"upgradeTo","stateMutability":"nonpayable","inputs":[{"name":"newImplementation","type":"address"}],"outputs":[]},
+{"type":"function","name":"upgradeToAndCall","stateMutability":"payable","inputs":[{"name":"newImplementation","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+{"type":"function","name":"changeAdmin","stateMutability":"nonpayable","inputs":[{"name":"newAdmin","type":"address"}],"outputs":[]}
+]`
+
+var parsedTransparentProxyABI = abis.MustParse(transparentProxyABI)
+
+// Artifacts holds the creation bytecode of the contracts that have no binding in pkg/bindings:
+// the OpenZeppelin proxy contracts, and the empty contract proxies point at until their
+// implementation is deployed.
+type Artifacts struct {
+	ProxyAdmin                  []byte
+	TransparentUpgradeableProxy []byte
+	UpgradeableBeacon           []byte
+	EmptyContract               []byte
+}
+
+// LoadArtifacts reads Artifacts from the output directory of forge build, usually out/ at the
+// repository root after make compile.
+func LoadArtifacts(outDir string) (*Artifacts, error) {
+	var a Artifacts
+	for name, dst := range map[string]*[]byte{
+		"ProxyAdmin":                  &a.ProxyAdmin,
+		"TransparentUpgradeableProxy": &a.TransparentUpgradeableProxy,
+		"UpgradeableBeacon":           &a.UpgradeableBeacon,
+		"EmptyContract":               &a.EmptyContract,
+	} {
+		code, err := LoadBytecode(outDir, name)
+		if err != nil {
+			return nil, err
+		}
+		*dst = code
+	}
+	return &a, nil
+}
+
+// LoadBytecode reads the creation bytecode of contract from the forge artifact
+// <outDir>/<contract>.sol/<contract>.json. If the contract was compiled with more than one
+// compiler version, the artifact of any of them is used.
+func LoadBytecode(outDir, contract string) ([]byte, error) {
+	path := filepath.Join(outDir, contract+".sol", contract+".json")
+	if _, err := os.Stat(path); err != nil {
+		matches, _ := filepath.Glob(filepath.Join(outDir, contract+".sol", contract+".*.json"))
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no artifact for %s in %s", contract, outDir)
+		}
+		path = matches[0]
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var artifact struct {
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	if err := json.Unmarshal(raw, &artifact); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	object := artifact.Bytecode.Object
+	if !strings.HasPrefix(object, "0x") {
+		object = "0x" + object
+	}
+	code, err := hexutil.Decode(object)
+	if err != nil || len(code) == 0 {
+		return nil, fmt.Errorf("artifact %s has no bytecode", path)
+	}
+	return code, nil
+}
@@ -0,0 +1,99 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// Create2Factory is the canonical deterministic deployment proxy, deployed at the same address
+// on mainnet, its testnets and most other EVM chains, and predeployed by anvil. It deploys the
+// init code following a 32 byte salt in its calldata with CREATE2.
+var Create2Factory = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// ErrNoCreate2Factory is returned when Create2Factory is not deployed on the chain.
+var ErrNoCreate2Factory = errors.New("CREATE2 factory not deployed")
+
+// InitCode returns the init code deploying bytecode with the constructor of contractABI and
+// params.
+func InitCode(contractABI abi.ABI, bytecode []byte, params ...interface{}) ([]byte, error) {
+	args, err := contractABI.Pack("", params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack constructor arguments: %w", err)
+	}
+	return append(append([]byte{}, bytecode...), args...), nil
+}
+
+// BindingInitCode returns the init code deploying the binding with metadata with params.
+func BindingInitCode(metadata *bind.MetaData, params ...interface{}) ([]byte, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bytecode, err := hexutil.Decode(metadata.Bin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bytecode: %w", err)
+	}
+	return InitCode(*parsed, bytecode, params...)
+}
+
+// Create2Address returns the address Create2Factory deploys initCode at with salt.
+func Create2Address(salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(Create2Factory, salt, crypto.Keccak256(initCode))
+}
+
+// Salt derives the salt of the contract called name in a deployment salted with base, so that
+// the contracts of a deployment get distinct salts.
+func Salt(base common.Hash, name string) common.Hash {
+	return crypto.Keccak256Hash(base[:], []byte(name))
+}
+
+// DeployCreate2 deploys initCode with salt through Create2Factory, and waits for the deployment.
+// The address is predicted before sending; if code is already deployed there, nothing is sent.
+// Contracts that take their owner from msg.sender are owned by the factory when deployed this
+// way.
+func DeployCreate2(ctx context.Context, backend Backend, opts *bind.TransactOpts, salt common.Hash, initCode []byte) (common.Address, error) {
+	dp := &deployer{ctx: ctx, backend: backend, opts: opts}
+	return dp.deployCreate2("contract", salt, initCode)
+}
+
+func (dp *deployer) deployCreate2(name string, salt common.Hash, initCode []byte) (common.Address, error) {
+	addr := Create2Address(salt, initCode)
+	code, err := dp.backend.CodeAt(dp.ctx, addr, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch code at %s: %w", addr, err)
+	}
+	if len(code) > 0 {
+		return addr, nil
+	}
+	if code, err = dp.backend.CodeAt(dp.ctx, Create2Factory, nil); err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch code at %s: %w", Create2Factory, err)
+	}
+	if len(code) == 0 {
+		return common.Address{}, fmt.Errorf("%w at %s", ErrNoCreate2Factory, Create2Factory)
+	}
+
+	factory := bind.NewBoundContract(Create2Factory, abi.ABI{}, dp.backend, dp.backend, dp.backend)
+	tx, err := factory.RawTransact(dp.txOpts(), append(salt.Bytes(), initCode...))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", name, elerrors.Decode(err))
+	}
+	if err := dp.waitMined(tx); err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", name, err)
+	}
+	if code, err = dp.backend.CodeAt(dp.ctx, addr, nil); err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch code at %s: %w", addr, err)
+	}
+	if len(code) == 0 {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: no code at predicted address %s", name, addr)
+	}
+	return addr, nil
+}
@@ -0,0 +1,429 @@
+// Package deploy deploys the EigenLayer core contracts to a fresh chain, in the order and with
+// the wiring of script/deploy/local/Deploy_From_Scratch.s.sol, for integration tests and devnets.
+//
+// The core contracts are deployed behind OpenZeppelin TransparentUpgradeableProxies owned by a
+// ProxyAdmin, and EigenPods behind an UpgradeableBeacon. Those contracts have no binding in
+// pkg/bindings, so their bytecode is read from the forge build output:
+//
+//	artifacts, _ := deploy.LoadArtifacts("out")
+//	d, err := deploy.Deploy(ctx, client, opts, artifacts, deploy.DefaultConfig())
+//	d.Register(addresses.Default, chainID)
+//
+// With Config.Salt set, the contracts are deployed through the canonical CREATE2 factory, so that
+// a deployment lands at the same proxy addresses on every chain. DeployCreate2 and
+// Create2Address deploy and predict other contracts the same way.
+//
+// DeployProxied and UpgradeAndCall deploy and upgrade further contracts behind the ProxyAdmin,
+// and Implementation and Admin read the EIP-1967 slots of any proxy.
+//
+// This tree predates the slashing release: there is no AllocationManager to deploy, and the
+// Slasher is left at the zero address, as the M2 contracts never call it.
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// MainnetETHPOSDeposit is the beacon chain deposit contract on mainnet.
+var MainnetETHPOSDeposit = common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa")
+
+// Backend is the chain access required by Deploy.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// StrategyConfig configures a StrategyBaseTVLLimits deployed for Token.
+type StrategyConfig struct {
+	Token            common.Address
+	MaxPerDeposit    *big.Int
+	MaxTotalDeposits *big.Int
+}
+
+// RewardsConfig configures the RewardsCoordinator.
+type RewardsConfig struct {
+	CalculationIntervalSeconds uint32
+	MaxRewardsDuration         uint32
+	MaxRetroactiveLength       uint32
+	MaxFutureLength            uint32
+	GenesisRewardsTimestamp    uint32
+	ActivationDelay            uint32
+	DefaultSplitBips           uint16
+	// Updater is the account allowed to submit distribution roots. It defaults to Owner.
+	Updater common.Address
+}
+
+// Config configures a deployment. Zero addresses default to the sender of the TransactOpts
+// passed to Deploy. All contracts are deployed unpaused.
+type Config struct {
+	// Owner owns the core contracts, the ProxyAdmin and the EigenPod beacon.
+	Owner common.Address
+	// Pausers and Unpauser are set in the PauserRegistry. Pausers defaults to Owner.
+	Pausers  []common.Address
+	Unpauser common.Address
+	// StrategyWhitelister may add strategies to the StrategyManager's deposit whitelist. The
+	// strategies in Strategies are only whitelisted when it is the deployer.
+	StrategyWhitelister common.Address
+	// ETHPOSDeposit is the beacon chain deposit contract EigenPods send deposits to.
+	ETHPOSDeposit common.Address
+	// GenesisTime is the beacon chain genesis time EigenPods compute slots from.
+	GenesisTime              uint64
+	MinWithdrawalDelayBlocks *big.Int
+	Rewards                  RewardsConfig
+	Strategies               []StrategyConfig
+	// Salt, if set, deploys the contracts through Create2Factory with salts derived from it, so
+	// that the same account deploying the same configuration gets the same proxy addresses on
+	// every chain. The ProxyAdmin and the EigenPod beacon, which would be owned by the factory,
+	// are deployed with CREATE; proxies are administered by the deployer until they are
+	// initialized, then handed over to the ProxyAdmin.
+	Salt *common.Hash
+}
+
+// DefaultConfig returns the configuration of script/configs/local/deploy_from_scratch.anvil.config.json.
+func DefaultConfig() Config {
+	return Config{
+		ETHPOSDeposit:            MainnetETHPOSDeposit,
+		GenesisTime:              1616508000,
+		MinWithdrawalDelayBlocks: big.NewInt(1),
+		Rewards: RewardsConfig{
+			CalculationIntervalSeconds: 604800,
+			MaxRewardsDuration:         6048000,
+			MaxRetroactiveLength:       7776000,
+			MaxFutureLength:            2592000,
+			GenesisRewardsTimestamp:    1710979200,
+			ActivationDelay:            7200,
+			DefaultSplitBips:           1000,
+		},
+	}
+}
+
+// Deployment holds the addresses of a deployment. Proxied contracts are at their proxy address,
+// with their implementation alongside.
+type Deployment struct {
+	ProxyAdmin     common.Address
+	PauserRegistry common.Address
+	EmptyContract  common.Address
+
+	DelegationManager                common.Address
+	DelegationManagerImplementation  common.Address
+	StrategyManager                  common.Address
+	StrategyManagerImplementation    common.Address
+	EigenPodManager                  common.Address
+	EigenPodManagerImplementation    common.Address
+	EigenPodBeacon                   common.Address
+	EigenPodImplementation           common.Address
+	AVSDirectory                     common.Address
+	AVSDirectoryImplementation       common.Address
+	RewardsCoordinator               common.Address
+	RewardsCoordinatorImplementation common.Address
+
+	// StrategyImplementation is the StrategyBaseTVLLimits implementation behind every strategy
+	// in Strategies, which are in the order of Config.Strategies.
+	StrategyImplementation common.Address
+	Strategies             []common.Address
+}
+
+// Register records the deployment in registry as the deployment of chainID.
+func (d *Deployment) Register(registry *addresses.Registry, chainID uint64) {
+	for name, addr := range map[string]common.Address{
+		addresses.ProxyAdmin:         d.ProxyAdmin,
+		addresses.PauserRegistry:     d.PauserRegistry,
+		addresses.DelegationManager:  d.DelegationManager,
+		addresses.StrategyManager:    d.StrategyManager,
+		addresses.EigenPodManager:    d.EigenPodManager,
+		addresses.EigenPodBeacon:     d.EigenPodBeacon,
+		addresses.AVSDirectory:       d.AVSDirectory,
+		addresses.RewardsCoordinator: d.RewardsCoordinator,
+	} {
+		registry.Override(chainID, name, addr)
+	}
+}
+
+// deployer sends the transactions of a deployment one at a time, waiting for each to be mined.
+type deployer struct {
+	ctx       context.Context
+	backend   Backend
+	opts      *bind.TransactOpts
+	artifacts *Artifacts
+	// salt, if set, is the base salt of CREATE2 deployments.
+	salt *common.Hash
+	// administered are the proxies administered by the deployer until handOver.
+	administered []common.Address
+}
+
+// Deploy deploys and initializes the core contracts and the strategies of cfg with opts, and
+// waits for every transaction to be mined.
+func Deploy(ctx context.Context, backend Backend, opts *bind.TransactOpts, artifacts *Artifacts, cfg Config) (*Deployment, error) {
+	cfg = cfg.withDefaults(opts.From)
+	dp := &deployer{ctx: ctx, backend: backend, opts: opts, artifacts: artifacts, salt: cfg.Salt}
+	d := new(Deployment)
+	var err error
+
+	// The ProxyAdmin and the beacon are owned by their deployer, so they are never deployed
+	// through the CREATE2 factory.
+	if d.ProxyAdmin, err = dp.create("ProxyAdmin", abi.ABI{}, artifacts.ProxyAdmin); err != nil {
+		return nil, err
+	}
+	if d.PauserRegistry, err = dp.deployBinding("PauserRegistry", PauserRegistry.PauserRegistryMetaData, cfg.Pausers, cfg.Unpauser); err != nil {
+		return nil, err
+	}
+
+	// The proxies are deployed first, pointing at an empty contract, so that the implementations
+	// can be constructed with each other's final addresses.
+	if d.EmptyContract, err = dp.deployCode("EmptyContract", abi.ABI{}, artifacts.EmptyContract); err != nil {
+		return nil, err
+	}
+	for _, proxy := range []struct {
+		name    string
+		address *common.Address
+	}{
+		{"DelegationManager", &d.DelegationManager},
+		{"StrategyManager", &d.StrategyManager},
+		{"AVSDirectory", &d.AVSDirectory},
+		{"EigenPodManager", &d.EigenPodManager},
+		{"RewardsCoordinator", &d.RewardsCoordinator},
+	} {
+		if *proxy.address, err = dp.deployProxy(proxy.name, d.ProxyAdmin, d.EmptyContract, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.EigenPodImplementation, err = dp.deployBinding("EigenPod", EigenPod.EigenPodMetaData, cfg.ETHPOSDeposit, d.EigenPodManager, cfg.GenesisTime); err != nil {
+		return nil, err
+	}
+	if d.EigenPodBeacon, err = dp.create("UpgradeableBeacon", abis.UpgradeableBeacon, artifacts.UpgradeableBeacon, d.EigenPodImplementation); err != nil {
+		return nil, err
+	}
+
+	var slasher common.Address
+	if d.DelegationManagerImplementation, err = dp.deployBinding("DelegationManager", DelegationManager.DelegationManagerMetaData,
+		d.StrategyManager, slasher, d.EigenPodManager); err != nil {
+		return nil, err
+	}
+	if d.StrategyManagerImplementation, err = dp.deployBinding("StrategyManager", StrategyManager.StrategyManagerMetaData,
+		d.DelegationManager, d.EigenPodManager, slasher); err != nil {
+		return nil, err
+	}
+	if d.AVSDirectoryImplementation, err = dp.deployBinding("AVSDirectory", AVSDirectory.AVSDirectoryMetaData, d.DelegationManager); err != nil {
+		return nil, err
+	}
+	if d.EigenPodManagerImplementation, err = dp.deployBinding("EigenPodManager", EigenPodManager.EigenPodManagerMetaData,
+		cfg.ETHPOSDeposit, d.EigenPodBeacon, d.StrategyManager, slasher, d.DelegationManager); err != nil {
+		return nil, err
+	}
+	rc := cfg.Rewards
+	if d.RewardsCoordinatorImplementation, err = dp.deployBinding("RewardsCoordinator", RewardsCoordinator.RewardsCoordinatorMetaData,
+		d.DelegationManager, d.StrategyManager,
+		rc.CalculationIntervalSeconds, rc.MaxRewardsDuration, rc.MaxRetroactiveLength, rc.MaxFutureLength, rc.GenesisRewardsTimestamp); err != nil {
+		return nil, err
+	}
+
+	unpaused := new(big.Int)
+	upgrades := []struct {
+		name           string
+		proxy, impl    common.Address
+		metadata       *bind.MetaData
+		initializeArgs []interface{}
+	}{
+		{"DelegationManager", d.DelegationManager, d.DelegationManagerImplementation, DelegationManager.DelegationManagerMetaData,
+			[]interface{}{cfg.Owner, d.PauserRegistry, unpaused, cfg.MinWithdrawalDelayBlocks, []common.Address{}, []*big.Int{}}},
+		{"StrategyManager", d.StrategyManager, d.StrategyManagerImplementation, StrategyManager.StrategyManagerMetaData,
+			[]interface{}{cfg.Owner, cfg.StrategyWhitelister, d.PauserRegistry, unpaused}},
+		{"AVSDirectory", d.AVSDirectory, d.AVSDirectoryImplementation, AVSDirectory.AVSDirectoryMetaData,
+			[]interface{}{cfg.Owner, d.PauserRegistry, unpaused}},
+		{"EigenPodManager", d.EigenPodManager, d.EigenPodManagerImplementation, EigenPodManager.EigenPodManagerMetaData,
+			[]interface{}{cfg.Owner, d.PauserRegistry, unpaused}},
+		{"RewardsCoordinator", d.RewardsCoordinator, d.RewardsCoordinatorImplementation, RewardsCoordinator.RewardsCoordinatorMetaData,
+			[]interface{}{cfg.Owner, d.PauserRegistry, unpaused, rc.Updater, rc.ActivationDelay, rc.DefaultSplitBips}},
+	}
+	for _, u := range upgrades {
+		data, err := EncodeInitializer(u.metadata, u.initializeArgs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize %s: %w", u.name, err)
+		}
+		if err := dp.upgradeAndCall(d.ProxyAdmin, u.proxy, u.impl, data); err != nil {
+			return nil, fmt.Errorf("failed to initialize %s: %w", u.name, err)
+		}
+	}
+
+	if err := dp.deployStrategies(d, cfg); err != nil {
+		return nil, err
+	}
+	if err := dp.handOver(d.ProxyAdmin); err != nil {
+		return nil, err
+	}
+	if err := dp.whitelistStrategies(d, cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.Owner != opts.From {
+		for _, owned := range []struct {
+			name    string
+			address common.Address
+			abi     abi.ABI
+		}{
+			{"ProxyAdmin", d.ProxyAdmin, abis.ProxyAdmin},
+			{"UpgradeableBeacon", d.EigenPodBeacon, abis.UpgradeableBeacon},
+		} {
+			if err := dp.transact(owned.name, owned.address, owned.abi, "transferOwnership", cfg.Owner); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d, nil
+}
+
+// deployStrategies deploys the StrategyBaseTVLLimits implementation and a proxy for each
+// strategy of cfg.
+func (dp *deployer) deployStrategies(d *Deployment, cfg Config) error {
+	var err error
+	if d.StrategyImplementation, err = dp.deployBinding("StrategyBaseTVLLimits", StrategyBaseTVLLimits.StrategyBaseTVLLimitsMetaData, d.StrategyManager); err != nil {
+		return err
+	}
+	for _, s := range cfg.Strategies {
+		data, err := EncodeInitializer(StrategyBaseTVLLimits.StrategyBaseTVLLimitsMetaData, s.MaxPerDeposit, s.MaxTotalDeposits, s.Token, d.PauserRegistry)
+		if err != nil {
+			return fmt.Errorf("failed to initialize strategy for %s: %w", s.Token, err)
+		}
+		proxy, err := dp.deployProxy("Strategy"+s.Token.Hex(), d.ProxyAdmin, d.StrategyImplementation, data)
+		if err != nil {
+			return err
+		}
+		d.Strategies = append(d.Strategies, proxy)
+	}
+	return nil
+}
+
+// whitelistStrategies whitelists the strategies of d for deposits if the deployer is the
+// strategy whitelister.
+func (dp *deployer) whitelistStrategies(d *Deployment, cfg Config) error {
+	if len(d.Strategies) == 0 || cfg.StrategyWhitelister != dp.opts.From {
+		return nil
+	}
+	sm, err := StrategyManager.NewStrategyManagerTransactor(d.StrategyManager, dp.backend)
+	if err != nil {
+		return err
+	}
+	tx, err := sm.AddStrategiesToDepositWhitelist(dp.txOpts(), d.Strategies, make([]bool, len(d.Strategies)))
+	if err != nil {
+		return fmt.Errorf("failed to whitelist strategies: %w", elerrors.Decode(err))
+	}
+	return dp.waitMined(tx)
+}
+
+// deploy runs fn, a binding's deploy function, and waits for the deployment.
+func (dp *deployer) deploy(name string, fn func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error)) (common.Address, error) {
+	addr, tx, err := fn(dp.txOpts())
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", name, elerrors.Decode(err))
+	}
+	if err := dp.waitMined(tx); err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", name, err)
+	}
+	return addr, nil
+}
+
+// deployBinding deploys the binding with metadata with params.
+func (dp *deployer) deployBinding(name string, metadata *bind.MetaData, params ...interface{}) (common.Address, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return dp.deployCode(name, *parsed, common.FromHex(metadata.Bin), params...)
+}
+
+// deployCode deploys bytecode with the constructor of contractABI and params, through the
+// CREATE2 factory if the deployment is salted.
+func (dp *deployer) deployCode(name string, contractABI abi.ABI, bytecode []byte, params ...interface{}) (common.Address, error) {
+	if dp.salt == nil {
+		return dp.create(name, contractABI, bytecode, params...)
+	}
+	if len(bytecode) == 0 {
+		return common.Address{}, fmt.Errorf("no bytecode for %s", name)
+	}
+	initCode, err := InitCode(contractABI, bytecode, params...)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", name, err)
+	}
+	return dp.deployCreate2(name, Salt(*dp.salt, name), initCode)
+}
+
+// create deploys bytecode with the constructor of contractABI and params with CREATE.
+func (dp *deployer) create(name string, contractABI abi.ABI, bytecode []byte, params ...interface{}) (common.Address, error) {
+	if len(bytecode) == 0 {
+		return common.Address{}, fmt.Errorf("no bytecode for %s", name)
+	}
+	return dp.deploy(name, func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := bind.DeployContract(opts, contractABI, bytecode, dp.backend, params...)
+		return addr, tx, err
+	})
+}
+
+// transact calls method of the contract at address and waits for the transaction.
+func (dp *deployer) transact(name string, address common.Address, contractABI abi.ABI, method string, args ...interface{}) error {
+	contract := bind.NewBoundContract(address, contractABI, dp.backend, dp.backend, dp.backend)
+	tx, err := contract.Transact(dp.txOpts(), method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to call %s.%s: %w", name, method, elerrors.Decode(err))
+	}
+	return dp.waitMined(tx)
+}
+
+func (dp *deployer) txOpts() *bind.TransactOpts {
+	opts := *dp.opts
+	opts.Context = dp.ctx
+	return &opts
+}
+
+func (dp *deployer) waitMined(tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(dp.ctx, dp.backend, tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return nil
+}
+
+// withDefaults fills in the zero fields of cfg, with deployer as the default account.
+func (cfg Config) withDefaults(deployer common.Address) Config {
+	if cfg.Owner == (common.Address{}) {
+		cfg.Owner = deployer
+	}
+	if len(cfg.Pausers) == 0 {
+		cfg.Pausers = []common.Address{cfg.Owner}
+	}
+	if cfg.Unpauser == (common.Address{}) {
+		cfg.Unpauser = cfg.Owner
+	}
+	if cfg.StrategyWhitelister == (common.Address{}) {
+		cfg.StrategyWhitelister = deployer
+	}
+	if cfg.MinWithdrawalDelayBlocks == nil {
+		cfg.MinWithdrawalDelayBlocks = new(big.Int)
+	}
+	if cfg.Rewards.Updater == (common.Address{}) {
+		cfg.Rewards.Updater = cfg.Owner
+	}
+	return cfg
+}
@@ -0,0 +1,160 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// EIP-1967 storage slots proxies keep their implementation and admin in.
+var (
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+)
+
+// StorageReader is the chain access required to read proxy slots.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Implementation returns the implementation proxy points at, read from its EIP-1967 slot.
+func Implementation(ctx context.Context, reader StorageReader, proxy common.Address) (common.Address, error) {
+	return readSlot(ctx, reader, proxy, ImplementationSlot)
+}
+
+// Admin returns the admin of proxy, usually a ProxyAdmin, read from its EIP-1967 slot.
+func Admin(ctx context.Context, reader StorageReader, proxy common.Address) (common.Address, error) {
+	return readSlot(ctx, reader, proxy, AdminSlot)
+}
+
+func readSlot(ctx context.Context, reader StorageReader, proxy common.Address, slot common.Hash) (common.Address, error) {
+	value, err := reader.StorageAt(ctx, proxy, slot, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read slot %s of %s: %w", slot, proxy, err)
+	}
+	return common.BytesToAddress(value), nil
+}
+
+// EncodeInitializer packs a call to the initialize function of the contract described by
+// metadata, such as DelegationManager.DelegationManagerMetaData.
+func EncodeInitializer(metadata *bind.MetaData, args ...interface{}) ([]byte, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("initialize", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack initializer: %w", err)
+	}
+	return data, nil
+}
+
+// DeployFunc deploys an implementation. It is a binding's deploy function with its constructor
+// arguments bound:
+//
+//	func(opts *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *AVSDirectory.AVSDirectory, error) {
+//		return AVSDirectory.DeployAVSDirectory(opts, backend, delegationManager)
+//	}
+type DeployFunc[T any] func(opts *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *T, error)
+
+// NewFunc is a binding's constructor, such as AVSDirectory.NewAVSDirectory.
+type NewFunc[T any] func(address common.Address, backend bind.ContractBackend) (*T, error)
+
+// Proxied is a contract deployed behind a TransparentUpgradeableProxy. Contract is bound to the
+// proxy.
+type Proxied[T any] struct {
+	Proxy          common.Address
+	Implementation common.Address
+	Contract       *T
+}
+
+// DeployProxy deploys a TransparentUpgradeableProxy administered by proxyAdmin, pointing at
+// implementation and called with initData, which may be empty. It waits for the deployment.
+func DeployProxy(ctx context.Context, backend Backend, opts *bind.TransactOpts, artifacts *Artifacts, proxyAdmin, implementation common.Address, initData []byte) (common.Address, error) {
+	dp := &deployer{ctx: ctx, backend: backend, opts: opts, artifacts: artifacts}
+	return dp.deployProxy("TransparentUpgradeableProxy", proxyAdmin, implementation, initData)
+}
+
+// DeployProxied deploys an implementation with deployImpl and a proxy administered by proxyAdmin
+// in front of it, initialized with initData, and returns a binding bound to the proxy.
+func DeployProxied[T any](ctx context.Context, backend Backend, opts *bind.TransactOpts, artifacts *Artifacts, proxyAdmin common.Address, deployImpl DeployFunc[T], newBinding NewFunc[T], initData []byte) (*Proxied[T], error) {
+	dp := &deployer{ctx: ctx, backend: backend, opts: opts, artifacts: artifacts}
+	impl, err := dp.deploy("implementation", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := deployImpl(opts, backend)
+		return addr, tx, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := dp.deployProxy("TransparentUpgradeableProxy", proxyAdmin, impl, initData)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := newBinding(proxy, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Proxied[T]{Proxy: proxy, Implementation: impl, Contract: contract}, nil
+}
+
+// UpgradeAndCall points proxy at implementation through proxyAdmin, which opts.From must own, and
+// calls it with data in the same transaction. With empty data it calls ProxyAdmin.upgrade
+// instead. It waits for the transaction to be mined.
+func UpgradeAndCall(ctx context.Context, backend Backend, opts *bind.TransactOpts, proxyAdmin, proxy, implementation common.Address, data []byte) error {
+	dp := &deployer{ctx: ctx, backend: backend, opts: opts}
+	return dp.upgradeAndCall(proxyAdmin, proxy, implementation, data)
+}
+
+// deployProxy deploys the proxy of the contract called name. In a salted deployment, the proxy is
+// administered by the deployer, so that its init code is the same on every chain, until
+// handOver.
+func (dp *deployer) deployProxy(name string, proxyAdmin, implementation common.Address, initData []byte) (common.Address, error) {
+	if dp.artifacts == nil {
+		return common.Address{}, fmt.Errorf("no bytecode for TransparentUpgradeableProxy")
+	}
+	if initData == nil {
+		initData = []byte{}
+	}
+	if dp.salt == nil {
+		return dp.deployCode("TransparentUpgradeableProxy", parsedTransparentProxyABI, dp.artifacts.TransparentUpgradeableProxy, implementation, proxyAdmin, initData)
+	}
+	proxy, err := dp.deployCode(name+"Proxy", parsedTransparentProxyABI, dp.artifacts.TransparentUpgradeableProxy, implementation, dp.opts.From, initData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	dp.administered = append(dp.administered, proxy)
+	return proxy, nil
+}
+
+// handOver makes proxyAdmin the admin of the proxies administered by the deployer.
+func (dp *deployer) handOver(proxyAdmin common.Address) error {
+	for _, proxy := range dp.administered {
+		if err := dp.transact("TransparentUpgradeableProxy", proxy, parsedTransparentProxyABI, "changeAdmin", proxyAdmin); err != nil {
+			return err
+		}
+	}
+	dp.administered = nil
+	return nil
+}
+
+func (dp *deployer) upgradeAndCall(proxyAdmin, proxy, implementation common.Address, data []byte) error {
+	for _, administered := range dp.administered {
+		if administered != proxy {
+			continue
+		}
+		if len(data) == 0 {
+			return dp.transact("TransparentUpgradeableProxy", proxy, parsedTransparentProxyABI, "upgradeTo", implementation)
+		}
+		return dp.transact("TransparentUpgradeableProxy", proxy, parsedTransparentProxyABI, "upgradeToAndCall", implementation, data)
+	}
+	if len(data) == 0 {
+		return dp.transact("ProxyAdmin", proxyAdmin, abis.ProxyAdmin, "upgrade", proxy, implementation)
+	}
+	return dp.transact("ProxyAdmin", proxyAdmin, abis.ProxyAdmin, "upgradeAndCall", proxy, implementation, data)
+}
@@ -0,0 +1,214 @@
+// Package deposit checks StrategyManager deposits before they are sent.
+//
+// ValidateDeposit reads the state depositIntoStrategy depends on and reports every check the
+// deposit would fail, rather than only the first revert a simulation would surface:
+//
+//	v, _ := deposit.NewValidator(client, strategyManagerAddress)
+//	failures, err := v.ValidateDeposit(ctx, strategy, token, amount, staker)
+//	if err != nil {
+//		return err
+//	}
+//	for _, f := range failures {
+//		fmt.Println(f) // max per deposit exceeded: amount 2000 > maxPerDeposit 1000
+//	}
+package deposit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/shares"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategy"
+)
+
+// MaxStakerStrategyListLength is StrategyManager.MAX_STAKER_STRATEGY_LIST_LENGTH.
+const MaxStakerStrategyListLength = 32
+
+// pausedDepositsIndex is PAUSED_DEPOSITS on both the StrategyManager and StrategyBase.
+const pausedDepositsIndex = 0
+
+// Errors for deposit checks that do not correspond to a typed revert in pkg/errors.
+var (
+	ErrInsufficientAllowance = errors.New("insufficient token allowance")
+	ErrInsufficientBalance   = errors.New("insufficient token balance")
+	ErrStrategyListFull      = errors.New("max strategy list length exceeded")
+)
+
+// Failure is a single check a deposit fails.
+type Failure struct {
+	// Err is the error the check reports, such as elerrors.ErrMaxPerDepositExceeded.
+	Err error
+	// Detail describes the values that failed the check.
+	Detail string
+}
+
+func (f Failure) Error() string {
+	if f.Detail == "" {
+		return f.Err.Error()
+	}
+	return f.Err.Error() + ": " + f.Detail
+}
+
+func (f Failure) Unwrap() error {
+	return f.Err
+}
+
+// Failures are the checks a deposit fails, in the order they were checked.
+type Failures []Failure
+
+// Err returns the failures joined into a single error, or nil if there are none.
+func (fs Failures) Err() error {
+	if len(fs) == 0 {
+		return nil
+	}
+	errs := make([]error, len(fs))
+	for i, f := range fs {
+		errs[i] = f
+	}
+	return errors.Join(errs...)
+}
+
+func (fs Failures) String() string {
+	msgs := make([]string, len(fs))
+	for i, f := range fs {
+		msgs[i] = f.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validator checks deposits into strategies through a StrategyManager.
+type Validator struct {
+	backend         bind.ContractCaller
+	strategyManager common.Address
+	manager         *StrategyManager.StrategyManagerCaller
+}
+
+// NewValidator returns a Validator for deposits through the StrategyManager at
+// strategyManager.
+func NewValidator(backend bind.ContractCaller, strategyManager common.Address) (*Validator, error) {
+	manager, err := StrategyManager.NewStrategyManagerCaller(strategyManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Validator{backend: backend, strategyManager: strategyManager, manager: manager}, nil
+}
+
+// ValidateDeposit checks, at the latest block, whether staker can deposit amount of token into
+// strategyAddr with StrategyManager.depositIntoStrategy. It returns every check the deposit
+// fails, which is empty if the deposit is expected to succeed, and an error only if the state
+// could not be read.
+//
+// Limits are only checked for strategies that implement getTVLLimits. The allowance checked is
+// the one staker has granted the StrategyManager, which transfers the tokens.
+func (v *Validator) ValidateDeposit(ctx context.Context, strategyAddr, token common.Address, amount *big.Int, staker common.Address) (Failures, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	strat, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(strategyAddr, v.backend)
+	if err != nil {
+		return nil, err
+	}
+	var failures Failures
+	fail := func(err error, format string, args ...interface{}) {
+		failures = append(failures, Failure{Err: err, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	paused, err := v.manager.Paused(opts, pausedDepositsIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch StrategyManager paused status: %w", err)
+	}
+	if paused {
+		fail(elerrors.ErrCurrentlyPaused{Index: pausedDepositsIndex}, "StrategyManager deposits are paused")
+	}
+	whitelisted, err := v.manager.StrategyIsWhitelistedForDeposit(opts, strategyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch strategy whitelist status: %w", err)
+	}
+	if !whitelisted {
+		fail(elerrors.ErrStrategyNotWhitelisted, "strategy %s", strategyAddr.Hex())
+	}
+
+	if paused, err = strat.Paused(opts, pausedDepositsIndex); err != nil {
+		return nil, fmt.Errorf("failed to fetch strategy paused status: %w", err)
+	}
+	if paused {
+		fail(elerrors.ErrCurrentlyPaused{Index: pausedDepositsIndex}, "strategy deposits are paused")
+	}
+	underlying, err := strat.UnderlyingToken(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch underlying token: %w", err)
+	}
+	if token != underlying {
+		fail(elerrors.ErrWrongToken, "token %s, underlying token %s", token.Hex(), underlying.Hex())
+	}
+
+	// The strategy's balance before the deposit, which its limits and share price use. Only
+	// meaningful for the underlying token.
+	balance, err := strategy.TokenBalance(opts, v.backend, underlying, strategyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch strategy token balance: %w", err)
+	}
+	totalShares, err := strat.TotalShares(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch total shares: %w", err)
+	}
+
+	maxPerDeposit, maxTotalDeposits, err := strat.GetTVLLimits(opts)
+	switch {
+	case err == nil:
+		if amount.Cmp(maxPerDeposit) > 0 {
+			fail(elerrors.ErrMaxPerDepositExceeded, "amount %s > maxPerDeposit %s", amount, maxPerDeposit)
+		}
+		if after := new(big.Int).Add(balance, amount); after.Cmp(maxTotalDeposits) > 0 {
+			fail(elerrors.ErrMaxTotalDepositsExceeded, "balance after deposit %s > maxTotalDeposits %s", after, maxTotalDeposits)
+		}
+	case errors.Is(elerrors.Decode(err), elerrors.ErrReverted):
+		// A StrategyBase without TVL limits.
+	default:
+		return nil, fmt.Errorf("failed to fetch TVL limits: %w", err)
+	}
+
+	allowance, err := strategy.TokenAllowance(opts, v.backend, token, staker, v.strategyManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch token allowance: %w", err)
+	}
+	if allowance.Cmp(amount) < 0 {
+		fail(ErrInsufficientAllowance, "allowance %s < amount %s", allowance, amount)
+	}
+	stakerBalance, err := strategy.TokenBalance(opts, v.backend, token, staker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch staker token balance: %w", err)
+	}
+	if stakerBalance.Cmp(amount) < 0 {
+		fail(ErrInsufficientBalance, "balance %s < amount %s", stakerBalance, amount)
+	}
+
+	state := shares.State{TotalShares: totalShares, Balance: balance}
+	if _, _, err := state.Deposit(amount); err != nil {
+		fail(err, "amount %s at total shares %s and balance %s", amount, totalShares, balance)
+	}
+
+	// A staker's first deposit into a strategy appends it to their strategy list.
+	stakerShares, err := v.manager.StakerStrategyShares(opts, staker, strategyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch staker shares: %w", err)
+	}
+	if stakerShares.Sign() == 0 {
+		length, err := v.manager.StakerStrategyListLength(opts, staker)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch staker strategy list length: %w", err)
+		}
+		if length.Cmp(big.NewInt(MaxStakerStrategyListLength)) >= 0 {
+			fail(ErrStrategyListFull, "staker has deposits in %s strategies", length)
+		}
+	}
+
+	return failures, nil
+}
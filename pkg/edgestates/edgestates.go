@@ -0,0 +1,290 @@
+// Package edgestates finds the stakers and pods in states the protocol permits but integrations
+// rarely expect, so that UIs can special-case them: pod owners with negative shares, and
+// positions too small to ever be withdrawn.
+//
+// A Scanner finds every staker from the StrategyManager's Deposit events and every pod owner
+// from the EigenPodManager's PodDeployed events, then reads their shares at the latest block:
+//
+//	scanner, _ := edgestates.NewScanner(client, edgestates.Config{StrategyManager: sm, EigenPodManager: epm, FromBlock: deployBlock})
+//	states, err := scanner.Scan(ctx)
+//
+// The contracts of this tree predate slashing: pods have no beacon chain slashing factor, and
+// beacon chain penalties are instead accounted for by reducing the podOwnerShares of the owner,
+// which turn negative when the penalty is accounted for after the shares were withdrawn.
+package edgestates
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logpager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// batchSize is the number of accounts whose shares are read per multicall.
+const batchSize = 250
+
+// Kinds of State.
+const (
+	// KindNegativeShares is a pod owner whose shares are negative. The owner cannot withdraw
+	// or delegate beacon chain ETH until restaked ETH repays the deficit.
+	KindNegativeShares = "negative shares"
+	// KindDust is a position whose shares are worth no tokens, or for a pod owner less than
+	// 1 gwei, which EigenPods cannot withdraw.
+	KindDust = "dust"
+)
+
+// gwei is the unit of EigenPod withdrawals, in wei.
+var gwei = big.NewInt(1_000_000_000)
+
+// Backend is the chain access required by Scanner.
+type Backend interface {
+	bind.ContractCaller
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures a Scanner.
+type Config struct {
+	StrategyManager common.Address
+	EigenPodManager common.Address
+	// FromBlock is the first block searched for stakers and pods, typically the deployment block
+	// of the core contracts.
+	FromBlock uint64
+	// Pager configures the paging of the log queries.
+	Pager logpager.Config
+}
+
+// State is a position in an edge state.
+type State struct {
+	// Kind is one of the Kind constants.
+	Kind   string
+	Staker common.Address
+	// Strategy is the strategy of the position, addresses.BeaconChainETHStrategy for the shares
+	// of a pod owner, whose pod is Pod.
+	Strategy common.Address
+	Pod      common.Address
+	Shares   *big.Int
+	// Underlying is the value of Shares in the strategy's underlying token, or in wei for pods.
+	Underlying *big.Int
+}
+
+// Scanner finds the positions in edge states.
+type Scanner struct {
+	backend Backend
+	cfg     Config
+	sm      *StrategyManager.StrategyManagerFilterer
+	epm     *EigenPodManager.EigenPodManagerFilterer
+}
+
+// NewScanner returns a Scanner of the stakers and pods of the contracts in cfg.
+func NewScanner(backend Backend, cfg Config) (*Scanner, error) {
+	pager := logpager.New(backend, cfg.Pager)
+	sm, err := StrategyManager.NewStrategyManagerFilterer(cfg.StrategyManager, pager)
+	if err != nil {
+		return nil, err
+	}
+	epm, err := EigenPodManager.NewEigenPodManagerFilterer(cfg.EigenPodManager, pager)
+	if err != nil {
+		return nil, err
+	}
+	return &Scanner{backend: backend, cfg: cfg, sm: sm, epm: epm}, nil
+}
+
+// Scan returns the positions in edge states at the latest block, ordered by staker and strategy.
+// Stakers whose shares were only ever received from withdrawals completed as shares, without a
+// deposit, are not found.
+func (s *Scanner) Scan(ctx context.Context) ([]State, error) {
+	head, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	filterOpts := &bind.FilterOpts{Start: s.cfg.FromBlock, End: &head, Context: ctx}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}
+
+	stakers, err := s.stakers(filterOpts)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := s.pods(filterOpts)
+	if err != nil {
+		return nil, err
+	}
+	states, err := s.strategyStates(opts, stakers)
+	if err != nil {
+		return nil, err
+	}
+	podStates, err := s.podStates(opts, pods)
+	if err != nil {
+		return nil, err
+	}
+	states = append(states, podStates...)
+	sort.Slice(states, func(i, j int) bool {
+		if c := states[i].Staker.Cmp(states[j].Staker); c != 0 {
+			return c < 0
+		}
+		return states[i].Strategy.Cmp(states[j].Strategy) < 0
+	})
+	return states, nil
+}
+
+// stakers returns the stakers that deposited into the StrategyManager.
+func (s *Scanner) stakers(opts *bind.FilterOpts) ([]common.Address, error) {
+	it, err := s.sm.FilterDeposit(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter Deposit events: %w", err)
+	}
+	defer it.Close()
+	seen := make(map[common.Address]bool)
+	var stakers []common.Address
+	for it.Next() {
+		if !seen[it.Event.Staker] {
+			seen[it.Event.Staker] = true
+			stakers = append(stakers, it.Event.Staker)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read Deposit events: %w", err)
+	}
+	return stakers, nil
+}
+
+// pods returns the pods deployed by the EigenPodManager, keyed by owner.
+func (s *Scanner) pods(opts *bind.FilterOpts) (map[common.Address]common.Address, error) {
+	it, err := s.epm.FilterPodDeployed(opts, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter PodDeployed events: %w", err)
+	}
+	defer it.Close()
+	pods := make(map[common.Address]common.Address)
+	for it.Next() {
+		pods[it.Event.PodOwner] = it.Event.EigenPod
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read PodDeployed events: %w", err)
+	}
+	return pods, nil
+}
+
+// strategyStates returns the positions of stakers in the StrategyManager whose shares are worth
+// no tokens.
+func (s *Scanner) strategyStates(opts *bind.CallOpts, stakers []common.Address) ([]State, error) {
+	var positions []State
+	for start := 0; start < len(stakers); start += batchSize {
+		chunk := stakers[start:min(start+batchSize, len(stakers))]
+		batch := multicall.NewBatch(s.backend)
+		sm, err := StrategyManager.NewStrategyManagerCaller(s.cfg.StrategyManager, batch)
+		if err != nil {
+			return nil, err
+		}
+		deposits := make([]*multicall.Result2[[]common.Address, []*big.Int], len(chunk))
+		for i, staker := range chunk {
+			staker := staker
+			deposits[i] = multicall.Add2(batch, func(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+				return sm.GetDeposits(opts, staker)
+			})
+		}
+		if err := batch.Execute(opts); err != nil {
+			return nil, fmt.Errorf("failed to read deposits: %w", err)
+		}
+		for i, staker := range chunk {
+			strategies, shares, err := deposits[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read deposits of %s: %w", staker.Hex(), err)
+			}
+			for j, strategy := range strategies {
+				positions = append(positions, State{Staker: staker, Strategy: strategy, Shares: shares[j]})
+			}
+		}
+	}
+
+	var states []State
+	for start := 0; start < len(positions); start += batchSize {
+		chunk := positions[start:min(start+batchSize, len(positions))]
+		batch := multicall.NewBatch(s.backend)
+		values := make([]*multicall.Result[*big.Int], len(chunk))
+		for i, p := range chunk {
+			strategy, err := StrategyBase.NewStrategyBaseCaller(p.Strategy, batch)
+			if err != nil {
+				return nil, err
+			}
+			amount := p.Shares
+			values[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+				return strategy.SharesToUnderlyingView(opts, amount)
+			})
+		}
+		if err := batch.Execute(opts); err != nil {
+			return nil, fmt.Errorf("failed to read underlying values: %w", err)
+		}
+		for i, p := range chunk {
+			value, err := values[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read underlying value of %s in %s: %w", p.Staker.Hex(), p.Strategy.Hex(), err)
+			}
+			if p.Shares.Sign() > 0 && value.Sign() == 0 {
+				p.Kind, p.Underlying = KindDust, value
+				states = append(states, p)
+			}
+		}
+	}
+	return states, nil
+}
+
+// podStates returns the pod owners whose shares are negative or less than 1 gwei.
+func (s *Scanner) podStates(opts *bind.CallOpts, pods map[common.Address]common.Address) ([]State, error) {
+	owners := make([]common.Address, 0, len(pods))
+	for owner := range pods {
+		owners = append(owners, owner)
+	}
+	var states []State
+	for start := 0; start < len(owners); start += batchSize {
+		chunk := owners[start:min(start+batchSize, len(owners))]
+		batch := multicall.NewBatch(s.backend)
+		epm, err := EigenPodManager.NewEigenPodManagerCaller(s.cfg.EigenPodManager, batch)
+		if err != nil {
+			return nil, err
+		}
+		shares := make([]*multicall.Result[*big.Int], len(chunk))
+		for i, owner := range chunk {
+			owner := owner
+			shares[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+				return epm.PodOwnerShares(opts, owner)
+			})
+		}
+		if err := batch.Execute(opts); err != nil {
+			return nil, fmt.Errorf("failed to read pod owner shares: %w", err)
+		}
+		for i, owner := range chunk {
+			amount, err := shares[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read shares of pod owner %s: %w", owner.Hex(), err)
+			}
+			state := State{
+				Staker:     owner,
+				Strategy:   addresses.BeaconChainETHStrategy,
+				Pod:        pods[owner],
+				Shares:     amount,
+				Underlying: amount,
+			}
+			switch {
+			case amount.Sign() < 0:
+				state.Kind = KindNegativeShares
+			case amount.Sign() > 0 && amount.Cmp(gwei) < 0:
+				state.Kind = KindDust
+			default:
+				continue
+			}
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
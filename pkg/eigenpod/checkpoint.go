@@ -0,0 +1,380 @@
+package eigenpod
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/monitor"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// Defaults of CheckpointConfig.
+const (
+	// DefaultCheckpointInterval is the default interval between passes over the pods.
+	DefaultCheckpointInterval = 15 * time.Minute
+	// DefaultMinGainGwei is the default amount of ETH a pod must hold beyond what was already
+	// checkpointed for a checkpoint to be worth its gas: 1 ETH.
+	DefaultMinGainGwei = 1_000_000_000
+)
+
+// gweiPerWei converts pod balances, in wei, to the gwei of EigenPod accounting.
+var gweiPerWei = big.NewInt(1_000_000_000)
+
+// CheckpointConfig configures a CheckpointDaemon.
+type CheckpointConfig struct {
+	// Pods are the pods checkpointed. The signer must be the owner or the proof submitter of
+	// each of them to start checkpoints; proofs can be submitted by anyone.
+	Pods []common.Address
+	// FromBlock is the first block searched for the validators restaked in the pods, typically
+	// the block the oldest pod was deployed at.
+	FromBlock uint64
+	// Interval is the interval between passes over the pods, DefaultCheckpointInterval if zero.
+	Interval time.Duration
+	// MinGainGwei is the amount of ETH, in gwei, that a pod must hold beyond its checkpointed
+	// balance for a checkpoint to be started, DefaultMinGainGwei if zero.
+	MinGainGwei uint64
+	// MaxAge starts a checkpoint of pods with active validators whose last checkpoint is older,
+	// however little they gained. Zero disables checkpoints by age.
+	MaxAge time.Duration
+	// BatchGasLimit is the gas limit of each transaction of proofs, DefaultBatchGasLimit if zero.
+	BatchGasLimit uint64
+	// MaxBatchSize is the maximum number of validators proven per transaction,
+	// DefaultMaxBatchSize if zero.
+	MaxBatchSize int
+	// Receipts configures waiting for the receipts of the daemon's transactions.
+	Receipts receipts.Options
+	// Alerter, if set, is alerted when a pass over a pod fails and when a checkpoint completes.
+	Alerter monitor.Alerter
+	Logger  logging.Logger
+}
+
+// CheckpointResult is the outcome of a pass over a pod.
+type CheckpointResult struct {
+	Pod common.Address
+	// Started is set if the pass started a checkpoint, for Reason.
+	Started bool
+	Reason  string
+	// CheckpointTimestamp identifies the checkpoint the pass worked on, zero if none.
+	CheckpointTimestamp uint64
+	// Proven is the number of validators the pass proved.
+	Proven int
+	// ProofsRemaining is the number of validators left to prove once the pass is done.
+	ProofsRemaining uint64
+	// Finalized is set if the checkpoint completed, with the change of the pod owner's shares.
+	Finalized     bool
+	ShareDeltaWei *big.Int
+	Transactions  []common.Hash
+}
+
+// CheckpointDaemon starts and proves the checkpoints of a set of pods.
+type CheckpointDaemon struct {
+	backend Backend
+	beacon  *beacon.Client
+	opts    *bind.TransactOpts
+	cfg     CheckpointConfig
+	logger  logging.Logger
+
+	// validators caches the restaked validators of each pod, and scanned the last block searched
+	// for them.
+	validators map[common.Address][]uint64
+	scanned    uint64
+}
+
+// NewCheckpointDaemon returns a daemon checkpointing cfg.Pods, reading states from bc and
+// signing with opts.
+func NewCheckpointDaemon(backend Backend, bc *beacon.Client, opts *bind.TransactOpts, cfg CheckpointConfig) (*CheckpointDaemon, error) {
+	if len(cfg.Pods) == 0 {
+		return nil, fmt.Errorf("no pods to checkpoint")
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultCheckpointInterval
+	}
+	if cfg.MinGainGwei == 0 {
+		cfg.MinGainGwei = DefaultMinGainGwei
+	}
+	if cfg.BatchGasLimit == 0 {
+		cfg.BatchGasLimit = DefaultBatchGasLimit
+	}
+	if cfg.MaxBatchSize == 0 {
+		cfg.MaxBatchSize = DefaultMaxBatchSize
+	}
+	return &CheckpointDaemon{
+		backend:    backend,
+		beacon:     bc,
+		opts:       opts,
+		cfg:        cfg,
+		logger:     logging.OrNop(cfg.Logger),
+		validators: make(map[common.Address][]uint64),
+	}, nil
+}
+
+// Run passes over the pods every interval until ctx is done. A pod whose pass fails is logged,
+// alerted on and retried on the next pass.
+func (d *CheckpointDaemon) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		for _, pod := range d.cfg.Pods {
+			result, err := d.Checkpoint(ctx, pod)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				d.logger.Error("checkpoint failed", "pod", pod.Hex(), "error", err)
+				d.alert(ctx, monitor.Alert{
+					Summary: fmt.Sprintf("checkpoint of pod %s failed: %v", pod.Hex(), err),
+					Source:  "EigenPod " + pod.Hex(),
+				})
+				continue
+			}
+			d.report(ctx, result)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Checkpoint makes a single pass over pod: it proves the active checkpoint of the pod or, if it
+// has none, starts one when it is profitable or needed and proves it.
+func (d *CheckpointDaemon) Checkpoint(ctx context.Context, pod common.Address) (*CheckpointResult, error) {
+	eigenPod, err := EigenPod.NewEigenPod(pod, d.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	result := &CheckpointResult{Pod: pod}
+	if result.CheckpointTimestamp, err = eigenPod.CurrentCheckpointTimestamp(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch current checkpoint: %w", err)
+	}
+	indices, err := d.restakedValidators(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.CheckpointTimestamp == 0 {
+		if result.Reason, err = d.startReason(ctx, eigenPod, pod, indices); err != nil || result.Reason == "" {
+			return result, err
+		}
+		receipt, err := send(ctx, d.backend, d.opts, d.cfg.Receipts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return eigenPod.StartCheckpoint(opts, false)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to start checkpoint: %w", err)
+		}
+		result.Started = true
+		result.Transactions = append(result.Transactions, receipt.TxHash)
+		d.logger.Info("started checkpoint", "pod", pod.Hex(), "reason", result.Reason, "tx", receipt.TxHash.Hex())
+		// A pod without active validators completes its checkpoint as soon as it is started.
+		if finalized := receipts.Events(receipt, pod, eigenPod.ParseCheckpointFinalized); len(finalized) > 0 {
+			result.CheckpointTimestamp = finalized[0].CheckpointTimestamp
+			result.Finalized, result.ShareDeltaWei = true, finalized[0].TotalShareDeltaWei
+			return result, nil
+		}
+		created := receipts.Events(receipt, pod, eigenPod.ParseCheckpointCreated)
+		if len(created) == 0 {
+			return nil, fmt.Errorf("checkpoint transaction %s emitted no CheckpointCreated event", receipt.TxHash.Hex())
+		}
+		result.CheckpointTimestamp = created[0].CheckpointTimestamp
+	}
+	if err := d.prove(ctx, eigenPod, pod, indices, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// startReason returns why a checkpoint of pod should be started, or "" if it should not.
+func (d *CheckpointDaemon) startReason(ctx context.Context, eigenPod *EigenPod.EigenPod, pod common.Address, indices []uint64) (string, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	balance, err := balanceAt(ctx, d.backend, pod)
+	if err != nil {
+		return "", err
+	}
+	withdrawable, err := eigenPod.WithdrawableRestakedExecutionLayerGwei(opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch withdrawable balance: %w", err)
+	}
+	gain := new(big.Int).Div(balance, gweiPerWei)
+	gain.Sub(gain, new(big.Int).SetUint64(withdrawable))
+	if gain.Cmp(new(big.Int).SetUint64(d.cfg.MinGainGwei)) >= 0 {
+		return fmt.Sprintf("pod holds %s gwei not yet checkpointed", gain), nil
+	}
+
+	active, err := eigenPod.ActiveValidatorCount(opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch active validator count: %w", err)
+	}
+	if active.Sign() == 0 || len(indices) == 0 {
+		return "", nil
+	}
+	// Exited and slashed validators keep their restaked balance until a checkpoint accounts for
+	// them.
+	ids := make([]string, len(indices))
+	for i, index := range indices {
+		ids[i] = strconv.FormatUint(index, 10)
+	}
+	validators, err := d.beacon.Validators(ctx, "head", ids...)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range validators {
+		if !v.Slashed && v.Balance > 0 {
+			continue
+		}
+		info, err := eigenPod.ValidatorPubkeyHashToInfo(opts, PubkeyHash(v.Pubkey))
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch validator %d: %w", v.Index, err)
+		}
+		if info.Status != ValidatorActive {
+			continue
+		}
+		if v.Slashed {
+			return fmt.Sprintf("validator %d was slashed", v.Index), nil
+		}
+		return fmt.Sprintf("validator %d exited", v.Index), nil
+	}
+
+	if d.cfg.MaxAge > 0 {
+		last, err := eigenPod.LastCheckpointTimestamp(opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch last checkpoint: %w", err)
+		}
+		if age := time.Since(time.Unix(int64(last), 0)); age > d.cfg.MaxAge {
+			return fmt.Sprintf("last checkpoint is %s old", age.Truncate(time.Minute)), nil
+		}
+	}
+	return "", nil
+}
+
+// prove submits the balance proofs of the validators of the pod not yet proven for the current
+// checkpoint, in batches.
+func (d *CheckpointDaemon) prove(ctx context.Context, eigenPod *EigenPod.EigenPod, pod common.Address, indices []uint64, result *CheckpointResult) error {
+	opts := &bind.CallOpts{Context: ctx}
+	checkpoint, err := eigenPod.CurrentCheckpoint(opts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch current checkpoint: %w", err)
+	}
+	prover, err := d.beacon.CheckpointProver(ctx, checkpoint.BeaconBlockRoot)
+	if err != nil {
+		return fmt.Errorf("failed to fetch checkpoint state: %w", err)
+	}
+	var pending []uint64
+	for _, index := range indices {
+		fields, err := prover.ValidatorFields(index)
+		if err != nil {
+			return err
+		}
+		info, err := eigenPod.ValidatorPubkeyHashToInfo(opts, fields[0])
+		if err != nil {
+			return fmt.Errorf("failed to fetch validator %d: %w", index, err)
+		}
+		if info.Status == ValidatorActive && info.LastCheckpointedAt < result.CheckpointTimestamp {
+			pending = append(pending, index)
+		}
+	}
+	if remaining := checkpoint.ProofsRemaining.Uint64(); uint64(len(pending)) != remaining {
+		return fmt.Errorf("found %d validators to prove but the checkpoint expects %d; is FromBlock before the pod's first restaked validator?", len(pending), remaining)
+	}
+
+	transact := func(batch []uint64) func(*bind.TransactOpts) (*types.Transaction, error) {
+		return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			proofs, err := prover.CheckpointProofs(batch)
+			if err != nil {
+				return nil, err
+			}
+			return eigenPod.VerifyCheckpointProofs(opts, proofs.BalanceContainerProof, proofs.BalanceProofs)
+		}
+	}
+	batches, err := splitBatches(pending, d.cfg.MaxBatchSize, d.cfg.BatchGasLimit, func(batch []uint64) (uint64, error) {
+		return estimateGas(ctx, d.opts, transact(batch))
+	})
+	if err != nil {
+		return err
+	}
+	result.ProofsRemaining = uint64(len(pending))
+	for i, batch := range batches {
+		receipt, err := send(ctx, d.backend, d.opts, d.cfg.Receipts, transact(batch))
+		if err != nil {
+			return fmt.Errorf("failed to submit batch %d of %d of checkpoint proofs: %w", i+1, len(batches), err)
+		}
+		proven := len(receipts.Events(receipt, pod, eigenPod.ParseValidatorCheckpointed))
+		result.Proven += proven
+		result.ProofsRemaining -= uint64(len(batch))
+		result.Transactions = append(result.Transactions, receipt.TxHash)
+		d.logger.Info("submitted checkpoint proofs", "pod", pod.Hex(), "batch", i+1, "batches", len(batches),
+			"validators", len(batch), "remaining", result.ProofsRemaining, "tx", receipt.TxHash.Hex())
+		if finalized := receipts.Events(receipt, pod, eigenPod.ParseCheckpointFinalized); len(finalized) > 0 {
+			result.Finalized, result.ShareDeltaWei = true, finalized[0].TotalShareDeltaWei
+		}
+	}
+	return nil
+}
+
+// restakedValidators returns the restaked validators of pod, searching the blocks mined since
+// the last search.
+func (d *CheckpointDaemon) restakedValidators(ctx context.Context, pod common.Address) ([]uint64, error) {
+	head, err := d.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	if d.scanned == 0 {
+		d.scanned = d.cfg.FromBlock
+		if d.scanned > 0 {
+			d.scanned--
+		}
+	}
+	if head > d.scanned {
+		for _, p := range d.cfg.Pods {
+			indices, err := restakedValidators(ctx, d.backend, p, d.scanned+1, &head)
+			if err != nil {
+				return nil, err
+			}
+			d.validators[p] = mergeIndices(d.validators[p], indices)
+		}
+		d.scanned = head
+	}
+	return d.validators[pod], nil
+}
+
+// report logs the result of a successful pass and alerts on completed checkpoints.
+func (d *CheckpointDaemon) report(ctx context.Context, result *CheckpointResult) {
+	if !result.Started && result.Proven == 0 && !result.Finalized {
+		d.logger.Debug("no checkpoint needed", "pod", result.Pod.Hex())
+		return
+	}
+	if !result.Finalized {
+		return
+	}
+	d.logger.Info("checkpoint completed", "pod", result.Pod.Hex(), "checkpoint", result.CheckpointTimestamp, "shareDeltaWei", result.ShareDeltaWei)
+	d.alert(ctx, monitor.Alert{
+		Summary: fmt.Sprintf("checkpoint %d of pod %s completed", result.CheckpointTimestamp, result.Pod.Hex()),
+		Source:  "EigenPod " + result.Pod.Hex(),
+		Details: map[string]interface{}{
+			"checkpointTimestamp": result.CheckpointTimestamp,
+			"shareDeltaWei":       result.ShareDeltaWei.String(),
+			"validatorsProven":    result.Proven,
+			"reason":              result.Reason,
+		},
+	})
+}
+
+func (d *CheckpointDaemon) alert(ctx context.Context, alert monitor.Alert) {
+	if d.cfg.Alerter == nil {
+		return
+	}
+	if err := d.cfg.Alerter.Alert(ctx, alert); err != nil {
+		d.logger.Warn("failed to deliver alert", "summary", alert.Summary, "error", err)
+	}
+}
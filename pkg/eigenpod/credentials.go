@@ -0,0 +1,258 @@
+package eigenpod
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// rootHistoryWindow is how long the EIP-4788 contract keeps beacon block roots, after which
+// proofs against them can no longer be verified.
+const rootHistoryWindow = 8191 * beaconproofs.SecondsPerSlot * time.Second
+
+// farFutureEpoch is the exit epoch of validators that have not exited, as an SSZ chunk.
+var farFutureEpoch = [32]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// CredentialsConfig configures VerifyWithdrawalCredentials.
+type CredentialsConfig struct {
+	// BatchGasLimit is the gas limit of each transaction of proofs, DefaultBatchGasLimit if zero.
+	BatchGasLimit uint64
+	// MaxBatchSize is the maximum number of validators verified per transaction,
+	// DefaultMaxBatchSize if zero.
+	MaxBatchSize int
+	// Receipts configures waiting for the receipts of the transactions.
+	Receipts receipts.Options
+	// ProgressFile, if set, records the beacon state proven against and the batches submitted,
+	// so that an interrupted run resumes against the same state.
+	ProgressFile string
+	// OnProgress, if set, is called after each batch is mined.
+	OnProgress func(CredentialsProgress)
+	Logger     logging.Logger
+}
+
+// CredentialsProgress reports a mined batch of withdrawal credential proofs.
+type CredentialsProgress struct {
+	Batch, Batches int
+	// Verified is the number of validators verified so far, out of Total.
+	Verified, Total int
+	Tx              common.Hash
+}
+
+// CredentialsResult is the outcome of VerifyWithdrawalCredentials.
+type CredentialsResult struct {
+	// BlockRoot and BeaconTimestamp identify the beacon state the proofs were built against.
+	BlockRoot       common.Hash
+	BeaconTimestamp uint64
+	// Verified are the validators verified by this run, and Skipped those the pod had already
+	// verified.
+	Verified     []uint64
+	Skipped      []uint64
+	Transactions []common.Hash
+}
+
+// credentialsProgress is the content of CredentialsConfig.ProgressFile.
+type credentialsProgress struct {
+	Pod             common.Address `json:"pod"`
+	BlockRoot       common.Hash    `json:"blockRoot"`
+	BeaconTimestamp uint64         `json:"beaconTimestamp"`
+	Verified        []uint64       `json:"verified"`
+	Transactions    []common.Hash  `json:"transactions"`
+}
+
+// VerifyWithdrawalCredentials verifies the withdrawal credentials of the validators at indices
+// for pod. Their proofs are built against a single beacon state, the parent of the finalized
+// block, split into batches that fit cfg.BatchGasLimit and submitted one after the other.
+// Validators the pod already verified are skipped, so a failed run can be repeated; with
+// cfg.ProgressFile, it is resumed against the same state while its root is still available to
+// the pod.
+func VerifyWithdrawalCredentials(ctx context.Context, backend Backend, bc *beacon.Client, opts *bind.TransactOpts, pod common.Address, indices []uint64, cfg CredentialsConfig) (*CredentialsResult, error) {
+	if cfg.BatchGasLimit == 0 {
+		cfg.BatchGasLimit = DefaultBatchGasLimit
+	}
+	if cfg.MaxBatchSize == 0 {
+		cfg.MaxBatchSize = DefaultMaxBatchSize
+	}
+	logger := logging.OrNop(cfg.Logger)
+	eigenPod, err := EigenPod.NewEigenPod(pod, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := readCredentialsProgress(cfg.ProgressFile, pod)
+	if err != nil {
+		return nil, err
+	}
+	// Proofs must be against a state more recent than the active checkpoint, if any.
+	checkpointTimestamp, err := eigenPod.CurrentCheckpointTimestamp(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch current checkpoint: %w", err)
+	}
+	var prover *beaconproofs.Prover
+	if progress != nil && progress.BeaconTimestamp > checkpointTimestamp &&
+		time.Since(time.Unix(int64(progress.BeaconTimestamp), 0)) < rootHistoryWindow {
+		if prover, err = bc.ProverAt(ctx, progress.BlockRoot.Hex()); err != nil {
+			return nil, err
+		}
+		logger.Info("resuming withdrawal credential proofs", "pod", pod.Hex(), "blockRoot", progress.BlockRoot.Hex(), "verified", len(progress.Verified))
+	} else {
+		prover, progress, err = latestCredentialsState(ctx, bc, pod)
+		if err != nil {
+			return nil, err
+		}
+		if progress.BeaconTimestamp <= checkpointTimestamp {
+			return nil, fmt.Errorf("pod %s has a checkpoint started after the finalized block; retry once it completes", pod.Hex())
+		}
+		if err := writeCredentialsProgress(cfg.ProgressFile, progress); err != nil {
+			return nil, err
+		}
+	}
+	result := &CredentialsResult{BlockRoot: progress.BlockRoot, BeaconTimestamp: progress.BeaconTimestamp}
+
+	credentials := podCredentials(pod)
+	callOpts := &bind.CallOpts{Context: ctx}
+	var pending []uint64
+	for _, index := range mergeIndices(nil, indices) {
+		fields, err := prover.ValidatorFields(index)
+		if err != nil {
+			return nil, err
+		}
+		info, err := eigenPod.ValidatorPubkeyHashToInfo(callOpts, fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch validator %d: %w", index, err)
+		}
+		switch {
+		case info.Status != ValidatorInactive:
+			result.Skipped = append(result.Skipped, index)
+		case !bytes.Equal(fields[1][1:], credentials[1:]) || (fields[1][0] != 0x01 && fields[1][0] != 0x02):
+			return nil, fmt.Errorf("validator %d has withdrawal credentials %x, not those of pod %s", index, fields[1], pod.Hex())
+		case fields[6] != farFutureEpoch:
+			return nil, fmt.Errorf("validator %d has exited and can no longer be restaked", index)
+		default:
+			pending = append(pending, index)
+		}
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	transact := func(batch []uint64) func(*bind.TransactOpts) (*types.Transaction, error) {
+		return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			proofs, err := prover.WithdrawalCredentialProofs(batch)
+			if err != nil {
+				return nil, err
+			}
+			return eigenPod.VerifyWithdrawalCredentials(opts, progress.BeaconTimestamp, proofs.StateRootProof,
+				proofs.ValidatorIndices, proofs.ValidatorFieldsProofs, proofs.ValidatorFields)
+		}
+	}
+	batches, err := splitBatches(pending, cfg.MaxBatchSize, cfg.BatchGasLimit, func(batch []uint64) (uint64, error) {
+		return estimateGas(ctx, opts, transact(batch))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, batch := range batches {
+		receipt, err := send(ctx, backend, opts, cfg.Receipts, transact(batch))
+		if err != nil {
+			return result, fmt.Errorf("failed to submit batch %d of %d of withdrawal credential proofs: %w", i+1, len(batches), err)
+		}
+		result.Verified = append(result.Verified, batch...)
+		result.Transactions = append(result.Transactions, receipt.TxHash)
+		progress.Verified = append(progress.Verified, batch...)
+		progress.Transactions = append(progress.Transactions, receipt.TxHash)
+		if err := writeCredentialsProgress(cfg.ProgressFile, progress); err != nil {
+			return result, err
+		}
+		logger.Info("verified withdrawal credentials", "pod", pod.Hex(), "batch", i+1, "batches", len(batches),
+			"validators", len(batch), "tx", receipt.TxHash.Hex())
+		if cfg.OnProgress != nil {
+			cfg.OnProgress(CredentialsProgress{
+				Batch:    i + 1,
+				Batches:  len(batches),
+				Verified: len(result.Verified),
+				Total:    len(pending),
+				Tx:       receipt.TxHash,
+			})
+		}
+	}
+	return result, nil
+}
+
+// latestCredentialsState returns a prover of the parent of the finalized block, whose root
+// EIP-4788 exposes at the timestamp of the finalized block.
+func latestCredentialsState(ctx context.Context, bc *beacon.Client, pod common.Address) (*beaconproofs.Prover, *credentialsProgress, error) {
+	finalized, err := bc.Header(ctx, "finalized")
+	if err != nil {
+		return nil, nil, err
+	}
+	prover, err := bc.ProverAt(ctx, finalized.ParentRoot.Hex())
+	if err != nil {
+		return nil, nil, err
+	}
+	// BeaconTimestamp is the timestamp of the slot after the state's, which may have been missed.
+	missed := finalized.Slot - prover.Slot() - 1
+	return prover, &credentialsProgress{
+		Pod:             pod,
+		BlockRoot:       finalized.ParentRoot,
+		BeaconTimestamp: prover.BeaconTimestamp() + missed*beaconproofs.SecondsPerSlot,
+	}, nil
+}
+
+// podCredentials returns the 0x01 withdrawal credentials of pod. Validators with 0x02
+// credentials to the pod are accepted as well.
+func podCredentials(pod common.Address) [32]byte {
+	var credentials [32]byte
+	credentials[0] = 0x01
+	copy(credentials[12:], pod.Bytes())
+	return credentials
+}
+
+func readCredentialsProgress(path string, pod common.Address) (*credentialsProgress, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read progress: %w", err)
+	}
+	var progress credentialsProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("failed to decode progress %s: %w", path, err)
+	}
+	if progress.Pod != pod {
+		return nil, fmt.Errorf("progress %s is of pod %s, not %s", path, progress.Pod.Hex(), pod.Hex())
+	}
+	return &progress, nil
+}
+
+func writeCredentialsProgress(path string, progress *credentialsProgress) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write progress: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
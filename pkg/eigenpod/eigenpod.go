@@ -0,0 +1,184 @@
+// Package eigenpod automates the native restaking flows of EigenPods: withdrawal credential
+// proofs, and checkpoints and their proofs, built from a beacon node with pkg/beacon and
+// submitted in batches sized to a gas limit.
+//
+// CheckpointDaemon watches a set of pods, starts a checkpoint when one is profitable or needed,
+// and proves it to completion:
+//
+//	bc := beacon.NewClient(beaconURL, beacon.Config{CacheDir: cacheDir})
+//	daemon, err := eigenpod.NewCheckpointDaemon(ethClient, bc, opts, eigenpod.CheckpointConfig{
+//		Pods:      []common.Address{pod},
+//		FromBlock: podDeployBlock,
+//	})
+//	err = daemon.Run(ctx)
+//
+// VerifyWithdrawalCredentials restakes validators in a pod, proving their withdrawal
+// credentials against a single beacon state in as many transactions as the gas limit requires,
+// and resumes an interrupted run from its progress file.
+//
+// Reconcile compares the shares of pod owners with the balances of their validators on the
+// beacon chain, and reports pods whose shares are negative or stale, such as after a slashing on
+// the beacon chain, with the checkpoint or stale balance proof that updates them.
+//
+// On Pectra EigenPods, RequestConsolidations and RequestWithdrawals send the EIP-7251
+// consolidation and EIP-7002 withdrawal requests of the pod's validators, paying the fee of the
+// predeploys with a margin the pod refunds.
+package eigenpod
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logpager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// Defaults of the batching of proofs.
+const (
+	// DefaultBatchGasLimit is the default gas limit of a transaction submitting proofs, well
+	// below the block gas limit.
+	DefaultBatchGasLimit = 10_000_000
+	// DefaultMaxBatchSize is the default maximum number of validators proven per transaction.
+	DefaultMaxBatchSize = 80
+)
+
+// Statuses of the validators of a pod, as returned by EigenPod.validatorStatus.
+const (
+	ValidatorInactive  uint8 = 0
+	ValidatorActive    uint8 = 1
+	ValidatorWithdrawn uint8 = 2
+)
+
+// ErrBatchTooLarge is returned when a single proof needs more gas than the batch gas limit.
+var ErrBatchTooLarge = errors.New("proof exceeds the batch gas limit")
+
+// Backend is the chain access required to prove pods.
+type Backend interface {
+	bind.ContractBackend
+	receipts.Backend
+}
+
+const getEthBalanceABI = `[{"type":"function","name":"getEthBalance","stateMutability":"view","inputs":[{"name":"addr","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}]}]`
+
+var parsedGetEthBalanceABI = abis.MustParse(getEthBalanceABI)
+
+// balanceAt returns the ETH balance of account, read through Multicall3 so that it works with
+// backends that only make calls.
+func balanceAt(ctx context.Context, caller bind.ContractCaller, account common.Address) (*big.Int, error) {
+	multicall3 := bind.NewBoundContract(multicall.Multicall3Address, parsedGetEthBalanceABI, caller, nil, nil)
+	var out []interface{}
+	if err := multicall3.Call(&bind.CallOpts{Context: ctx}, &out, "getEthBalance", account); err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s: %w", account.Hex(), err)
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// PubkeyHash returns the hash under which an EigenPod stores the validator with the 48-byte BLS
+// public key pubkey: the SHA-256 of the key padded to 64 bytes.
+func PubkeyHash(pubkey []byte) [32]byte {
+	return sha256.Sum256(append(append([]byte{}, pubkey...), make([]byte, 16)...))
+}
+
+// RestakedValidators returns the indices of the validators whose withdrawal credentials were
+// verified for pod since fromBlock, in ascending order, including validators since withdrawn.
+func RestakedValidators(ctx context.Context, backend Backend, pod common.Address, fromBlock uint64) ([]uint64, error) {
+	return restakedValidators(ctx, backend, pod, fromBlock, nil)
+}
+
+func restakedValidators(ctx context.Context, backend Backend, pod common.Address, fromBlock uint64, toBlock *uint64) ([]uint64, error) {
+	filterer, err := EigenPod.NewEigenPodFilterer(pod, logpager.New(backend, logpager.Config{}))
+	if err != nil {
+		return nil, err
+	}
+	it, err := filterer.FilterValidatorRestaked(&bind.FilterOpts{Start: fromBlock, End: toBlock, Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch restaked validators of pod %s: %w", pod.Hex(), err)
+	}
+	defer it.Close()
+	var indices []uint64
+	for it.Next() {
+		indices = append(indices, it.Event.ValidatorIndex.Uint64())
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to fetch restaked validators of pod %s: %w", pod.Hex(), err)
+	}
+	return mergeIndices(nil, indices), nil
+}
+
+// mergeIndices returns the sorted union of the validator indices a and b.
+func mergeIndices(a, b []uint64) []uint64 {
+	seen := make(map[uint64]bool, len(a)+len(b))
+	merged := make([]uint64, 0, len(a)+len(b))
+	for _, index := range append(append([]uint64{}, a...), b...) {
+		if !seen[index] {
+			seen[index] = true
+			merged = append(merged, index)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i] < merged[j] })
+	return merged
+}
+
+// splitBatches splits items into consecutive batches of at most maxSize items whose
+// transactions, as estimated by estimate, need at most gasLimit gas. Batches that exceed the
+// limit, or whose estimation fails, are halved until they fit.
+func splitBatches[T any](items []T, maxSize int, gasLimit uint64, estimate func([]T) (uint64, error)) ([][]T, error) {
+	var batches [][]T
+	size := maxSize
+	for start := 0; start < len(items); {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		gas, err := estimate(items[start:end])
+		if err == nil && gas <= gasLimit {
+			batches = append(batches, items[start:end])
+			start = end
+			continue
+		}
+		if end-start == 1 {
+			if err != nil {
+				return nil, fmt.Errorf("failed to estimate gas: %w", err)
+			}
+			return nil, fmt.Errorf("%w: %d gas for a single proof, limit %d", ErrBatchTooLarge, gas, gasLimit)
+		}
+		size = (end - start) / 2
+	}
+	return batches, nil
+}
+
+// estimateGas returns the gas transact needs, without sending its transaction.
+func estimateGas(ctx context.Context, opts *bind.TransactOpts, transact func(*bind.TransactOpts) (*types.Transaction, error)) (uint64, error) {
+	estimateOpts := *opts
+	estimateOpts.Context = ctx
+	estimateOpts.NoSend = true
+	estimateOpts.GasLimit = 0
+	tx, err := transact(&estimateOpts)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Gas(), nil
+}
+
+// send sends the transaction of transact and waits for its receipt.
+func send(ctx context.Context, backend Backend, opts *bind.TransactOpts, wait receipts.Options, transact func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	sendOpts := *opts
+	sendOpts.Context = ctx
+	tx, err := transact(&sendOpts)
+	if err != nil {
+		return nil, err
+	}
+	return receipts.Wait(ctx, backend, tx, wait)
+}
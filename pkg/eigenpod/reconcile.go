@@ -0,0 +1,378 @@
+package eigenpod
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// Defaults of ReconcileConfig.
+const (
+	// DefaultStaleThresholdGwei is the default difference between a pod's balance on the beacon
+	// chain and in EigenLayer beyond which its shares are stale: 1 ETH.
+	DefaultStaleThresholdGwei = DefaultMinGainGwei
+	// DefaultCheckpointGrace is the default time a checkpoint may stay in progress before it is
+	// reported.
+	DefaultCheckpointGrace = time.Hour
+)
+
+const (
+	// reconcileBatchSize is the number of validators whose info is read per multicall.
+	reconcileBatchSize = 250
+	// validatorsPerRequest is the number of validators fetched per beacon node request, to keep
+	// its URL short.
+	validatorsPerRequest = 100
+)
+
+// Kinds of Finding.
+const (
+	// FindingNegativeShares is a pod owner whose shares are negative, from beacon chain
+	// penalties accounted for after its shares were withdrawn.
+	FindingNegativeShares = "negative shares"
+	// FindingSlashedValidator is a validator slashed on the beacon chain whose restaked balance
+	// does not account for it.
+	FindingSlashedValidator = "slashed validator"
+	// FindingExitedValidator is a validator with no balance left on the beacon chain that the
+	// pod still counts as active.
+	FindingExitedValidator = "exited validator"
+	// FindingStaleShares is a pod whose balance on the beacon chain and in the pod differs from
+	// its checkpointed balance by more than ReconcileConfig.StaleThresholdGwei.
+	FindingStaleShares = "stale shares"
+	// FindingCheckpointPending is a checkpoint in progress for longer than
+	// ReconcileConfig.CheckpointGrace, during which the shares of the pod are not updated.
+	FindingCheckpointPending = "checkpoint pending"
+)
+
+// Remediations suggested by Reconcile.
+const (
+	// RemediationStartCheckpoint is to start a checkpoint of the pod, which only its owner or
+	// proof submitter can do, and prove it.
+	RemediationStartCheckpoint = "start checkpoint"
+	// RemediationVerifyStaleBalance is to prove a slashed validator with verifyStaleBalance,
+	// which anyone can do, and which starts a checkpoint of the pod.
+	RemediationVerifyStaleBalance = "verify stale balance"
+	// RemediationCompleteCheckpoint is to prove the validators left in the checkpoint in
+	// progress.
+	RemediationCompleteCheckpoint = "complete checkpoint"
+)
+
+// ReconcileConfig configures Reconcile.
+type ReconcileConfig struct {
+	// FromBlock is the first block searched for the validators restaked in the pods, typically
+	// the block the oldest pod was deployed at.
+	FromBlock uint64
+	// StateID is the beacon state the validators are read from, "head" if empty.
+	StateID string
+	// StaleThresholdGwei is the difference, in gwei, between the balance of a pod on the beacon
+	// chain and in the pod and its checkpointed balance beyond which its shares are stale,
+	// DefaultStaleThresholdGwei if zero.
+	StaleThresholdGwei uint64
+	// CheckpointGrace is how long a checkpoint may stay in progress before it is reported,
+	// DefaultCheckpointGrace if zero.
+	CheckpointGrace time.Duration
+}
+
+// Reconciliation compares the shares of a pod in EigenLayer with the balances of its validators
+// on the beacon chain.
+type Reconciliation struct {
+	Pod   common.Address
+	Owner common.Address
+	// SharesWei are the shares of the owner in the EigenPodManager, negative if the owner owes
+	// shares.
+	SharesWei *big.Int
+	// CurrentCheckpointTimestamp is the checkpoint in progress, zero if none, and
+	// LastCheckpointTimestamp the last one completed.
+	CurrentCheckpointTimestamp uint64
+	LastCheckpointTimestamp    uint64
+	// RestakedGwei is the sum of the restaked balances of the active validators of the pod, as
+	// of their last checkpoint, and BeaconGwei the sum of their balances on the beacon chain.
+	RestakedGwei uint64
+	BeaconGwei   uint64
+	// WithdrawableGwei is the ETH of the pod already checkpointed, and PodBalanceGwei the ETH
+	// the pod holds.
+	WithdrawableGwei uint64
+	PodBalanceGwei   uint64
+	// Validators are the active validators of the pod.
+	Validators []ValidatorBalance
+	Findings   []Finding
+}
+
+// DeltaGwei is the change of the pod owner's shares, in gwei, that a checkpoint completed now
+// would make: the change of the beacon chain balances of the pod's active validators since their
+// last checkpoint plus the ETH the pod holds beyond what was checkpointed.
+func (r *Reconciliation) DeltaGwei() *big.Int {
+	delta := new(big.Int).SetUint64(r.BeaconGwei)
+	delta.Sub(delta, new(big.Int).SetUint64(r.RestakedGwei))
+	delta.Add(delta, new(big.Int).SetUint64(r.PodBalanceGwei))
+	return delta.Sub(delta, new(big.Int).SetUint64(r.WithdrawableGwei))
+}
+
+// ValidatorBalance is an active validator of a pod.
+type ValidatorBalance struct {
+	Index uint64
+	// RestakedGwei is the balance of the validator as of its last checkpoint, at
+	// LastCheckpointedAt.
+	RestakedGwei       uint64
+	LastCheckpointedAt uint64
+	// BeaconGwei is the balance of the validator on the beacon chain, Status its status there
+	// and Slashed whether it was slashed.
+	BeaconGwei uint64
+	Status     string
+	Slashed    bool
+}
+
+// Finding is a discrepancy found by Reconcile, with the remediation that resolves it.
+type Finding struct {
+	// Kind is one of the Finding constants.
+	Kind string
+	// Validators are the validators involved, if any.
+	Validators []uint64
+	Detail     string
+	// Remediation is one of the Remediation constants, or empty if no call to the pod resolves
+	// the finding.
+	Remediation string
+}
+
+// Reconcile compares the shares of the owners of pods in the EigenPodManager and the
+// checkpointed balances of the pods' validators with their balances on the beacon chain, and
+// reports the pods whose shares are negative or stale: validators slashed or exited on the
+// beacon chain that the pod still counts at their restaked balance, balances that changed by
+// more than cfg.StaleThresholdGwei since the last checkpoint, and checkpoints left in progress.
+// Each finding suggests the remediation that updates the shares.
+func Reconcile(ctx context.Context, backend Backend, bc *beacon.Client, pods []common.Address, cfg ReconcileConfig) ([]Reconciliation, error) {
+	if cfg.StateID == "" {
+		cfg.StateID = "head"
+	}
+	if cfg.StaleThresholdGwei == 0 {
+		cfg.StaleThresholdGwei = DefaultStaleThresholdGwei
+	}
+	if cfg.CheckpointGrace == 0 {
+		cfg.CheckpointGrace = DefaultCheckpointGrace
+	}
+	reconciliations := make([]Reconciliation, 0, len(pods))
+	for _, pod := range pods {
+		r, err := reconcile(ctx, backend, bc, pod, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile pod %s: %w", pod.Hex(), err)
+		}
+		reconciliations = append(reconciliations, *r)
+	}
+	return reconciliations, nil
+}
+
+func reconcile(ctx context.Context, backend Backend, bc *beacon.Client, pod common.Address, cfg ReconcileConfig) (*Reconciliation, error) {
+	head, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}
+	indices, err := restakedValidators(ctx, backend, pod, cfg.FromBlock, &head)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := multicall.NewBatch(backend)
+	eigenPod, err := EigenPod.NewEigenPodCaller(pod, batch)
+	if err != nil {
+		return nil, err
+	}
+	owner := multicall.Add(batch, eigenPod.PodOwner)
+	manager := multicall.Add(batch, eigenPod.EigenPodManager)
+	current := multicall.Add(batch, eigenPod.CurrentCheckpointTimestamp)
+	last := multicall.Add(batch, eigenPod.LastCheckpointTimestamp)
+	withdrawable := multicall.Add(batch, eigenPod.WithdrawableRestakedExecutionLayerGwei)
+	if err := batch.Execute(opts); err != nil {
+		return nil, fmt.Errorf("failed to read pod: %w", err)
+	}
+	r := &Reconciliation{Pod: pod}
+	if r.Owner, err = owner.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read pod owner: %w", err)
+	}
+	managerAddress, err := manager.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read EigenPodManager: %w", err)
+	}
+	if r.CurrentCheckpointTimestamp, err = current.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read current checkpoint: %w", err)
+	}
+	if r.LastCheckpointTimestamp, err = last.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read last checkpoint: %w", err)
+	}
+	if r.WithdrawableGwei, err = withdrawable.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read withdrawable balance: %w", err)
+	}
+	epm, err := EigenPodManager.NewEigenPodManagerCaller(managerAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	if r.SharesWei, err = epm.PodOwnerShares(opts, r.Owner); err != nil {
+		return nil, fmt.Errorf("failed to read shares of %s: %w", r.Owner.Hex(), err)
+	}
+	balance, err := balanceAt(ctx, backend, pod)
+	if err != nil {
+		return nil, err
+	}
+	r.PodBalanceGwei = new(big.Int).Div(balance, gweiPerWei).Uint64()
+
+	validators, err := beaconValidators(ctx, bc, cfg.StateID, indices)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := validatorInfos(ctx, backend, pod, validators, opts)
+	if err != nil {
+		return nil, err
+	}
+	var slashed, exited []uint64
+	for i, v := range validators {
+		if infos[i].Status != ValidatorActive {
+			continue
+		}
+		r.Validators = append(r.Validators, ValidatorBalance{
+			Index:              v.Index,
+			RestakedGwei:       infos[i].RestakedBalanceGwei,
+			LastCheckpointedAt: infos[i].LastCheckpointedAt,
+			BeaconGwei:         v.Balance,
+			Status:             v.Status,
+			Slashed:            v.Slashed,
+		})
+		r.RestakedGwei += infos[i].RestakedBalanceGwei
+		r.BeaconGwei += v.Balance
+		switch {
+		case v.Slashed && v.Balance < infos[i].RestakedBalanceGwei:
+			slashed = append(slashed, v.Index)
+		case v.Balance == 0:
+			exited = append(exited, v.Index)
+		}
+	}
+	r.findings(slashed, exited, cfg)
+	return r, nil
+}
+
+// findings sets the findings of r, given its slashed and exited validators.
+func (r *Reconciliation) findings(slashed, exited []uint64, cfg ReconcileConfig) {
+	checkpointing := r.CurrentCheckpointTimestamp != 0
+	// A checkpoint in progress accounts for every validator not proven yet, so completing it is
+	// the remediation of all findings.
+	remediation := func(otherwise string) string {
+		if checkpointing {
+			return RemediationCompleteCheckpoint
+		}
+		return otherwise
+	}
+	delta := r.DeltaGwei()
+
+	if r.SharesWei.Sign() < 0 {
+		f := Finding{Kind: FindingNegativeShares, Detail: fmt.Sprintf("pod owner %s has %s wei of shares", r.Owner.Hex(), r.SharesWei)}
+		if delta.Sign() > 0 {
+			f.Remediation = remediation(RemediationStartCheckpoint)
+		} else {
+			f.Detail += "; the deficit is repaid by restaking more ETH"
+		}
+		r.Findings = append(r.Findings, f)
+	}
+	if len(slashed) > 0 {
+		r.Findings = append(r.Findings, Finding{
+			Kind:        FindingSlashedValidator,
+			Validators:  slashed,
+			Detail:      fmt.Sprintf("%d validators were slashed on the beacon chain since their last checkpoint", len(slashed)),
+			Remediation: remediation(RemediationVerifyStaleBalance),
+		})
+	}
+	if len(exited) > 0 {
+		r.Findings = append(r.Findings, Finding{
+			Kind:        FindingExitedValidator,
+			Validators:  exited,
+			Detail:      fmt.Sprintf("%d validators exited the beacon chain but are still active in the pod", len(exited)),
+			Remediation: remediation(RemediationStartCheckpoint),
+		})
+	}
+	if new(big.Int).Abs(delta).Cmp(new(big.Int).SetUint64(cfg.StaleThresholdGwei)) >= 0 {
+		r.Findings = append(r.Findings, Finding{
+			Kind:        FindingStaleShares,
+			Detail:      fmt.Sprintf("a checkpoint would change the shares by %s gwei", delta),
+			Remediation: remediation(RemediationStartCheckpoint),
+		})
+	}
+	if checkpointing {
+		if age := time.Since(time.Unix(int64(r.CurrentCheckpointTimestamp), 0)); age > cfg.CheckpointGrace {
+			r.Findings = append(r.Findings, Finding{
+				Kind:        FindingCheckpointPending,
+				Detail:      fmt.Sprintf("checkpoint %d is in progress for %s", r.CurrentCheckpointTimestamp, age.Truncate(time.Minute)),
+				Remediation: RemediationCompleteCheckpoint,
+			})
+		}
+	}
+}
+
+// beaconValidators returns the validators at indices in the state stateID, in the same order,
+// fetched in chunks of validatorsPerRequest.
+func beaconValidators(ctx context.Context, bc *beacon.Client, stateID string, indices []uint64) ([]beacon.Validator, error) {
+	var validators []beacon.Validator
+	for start := 0; start < len(indices); start += validatorsPerRequest {
+		chunk := indices[start:min(start+validatorsPerRequest, len(indices))]
+		ids := make([]string, len(chunk))
+		for i, index := range chunk {
+			ids[i] = strconv.FormatUint(index, 10)
+		}
+		found, err := bc.Validators(ctx, stateID, ids...)
+		if err != nil {
+			return nil, err
+		}
+		byIndex := make(map[uint64]beacon.Validator, len(found))
+		for _, v := range found {
+			byIndex[v.Index] = v
+		}
+		for _, index := range chunk {
+			v, ok := byIndex[index]
+			if !ok {
+				return nil, fmt.Errorf("validator %d not found at state %s: %w", index, stateID, beacon.ErrNotFound)
+			}
+			validators = append(validators, v)
+		}
+	}
+	return validators, nil
+}
+
+// validatorInfos returns the info of validators in pod, in the same order.
+func validatorInfos(ctx context.Context, backend bind.ContractCaller, pod common.Address, validators []beacon.Validator, opts *bind.CallOpts) ([]EigenPod.IEigenPodValidatorInfo, error) {
+	infos := make([]EigenPod.IEigenPodValidatorInfo, 0, len(validators))
+	for start := 0; start < len(validators); start += reconcileBatchSize {
+		chunk := validators[start:min(start+reconcileBatchSize, len(validators))]
+		batch := multicall.NewBatch(backend)
+		eigenPod, err := EigenPod.NewEigenPodCaller(pod, batch)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]*multicall.Result[EigenPod.IEigenPodValidatorInfo], len(chunk))
+		for i, v := range chunk {
+			hash := PubkeyHash(v.Pubkey)
+			results[i] = multicall.Add(batch, func(opts *bind.CallOpts) (EigenPod.IEigenPodValidatorInfo, error) {
+				return eigenPod.ValidatorPubkeyHashToInfo(opts, hash)
+			})
+		}
+		if err := batch.Execute(opts); err != nil {
+			return nil, fmt.Errorf("failed to read validators: %w", err)
+		}
+		for i, v := range chunk {
+			info, err := results[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read validator %d: %w", v.Index, err)
+			}
+			if info.ValidatorIndex != v.Index {
+				return nil, fmt.Errorf("validator %d is recorded in the pod with index %d", v.Index, info.ValidatorIndex)
+			}
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
@@ -0,0 +1,268 @@
+package eigenpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// The execution layer requests of Pectra EigenPods, which forward them to the EIP-7002 and
+// EIP-7251 predeploys. The generated bindings predate them.
+const podRequestsABI = `[
+{"type":"function","name":"requestConsolidation","stateMutability":"payable","inputs":[{"name":"requests","type":"tuple[]","components":[{"name":"srcPubkey","type":"bytes"},{"name":"targetPubkey","type":"bytes"}]}],"outputs":[]},
+{"type":"function","name":"requestWithdrawal","stateMutability":"payable","inputs":[{"name":"requests","type":"tuple[]","components":[{"name":"pubkey","type":"bytes"},{"name":"amountGwei","type":"uint64"}]}],"outputs":[]},
+{"type":"function","name":"getConsolidationRequestFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"getWithdrawalRequestFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"event","name":"ConsolidationRequested","anonymous":false,"inputs":[{"name":"sourcePubkeyHash","type":"bytes32","indexed":true},{"name":"targetPubkeyHash","type":"bytes32","indexed":true}]},
+{"type":"event","name":"SwitchToCompoundingRequested","anonymous":false,"inputs":[{"name":"validatorPubkeyHash","type":"bytes32","indexed":true}]},
+{"type":"event","name":"WithdrawalRequested","anonymous":false,"inputs":[{"name":"validatorPubkeyHash","type":"bytes32","indexed":true},{"name":"withdrawalAmountGwei","type":"uint64","indexed":false}]},
+{"type":"event","name":"ExitRequested","anonymous":false,"inputs":[{"name":"validatorPubkeyHash","type":"bytes32","indexed":true}]}
+]`
+
+var parsedPodRequestsABI = abis.MustParse(podRequestsABI)
+
+// Defaults of RequestConfig.
+const (
+	// DefaultFeeMarginPercent is the default margin added to the request fee, which may rise by
+	// the time the transaction is mined. The pod refunds what it does not forward.
+	DefaultFeeMarginPercent = 20
+	// DefaultMaxConsolidationsPerTx and DefaultMaxWithdrawalsPerTx are the numbers of requests the
+	// predeploys dequeue per block; larger batches queue and raise the fee of later blocks.
+	DefaultMaxConsolidationsPerTx = 2
+	DefaultMaxWithdrawalsPerTx    = 16
+)
+
+// blsPubkeySize is the size of validator public keys.
+const blsPubkeySize = 48
+
+// ConsolidationRequest requests that the balance of the validator Source be moved to Target.
+// A request whose Source and Target are the same switches the validator to compounding (0x02)
+// withdrawal credentials instead.
+type ConsolidationRequest struct {
+	Source []byte
+	Target []byte
+}
+
+// WithdrawalRequest requests a withdrawal of AmountGwei from the validator Pubkey, whose
+// credentials must be compounding. A zero AmountGwei requests a full exit.
+type WithdrawalRequest struct {
+	Pubkey     []byte
+	AmountGwei uint64
+}
+
+// abi types of the requests.
+type consolidationRequest struct {
+	SrcPubkey    []byte `abi:"srcPubkey"`
+	TargetPubkey []byte `abi:"targetPubkey"`
+}
+
+type withdrawalRequest struct {
+	Pubkey     []byte `abi:"pubkey"`
+	AmountGwei uint64 `abi:"amountGwei"`
+}
+
+// RequestConfig configures RequestConsolidations and RequestWithdrawals.
+type RequestConfig struct {
+	// FeeMarginPercent is added to the request fee, DefaultFeeMarginPercent if zero. Negative
+	// pays the exact fee.
+	FeeMarginPercent int
+	// MaxPerTx is the maximum number of requests per transaction, DefaultMaxConsolidationsPerTx
+	// or DefaultMaxWithdrawalsPerTx if zero.
+	MaxPerTx int
+	// BatchGasLimit is the gas limit of each transaction, DefaultBatchGasLimit if zero.
+	BatchGasLimit uint64
+	// Receipts configures waiting for the receipts of the transactions.
+	Receipts receipts.Options
+	Logger   logging.Logger
+}
+
+// RequestResult is a mined transaction of requests.
+type RequestResult struct {
+	Tx common.Hash
+	// Fee is the fee per request the pod was sent, margin included.
+	Fee *big.Int
+	// Consolidations, SwitchesToCompounding, Withdrawals and Exits hold the public key hashes of
+	// the requests emitted by the pod, with the amount of the withdrawals.
+	Consolidations        []ConsolidationRequested
+	SwitchesToCompounding []common.Hash
+	Withdrawals           []WithdrawalRequested
+	Exits                 []common.Hash
+}
+
+// ConsolidationRequested is a consolidation emitted by a pod.
+type ConsolidationRequested struct {
+	SourcePubkeyHash common.Hash
+	TargetPubkeyHash common.Hash
+}
+
+// WithdrawalRequested is a partial withdrawal emitted by a pod.
+type WithdrawalRequested struct {
+	PubkeyHash common.Hash
+	AmountGwei uint64
+}
+
+// ConsolidationRequestFee returns the current fee of a consolidation request of pod.
+func ConsolidationRequestFee(ctx context.Context, caller bind.ContractCaller, pod common.Address) (*big.Int, error) {
+	return requestFee(ctx, caller, pod, "getConsolidationRequestFee")
+}
+
+// WithdrawalRequestFee returns the current fee of a withdrawal request of pod.
+func WithdrawalRequestFee(ctx context.Context, caller bind.ContractCaller, pod common.Address) (*big.Int, error) {
+	return requestFee(ctx, caller, pod, "getWithdrawalRequestFee")
+}
+
+func requestFee(ctx context.Context, caller bind.ContractCaller, pod common.Address, method string) (*big.Int, error) {
+	contract := bind.NewBoundContract(pod, parsedPodRequestsABI, caller, nil, nil)
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, method); err != nil {
+		return nil, fmt.Errorf("failed to fetch request fee of pod %s: %w", pod.Hex(), err)
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// RequestConsolidations sends the consolidation requests of pod in batches, paying the current
+// fee of each batch. The signer must be the pod owner or its proof submitter.
+func RequestConsolidations(ctx context.Context, backend Backend, opts *bind.TransactOpts, pod common.Address, requests []ConsolidationRequest, cfg RequestConfig) ([]*RequestResult, error) {
+	args := make([]consolidationRequest, len(requests))
+	for i, r := range requests {
+		if len(r.Source) != blsPubkeySize || len(r.Target) != blsPubkeySize {
+			return nil, fmt.Errorf("consolidation request %d: public keys must be %d bytes", i, blsPubkeySize)
+		}
+		args[i] = consolidationRequest{SrcPubkey: r.Source, TargetPubkey: r.Target}
+	}
+	if cfg.MaxPerTx == 0 {
+		cfg.MaxPerTx = DefaultMaxConsolidationsPerTx
+	}
+	return sendRequests(ctx, backend, opts, pod, args, "requestConsolidation", "getConsolidationRequestFee", cfg)
+}
+
+// RequestWithdrawals sends the withdrawal and exit requests of pod in batches, paying the
+// current fee of each batch. The signer must be the pod owner or its proof submitter.
+func RequestWithdrawals(ctx context.Context, backend Backend, opts *bind.TransactOpts, pod common.Address, requests []WithdrawalRequest, cfg RequestConfig) ([]*RequestResult, error) {
+	args := make([]withdrawalRequest, len(requests))
+	for i, r := range requests {
+		if len(r.Pubkey) != blsPubkeySize {
+			return nil, fmt.Errorf("withdrawal request %d: public key must be %d bytes", i, blsPubkeySize)
+		}
+		args[i] = withdrawalRequest{Pubkey: r.Pubkey, AmountGwei: r.AmountGwei}
+	}
+	if cfg.MaxPerTx == 0 {
+		cfg.MaxPerTx = DefaultMaxWithdrawalsPerTx
+	}
+	return sendRequests(ctx, backend, opts, pod, args, "requestWithdrawal", "getWithdrawalRequestFee", cfg)
+}
+
+func sendRequests[T any](ctx context.Context, backend Backend, opts *bind.TransactOpts, pod common.Address, requests []T, method, feeMethod string, cfg RequestConfig) ([]*RequestResult, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("no requests")
+	}
+	if cfg.FeeMarginPercent == 0 {
+		cfg.FeeMarginPercent = DefaultFeeMarginPercent
+	}
+	if cfg.FeeMarginPercent < 0 {
+		cfg.FeeMarginPercent = 0
+	}
+	if cfg.BatchGasLimit == 0 {
+		cfg.BatchGasLimit = DefaultBatchGasLimit
+	}
+	logger := logging.OrNop(cfg.Logger)
+	contract := bind.NewBoundContract(pod, parsedPodRequestsABI, backend, backend, backend)
+
+	// fee returns the fee per request with its margin.
+	fee := func() (*big.Int, error) {
+		fee, err := requestFee(ctx, backend, pod, feeMethod)
+		if err != nil {
+			return nil, err
+		}
+		fee.Mul(fee, big.NewInt(int64(100+cfg.FeeMarginPercent)))
+		return fee.Div(fee, big.NewInt(100)), nil
+	}
+	transact := func(batch []T, feePerRequest *big.Int) func(*bind.TransactOpts) (*types.Transaction, error) {
+		return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = new(big.Int).Mul(feePerRequest, big.NewInt(int64(len(batch))))
+			return contract.Transact(opts, method, batch)
+		}
+	}
+	estimateFee, err := fee()
+	if err != nil {
+		return nil, err
+	}
+	batches, err := splitBatches(requests, cfg.MaxPerTx, cfg.BatchGasLimit, func(batch []T) (uint64, error) {
+		return estimateGas(ctx, opts, transact(batch, estimateFee))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*RequestResult
+	for i, batch := range batches {
+		// The fee rises with the requests queued by earlier batches.
+		feePerRequest, err := fee()
+		if err != nil {
+			return results, err
+		}
+		receipt, err := send(ctx, backend, opts, cfg.Receipts, transact(batch, feePerRequest))
+		if err != nil {
+			return results, fmt.Errorf("failed to send batch %d of %d of %s: %w", i+1, len(batches), method, err)
+		}
+		result, err := parseRequestResult(receipt, pod, feePerRequest)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+		logger.Info("sent execution layer requests", "pod", pod.Hex(), "method", method, "batch", i+1, "batches", len(batches),
+			"requests", len(batch), "feePerRequest", feePerRequest, "tx", receipt.TxHash.Hex())
+	}
+	return results, nil
+}
+
+// parseRequestResult decodes the request events of pod in receipt.
+func parseRequestResult(receipt *types.Receipt, pod common.Address, fee *big.Int) (*RequestResult, error) {
+	events := parsedPodRequestsABI.Events
+	result := &RequestResult{Tx: receipt.TxHash, Fee: fee}
+	for _, log := range receipt.Logs {
+		if log.Address != pod || len(log.Topics) == 0 {
+			continue
+		}
+		switch log.Topics[0] {
+		case events["ConsolidationRequested"].ID:
+			if len(log.Topics) == 3 {
+				result.Consolidations = append(result.Consolidations, ConsolidationRequested{
+					SourcePubkeyHash: log.Topics[1],
+					TargetPubkeyHash: log.Topics[2],
+				})
+			}
+		case events["SwitchToCompoundingRequested"].ID:
+			if len(log.Topics) == 2 {
+				result.SwitchesToCompounding = append(result.SwitchesToCompounding, log.Topics[1])
+			}
+		case events["ExitRequested"].ID:
+			if len(log.Topics) == 2 {
+				result.Exits = append(result.Exits, log.Topics[1])
+			}
+		case events["WithdrawalRequested"].ID:
+			if len(log.Topics) != 2 {
+				continue
+			}
+			values, err := parsedPodRequestsABI.Unpack("WithdrawalRequested", log.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode WithdrawalRequested event: %w", err)
+			}
+			result.Withdrawals = append(result.Withdrawals, WithdrawalRequested{
+				PubkeyHash: log.Topics[1],
+				AmountGwei: values[0].(uint64),
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package errors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// errorSelector and panicSelector are the selectors of Error(string) and Panic(uint256).
+var (
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// ErrReverted is matched by every *RevertError.
+var ErrReverted = errors.New("execution reverted")
+
+// RevertError is a decoded revert.
+type RevertError struct {
+	// Reason is the original revert string. For custom errors it is the error's
+	// signature with its decoded arguments, and for panics it describes the panic.
+	Reason string
+	// Data is the raw revert data, if the backend returned it.
+	Data []byte
+	// Err is the typed error for Reason, or nil if the revert is not recognised.
+	// Custom errors are not mapped to typed errors; match them on Custom.Name.
+	Err error
+	// Custom is the ABI definition of the custom error that was decoded, if any.
+	Custom *abi.Error
+	// Args are the decoded arguments of Custom.
+	Args []interface{}
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return ErrReverted.Error()
+	}
+	return ErrReverted.Error() + ": " + e.Reason
+}
+
+// Unwrap returns ErrReverted and, if the revert was recognised, its typed error.
+func (e *RevertError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrReverted}
+	}
+	return []error{e.Err, ErrReverted}
+}
+
+// Decode converts a reverted call's error into a *RevertError. Errors that do not carry
+// revert data or an "execution reverted" message are returned unchanged, as is nil.
+func Decode(err error) error {
+	if err == nil {
+		return nil
+	}
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return err
+	}
+
+	var dataErr interface{ ErrorData() interface{} }
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if raw, decodeErr := hexutil.Decode(data); decodeErr == nil && len(raw) > 0 {
+				return DecodeRevertData(raw)
+			}
+		}
+	}
+
+	// Some backends only surface the reason in the error message.
+	msg := err.Error()
+	if i := strings.Index(msg, "execution reverted: "); i >= 0 {
+		return FromReason(msg[i+len("execution reverted: "):])
+	}
+	if strings.Contains(msg, "execution reverted") {
+		return &RevertError{}
+	}
+	return err
+}
+
+// DecodeRevertData decodes raw revert data as an Error(string), a Panic(uint256) or a custom
+// error declared by any contract in pkg/bindings.
+func DecodeRevertData(data []byte) *RevertError {
+	if len(data) < 4 {
+		return &RevertError{Data: data}
+	}
+
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			break
+		}
+		revertErr := FromReason(reason)
+		revertErr.Data = data
+		return revertErr
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) != 4+32 {
+			break
+		}
+		code := new(big.Int).SetBytes(data[4:])
+		if !code.IsUint64() {
+			break
+		}
+		panicErr := ErrPanic{Code: code.Uint64()}
+		return &RevertError{Reason: panicErr.Error(), Data: data, Err: panicErr}
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	if custom, ok := customErrors()[selector]; ok {
+		if args, err := custom.Unpack(data); err == nil {
+			values, _ := args.([]interface{})
+			return &RevertError{
+				Reason: formatCustom(custom, values),
+				Data:   data,
+				Custom: custom,
+				Args:   values,
+			}
+		}
+	}
+	return &RevertError{Data: data}
+}
+
+// FromReason returns the *RevertError for an Error(string) revert with the given reason.
+func FromReason(reason string) *RevertError {
+	return &RevertError{Reason: reason, Err: reasons[reason]}
+}
+
+var (
+	customErrorsOnce  sync.Once
+	customErrorsIndex map[[4]byte]*abi.Error
+)
+
+// customErrors indexes the custom errors declared in the ABIs of every binding by selector.
+func customErrors() map[[4]byte]*abi.Error {
+	customErrorsOnce.Do(func() {
+		customErrorsIndex = make(map[[4]byte]*abi.Error)
+		for _, metaData := range abis.MetaData {
+			parsed, err := metaData.GetAbi()
+			if err != nil {
+				continue
+			}
+			for _, abiErr := range parsed.Errors {
+				abiErr := abiErr
+				var selector [4]byte
+				copy(selector[:], abiErr.ID[:4])
+				customErrorsIndex[selector] = &abiErr
+			}
+		}
+	})
+	return customErrorsIndex
+}
+
+// formatCustom renders a custom error as its name followed by its arguments.
+func formatCustom(custom *abi.Error, args []interface{}) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = fmt.Sprint(arg)
+	}
+	return custom.Name + "(" + strings.Join(parts, ", ") + ")"
+}
@@ -0,0 +1,137 @@
+// Package errors decodes revert data returned by calls against the bindings in pkg/bindings
+// into typed Go errors.
+//
+// Decode wraps a reverted call's error in a *RevertError, which preserves the original revert
+// string and unwraps to one of the errors below when the revert is recognised:
+//
+//	_, err := strategyManager.DepositIntoStrategy(opts, strategy, token, amount)
+//	if errors.Is(elerrors.Decode(err), elerrors.ErrMaxPerDepositExceeded) {
+//		...
+//	}
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors for the common reverts of the EigenLayer core contracts.
+var (
+	ErrMaxPerDepositExceeded    = errors.New("max per deposit exceeded")
+	ErrMaxTotalDepositsExceeded = errors.New("max total deposits exceeded")
+	ErrSharesFrozen             = errors.New("shares are frozen")
+	ErrZeroNewShares            = errors.New("deposit mints zero shares")
+	ErrWrongToken               = errors.New("token is not the strategy's underlying token")
+	ErrStrategyNotWhitelisted   = errors.New("strategy not whitelisted for deposit")
+	ErrSignatureExpired         = errors.New("signature expired")
+	ErrSaltSpent                = errors.New("salt already spent")
+	ErrNotPauser                = errors.New("caller is not a pauser")
+	ErrNotUnpauser              = errors.New("caller is not the unpauser")
+	ErrOperatorNotRegistered    = errors.New("operator not registered")
+	ErrAlreadyRegisteredToAVS   = errors.New("operator already registered to AVS")
+	ErrNotRegisteredToAVS       = errors.New("operator not registered to AVS")
+	ErrAlreadyDelegated         = errors.New("staker already delegated")
+	ErrNotDelegated             = errors.New("staker not delegated")
+	ErrWithdrawalNotQueued      = errors.New("withdrawal not queued")
+	ErrWithdrawalDelayNotPassed = errors.New("withdrawal delay has not passed")
+	ErrWithdrawerMustBeStaker   = errors.New("withdrawer must be staker")
+	ErrInputLengthMismatch      = errors.New("input length mismatch")
+	ErrRootNotActivated         = errors.New("rewards root not activated")
+	ErrRootDisabled             = errors.New("rewards root disabled")
+)
+
+// ErrCurrentlyPaused is returned for calls rejected by Pausable's pause checks.
+//
+// Index is the pause flag that blocked the call, or -1 if the whole contract is paused or
+// the revert does not identify the flag. Pausable's revert strings never include the index,
+// so callers that know which flag guards the method may set it themselves.
+type ErrCurrentlyPaused struct {
+	Index int
+}
+
+func (e ErrCurrentlyPaused) Error() string {
+	if e.Index < 0 {
+		return "currently paused"
+	}
+	return fmt.Sprintf("currently paused: index %d", e.Index)
+}
+
+// Is reports whether target is an ErrCurrentlyPaused, so errors.Is(err, ErrCurrentlyPaused{})
+// matches regardless of Index.
+func (e ErrCurrentlyPaused) Is(target error) bool {
+	_, ok := target.(ErrCurrentlyPaused)
+	return ok
+}
+
+// ErrPanic is returned for reverts raised by a Solidity Panic(uint256).
+type ErrPanic struct {
+	Code uint64
+}
+
+func (e ErrPanic) Error() string {
+	if desc, ok := panicCodes[e.Code]; ok {
+		return fmt.Sprintf("panic 0x%02x: %s", e.Code, desc)
+	}
+	return fmt.Sprintf("panic 0x%02x", e.Code)
+}
+
+// panicCodes describes the panic codes emitted by the Solidity compiler.
+var panicCodes = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// reasons maps revert strings to the error they decode to.
+var reasons = map[string]error{
+	"StrategyBaseTVLLimits: max per deposit exceeded":                               ErrMaxPerDepositExceeded,
+	"StrategyBaseTVLLimits: max deposits exceeded":                                  ErrMaxTotalDepositsExceeded,
+	"StrategyManager._requireSharesNotFrozen: staker shares are frozen":             ErrSharesFrozen,
+	"StrategyBase.deposit: newShares cannot be zero":                                ErrZeroNewShares,
+	"StrategyBase.deposit: Can only deposit underlyingToken":                        ErrWrongToken,
+	"StrategyBase.withdraw: Can only withdraw the strategy token":                   ErrWrongToken,
+	"EigenStrategy.deposit: Can only deposit bEIGEN or EIGEN":                       ErrWrongToken,
+	"EigenStrategy.withdraw: Can only withdraw bEIGEN or EIGEN":                     ErrWrongToken,
+	"StrategyManager.onlyStrategiesWhitelistedForDeposit: strategy not whitelisted": ErrStrategyNotWhitelisted,
+
+	"Pausable: index is paused":                                      ErrCurrentlyPaused{Index: -1},
+	"Pausable: contract is paused":                                   ErrCurrentlyPaused{Index: -1},
+	"EigenPod.onlyWhenNotPaused: index is paused in EigenPodManager": ErrCurrentlyPaused{Index: -1},
+	"msg.sender is not permissioned as pauser":                       ErrNotPauser,
+	"msg.sender is not permissioned as unpauser":                     ErrNotUnpauser,
+
+	"StrategyManager.depositIntoStrategyWithSignature: signature expired": ErrSignatureExpired,
+	"DelegationManager._delegate: approver signature expired":             ErrSignatureExpired,
+	"DelegationManager.delegateToBySignature: staker signature expired":   ErrSignatureExpired,
+	"AVSDirectory.registerOperatorToAVS: operator signature expired":      ErrSignatureExpired,
+	"DelegationManager._delegate: approverSalt already spent":             ErrSaltSpent,
+	"AVSDirectory.registerOperatorToAVS: salt already spent":              ErrSaltSpent,
+	"AVSDirectory.cancelSalt: cannot cancel spent salt":                   ErrSaltSpent,
+
+	"DelegationManager.delegateTo: operator is not registered in EigenLayer":            ErrOperatorNotRegistered,
+	"DelegationManager.delegateToBySignature: operator is not registered in EigenLayer": ErrOperatorNotRegistered,
+	"AVSDirectory.registerOperatorToAVS: operator not registered to EigenLayer yet":     ErrOperatorNotRegistered,
+	"AVSDirectory.registerOperatorToAVS: operator already registered":                   ErrAlreadyRegisteredToAVS,
+	"AVSDirectory.deregisterOperatorFromAVS: operator not registered":                   ErrNotRegisteredToAVS,
+	"DelegationManager.delegateTo: staker is already actively delegated":                ErrAlreadyDelegated,
+	"DelegationManager.delegateToBySignature: staker is already actively delegated":     ErrAlreadyDelegated,
+	"DelegationManager.registerAsOperator: caller is already actively delegated":        ErrAlreadyDelegated,
+	"DelegationManager.undelegate: staker must be delegated to undelegate":              ErrNotDelegated,
+
+	"DelegationManager._completeQueuedWithdrawal: action is not in queue":                                                                  ErrWithdrawalNotQueued,
+	"DelegationManager._completeQueuedWithdrawal: minWithdrawalDelayBlocks period has not yet passed":                                      ErrWithdrawalDelayNotPassed,
+	"DelegationManager._completeQueuedWithdrawal: withdrawalDelayBlocks period has not yet passed for this strategy":                       ErrWithdrawalDelayNotPassed,
+	"DelegationManager.queueWithdrawal: withdrawer must be staker":                                                                         ErrWithdrawerMustBeStaker,
+	"DelegationManager._removeSharesAndQueueWithdrawal: withdrawer must be same address as staker if thirdPartyTransfersForbidden are set": ErrWithdrawerMustBeStaker,
+	"DelegationManager.queueWithdrawal: input length mismatch":                                                                             ErrInputLengthMismatch,
+	"DelegationManager._completeQueuedWithdrawal: input length mismatch":                                                                   ErrInputLengthMismatch,
+
+	"RewardsCoordinator._checkClaim: root not activated yet": ErrRootNotActivated,
+	"RewardsCoordinator._checkClaim: root is disabled":       ErrRootDisabled,
+}
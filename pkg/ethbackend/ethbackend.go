@@ -0,0 +1,20 @@
+// Package ethbackend defines Backend, the chain access used by the EigenLayer client and wrapped
+// by the middleware backends, such as those of pkg/retry, pkg/failover and pkg/logging. It imports
+// nothing from this module, so that any package can depend on it.
+package ethbackend
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Backend is the chain access required by the EigenLayer client. Both *ethclient.Client and
+// the simulated backend's client satisfy it.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
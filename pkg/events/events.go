@@ -0,0 +1,377 @@
+// Package events streams contract events with reorg handling.
+//
+// The Watch methods of the generated bindings forward every log as it arrives and leave reorgs to
+// the caller. A Stream instead polls the chain, remembers the hash of every block it has seen
+// events in until that block is Confirmations deep, and reports events dropped by a reorg as
+// Removed. Events that reach the confirmation depth are reported as Finalized and are not
+// tracked further.
+//
+// A Stream works with the Parse method of any event in the bindings:
+//
+//	query, _ := events.Query(dmAddress, "DelegationManager", "OperatorSharesIncreased")
+//	stream := events.NewStream(client, query, filterer.ParseOperatorSharesIncreased, events.Config{Confirmations: 12})
+//	sub := stream.Watch(sink)
+//
+// Reconnect keeps a websocket subscription created through a binding's Watch method alive
+// across dropped connections, catching up on missed events through its Filter method.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// DefaultPollInterval is the default interval at which a Stream checks for new blocks, one slot.
+const DefaultPollInterval = 12 * time.Second
+
+// ErrReorgTooDeep is returned when a reorg replaces a block the Stream has already finalized.
+var ErrReorgTooDeep = errors.New("reorg deeper than confirmation depth")
+
+// Backend is the chain access required by Stream.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Kind is the kind of a Notification.
+type Kind int
+
+const (
+	// Added reports an event in a block that is not yet Confirmations deep.
+	Added Kind = iota
+	// Removed reports that a previously Added event was dropped by a reorg.
+	Removed
+	// Finalized reports an event whose block is Confirmations deep. Events first seen in a
+	// block that is already Confirmations deep are only reported as Finalized.
+	Finalized
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Finalized:
+		return "finalized"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Notification reports a change to the state of an event. Log.Removed is set on Removed
+// notifications.
+type Notification[T any] struct {
+	Kind  Kind
+	Event *T
+	Log   types.Log
+}
+
+// Config configures a Stream.
+type Config struct {
+	// FromBlock is the first block streamed. Zero starts at the chain head when the Stream starts.
+	FromBlock uint64
+	// Confirmations is the number of blocks built on top of an event's block before it is
+	// Finalized. Zero finalizes events as soon as they are seen.
+	Confirmations uint64
+	// PollInterval is how often the Stream checks for new blocks. It defaults to DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Query returns a filter query for the event named event of contract deployed at address, where
+// contract is the name of its binding in pkg/bindings.
+func Query(address common.Address, contract, event string) (ethereum.FilterQuery, error) {
+	parsed, err := abis.ABI(contract)
+	if err != nil {
+		return ethereum.FilterQuery{}, err
+	}
+	ev, ok := parsed.Events[event]
+	if !ok {
+		return ethereum.FilterQuery{}, fmt.Errorf("event %s not found in %s ABI", event, contract)
+	}
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{{ev.ID}},
+	}, nil
+}
+
+// Stream streams the events matching a filter query, parsed into T.
+type Stream[T any] struct {
+	backend Backend
+	query   ethereum.FilterQuery
+	parse   func(types.Log) (*T, error)
+	cfg     Config
+
+	// next is the next block to fetch logs from. Zero means the stream has not started.
+	next uint64
+	// finalized is the highest block whose events have been Finalized.
+	finalized uint64
+	// hashes holds the hash of every fetched block from finalized onwards. The finalized block
+	// itself has no hash if it was already Confirmations deep when fetched.
+	hashes map[uint64]common.Hash
+	// pending holds the Added events that are not yet Finalized, in log order.
+	pending []Notification[T]
+}
+
+// NewStream returns a Stream of the logs matching query, parsed with parse. The FromBlock,
+// ToBlock and BlockHash of query are ignored.
+func NewStream[T any](backend Backend, query ethereum.FilterQuery, parse func(types.Log) (*T, error), cfg Config) *Stream[T] {
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	query.FromBlock, query.ToBlock, query.BlockHash = nil, nil, nil
+	s := &Stream[T]{
+		backend: backend,
+		query:   query,
+		parse:   parse,
+		cfg:     cfg,
+		next:    cfg.FromBlock,
+		hashes:  make(map[uint64]common.Hash),
+	}
+	if s.next > 0 {
+		s.finalized = s.next - 1
+	}
+	return s
+}
+
+// Watch polls the chain every Config.PollInterval and delivers notifications to sink until the
+// subscription is unsubscribed or polling fails.
+func (s *Stream[T]) Watch(sink chan<- *Notification[T]) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			notifications, err := s.Poll(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			for _, n := range notifications {
+				select {
+				case sink <- n:
+				case <-quit:
+					return nil
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+// Poll checks the chain once and returns the resulting notifications in order: Removed events
+// first, newest first, then Added and Finalized events in log order.
+func (s *Stream[T]) Poll(ctx context.Context) ([]*Notification[T], error) {
+	head, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain head: %w", err)
+	}
+	headNumber := head.Number.Uint64()
+	if s.next == 0 {
+		s.next = headNumber
+		if s.next == 0 {
+			s.next = 1
+		}
+		s.finalized = s.next - 1
+	}
+
+	notifications, err := s.unwind(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if headNumber >= s.next {
+		added, err := s.fetch(ctx, headNumber)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, added...)
+	}
+	return append(notifications, s.finalize(headNumber)...), nil
+}
+
+// unwind detects a reorg of the tracked blocks, removing the events in reorganized blocks.
+func (s *Stream[T]) unwind(ctx context.Context) ([]*Notification[T], error) {
+	fork := s.next - 1
+	for ; fork >= s.finalized; fork-- {
+		hash, ok := s.hashes[fork]
+		if !ok {
+			break
+		}
+		// A block beyond the head of a shorter new chain is not found, and counts as replaced.
+		header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(fork))
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("failed to get header %d: %w", fork, err)
+		}
+		if err == nil && header.Hash() == hash {
+			break
+		}
+		if fork == s.finalized {
+			return nil, ErrReorgTooDeep
+		}
+	}
+	if fork == s.next-1 {
+		return nil, nil
+	}
+	return s.removeAfter(fork), nil
+}
+
+// removeAfter drops the events and hashes of every block after fork and rewinds to fork+1.
+func (s *Stream[T]) removeAfter(fork uint64) []*Notification[T] {
+	var removed []*Notification[T]
+	kept := s.pending[:0]
+	for _, n := range s.pending {
+		if n.Log.BlockNumber <= fork {
+			kept = append(kept, n)
+			continue
+		}
+		removed = append(removed, &Notification[T]{Kind: Removed, Event: n.Event, Log: n.Log})
+	}
+	s.pending = kept
+	for number := range s.hashes {
+		if number > fork {
+			delete(s.hashes, number)
+		}
+	}
+	s.next = fork + 1
+
+	// Report the newest events first, so consumers undo them in reverse order.
+	sort.SliceStable(removed, func(i, j int) bool { return logBefore(removed[j].Log, removed[i].Log) })
+	for _, n := range removed {
+		n.Log.Removed = true
+	}
+	return removed
+}
+
+// fetch fetches the events from s.next to head, recording the hash of every fetched block that
+// is not yet Confirmations deep.
+func (s *Stream[T]) fetch(ctx context.Context, head uint64) ([]*Notification[T], error) {
+	confirmedAt := uint64(0)
+	if head >= s.cfg.Confirmations {
+		confirmedAt = head - s.cfg.Confirmations
+	}
+
+	// Record the hashes of unconfirmed blocks before fetching their logs, so that logs from a
+	// competing fork are detected below.
+	hashes := make(map[uint64]common.Hash)
+	start := s.next
+	if confirmedAt+1 > start {
+		start = confirmedAt + 1
+	}
+	for number := start; number <= head; number++ {
+		header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header %d: %w", number, err)
+		}
+		hashes[number] = header.Hash()
+	}
+
+	query := s.query
+	query.FromBlock = new(big.Int).SetUint64(s.next)
+	query.ToBlock = new(big.Int).SetUint64(head)
+	logs, err := s.backend.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter logs in blocks %d-%d: %w", s.next, head, err)
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logBefore(logs[i], logs[j]) })
+
+	// A log whose block hash differs from the recorded one was fetched from a different fork
+	// than the headers. Stop before its block and fetch the rest on the next poll.
+	end := head
+	for _, log := range logs {
+		if hash, ok := hashes[log.BlockNumber]; ok && hash != log.BlockHash {
+			end = log.BlockNumber - 1
+			break
+		}
+	}
+
+	var added []*Notification[T]
+	for _, log := range logs {
+		if log.BlockNumber > end || log.Removed {
+			continue
+		}
+		ev, err := s.parse(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log %d of tx %s: %w", log.Index, log.TxHash, err)
+		}
+		n := Notification[T]{Kind: Added, Event: ev, Log: log}
+		if log.BlockNumber <= confirmedAt {
+			n.Kind = Finalized
+		} else {
+			s.pending = append(s.pending, n)
+		}
+		added = append(added, &n)
+	}
+	for number, hash := range hashes {
+		if number <= end {
+			s.hashes[number] = hash
+		}
+	}
+	s.next = end + 1
+	return added, nil
+}
+
+// finalize finalizes the pending events that are Confirmations deep at head.
+func (s *Stream[T]) finalize(head uint64) []*Notification[T] {
+	if head < s.cfg.Confirmations {
+		return nil
+	}
+	confirmedAt := head - s.cfg.Confirmations
+	if confirmedAt >= s.next {
+		confirmedAt = s.next - 1
+	}
+	if confirmedAt <= s.finalized {
+		return nil
+	}
+
+	var finalized []*Notification[T]
+	kept := s.pending[:0]
+	for _, n := range s.pending {
+		if n.Log.BlockNumber > confirmedAt {
+			kept = append(kept, n)
+			continue
+		}
+		finalized = append(finalized, &Notification[T]{Kind: Finalized, Event: n.Event, Log: n.Log})
+	}
+	s.pending = kept
+	// Keep the hash of the last finalized block, so a reorg replacing it is detected.
+	for number := range s.hashes {
+		if number < confirmedAt {
+			delete(s.hashes, number)
+		}
+	}
+	s.finalized = confirmedAt
+	return finalized
+}
+
+func logBefore(a, b types.Log) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber < b.BlockNumber
+	}
+	return a.Index < b.Index
+}
@@ -0,0 +1,260 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+const (
+	// DefaultMinBackoff is the default delay before the first reconnection attempt.
+	DefaultMinBackoff = time.Second
+	// DefaultMaxBackoff is the default upper bound of the delay between reconnection attempts.
+	DefaultMaxBackoff = time.Minute
+)
+
+// Iterator is the iterator returned by the Filter methods of the bindings, such as
+// *DelegationManager.DelegationManagerOperatorSharesIncreasedIterator.
+type Iterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
+
+// Connection is a single connection to a node, on which one event of a binding is watched.
+type Connection[T any] struct {
+	// Watch subscribes to the event, such as a closure over the binding's WatchPaused.
+	Watch func(opts *bind.WatchOpts, sink chan<- *T) (event.Subscription, error)
+	// Filter returns the past occurrences of the event, such as a closure over the binding's
+	// FilterPaused.
+	Filter func(opts *bind.FilterOpts) (Iterator, error)
+	// BlockNumber returns the current block number.
+	BlockNumber func(ctx context.Context) (uint64, error)
+	// Close, if set, is called when the connection is abandoned, such as the Close method of
+	// the dialed client.
+	Close func()
+}
+
+// Dialer opens a new Connection.
+type Dialer[T any] func(ctx context.Context) (*Connection[T], error)
+
+// ReconnectConfig configures Reconnect.
+type ReconnectConfig struct {
+	// FromBlock is the first block whose events are delivered. Zero starts at the chain head
+	// when the first connection is made.
+	FromBlock uint64
+	// MinBackoff is the delay before the first reconnection attempt. It defaults to DefaultMinBackoff.
+	MinBackoff time.Duration
+	// MaxBackoff bounds the delay between reconnection attempts, which doubles after every
+	// failed attempt. It defaults to DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// OnError, if set, is called with every error that causes a reconnection.
+	OnError func(err error)
+}
+
+// Reconnect delivers the events watched through connections opened by dial to sink, until the
+// returned subscription is unsubscribed. When the subscription of a connection fails, the
+// connection is closed and a new one dialed with exponential backoff. Each new connection first
+// delivers the events since the last delivered one through Filter, so no events are lost across
+// reconnections and none are delivered twice. Events removed by a reorg are delivered with
+// Raw.Removed set, as Watch delivers them.
+//
+// T must be an event struct of the bindings, with a Raw types.Log field.
+func Reconnect[T any](dial Dialer[T], sink chan<- *T, cfg ReconnectConfig) event.Subscription {
+	if cfg.MinBackoff == 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	r := &reconnector[T]{dial: dial, sink: sink, cfg: cfg, next: cfg.FromBlock}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		backoff := cfg.MinBackoff
+		for {
+			delivered, err := r.run(ctx, quit)
+			if err == nil || ctx.Err() != nil {
+				return nil
+			}
+			if cfg.OnError != nil {
+				cfg.OnError(err)
+			}
+			if delivered {
+				backoff = cfg.MinBackoff
+			}
+			select {
+			case <-time.After(backoff):
+			case <-quit:
+				return nil
+			}
+			backoff *= 2
+			if backoff > cfg.MaxBackoff {
+				backoff = cfg.MaxBackoff
+			}
+		}
+	})
+}
+
+type reconnector[T any] struct {
+	dial Dialer[T]
+	sink chan<- *T
+	cfg  ReconnectConfig
+
+	// next is the block to resume from. Zero means no connection has been made yet.
+	next uint64
+	// last is the position of the last delivered event, if any.
+	last    position
+	hasLast bool
+}
+
+type position struct {
+	block, index uint64
+}
+
+func (p position) after(q position) bool {
+	return p.block > q.block || (p.block == q.block && p.index > q.index)
+}
+
+// run delivers events over a single connection until it fails, returning whether any event
+// was delivered and the error that ended the connection. It returns a nil error when quit is
+// closed.
+func (r *reconnector[T]) run(ctx context.Context, quit <-chan struct{}) (bool, error) {
+	conn, err := r.dial(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to dial: %w", err)
+	}
+	if conn.Close != nil {
+		defer conn.Close()
+	}
+
+	if r.next == 0 {
+		head, err := conn.BlockNumber(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get block number: %w", err)
+		}
+		r.next = head
+	}
+
+	// Subscribe before filtering, so that events mined in between are buffered rather than
+	// missed. Events seen by both are delivered once.
+	live := make(chan *T, 128)
+	sub, err := conn.Watch(&bind.WatchOpts{Context: ctx}, live)
+	if err != nil {
+		return false, fmt.Errorf("failed to subscribe: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	it, err := conn.Filter(&bind.FilterOpts{Start: r.next, Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to filter from block %d: %w", r.next, err)
+	}
+	delivered := false
+	for it.Next() {
+		ok, err := r.deliver(iteratorEvent[T](it), quit)
+		if err != nil || !ok {
+			it.Close()
+			return delivered, err
+		}
+		delivered = true
+	}
+	err = it.Error()
+	it.Close()
+	if err != nil {
+		return delivered, fmt.Errorf("failed to filter from block %d: %w", r.next, err)
+	}
+
+	for {
+		select {
+		case ev := <-live:
+			ok, err := r.deliver(ev, quit)
+			if err != nil || !ok {
+				return delivered, err
+			}
+			delivered = true
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return delivered, err
+		case <-quit:
+			return delivered, nil
+		}
+	}
+}
+
+// deliver sends ev to the sink unless it was already delivered, returning false if quit was
+// closed first.
+func (r *reconnector[T]) deliver(ev *T, quit <-chan struct{}) (bool, error) {
+	log, err := rawLog(ev)
+	if err != nil {
+		return false, err
+	}
+	pos := position{log.BlockNumber, uint64(log.Index)}
+	if !log.Removed && r.hasLast && !pos.after(r.last) {
+		return true, nil
+	}
+	select {
+	case r.sink <- ev:
+	case <-quit:
+		return false, nil
+	}
+	if log.Removed {
+		// Deliver the events that replace the reorganized block, even if they reuse positions
+		// of events already delivered.
+		if r.hasLast && !pos.after(r.last) {
+			r.last = position{log.BlockNumber - 1, math.MaxUint64}
+			r.hasLast = log.BlockNumber > 0
+			r.next = log.BlockNumber
+		}
+		return true, nil
+	}
+	r.last, r.hasLast = pos, true
+	r.next = log.BlockNumber
+	return true, nil
+}
+
+// iteratorEvent returns the Event field of a binding iterator.
+func iteratorEvent[T any](it Iterator) *T {
+	v := reflect.ValueOf(it)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	field := v.FieldByName("Event")
+	if !field.IsValid() {
+		return nil
+	}
+	ev, _ := field.Interface().(*T)
+	return ev
+}
+
+// rawLog returns the Raw field of a binding event.
+func rawLog[T any](ev *T) (types.Log, error) {
+	if ev == nil {
+		return types.Log{}, errors.New("nil event")
+	}
+	field := reflect.ValueOf(ev).Elem().FieldByName("Raw")
+	if !field.IsValid() {
+		return types.Log{}, fmt.Errorf("%T has no Raw field", ev)
+	}
+	log, ok := field.Interface().(types.Log)
+	if !ok {
+		return types.Log{}, fmt.Errorf("Raw field of %T is not a types.Log", ev)
+	}
+	return log, nil
+}
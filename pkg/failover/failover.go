@@ -0,0 +1,394 @@
+// Package failover provides a backend over several RPC endpoints that keeps working when some
+// of them fail, for long-running monitors and indexers.
+//
+// Requests go to the first healthy endpoint, in the order given, and fail over to the next one
+// when they fail for a transient reason, as classified by pkg/retry. Endpoints are health
+// checked in the background: an endpoint is unhealthy while it fails to report its block
+// number, or lags the highest head by more than Config.MaxLag blocks. Log subscriptions are
+// re-established on the current endpoint when theirs fails.
+//
+// With Config.Quorum set, eth_calls are sent to that many endpoints at the same block and fail
+// with ErrQuorum unless all results agree:
+//
+//	backend, err := failover.Dial(ctx, []string{primaryURL, secondaryURL}, failover.Config{})
+//	defer backend.Close()
+//	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+package failover
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/retry"
+)
+
+const (
+	// DefaultHealthInterval is the default interval between health checks.
+	DefaultHealthInterval = 15 * time.Second
+	// DefaultTimeout is the default timeout of a health check.
+	DefaultTimeout = 5 * time.Second
+	// DefaultMaxLag is the default number of blocks an endpoint may lag the highest head.
+	DefaultMaxLag = 5
+	// maxResubscribeBackoff is the maximum delay between attempts to re-establish a
+	// subscription.
+	maxResubscribeBackoff = 30 * time.Second
+)
+
+var (
+	// ErrNoEndpoints is returned when no endpoints are given.
+	ErrNoEndpoints = errors.New("no endpoints")
+	// ErrQuorum is returned by quorum reads whose endpoints disagree, or when fewer endpoints
+	// than the quorum are healthy.
+	ErrQuorum = errors.New("quorum not reached")
+)
+
+// Config configures a Backend.
+type Config struct {
+	// HealthInterval is the interval between health checks. It defaults to
+	// DefaultHealthInterval.
+	HealthInterval time.Duration
+	// Timeout is the timeout of a health check. It defaults to DefaultTimeout.
+	Timeout time.Duration
+	// MaxLag is the number of blocks an endpoint may lag the highest head and stay healthy. It
+	// defaults to DefaultMaxLag.
+	MaxLag uint64
+	// Quorum, if greater than one, is the number of endpoints each eth_call is sent to.
+	Quorum int
+}
+
+// Status is the health of an endpoint, as of its last health check.
+type Status struct {
+	Healthy bool
+	Head    uint64
+	Err     error
+}
+
+type endpoint struct {
+	backend ethbackend.Backend
+	close   func()
+
+	healthy atomic.Bool
+	mu      sync.Mutex
+	status  Status
+}
+
+// Backend is an ethbackend.Backend over several endpoints. It is safe for concurrent use.
+type Backend struct {
+	endpoints []*endpoint
+	cfg       Config
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// Dial dials urls and returns a Backend over them, in order of preference.
+func Dial(ctx context.Context, urls []string, cfg Config) (*Backend, error) {
+	backends := make([]ethbackend.Backend, 0, len(urls))
+	closers := make([]func(), 0, len(urls))
+	for _, url := range urls {
+		c, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			for _, close := range closers {
+				close()
+			}
+			return nil, fmt.Errorf("failed to dial %s: %w", url, err)
+		}
+		backends = append(backends, c)
+		closers = append(closers, c.Close)
+	}
+	b, err := New(backends, cfg)
+	if err != nil {
+		return nil, err
+	}
+	for i, close := range closers {
+		b.endpoints[i].close = close
+	}
+	return b, nil
+}
+
+// New returns a Backend over backends, in order of preference, and starts health checking
+// them. All endpoints are healthy until the first check.
+func New(backends []ethbackend.Backend, cfg Config) (*Backend, error) {
+	if len(backends) == 0 {
+		return nil, ErrNoEndpoints
+	}
+	if cfg.HealthInterval == 0 {
+		cfg.HealthInterval = DefaultHealthInterval
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxLag == 0 {
+		cfg.MaxLag = DefaultMaxLag
+	}
+	b := &Backend{cfg: cfg, stop: make(chan struct{}), done: make(chan struct{})}
+	for _, backend := range backends {
+		e := &endpoint{backend: backend, status: Status{Healthy: true}}
+		e.healthy.Store(true)
+		b.endpoints = append(b.endpoints, e)
+	}
+	go b.healthCheck()
+	return b, nil
+}
+
+// Close stops health checking and closes the endpoints dialed by Dial.
+func (b *Backend) Close() {
+	b.closeOnce.Do(func() {
+		close(b.stop)
+		<-b.done
+		for _, e := range b.endpoints {
+			if e.close != nil {
+				e.close()
+			}
+		}
+	})
+}
+
+// Status returns the status of each endpoint, in order.
+func (b *Backend) Status() []Status {
+	statuses := make([]Status, len(b.endpoints))
+	for i, e := range b.endpoints {
+		e.mu.Lock()
+		statuses[i] = e.status
+		e.mu.Unlock()
+	}
+	return statuses
+}
+
+func (b *Backend) healthCheck() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.cfg.HealthInterval)
+	defer ticker.Stop()
+	for {
+		b.Check()
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check health checks every endpoint now.
+func (b *Backend) Check() {
+	heads := make([]uint64, len(b.endpoints))
+	errs := make([]error, len(b.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range b.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), b.cfg.Timeout)
+			defer cancel()
+			heads[i], errs[i] = e.backend.BlockNumber(ctx)
+		}(i, e)
+	}
+	wg.Wait()
+
+	var best uint64
+	for i := range b.endpoints {
+		if errs[i] == nil && heads[i] > best {
+			best = heads[i]
+		}
+	}
+	for i, e := range b.endpoints {
+		status := Status{Head: heads[i], Err: errs[i]}
+		if status.Err == nil && best-status.Head > b.cfg.MaxLag {
+			status.Err = fmt.Errorf("endpoint lags %d blocks behind head %d", best-status.Head, best)
+		}
+		status.Healthy = status.Err == nil
+		e.mu.Lock()
+		e.status = status
+		e.mu.Unlock()
+		e.healthy.Store(status.Healthy)
+	}
+}
+
+// ordered returns the healthy endpoints, followed by the unhealthy ones as a last resort.
+func (b *Backend) ordered() []*endpoint {
+	endpoints := make([]*endpoint, 0, len(b.endpoints))
+	for _, e := range b.endpoints {
+		if e.healthy.Load() {
+			endpoints = append(endpoints, e)
+		}
+	}
+	for _, e := range b.endpoints {
+		if !e.healthy.Load() {
+			endpoints = append(endpoints, e)
+		}
+	}
+	return endpoints
+}
+
+// do calls f with each endpoint until it succeeds or fails for a reason that is not transient.
+// Endpoints failing transiently are marked unhealthy until their next health check.
+func do[T any](ctx context.Context, b *Backend, f func(ethbackend.Backend) (T, error)) (T, error) {
+	var (
+		out T
+		err error
+	)
+	for _, e := range b.ordered() {
+		out, err = f(e.backend)
+		if err == nil || retry.Classify(err) != retry.Transient || ctx.Err() != nil {
+			return out, err
+		}
+		e.healthy.Store(false)
+	}
+	return out, err
+}
+
+// CodeAt fails over.
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return do(ctx, b, func(c ethbackend.Backend) ([]byte, error) { return c.CodeAt(ctx, contract, blockNumber) })
+}
+
+// CallContract fails over, or is a quorum read if Config.Quorum is greater than one.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.cfg.Quorum > 1 {
+		return b.quorumCall(ctx, call, blockNumber)
+	}
+	return do(ctx, b, func(c ethbackend.Backend) ([]byte, error) { return c.CallContract(ctx, call, blockNumber) })
+}
+
+// quorumCall sends call to the first Config.Quorum healthy endpoints, at the lowest of their
+// heads when blockNumber is nil, so that endpoints a block apart do not disagree.
+func (b *Backend) quorumCall(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var endpoints []*endpoint
+	for _, e := range b.endpoints {
+		if e.healthy.Load() && len(endpoints) < b.cfg.Quorum {
+			endpoints = append(endpoints, e)
+		}
+	}
+	if len(endpoints) < b.cfg.Quorum {
+		return nil, fmt.Errorf("%w: %d of %d endpoints healthy", ErrQuorum, len(endpoints), b.cfg.Quorum)
+	}
+	if blockNumber == nil {
+		var lowest uint64
+		for i, e := range endpoints {
+			e.mu.Lock()
+			head := e.status.Head
+			e.mu.Unlock()
+			if i == 0 || head < lowest {
+				lowest = head
+			}
+		}
+		if lowest > 0 {
+			blockNumber = new(big.Int).SetUint64(lowest)
+		}
+	}
+
+	outs := make([][]byte, len(endpoints))
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			outs[i], errs[i] = e.backend.CallContract(ctx, call, blockNumber)
+		}(i, e)
+	}
+	wg.Wait()
+	for i := range endpoints {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if !bytes.Equal(outs[i], outs[0]) {
+			return nil, fmt.Errorf("%w: endpoints returned different results at block %s", ErrQuorum, blockNumber)
+		}
+	}
+	return outs[0], nil
+}
+
+// EstimateGas fails over.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (uint64, error) { return c.EstimateGas(ctx, call) })
+}
+
+// PendingCodeAt fails over.
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return do(ctx, b, func(c ethbackend.Backend) ([]byte, error) { return c.PendingCodeAt(ctx, account) })
+}
+
+// PendingNonceAt fails over.
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (uint64, error) { return c.PendingNonceAt(ctx, account) })
+}
+
+// SuggestGasPrice fails over.
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (*big.Int, error) { return c.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap fails over.
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (*big.Int, error) { return c.SuggestGasTipCap(ctx) })
+}
+
+// HeaderByNumber fails over.
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (*types.Header, error) { return c.HeaderByNumber(ctx, number) })
+}
+
+// SendTransaction fails over.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := do(ctx, b, func(c ethbackend.Backend) (struct{}, error) { return struct{}{}, c.SendTransaction(ctx, tx) })
+	return err
+}
+
+// FilterLogs fails over.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return do(ctx, b, func(c ethbackend.Backend) ([]types.Log, error) { return c.FilterLogs(ctx, query) })
+}
+
+// SubscribeFilterLogs subscribes on the first endpoint that accepts the subscription, and
+// re-subscribes on the current one whenever the subscription fails, until it is unsubscribed.
+// Logs emitted while re-subscribing are missed; use FilterLogs to fill the gap.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	sub, err := do(ctx, b, func(c ethbackend.Backend) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, query, ch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	first := true
+	return event.ResubscribeErr(maxResubscribeBackoff, func(ctx context.Context, _ error) (event.Subscription, error) {
+		if first {
+			first = false
+			return sub, nil
+		}
+		return do(ctx, b, func(c ethbackend.Backend) (ethereum.Subscription, error) {
+			return c.SubscribeFilterLogs(ctx, query, ch)
+		})
+	}), nil
+}
+
+// TransactionReceipt fails over.
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (*types.Receipt, error) { return c.TransactionReceipt(ctx, txHash) })
+}
+
+// ChainID fails over.
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (*big.Int, error) { return c.ChainID(ctx) })
+}
+
+// BlockNumber fails over.
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return do(ctx, b, func(c ethbackend.Backend) (uint64, error) { return c.BlockNumber(ctx) })
+}
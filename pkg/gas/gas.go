@@ -0,0 +1,244 @@
+// Package gas sets the gas limits and EIP-1559 fees of the transactions sent through the
+// bindings from configurable policies, instead of gas settings hard-coded in each script.
+//
+// A Backend wraps the backend the bindings send transactions through. When TransactOpts leave
+// GasLimit unset, the bindings estimate it through the backend, which pads the estimate by a
+// per-method margin; when they leave GasTipCap unset, the tip is taken from the fee policy. The
+// fee cap of a policy, which the bindings otherwise derive as twice the base fee plus the tip,
+// is set explicitly by Apply:
+//
+//	backend, _ := gas.NewBackend(ethClient, gas.Config{
+//		Policy:        gas.Fast,
+//		Padding:       0.2,
+//		MethodPadding: map[string]float64{"DelegationManager.completeQueuedWithdrawals": 0.5},
+//		MaxFeeCap:     big.NewInt(200 * params.GWei),
+//	})
+//	c, _ := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+//	opts, err := backend.Apply(ctx, opts)
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+// ErrFeeTooHigh is returned when a transaction's fee cap is above Config.MaxFeeCap.
+var ErrFeeTooHigh = errors.New("fee cap too high")
+
+// Fees are the EIP-1559 fees of a transaction.
+type Fees struct {
+	TipCap *big.Int
+	FeeCap *big.Int
+}
+
+// FeeBackend is the chain access of fee policies.
+type FeeBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// FeeHistoryReader reads the fee history. *ethclient.Client satisfies it.
+type FeeHistoryReader interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// FeePolicy chooses the fees of transactions.
+type FeePolicy interface {
+	Fees(ctx context.Context, backend FeeBackend) (*Fees, error)
+}
+
+// Suggested is the policy of the bindings: the tip suggested by the node, and a fee cap of twice
+// the base fee plus the tip.
+type Suggested struct{}
+
+// Fees returns the suggested fees.
+func (Suggested) Fees(ctx context.Context, backend FeeBackend) (*Fees, error) {
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	tip, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest tip: %w", err)
+	}
+	return feesOf(tip, head.BaseFee, 2), nil
+}
+
+// Percentile takes the tip from the recent fee history.
+type Percentile struct {
+	// Percentile is the percentile of the tips paid in each block, between 0 and 100.
+	Percentile float64
+	// Blocks is the number of recent blocks whose tips are considered. The tip is the median of
+	// their percentiles, ignoring empty blocks.
+	Blocks uint64
+	// BaseFeeMultiplier is the number of times the next block's base fee is covered by the fee
+	// cap. Each full block raises the base fee by 12.5%, so a multiplier of 2 stays valid for at
+	// least 6 full blocks.
+	BaseFeeMultiplier int64
+	// MinTip is the minimum tip, also used when the recent blocks are empty.
+	MinTip *big.Int
+}
+
+// Policies for transactions that can wait for cheap inclusion, and for those that should be
+// included in the next blocks.
+var (
+	Conservative = Percentile{Percentile: 25, Blocks: 20, BaseFeeMultiplier: 2}
+	Fast         = Percentile{Percentile: 90, Blocks: 5, BaseFeeMultiplier: 3}
+)
+
+// Fees returns the fees of the recent fee history. backend must implement FeeHistoryReader.
+func (p Percentile) Fees(ctx context.Context, backend FeeBackend) (*Fees, error) {
+	reader, ok := backend.(FeeHistoryReader)
+	if !ok {
+		return nil, errors.New("backend does not read the fee history")
+	}
+	history, err := reader.FeeHistory(ctx, max(p.Blocks, 1), nil, []float64{p.Percentile})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, errors.New("empty fee history")
+	}
+	var tips []*big.Int
+	for i, rewards := range history.Reward {
+		if len(rewards) > 0 && i < len(history.GasUsedRatio) && history.GasUsedRatio[i] > 0 {
+			tips = append(tips, rewards[0])
+		}
+	}
+	tip := new(big.Int)
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+		tip.Set(tips[len(tips)/2])
+	}
+	if p.MinTip != nil && tip.Cmp(p.MinTip) < 0 {
+		tip.Set(p.MinTip)
+	}
+	// the last base fee is that of the next block
+	return feesOf(tip, history.BaseFee[len(history.BaseFee)-1], max(p.BaseFeeMultiplier, 1)), nil
+}
+
+// Fixed is a policy of constant fees.
+type Fixed Fees
+
+// Fees returns the fixed fees.
+func (f Fixed) Fees(context.Context, FeeBackend) (*Fees, error) {
+	return &Fees{TipCap: new(big.Int).Set(f.TipCap), FeeCap: new(big.Int).Set(f.FeeCap)}, nil
+}
+
+func feesOf(tip, baseFee *big.Int, multiplier int64) *Fees {
+	feeCap := new(big.Int).Set(tip)
+	if baseFee != nil {
+		feeCap.Add(feeCap, new(big.Int).Mul(baseFee, big.NewInt(multiplier)))
+	}
+	return &Fees{TipCap: tip, FeeCap: feeCap}
+}
+
+// Config configures a Backend.
+type Config struct {
+	// Policy chooses the fees of transactions. It defaults to Suggested.
+	Policy FeePolicy
+	// Padding is the fraction gas estimates are raised by, such as 0.2 for 20%. Zero leaves
+	// estimates as they are.
+	Padding float64
+	// MethodPadding overrides Padding for the methods it names as "Contract.method", where
+	// Contract is the name of a binding in pkg/bindings.
+	MethodPadding map[string]float64
+	// MaxFeeCap, if set, refuses to send transactions with a higher fee cap or gas price.
+	MaxFeeCap *big.Int
+}
+
+// Backend is an ethbackend.Backend that pads gas estimates and suggests tips from a fee policy.
+type Backend struct {
+	ethbackend.Backend
+	cfg     Config
+	padding map[[4]byte]float64
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend with gas limits and fees set according to cfg. It fails if
+// cfg.MethodPadding names an unknown method.
+func NewBackend(backend ethbackend.Backend, cfg Config) (*Backend, error) {
+	if cfg.Policy == nil {
+		cfg.Policy = Suggested{}
+	}
+	b := &Backend{Backend: backend, cfg: cfg, padding: make(map[[4]byte]float64)}
+	for name, padding := range cfg.MethodPadding {
+		contract, method, ok := strings.Cut(name, ".")
+		if !ok {
+			return nil, fmt.Errorf("method %q is not named as Contract.method", name)
+		}
+		parsed, err := abis.ABI(contract)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := parsed.Methods[method]
+		if !ok {
+			return nil, fmt.Errorf("no method %s in %s", method, contract)
+		}
+		b.padding[[4]byte(m.ID)] = padding
+	}
+	return b, nil
+}
+
+// Fees returns the fees of the policy.
+func (b *Backend) Fees(ctx context.Context) (*Fees, error) {
+	return b.cfg.Policy.Fees(ctx, b.Backend)
+}
+
+// Apply returns a copy of opts with the fees of the policy, unless it already sets fees.
+func (b *Backend) Apply(ctx context.Context, opts *bind.TransactOpts) (*bind.TransactOpts, error) {
+	out := *opts
+	if opts.GasPrice != nil || opts.GasFeeCap != nil || opts.GasTipCap != nil {
+		return &out, nil
+	}
+	fees, err := b.Fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out.GasTipCap, out.GasFeeCap = fees.TipCap, fees.FeeCap
+	return &out, nil
+}
+
+// EstimateGas pads the estimate of call by the padding of its method.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	gas, err := b.Backend.EstimateGas(ctx, call)
+	if err != nil {
+		return 0, err
+	}
+	padding := b.cfg.Padding
+	if len(call.Data) >= 4 {
+		if p, ok := b.padding[[4]byte(call.Data[:4])]; ok {
+			padding = p
+		}
+	}
+	return gas + uint64(float64(gas)*padding), nil
+}
+
+// SuggestGasTipCap returns the tip of the policy.
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	fees, err := b.Fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fees.TipCap, nil
+}
+
+// SendTransaction refuses transactions with a fee cap above MaxFeeCap.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if b.cfg.MaxFeeCap != nil && tx.GasFeeCap().Cmp(b.cfg.MaxFeeCap) > 0 {
+		return fmt.Errorf("%w: %s is above %s", ErrFeeTooHigh, tx.GasFeeCap(), b.cfg.MaxFeeCap)
+	}
+	return b.Backend.SendTransaction(ctx, tx)
+}
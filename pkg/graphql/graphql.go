@@ -0,0 +1,678 @@
+// Package graphql serves the events of an indexer.Store over GraphQL, so that frontends can query
+// deposits, withdrawals, delegations, operators, strategies and rewards claims without running a
+// subgraph.
+//
+// Types and fields follow the names used by EigenLayer subgraphs where possible. Every type has a
+// plural field returning a list and a singular field returning an entity by id:
+//
+//	{
+//	  withdrawals(first: 10, where: {staker: "0x…", completed: false}, orderBy: blockNumber, orderDirection: desc) {
+//	    id
+//	    strategies
+//	    shares
+//	  }
+//	  operator(id: "0x…") { metadataURI delegationApprover }
+//	}
+//
+// Lists take first, skip, where, orderBy and orderDirection arguments. where filters by field
+// equality, and the _not, _in, _not_in, _gt, _gte, _lt, _lte and, for lists, _contains suffixes.
+// Addresses and bytes are returned as lowercase hex and integers as decimal strings, like the
+// Bytes and BigInt scalars of subgraphs.
+//
+// The server implements queries, with variables, aliases, fragments and the skip and include
+// directives. It does not implement mutations, subscriptions or introspection:
+//
+//	server := graphql.New(store, graphql.Config{Logger: logger})
+//	http.Handle("/graphql", server.Handler())
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+const (
+	// DefaultFirst is the default number of entities returned by a list.
+	DefaultFirst = 100
+	// DefaultMaxFirst is the default maximum of the first argument.
+	DefaultMaxFirst = 1000
+)
+
+// Config configures a Server.
+type Config struct {
+	// MaxFirst bounds the first argument of lists. It defaults to DefaultMaxFirst.
+	MaxFirst int
+	// Logger receives the queries that failed. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Server executes GraphQL queries against the events of a Store.
+type Server struct {
+	store indexer.Store
+	cfg   Config
+}
+
+// New returns a Server querying store.
+func New(store indexer.Store, cfg Config) *Server {
+	if cfg.MaxFirst == 0 {
+		cfg.MaxFirst = DefaultMaxFirst
+	}
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	return &Server{store: store, cfg: cfg}
+}
+
+// Request is a GraphQL request.
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// Response is a GraphQL response. Data is nil if the query could not be executed at all.
+type Response struct {
+	Data   *Object `json:"data"`
+	Errors []Error `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Object is a result object, whose fields encode as JSON in the order they were selected.
+type Object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// Get returns the value of the field with name.
+func (o *Object) Get(name string) interface{} {
+	return o.values[name]
+}
+
+func (o *Object) set(name string, value interface{}) {
+	if _, ok := o.values[name]; !ok {
+		o.keys = append(o.keys, name)
+	}
+	o.values[name] = value
+}
+
+// MarshalJSON encodes o with its fields in order.
+func (o *Object) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+func newObject() *Object {
+	return &Object{values: make(map[string]interface{})}
+}
+
+// Execute executes the query of req. Errors are reported in the response.
+func (s *Server) Execute(ctx context.Context, req Request) *Response {
+	resp := s.execute(ctx, req)
+	for _, e := range resp.Errors {
+		s.cfg.Logger.Warn("graphql query failed", "operation", req.OperationName, "err", e.Message)
+	}
+	return resp
+}
+
+func (s *Server) execute(ctx context.Context, req Request) *Response {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return &Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	op, err := doc.operation(req.OperationName)
+	if err != nil {
+		return &Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	if op.kind != "query" {
+		return &Response{Errors: []Error{{Message: fmt.Sprintf("%s operations are not supported", op.kind)}}}
+	}
+
+	r := &resolver{
+		ctx:       ctx,
+		store:     s.store,
+		maxFirst:  s.cfg.MaxFirst,
+		doc:       doc,
+		variables: req.Variables,
+		events:    make(map[string][]indexer.Event),
+		latests:   make(map[string]map[string]indexer.Event),
+	}
+	resp := &Response{Data: newObject()}
+	fields, err := r.collect(op.selections, make(map[string]bool))
+	if err != nil {
+		return &Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	for _, f := range fields {
+		value, err := r.root(f)
+		if err != nil {
+			resp.Errors = append(resp.Errors, Error{Message: err.Error(), Path: []interface{}{f.alias}})
+		}
+		resp.Data.set(f.alias, value)
+	}
+	return resp
+}
+
+// operation returns the operation with name, or the only operation if name is empty.
+func (d *document) operation(name string) (*operation, error) {
+	if name == "" {
+		if len(d.operations) != 1 {
+			return nil, errors.New("operationName is required for documents with several operations")
+		}
+		return d.operations[0], nil
+	}
+	for _, op := range d.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+// resolver resolves the fields of a single request, caching the events it reads.
+type resolver struct {
+	ctx       context.Context
+	store     indexer.Store
+	maxFirst  int
+	doc       *document
+	variables map[string]interface{}
+	events    map[string][]indexer.Event
+	latests   map[string]map[string]indexer.Event
+}
+
+// read returns the stored events of contract named name.
+func (r *resolver) read(contract, name string) ([]indexer.Event, error) {
+	k := contract + "." + name
+	if events, ok := r.events[k]; ok {
+		return events, nil
+	}
+	events, err := r.store.Events(r.ctx, indexer.Filter{Contract: contract, Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s events: %w", k, err)
+	}
+	r.events[k] = events
+	return events, nil
+}
+
+// latest returns the latest stored events of contract named name, keyed by their normalized
+// argument arg.
+func (r *resolver) latest(contract, name, arg string) (map[string]indexer.Event, error) {
+	k := contract + "." + name + "." + arg
+	if latest, ok := r.latests[k]; ok {
+		return latest, nil
+	}
+	events, err := r.read(contract, name)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]indexer.Event)
+	for _, e := range events {
+		latest[key(normalize(e.Args[arg]))] = e
+	}
+	r.latests[k] = latest
+	return latest, nil
+}
+
+// collect returns the fields of selections, expanding fragments and applying directives.
+func (r *resolver) collect(selections []*selection, visiting map[string]bool) ([]*selection, error) {
+	var fields []*selection
+	seen := make(map[string]bool)
+	add := func(f *selection) {
+		// fields selected several times under one name are returned once
+		if !seen[f.alias] {
+			seen[f.alias] = true
+			fields = append(fields, f)
+		}
+	}
+	for _, s := range selections {
+		include, err := r.included(s.directives)
+		if err != nil {
+			return nil, err
+		}
+		if !include {
+			continue
+		}
+		var nested []*selection
+		switch {
+		case s.spread != "":
+			f, ok := r.doc.fragments[s.spread]
+			if !ok {
+				return nil, fmt.Errorf("unknown fragment %q", s.spread)
+			}
+			if visiting[s.spread] {
+				return nil, fmt.Errorf("fragment %q spreads itself", s.spread)
+			}
+			visiting[s.spread] = true
+			nested, err = r.collect(f.selections, visiting)
+			delete(visiting, s.spread)
+		case s.inline:
+			nested, err = r.collect(s.selections, visiting)
+		default:
+			add(s)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range nested {
+			add(f)
+		}
+	}
+	return fields, nil
+}
+
+// included evaluates the skip and include directives.
+func (r *resolver) included(directives []directive) (bool, error) {
+	for _, d := range directives {
+		if d.name != "skip" && d.name != "include" {
+			return false, fmt.Errorf("unknown directive @%s", d.name)
+		}
+		cond, err := r.value(d.args["if"])
+		if err != nil {
+			return false, err
+		}
+		b, ok := cond.(bool)
+		if !ok {
+			return false, fmt.Errorf("argument if of @%s must be a boolean", d.name)
+		}
+		if b == (d.name == "skip") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// value resolves the variables in an argument value.
+func (r *resolver) value(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case variable:
+		value, ok := r.variables[string(v)]
+		if !ok {
+			return nil, nil
+		}
+		return value, nil
+	case enum:
+		return string(v), nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			var err error
+			if out[i], err = r.value(item); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for name, item := range v {
+			var err error
+			if out[name], err = r.value(item); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+// root resolves a field of the query type.
+func (r *resolver) root(f *selection) (interface{}, error) {
+	if f.name == "__typename" {
+		return "Query", nil
+	}
+	if f.name == "__schema" || f.name == "__type" {
+		return nil, errors.New("introspection is not supported")
+	}
+	for _, ent := range entities {
+		switch f.name {
+		case ent.plural:
+			return r.list(ent, f)
+		case ent.singular():
+			return r.single(ent, f)
+		}
+	}
+	return nil, fmt.Errorf("cannot query field %q on type \"Query\"", f.name)
+}
+
+// single resolves the singular field of ent, the entity with the id argument.
+func (r *resolver) single(ent *entity, f *selection) (interface{}, error) {
+	args, err := r.arguments(f, "id")
+	if err != nil {
+		return nil, err
+	}
+	id, ok := args["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("argument id of %s must be a string", f.name)
+	}
+	objects, err := r.objects(ent)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		if strings.EqualFold(key(obj["id"]), id) {
+			return r.project(ent, obj, f)
+		}
+	}
+	return nil, nil
+}
+
+// list resolves the plural field of ent.
+func (r *resolver) list(ent *entity, f *selection) (interface{}, error) {
+	args, err := r.arguments(f, "first", "skip", "where", "orderBy", "orderDirection")
+	if err != nil {
+		return nil, err
+	}
+	first, err := intArg(args, "first", DefaultFirst)
+	if err != nil {
+		return nil, err
+	}
+	if first < 0 || first > r.maxFirst {
+		return nil, fmt.Errorf("argument first must be between 0 and %d", r.maxFirst)
+	}
+	skip, err := intArg(args, "skip", 0)
+	if err != nil {
+		return nil, err
+	}
+	if skip < 0 {
+		return nil, errors.New("argument skip must not be negative")
+	}
+
+	objects, err := r.objects(ent)
+	if err != nil {
+		return nil, err
+	}
+	if where, ok := args["where"]; ok && where != nil {
+		conditions, ok := where.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("argument where must be an object")
+		}
+		if objects, err = filter(ent, objects, conditions); err != nil {
+			return nil, err
+		}
+	}
+	if err := order(ent, objects, args["orderBy"], args["orderDirection"]); err != nil {
+		return nil, err
+	}
+
+	if skip > len(objects) {
+		skip = len(objects)
+	}
+	objects = objects[skip:]
+	if first < len(objects) {
+		objects = objects[:first]
+	}
+	out := make([]interface{}, len(objects))
+	for i, obj := range objects {
+		if out[i], err = r.project(ent, obj, f); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// arguments resolves the arguments of f, failing on arguments not in names.
+func (r *resolver) arguments(f *selection, names ...string) (map[string]interface{}, error) {
+	args := make(map[string]interface{}, len(f.args))
+	for name, v := range f.args {
+		known := false
+		for _, n := range names {
+			known = known || n == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown argument %q on field %q", name, f.name)
+		}
+		value, err := r.value(v)
+		if err != nil {
+			return nil, err
+		}
+		args[name] = value
+	}
+	return args, nil
+}
+
+func intArg(args map[string]interface{}, name string, def int) (int, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return def, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		// variables decoded from JSON
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, fmt.Errorf("argument %s must be an integer", name)
+}
+
+// objects returns the entities of type ent, in the order of their events.
+func (r *resolver) objects(ent *entity) ([]map[string]interface{}, error) {
+	events, err := r.read(ent.contract, ent.event)
+	if err != nil {
+		return nil, err
+	}
+	var objects []map[string]interface{}
+	index := make(map[string]int)
+	for _, e := range events {
+		obj, err := r.object(ent, e)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		if ent.unique {
+			id := key(obj["id"])
+			if i, ok := index[id]; ok {
+				objects[i] = obj
+				continue
+			}
+			index[id] = len(objects)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// project returns the fields of obj selected by f.
+func (r *resolver) project(ent *entity, obj map[string]interface{}, f *selection) (*Object, error) {
+	if len(f.selections) == 0 {
+		return nil, fmt.Errorf("field %q of type %q must have a selection of subfields", f.name, ent.typename)
+	}
+	fields, err := r.collect(f.selections, make(map[string]bool))
+	if err != nil {
+		return nil, err
+	}
+	out := newObject()
+	for _, field := range fields {
+		if field.name == "__typename" {
+			out.set(field.alias, ent.typename)
+			continue
+		}
+		if !ent.hasField(field.name) {
+			return nil, fmt.Errorf("cannot query field %q on type %q", field.name, ent.typename)
+		}
+		if len(field.selections) > 0 {
+			return nil, fmt.Errorf("field %q of type %q must not have a selection", field.name, ent.typename)
+		}
+		out.set(field.alias, obj[field.name])
+	}
+	return out, nil
+}
+
+// operators are the suffixes of where conditions, longest first.
+var operators = []string{"_not_in", "_contains", "_not", "_gte", "_lte", "_in", "_gt", "_lt"}
+
+// filter returns the objects matching all conditions.
+func filter(ent *entity, objects []map[string]interface{}, conditions map[string]interface{}) ([]map[string]interface{}, error) {
+	type condition struct {
+		field, op string
+		value     interface{}
+	}
+	var conds []condition
+	for name, value := range conditions {
+		c := condition{field: name, value: value}
+		if !ent.hasField(name) {
+			for _, op := range operators {
+				if field, ok := strings.CutSuffix(name, op); ok && ent.hasField(field) {
+					c.field, c.op = field, op
+					break
+				}
+			}
+			if c.op == "" {
+				return nil, fmt.Errorf("unknown where condition %q on type %q", name, ent.typename)
+			}
+		}
+		if (c.op == "_in" || c.op == "_not_in") != isList(value) {
+			return nil, fmt.Errorf("where condition %q must be a list only with _in and _not_in", name)
+		}
+		conds = append(conds, c)
+	}
+
+	var out []map[string]interface{}
+	for _, obj := range objects {
+		match := true
+		for _, c := range conds {
+			match = match && matches(obj[c.field], c.op, c.value)
+		}
+		if match {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func isList(v interface{}) bool {
+	_, ok := v.([]interface{})
+	return ok
+}
+
+func matches(value interface{}, op string, arg interface{}) bool {
+	switch op {
+	case "":
+		return compare(value, arg) == 0
+	case "_not":
+		return compare(value, arg) != 0
+	case "_in", "_not_in":
+		found := false
+		for _, item := range arg.([]interface{}) {
+			found = found || compare(value, item) == 0
+		}
+		return found == (op == "_in")
+	case "_contains":
+		if list, ok := value.([]interface{}); ok {
+			for _, item := range list {
+				if compare(item, arg) == 0 {
+					return true
+				}
+			}
+			return false
+		}
+		s, ok := value.(string)
+		return ok && strings.Contains(strings.ToLower(s), strings.ToLower(fmt.Sprint(arg)))
+	}
+	if value == nil || arg == nil {
+		return false
+	}
+	c := compare(value, arg)
+	switch op {
+	case "_gt":
+		return c > 0
+	case "_gte":
+		return c >= 0
+	case "_lt":
+		return c < 0
+	default:
+		return c <= 0
+	}
+}
+
+// compare compares a field value with an argument, numerically if both are integers, and as
+// case-insensitive strings otherwise.
+func compare(value, arg interface{}) int {
+	if value == nil || arg == nil {
+		if value == nil && arg == nil {
+			return 0
+		}
+		if value == nil {
+			return -1
+		}
+		return 1
+	}
+	a, b := scalar(value), scalar(arg)
+	x, okx := new(big.Int).SetString(a, 10)
+	y, oky := new(big.Int).SetString(b, 10)
+	if okx && oky {
+		return x.Cmp(y)
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func scalar(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return normalize(v).(string)
+	}
+	return fmt.Sprint(v)
+}
+
+// order sorts objects by the orderBy field, in event order by default.
+func order(ent *entity, objects []map[string]interface{}, by, direction interface{}) error {
+	desc := false
+	switch direction {
+	case nil, "asc":
+	case "desc":
+		desc = true
+	default:
+		return fmt.Errorf("orderDirection must be asc or desc, not %v", direction)
+	}
+	if by == nil {
+		if desc {
+			for i, j := 0, len(objects)-1; i < j; i, j = i+1, j-1 {
+				objects[i], objects[j] = objects[j], objects[i]
+			}
+		}
+		return nil
+	}
+	field, ok := by.(string)
+	if !ok || !ent.hasField(field) {
+		return fmt.Errorf("cannot order %s by %v", ent.plural, by)
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		c := compare(objects[i][field], objects[j][field])
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+	return nil
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// maxRequestBytes bounds the size of a request body.
+const maxRequestBytes = 1 << 20
+
+// Handler returns an HTTP handler executing queries. It accepts a POST of a JSON Request, or a GET
+// with the query, variables and operationName URL parameters, and responds with the JSON
+// Response. Malformed requests fail with 400.
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			req.Query, req.OperationName = q.Get("query"), q.Get("operationName")
+			if vars := q.Get("variables"); vars != "" {
+				if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+					writeError(w, http.StatusBadRequest, err)
+					return
+				}
+			}
+		case http.MethodPost:
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		if req.Query == "" {
+			writeError(w, http.StatusBadRequest, errors.New("missing query"))
+			return
+		}
+		writeJSON(w, http.StatusOK, s.Execute(r.Context(), req))
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &Response{Errors: []Error{{Message: err.Error()}}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,520 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// document is a parsed GraphQL request document.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind       string
+	name       string
+	selections []*selection
+}
+
+type fragment struct {
+	name       string
+	selections []*selection
+}
+
+// selection is a field, a fragment spread if spread is set, or an inline fragment if inline is
+// set. Type conditions are not checked, since every selection set selects a single type.
+type selection struct {
+	alias      string
+	name       string
+	args       map[string]interface{}
+	directives []directive
+	selections []*selection
+
+	spread string
+	inline bool
+}
+
+type directive struct {
+	name string
+	args map[string]interface{}
+}
+
+// variable and enum are the values of variables and enum literals before resolution.
+type (
+	variable string
+	enum     string
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+type parser struct {
+	src string
+	pos int
+	tok token
+}
+
+func parse(src string) (*document, error) {
+	p := &parser{src: src}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	doc := &document{fragments: make(map[string]*fragment)}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.peek(tokenPunct, "{"):
+			selections, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{kind: "query", selections: selections})
+		case p.peek(tokenName, "query"), p.peek(tokenName, "mutation"), p.peek(tokenName, "subscription"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.peek(tokenName, "fragment"):
+			f, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			doc.fragments[f.name] = f
+		default:
+			return nil, p.errorf("unexpected %q", p.tok.value)
+		}
+	}
+	return doc, nil
+}
+
+func (p *parser) operation() (*operation, error) {
+	op := &operation{kind: p.tok.value}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokenName {
+		op.name = p.tok.value
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	// variable definitions only declare types, which are not checked
+	if p.peek(tokenPunct, "(") {
+		if err := p.skipBalanced("(", ")"); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+	return op, nil
+}
+
+func (p *parser) fragment() (*fragment, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	name, err := p.expect(tokenName, "")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenName, "on"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenName, ""); err != nil {
+		return nil, err
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return &fragment{name: name, selections: selections}, nil
+}
+
+func (p *parser) selectionSet() ([]*selection, error) {
+	if _, err := p.expect(tokenPunct, "{"); err != nil {
+		return nil, err
+	}
+	var selections []*selection
+	for !p.peek(tokenPunct, "}") {
+		s, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+	}
+	return selections, p.next()
+}
+
+func (p *parser) selection() (*selection, error) {
+	s := &selection{}
+	if p.peek(tokenPunct, "...") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind == tokenName && p.tok.value != "on" {
+			s.spread = p.tok.value
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			var err error
+			s.directives, err = p.directives()
+			return s, err
+		}
+		s.inline = true
+		if p.peek(tokenName, "on") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenName, ""); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if s.directives, err = p.directives(); err != nil {
+			return nil, err
+		}
+		s.selections, err = p.selectionSet()
+		return s, err
+	}
+
+	name, err := p.expect(tokenName, "")
+	if err != nil {
+		return nil, err
+	}
+	s.alias, s.name = name, name
+	if p.peek(tokenPunct, ":") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if s.name, err = p.expect(tokenName, ""); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek(tokenPunct, "(") {
+		if s.args, err = p.arguments(); err != nil {
+			return nil, err
+		}
+	}
+	if s.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if p.peek(tokenPunct, "{") {
+		if s.selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (p *parser) directives() ([]directive, error) {
+	var directives []directive
+	for p.peek(tokenPunct, "@") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		name, err := p.expect(tokenName, "")
+		if err != nil {
+			return nil, err
+		}
+		d := directive{name: name}
+		if p.peek(tokenPunct, "(") {
+			if d.args, err = p.arguments(); err != nil {
+				return nil, err
+			}
+		}
+		directives = append(directives, d)
+	}
+	return directives, nil
+}
+
+func (p *parser) arguments() (map[string]interface{}, error) {
+	if _, err := p.expect(tokenPunct, "("); err != nil {
+		return nil, err
+	}
+	args := make(map[string]interface{})
+	for !p.peek(tokenPunct, ")") {
+		name, err := p.expect(tokenName, "")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenPunct, ":"); err != nil {
+			return nil, err
+		}
+		if args[name], err = p.value(); err != nil {
+			return nil, err
+		}
+	}
+	return args, p.next()
+}
+
+func (p *parser) value() (interface{}, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == tokenPunct && tok.value == "$":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		name, err := p.expect(tokenName, "")
+		return variable(name), err
+	case tok.kind == tokenPunct && tok.value == "[":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		list := []interface{}{}
+		for !p.peek(tokenPunct, "]") {
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, p.next()
+	case tok.kind == tokenPunct && tok.value == "{":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		object := make(map[string]interface{})
+		for !p.peek(tokenPunct, "}") {
+			name, err := p.expect(tokenName, "")
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenPunct, ":"); err != nil {
+				return nil, err
+			}
+			if object[name], err = p.value(); err != nil {
+				return nil, err
+			}
+		}
+		return object, p.next()
+	case tok.kind == tokenInt:
+		n, err := strconv.ParseInt(tok.value, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid integer %s", tok.value)
+		}
+		return n, p.next()
+	case tok.kind == tokenFloat:
+		f, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %s", tok.value)
+		}
+		return f, p.next()
+	case tok.kind == tokenString:
+		return tok.value, p.next()
+	case tok.kind == tokenName:
+		var v interface{}
+		switch tok.value {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		default:
+			v = enum(tok.value)
+		}
+		return v, p.next()
+	}
+	return nil, p.errorf("unexpected %q", tok.value)
+}
+
+// skipBalanced skips tokens from open to the matching close.
+func (p *parser) skipBalanced(open, close string) error {
+	depth := 0
+	for {
+		switch {
+		case p.tok.kind == tokenEOF:
+			return p.errorf("unterminated %s", open)
+		case p.peek(tokenPunct, open):
+			depth++
+		case p.peek(tokenPunct, close):
+			depth--
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func (p *parser) peek(kind tokenKind, value string) bool {
+	return p.tok.kind == kind && p.tok.value == value
+}
+
+// expect consumes a token of kind, with value unless it is empty, and returns its value.
+func (p *parser) expect(kind tokenKind, value string) (string, error) {
+	if p.tok.kind != kind || (value != "" && p.tok.value != value) {
+		if value == "" {
+			value = "name"
+		}
+		if p.tok.kind == tokenEOF {
+			return "", p.errorf("expected %s, found end of document", value)
+		}
+		return "", p.errorf("expected %s, found %q", value, p.tok.value)
+	}
+	v := p.tok.value
+	return v, p.next()
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("syntax error at offset %d: %s", p.tok.pos, fmt.Sprintf(format, args...))
+}
+
+// next lexes the next token.
+func (p *parser) next() error {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+			continue
+		}
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+			continue
+		}
+		if strings.HasPrefix(p.src[p.pos:], "\uFEFF") {
+			p.pos += len("\uFEFF")
+			continue
+		}
+		break
+	}
+	start := p.pos
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokenEOF, pos: start}
+		return nil
+	}
+
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok = token{kind: tokenPunct, value: "...", pos: start}
+	case strings.ContainsRune("!$()[]{}:=@|&", rune(c)):
+		p.pos++
+		p.tok = token{kind: tokenPunct, value: string(c), pos: start}
+	case c == '_' || isLetter(c):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok = token{kind: tokenName, value: p.src[start:p.pos], pos: start}
+	case c == '-' || isDigit(c):
+		kind := tokenInt
+		p.pos++
+		for p.pos < len(p.src) {
+			d := p.src[p.pos]
+			if d == '.' || d == 'e' || d == 'E' || ((d == '+' || d == '-') && (p.src[p.pos-1] == 'e' || p.src[p.pos-1] == 'E')) {
+				kind = tokenFloat
+			} else if !isDigit(d) {
+				break
+			}
+			p.pos++
+		}
+		p.tok = token{kind: kind, value: p.src[start:p.pos], pos: start}
+	case c == '"':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			end := strings.Index(p.src[p.pos+3:], `"""`)
+			if end < 0 {
+				return fmt.Errorf("syntax error at offset %d: unterminated string", start)
+			}
+			p.tok = token{kind: tokenString, value: strings.TrimSpace(p.src[p.pos+3 : p.pos+3+end]), pos: start}
+			p.pos += 3 + end + 3
+			return nil
+		}
+		s, err := p.lexString()
+		if err != nil {
+			return err
+		}
+		p.tok = token{kind: tokenString, value: s, pos: start}
+	default:
+		r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+		return fmt.Errorf("syntax error at offset %d: unexpected character %q", start, r)
+	}
+	return nil
+}
+
+func (p *parser) lexString() (string, error) {
+	start := p.pos
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return b.String(), nil
+		case c == '\n' || c == '\r':
+			return "", fmt.Errorf("syntax error at offset %d: unterminated string", start)
+		case c == '\\' && p.pos+1 < len(p.src):
+			esc := p.src[p.pos+1]
+			p.pos += 2
+			switch esc {
+			case '"', '\\', '/':
+				b.WriteByte(esc)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					return "", fmt.Errorf("syntax error at offset %d: invalid escape", p.pos)
+				}
+				code, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					return "", fmt.Errorf("syntax error at offset %d: invalid escape", p.pos)
+				}
+				b.WriteRune(rune(code))
+				p.pos += 4
+			default:
+				return "", fmt.Errorf("syntax error at offset %d: invalid escape \\%c", p.pos-1, esc)
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", fmt.Errorf("syntax error at offset %d: unterminated string", start)
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
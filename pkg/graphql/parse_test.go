@@ -0,0 +1,260 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  *document
+	}{
+		{
+			name:  "shorthand query",
+			query: "{ deposits { id } }",
+			want: &document{
+				operations: []*operation{{kind: "query", selections: []*selection{
+					{alias: "deposits", name: "deposits", selections: []*selection{{alias: "id", name: "id"}}},
+				}}},
+				fragments: map[string]*fragment{},
+			},
+		},
+		{
+			name: "nested selections with aliases and arguments",
+			query: `query Positions {
+				recent: withdrawals(first: 10, skip: 0.5, where: {staker: "0xab", completed: false, strategy_in: ["0x1", "0x2"]}, orderBy: blockNumber, orderDirection: null) {
+					id
+					staker { id delegatedTo: operator { id } }
+				}
+			}`,
+			want: &document{
+				operations: []*operation{{kind: "query", name: "Positions", selections: []*selection{
+					{
+						alias: "recent",
+						name:  "withdrawals",
+						args: map[string]interface{}{
+							"first": int64(10),
+							"skip":  0.5,
+							"where": map[string]interface{}{
+								"staker":      "0xab",
+								"completed":   false,
+								"strategy_in": []interface{}{"0x1", "0x2"},
+							},
+							"orderBy":        enum("blockNumber"),
+							"orderDirection": nil,
+						},
+						selections: []*selection{
+							{alias: "id", name: "id"},
+							{alias: "staker", name: "staker", selections: []*selection{
+								{alias: "id", name: "id"},
+								{alias: "delegatedTo", name: "operator", selections: []*selection{{alias: "id", name: "id"}}},
+							}},
+						},
+					},
+				}}},
+				fragments: map[string]*fragment{},
+			},
+		},
+		{
+			name: "variables",
+			query: `query ($staker: Bytes!, $first: Int = 10, $where: Deposit_filter) {
+				deposits(first: $first, where: {staker: $staker, strategy_in: [$strategy]}) { id }
+			}`,
+			want: &document{
+				operations: []*operation{{kind: "query", selections: []*selection{
+					{
+						alias: "deposits",
+						name:  "deposits",
+						args: map[string]interface{}{
+							"first": variable("first"),
+							"where": map[string]interface{}{
+								"staker":      variable("staker"),
+								"strategy_in": []interface{}{variable("strategy")},
+							},
+						},
+						selections: []*selection{{alias: "id", name: "id"}},
+					},
+				}}},
+				fragments: map[string]*fragment{},
+			},
+		},
+		{
+			name: "fragments and directives",
+			query: `
+				# the operator and its stakers
+				query Operator($skip: Boolean!) @cached {
+					operator(id: "0x1") { ...details @include(if: true) ... on Operator @skip(if: $skip) { id } }
+				}
+				fragment details on Operator { metadataURI }`,
+			want: &document{
+				operations: []*operation{{kind: "query", name: "Operator", selections: []*selection{
+					{
+						alias: "operator",
+						name:  "operator",
+						args:  map[string]interface{}{"id": "0x1"},
+						selections: []*selection{
+							{spread: "details", directives: []directive{{name: "include", args: map[string]interface{}{"if": true}}}},
+							{
+								inline:     true,
+								directives: []directive{{name: "skip", args: map[string]interface{}{"if": variable("skip")}}},
+								selections: []*selection{{alias: "id", name: "id"}},
+							},
+						},
+					},
+				}}},
+				fragments: map[string]*fragment{
+					"details": {name: "details", selections: []*selection{{alias: "metadataURI", name: "metadataURI"}}},
+				},
+			},
+		},
+		{
+			name:  "strings",
+			query: `{ a(s: "tab\t \"quoted\" \\ \/ é \ud83d", b: """  block "string"  """, n: -1.5e3, i: -7) }`,
+			want: &document{
+				operations: []*operation{{kind: "query", selections: []*selection{
+					{alias: "a", name: "a", args: map[string]interface{}{
+						"s": "tab\t \"quoted\" \\ / é �",
+						"b": `block "string"`,
+						"n": -1.5e3,
+						"i": int64(-7),
+					}},
+				}}},
+				fragments: map[string]*fragment{},
+			},
+		},
+		{
+			name:  "several operations",
+			query: "\uFEFFquery A { a } mutation B { b }, subscription { c }",
+			want: &document{
+				operations: []*operation{
+					{kind: "query", name: "A", selections: []*selection{{alias: "a", name: "a"}}},
+					{kind: "mutation", name: "B", selections: []*selection{{alias: "b", name: "b"}}},
+					{kind: "subscription", selections: []*selection{{alias: "c", name: "c"}}},
+				},
+				fragments: map[string]*fragment{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				gotJSON, _ := json.Marshal(dump(got))
+				wantJSON, _ := json.Marshal(dump(tt.want))
+				t.Errorf("parse() = %s\nwant %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+// dump returns the selections of doc as plain values, for printing.
+func dump(doc *document) interface{} {
+	var selections func([]*selection) []interface{}
+	selections = func(list []*selection) []interface{} {
+		var out []interface{}
+		for _, s := range list {
+			var directives []interface{}
+			for _, d := range s.directives {
+				directives = append(directives, map[string]interface{}{"name": d.name, "args": d.args})
+			}
+			out = append(out, map[string]interface{}{
+				"alias": s.alias, "name": s.name, "args": s.args, "directives": directives,
+				"spread": s.spread, "inline": s.inline, "selections": selections(s.selections),
+			})
+		}
+		return out
+	}
+	out := map[string]interface{}{}
+	for _, op := range doc.operations {
+		out[op.kind+" "+op.name] = selections(op.selections)
+	}
+	for name, f := range doc.fragments {
+		out["fragment "+name] = selections(f.selections)
+	}
+	return out
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"empty selection set", "{", "syntax error at offset 1: expected name, found end of document"},
+		{"unclosed selection set", "{ deposits { id }", "syntax error at offset 17: expected name, found end of document"},
+		{"unexpected definition", "type Query { a }", `syntax error at offset 0: unexpected "type"`},
+		{"missing selection set", "query Q", "syntax error at offset 7: expected {, found end of document"},
+		{"argument without value", "{ a(first:) }", `syntax error at offset 10: unexpected ")"`},
+		{"argument without colon", "{ a(first 1) }", `syntax error at offset 10: expected :, found "1"`},
+		{"unclosed arguments", "{ a(first: 1 }", `syntax error at offset 13: expected name, found "}"`},
+		{"unclosed list", "{ a(in: [1, 2) }", `syntax error at offset 13: unexpected ")"`},
+		{"unclosed variable definitions", "query Q($a: Int { a }", "syntax error at offset 21: unterminated ("},
+		{"variable without name", "{ a(first: $) }", `syntax error at offset 12: expected name, found ")"`},
+		{"alias without field", "{ a: }", `syntax error at offset 5: expected name, found "}"`},
+		{"fragment without type condition", "fragment f { a }", `syntax error at offset 11: expected on, found "{"`},
+		{"unterminated string", `{ a(s: "abc) }`, "syntax error at offset 7: unterminated string"},
+		{"newline in string", "{ a(s: \"a\nb\") }", "syntax error at offset 7: unterminated string"},
+		{"unterminated block string", `{ a(s: """abc) }`, "syntax error at offset 7: unterminated string"},
+		{"invalid escape", `{ a(s: "\x") }`, `syntax error at offset 9: invalid escape \x`},
+		{"invalid unicode escape", `{ a(s: "\u12g4") }`, "syntax error at offset 10: invalid escape"},
+		{"integer overflow", "{ a(first: 99999999999999999999) }", "syntax error at offset 11: invalid integer 99999999999999999999"},
+		{"invalid float", "{ a(n: 1.2.3) }", "syntax error at offset 7: invalid float 1.2.3"},
+		{"unexpected character", "{ a; }", "syntax error at offset 3: unexpected character ';'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parse(tt.query)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("parse(%q) error = %v, want %s", tt.query, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveVariables(t *testing.T) {
+	r := &resolver{variables: map[string]interface{}{
+		"staker": "0xab",
+		"first":  float64(5),
+	}}
+	got, err := r.value(map[string]interface{}{
+		"first":   variable("first"),
+		"orderBy": enum("blockNumber"),
+		"where": map[string]interface{}{
+			"staker_in": []interface{}{variable("staker"), "0xcd"},
+			"operator":  variable("undefined"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"first":   float64(5),
+		"orderBy": "blockNumber",
+		"where": map[string]interface{}{
+			"staker_in": []interface{}{"0xab", "0xcd"},
+			"operator":  nil,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("value() = %v, want %v", got, want)
+	}
+}
+
+func TestExecuteMalformedQuery(t *testing.T) {
+	s := New(indexer.NewMemoryStore(), Config{})
+	resp := s.Execute(context.Background(), Request{Query: "{ deposits(first: $first { id } }"})
+	if resp.Data != nil || len(resp.Errors) != 1 || resp.Errors[0].Message != `syntax error at offset 25: expected name, found "{"` {
+		t.Errorf("Execute() = %+v", resp)
+	}
+}
@@ -0,0 +1,303 @@
+package graphql
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+)
+
+// entity is a type of the schema, built from the events of a single contract event. Each type is
+// queried as a list through its plural field, and by id through its singular field.
+type entity struct {
+	typename string
+	plural   string
+	contract string
+	event    string
+	// fields are the fields of the type besides the common fields of every event.
+	fields []string
+	// build returns the fields of the entity of event e, or nil to leave e out.
+	build func(r *resolver, e indexer.Event) (map[string]interface{}, error)
+	// unique keeps only the latest entity with each id.
+	unique bool
+}
+
+// eventFields are the fields of every type, describing the event it was built from.
+var eventFields = []string{"id", "blockNumber", "blockHash", "transactionHash", "logIndex", "contract"}
+
+var entities = []*entity{
+	{
+		typename: "Deposit",
+		plural:   "deposits",
+		contract: "StrategyManager",
+		event:    "Deposit",
+		fields:   []string{"staker", "token", "strategy", "shares"},
+		build: func(_ *resolver, e indexer.Event) (map[string]interface{}, error) {
+			return args(e, "staker", "token", "strategy", "shares"), nil
+		},
+	},
+	{
+		typename: "Withdrawal",
+		plural:   "withdrawals",
+		contract: "DelegationManager",
+		event:    "WithdrawalQueued",
+		fields: []string{
+			"withdrawalRoot", "staker", "delegatedTo", "withdrawer", "nonce", "startBlock", "strategies",
+			"shares", "completed", "completedTransactionHash",
+		},
+		build: func(r *resolver, e indexer.Event) (map[string]interface{}, error) {
+			obj := args(e, "withdrawalRoot")
+			withdrawal, _ := normalize(e.Args["withdrawal"]).(map[string]interface{})
+			for _, name := range []string{"staker", "delegatedTo", "withdrawer", "nonce", "startBlock", "strategies", "shares"} {
+				obj[name] = withdrawal[name]
+			}
+			obj["id"] = obj["withdrawalRoot"]
+			completed, err := r.latest("DelegationManager", "WithdrawalCompleted", "withdrawalRoot")
+			if err != nil {
+				return nil, err
+			}
+			obj["completed"], obj["completedTransactionHash"] = false, nil
+			if c, ok := completed[key(obj["withdrawalRoot"])]; ok {
+				obj["completed"], obj["completedTransactionHash"] = true, normalize(c.TxHash)
+			}
+			return obj, nil
+		},
+	},
+	{
+		typename: "Delegation",
+		plural:   "delegations",
+		contract: "DelegationManager",
+		event:    "StakerDelegated",
+		fields:   []string{"staker", "operator"},
+		build: func(_ *resolver, e indexer.Event) (map[string]interface{}, error) {
+			return args(e, "staker", "operator"), nil
+		},
+	},
+	{
+		typename: "Undelegation",
+		plural:   "undelegations",
+		contract: "DelegationManager",
+		event:    "StakerUndelegated",
+		fields:   []string{"staker", "operator"},
+		build: func(_ *resolver, e indexer.Event) (map[string]interface{}, error) {
+			return args(e, "staker", "operator"), nil
+		},
+	},
+	{
+		typename: "Operator",
+		plural:   "operators",
+		contract: "DelegationManager",
+		event:    "OperatorRegistered",
+		fields:   []string{"address", "delegationApprover", "stakerOptOutWindowBlocks", "metadataURI"},
+		unique:   true,
+		build: func(r *resolver, e indexer.Event) (map[string]interface{}, error) {
+			obj := args(e, "operator")
+			obj["address"] = obj["operator"]
+			delete(obj, "operator")
+			obj["id"] = obj["address"]
+
+			details, _ := normalize(e.Args["operatorDetails"]).(map[string]interface{})
+			modified, err := r.latest("DelegationManager", "OperatorDetailsModified", "operator")
+			if err != nil {
+				return nil, err
+			}
+			if m, ok := modified[key(obj["address"])]; ok {
+				details, _ = normalize(m.Args["newOperatorDetails"]).(map[string]interface{})
+			}
+			obj["delegationApprover"] = details["delegationApprover"]
+			obj["stakerOptOutWindowBlocks"] = details["stakerOptOutWindowBlocks"]
+
+			uris, err := r.latest("DelegationManager", "OperatorMetadataURIUpdated", "operator")
+			if err != nil {
+				return nil, err
+			}
+			obj["metadataURI"] = nil
+			if u, ok := uris[key(obj["address"])]; ok {
+				obj["metadataURI"] = normalize(u.Args["metadataURI"])
+			}
+			return obj, nil
+		},
+	},
+	{
+		typename: "Strategy",
+		plural:   "strategies",
+		contract: "StrategyManager",
+		event:    "StrategyAddedToDepositWhitelist",
+		fields:   []string{"address", "whitelisted"},
+		unique:   true,
+		build: func(r *resolver, e indexer.Event) (map[string]interface{}, error) {
+			obj := args(e, "strategy")
+			obj["address"] = obj["strategy"]
+			delete(obj, "strategy")
+			obj["id"] = obj["address"]
+			removed, err := r.latest("StrategyManager", "StrategyRemovedFromDepositWhitelist", "strategy")
+			if err != nil {
+				return nil, err
+			}
+			// removed and added again if the removal precedes this addition
+			rm, ok := removed[key(obj["address"])]
+			obj["whitelisted"] = !ok || rm.BlockNumber < e.BlockNumber ||
+				(rm.BlockNumber == e.BlockNumber && rm.LogIndex < e.LogIndex)
+			return obj, nil
+		},
+	},
+	{
+		typename: "RewardsClaim",
+		plural:   "rewardsClaims",
+		contract: "RewardsCoordinator",
+		event:    "RewardsClaimed",
+		fields:   []string{"root", "earner", "claimer", "recipient", "token", "claimedAmount"},
+		build: func(_ *resolver, e indexer.Event) (map[string]interface{}, error) {
+			return args(e, "root", "earner", "claimer", "recipient", "token", "claimedAmount"), nil
+		},
+	},
+}
+
+// singular returns the name of the field querying a single entity of type e by id.
+func (e *entity) singular() string {
+	return strings.ToLower(e.typename[:1]) + e.typename[1:]
+}
+
+func (e *entity) hasField(name string) bool {
+	for _, f := range eventFields {
+		if f == name {
+			return true
+		}
+	}
+	for _, f := range e.fields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// args returns the normalized arguments names of e.
+func args(e indexer.Event, names ...string) map[string]interface{} {
+	obj := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		obj[name] = normalize(e.Args[name])
+	}
+	return obj
+}
+
+// object returns the entity of type ent built from e, with the event fields.
+func (r *resolver) object(ent *entity, e indexer.Event) (map[string]interface{}, error) {
+	obj, err := ent.build(r, e)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	if _, ok := obj["id"]; !ok {
+		obj["id"] = fmt.Sprintf("%s-%d", strings.ToLower(e.TxHash.Hex()), e.LogIndex)
+	}
+	obj["blockNumber"] = strconv.FormatUint(e.BlockNumber, 10)
+	obj["blockHash"] = normalize(e.BlockHash)
+	obj["transactionHash"] = normalize(e.TxHash)
+	obj["logIndex"] = strconv.FormatUint(uint64(e.LogIndex), 10)
+	obj["contract"] = normalize(e.Address)
+	return obj, nil
+}
+
+// key returns the string a normalized value is looked up by.
+func key(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// normalize converts a decoded event argument, as decoded by the indexer or read back from an
+// indexer.SQLStore, into the value returned for it: addresses and bytes as lowercase hex, as in
+// subgraphs, integers as decimal strings, like the BigInt scalar of subgraphs, and structs as
+// objects keyed by the names of their components.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case common.Address:
+		return strings.ToLower(v.Hex())
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case string:
+		if strings.HasPrefix(v, "0x") {
+			return strings.ToLower(v)
+		}
+		return v
+	case bool:
+		return v
+	case float64:
+		// SQLStore arguments decoded from JSON
+		if v == float64(int64(v)) {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return new(big.Float).SetFloat64(v).Text('f', 0)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for name, value := range v {
+			out[name] = normalize(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = normalize(value)
+		}
+		return out
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			out[i] = normalize(rv.Index(i).Interface())
+		}
+		return out
+	case reflect.Struct:
+		// the structs of tuple arguments tag their fields with the component names
+		out := make(map[string]interface{}, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" {
+				r := []rune(field.Name)
+				r[0] = unicode.ToLower(r[0])
+				name = string(r)
+			}
+			out[name] = normalize(rv.Field(i).Interface())
+		}
+		return out
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalize(rv.Elem().Interface())
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,334 @@
+// Package grpcapi serves the restaking state read by the client package over gRPC, for services
+// that prefer RPC over direct chain access.
+//
+// The RestakingState service is defined in proto/eigenlayer/restaking/v1/restaking.proto, from
+// which clients in any language are generated. It returns the StakerState and OperatorState of
+// the client and the TVL of strategies:
+//
+//	registry, _ := strategies.NewRegistry(ethClient, strategies.Config{StrategyManager: sm})
+//	server := grpcapi.NewServer(c, grpcapi.Config{Strategies: registry, Withdrawals: ledger})
+//	err := http.ListenAndServeTLS(":8443", certFile, keyFile, server.Handler())
+//
+// The gRPC protocol is implemented over net/http rather than with grpc-go, which is only used to
+// test the server. The handler serves HTTP/2 over TLS, and plaintext HTTP/2 (h2c) for clients
+// dialing without transport security, such as with http.ListenAndServe. Only unary calls without
+// compression are supported.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ledger"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/snapshot"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+)
+
+// ServiceName is the full name of the RestakingState service.
+const ServiceName = "eigenlayer.restaking.v1.RestakingState"
+
+// Code is a gRPC status code.
+type Code uint32
+
+// The status codes returned by the server.
+const (
+	CodeOK                 Code = 0
+	CodeCanceled           Code = 1
+	CodeInvalidArgument    Code = 3
+	CodeDeadlineExceeded   Code = 4
+	CodeFailedPrecondition Code = 9
+	CodeUnimplemented      Code = 12
+	CodeInternal           Code = 13
+	CodeUnavailable        Code = 14
+)
+
+// Error is an error with a gRPC status code.
+type Error struct {
+	Code    Code
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("grpc status %d: %s", e.Code, e.Message)
+}
+
+func errorf(code Code, format string, args ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// statusOf returns the status of err. Errors reading the chain are reported as unavailable, since
+// they are usually transient.
+func statusOf(err error) *Error {
+	var status *Error
+	switch {
+	case errors.As(err, &status):
+		return status
+	case errors.Is(err, context.DeadlineExceeded):
+		return &Error{Code: CodeDeadlineExceeded, Message: err.Error()}
+	case errors.Is(err, context.Canceled):
+		return &Error{Code: CodeCanceled, Message: err.Error()}
+	}
+	return &Error{Code: CodeUnavailable, Message: err.Error()}
+}
+
+// StrategyLister lists the whitelisted strategies. *strategies.Registry satisfies it.
+type StrategyLister interface {
+	ListStrategies(ctx context.Context) ([]strategies.Strategy, error)
+}
+
+// WithdrawalSource tracks the queued withdrawals of stakers. *ledger.Ledger satisfies it.
+type WithdrawalSource interface {
+	PendingWithdrawals(staker common.Address) []ledger.Withdrawal
+}
+
+// EarningsSource holds the cumulative earnings of the current rewards distribution.
+// *rewards.Distribution satisfies it.
+type EarningsSource interface {
+	Earnings(earner common.Address) []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf
+}
+
+// Config configures a Server.
+type Config struct {
+	// Strategies lists the strategies of operator and TVL requests that name none. Without it,
+	// those requests must name their strategies.
+	Strategies StrategyLister
+	// Withdrawals, if set, provides the queued withdrawals reported in staker states.
+	Withdrawals WithdrawalSource
+	// Earnings, if set, provides the earnings whose claimable rewards are reported in staker
+	// states.
+	Earnings EarningsSource
+	// Logger receives the calls that failed. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Server implements the RestakingState service.
+type Server struct {
+	client *client.EigenLayerClient
+	cfg    Config
+}
+
+// NewServer returns a Server reading state through c.
+func NewServer(c *client.EigenLayerClient, cfg Config) *Server {
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	return &Server{client: c, cfg: cfg}
+}
+
+// GetStakerState returns the state of the staker of req.
+func (s *Server) GetStakerState(ctx context.Context, req *GetStakerStateRequest) (*StakerState, error) {
+	staker, err := parseAddress("staker", req.Staker)
+	if err != nil {
+		return nil, err
+	}
+	var queued []DelegationManager.IDelegationManagerWithdrawal
+	if s.cfg.Withdrawals != nil {
+		for _, w := range s.cfg.Withdrawals.PendingWithdrawals(staker) {
+			queued = append(queued, w.IDelegationManagerWithdrawal)
+		}
+	}
+	var earnings []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf
+	if s.cfg.Earnings != nil {
+		earnings = s.cfg.Earnings.Earnings(staker)
+	}
+	state, err := s.client.StakerState(ctx, staker, queued, earnings, at(req.BlockNumber)...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &StakerState{
+		Staker:         hexOf(state.Staker),
+		BlockNumber:    state.BlockNumber,
+		DelegatedTo:    hexOf(state.DelegatedTo),
+		Deposits:       sharesOf(state.Deposits),
+		EigenPod:       hexOf(state.EigenPod),
+		HasPod:         state.HasPod,
+		PodOwnerShares: decimal(state.PodOwnerShares),
+		Claimer:        hexOf(state.Claimer),
+	}
+	for _, w := range state.Withdrawals {
+		pending := &PendingWithdrawal{
+			Root:        w.Root.Hex(),
+			Staker:      hexOf(w.Withdrawal.Staker),
+			DelegatedTo: hexOf(w.Withdrawal.DelegatedTo),
+			Withdrawer:  hexOf(w.Withdrawal.Withdrawer),
+			Nonce:       decimal(w.Withdrawal.Nonce),
+			StartBlock:  uint64(w.Withdrawal.StartBlock),
+			UnlockBlock: w.UnlockBlock,
+		}
+		for i, strategy := range w.Withdrawal.Strategies {
+			pending.Shares = append(pending.Shares, &StrategyShares{Strategy: strategy.Hex(), Shares: decimal(w.Withdrawal.Shares[i])})
+		}
+		out.Withdrawals = append(out.Withdrawals, pending)
+	}
+	for _, r := range state.Rewards {
+		out.Rewards = append(out.Rewards, &RewardClaim{
+			Token:              r.Token.Hex(),
+			CumulativeEarnings: decimal(r.CumulativeEarnings),
+			CumulativeClaimed:  decimal(r.CumulativeClaimed),
+			Claimable:          decimal(r.Claimable),
+		})
+	}
+	return out, nil
+}
+
+// GetOperatorState returns the state of the operator of req.
+func (s *Server) GetOperatorState(ctx context.Context, req *GetOperatorStateRequest) (*OperatorState, error) {
+	operator, err := parseAddress("operator", req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	strategyAddrs, err := parseAddresses("strategies", req.Strategies)
+	if err != nil {
+		return nil, err
+	}
+	avss, err := parseAddresses("avss", req.AVSs)
+	if err != nil {
+		return nil, err
+	}
+	if len(strategyAddrs) == 0 {
+		list, err := s.strategies(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, strategy := range list {
+			strategyAddrs = append(strategyAddrs, strategy.Address)
+		}
+	}
+	state, err := s.client.OperatorState(ctx, operator, strategyAddrs, avss, at(req.BlockNumber)...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &OperatorState{
+		Operator:                 hexOf(state.Operator),
+		BlockNumber:              state.BlockNumber,
+		IsOperator:               state.IsOperator,
+		DelegationApprover:       hexOf(state.DelegationApprover),
+		StakerOptOutWindowBlocks: state.Details.StakerOptOutWindowBlocks,
+		Shares:                   sharesOf(state.Shares),
+	}
+	for _, avs := range state.AVSs {
+		out.AVSs = append(out.AVSs, avs.Hex())
+	}
+	return out, nil
+}
+
+// GetStrategyTVL returns the TVL of the strategies of req.
+func (s *Server) GetStrategyTVL(ctx context.Context, req *GetStrategyTVLRequest) (*StrategyTVL, error) {
+	addrs, err := parseAddresses("strategies", req.Strategies)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.strategies(ctx, addrs)
+	if err != nil {
+		return nil, err
+	}
+	var blockNumber *big.Int
+	if req.BlockNumber != 0 {
+		blockNumber = new(big.Int).SetUint64(req.BlockNumber)
+	}
+	snap, err := snapshot.Take(ctx, s.client.Backend, list, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &StrategyTVL{BlockNumber: snap.BlockNumber, Time: snap.Time.Unix()}
+	for _, row := range snap.Rows {
+		out.Rows = append(out.Rows, &StrategyTVLRow{
+			Strategy:     row.Strategy.Hex(),
+			Token:        row.Token.Hex(),
+			Symbol:       row.Symbol,
+			Decimals:     uint32(row.Decimals),
+			TotalShares:  decimal(row.TotalShares),
+			Underlying:   decimal(row.Underlying),
+			TokenBalance: decimal(row.TokenBalance),
+		})
+	}
+	return out, nil
+}
+
+// strategies returns the listed strategies with addrs, or all of them if addrs is empty.
+func (s *Server) strategies(ctx context.Context, addrs []common.Address) ([]strategies.Strategy, error) {
+	if s.cfg.Strategies == nil {
+		if len(addrs) == 0 {
+			return nil, errorf(CodeFailedPrecondition, "the server lists no strategies, name them in the request")
+		}
+		return nil, errorf(CodeFailedPrecondition, "the server lists no strategies to read the tokens of")
+	}
+	list, err := s.cfg.Strategies.ListStrategies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list strategies: %w", err)
+	}
+	if len(addrs) == 0 {
+		return list, nil
+	}
+	byAddress := make(map[common.Address]strategies.Strategy, len(list))
+	for _, strategy := range list {
+		byAddress[strategy.Address] = strategy
+	}
+	selected := make([]strategies.Strategy, len(addrs))
+	for i, addr := range addrs {
+		strategy, ok := byAddress[addr]
+		if !ok {
+			return nil, errorf(CodeInvalidArgument, "strategy %s is not whitelisted", addr.Hex())
+		}
+		selected[i] = strategy
+	}
+	return selected, nil
+}
+
+func at(blockNumber uint64) []client.CallOption {
+	if blockNumber == 0 {
+		return nil
+	}
+	return []client.CallOption{client.At(blockNumber)}
+}
+
+func parseAddress(field, s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, errorf(CodeInvalidArgument, "%s %q is not an address", field, s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func parseAddresses(field string, list []string) ([]common.Address, error) {
+	addrs := make([]common.Address, len(list))
+	for i, s := range list {
+		var err error
+		if addrs[i], err = parseAddress(field, strings.TrimSpace(s)); err != nil {
+			return nil, err
+		}
+	}
+	return addrs, nil
+}
+
+// hexOf returns the hex of addr, or the empty string for the zero address.
+func hexOf(addr common.Address) string {
+	if addr == (common.Address{}) {
+		return ""
+	}
+	return addr.Hex()
+}
+
+func decimal(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
+
+func sharesOf(shares []client.StrategyShares) []*StrategyShares {
+	out := make([]*StrategyShares, len(shares))
+	for i, s := range shares {
+		out[i] = &StrategyShares{Strategy: s.Strategy.Hex(), Shares: decimal(s.Shares)}
+	}
+	return out
+}
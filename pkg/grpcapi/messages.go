@@ -0,0 +1,282 @@
+package grpcapi
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The messages of proto/eigenlayer/restaking/v1/restaking.proto, encoded with protowire.
+// Requests are decoded and responses encoded; unknown fields are skipped. The JSON tags name the
+// fields as the REST API of pkg/restapi returns them.
+
+// StrategyShares is an amount of shares in a strategy.
+type StrategyShares struct {
+	Strategy string `json:"strategy"`
+	Shares   string `json:"shares"`
+}
+
+func (m *StrategyShares) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Strategy)
+	return appendString(b, 2, m.Shares)
+}
+
+// GetStakerStateRequest requests the state of a staker.
+type GetStakerStateRequest struct {
+	Staker      string `json:"staker"`
+	BlockNumber uint64 `json:"blockNumber"`
+}
+
+func (m *GetStakerStateRequest) unmarshal(b []byte) error {
+	return consumeFields(b, func(num protowire.Number, v uint64, data []byte) {
+		switch num {
+		case 1:
+			m.Staker = string(data)
+		case 2:
+			m.BlockNumber = v
+		}
+	})
+}
+
+// PendingWithdrawal is a queued withdrawal that has not been completed.
+type PendingWithdrawal struct {
+	Root        string            `json:"root"`
+	Staker      string            `json:"staker"`
+	DelegatedTo string            `json:"delegatedTo"`
+	Withdrawer  string            `json:"withdrawer"`
+	Nonce       string            `json:"nonce"`
+	StartBlock  uint64            `json:"startBlock"`
+	Shares      []*StrategyShares `json:"shares"`
+	UnlockBlock uint64            `json:"unlockBlock"`
+}
+
+func (m *PendingWithdrawal) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Root)
+	b = appendString(b, 2, m.Staker)
+	b = appendString(b, 3, m.DelegatedTo)
+	b = appendString(b, 4, m.Withdrawer)
+	b = appendString(b, 5, m.Nonce)
+	b = appendVarint(b, 6, m.StartBlock)
+	for _, s := range m.Shares {
+		b = appendMessage(b, 7, s.marshal)
+	}
+	return appendVarint(b, 8, m.UnlockBlock)
+}
+
+// RewardClaim is the rewards a staker can claim in a token.
+type RewardClaim struct {
+	Token              string `json:"token"`
+	CumulativeEarnings string `json:"cumulativeEarnings"`
+	CumulativeClaimed  string `json:"cumulativeClaimed"`
+	Claimable          string `json:"claimable"`
+}
+
+func (m *RewardClaim) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Token)
+	b = appendString(b, 2, m.CumulativeEarnings)
+	b = appendString(b, 3, m.CumulativeClaimed)
+	return appendString(b, 4, m.Claimable)
+}
+
+// StakerState is the restaking position of a staker at a block.
+type StakerState struct {
+	Staker         string               `json:"staker"`
+	BlockNumber    uint64               `json:"blockNumber"`
+	DelegatedTo    string               `json:"delegatedTo"`
+	Deposits       []*StrategyShares    `json:"deposits"`
+	Withdrawals    []*PendingWithdrawal `json:"withdrawals"`
+	EigenPod       string               `json:"eigenPod"`
+	HasPod         bool                 `json:"hasPod"`
+	PodOwnerShares string               `json:"podOwnerShares"`
+	Claimer        string               `json:"claimer"`
+	Rewards        []*RewardClaim       `json:"rewards"`
+}
+
+func (m *StakerState) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Staker)
+	b = appendVarint(b, 2, m.BlockNumber)
+	b = appendString(b, 3, m.DelegatedTo)
+	for _, d := range m.Deposits {
+		b = appendMessage(b, 4, d.marshal)
+	}
+	for _, w := range m.Withdrawals {
+		b = appendMessage(b, 5, w.marshal)
+	}
+	b = appendString(b, 6, m.EigenPod)
+	b = appendBool(b, 7, m.HasPod)
+	b = appendString(b, 8, m.PodOwnerShares)
+	b = appendString(b, 9, m.Claimer)
+	for _, r := range m.Rewards {
+		b = appendMessage(b, 10, r.marshal)
+	}
+	return b
+}
+
+// GetOperatorStateRequest requests the state of an operator.
+type GetOperatorStateRequest struct {
+	Operator    string   `json:"operator"`
+	Strategies  []string `json:"strategies"`
+	AVSs        []string `json:"avss"`
+	BlockNumber uint64   `json:"blockNumber"`
+}
+
+func (m *GetOperatorStateRequest) unmarshal(b []byte) error {
+	return consumeFields(b, func(num protowire.Number, v uint64, data []byte) {
+		switch num {
+		case 1:
+			m.Operator = string(data)
+		case 2:
+			m.Strategies = append(m.Strategies, string(data))
+		case 3:
+			m.AVSs = append(m.AVSs, string(data))
+		case 4:
+			m.BlockNumber = v
+		}
+	})
+}
+
+// OperatorState is the delegation state of an operator at a block.
+type OperatorState struct {
+	Operator                 string            `json:"operator"`
+	BlockNumber              uint64            `json:"blockNumber"`
+	IsOperator               bool              `json:"isOperator"`
+	DelegationApprover       string            `json:"delegationApprover"`
+	StakerOptOutWindowBlocks uint32            `json:"stakerOptOutWindowBlocks"`
+	Shares                   []*StrategyShares `json:"shares"`
+	AVSs                     []string          `json:"avss"`
+}
+
+func (m *OperatorState) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Operator)
+	b = appendVarint(b, 2, m.BlockNumber)
+	b = appendBool(b, 3, m.IsOperator)
+	b = appendString(b, 4, m.DelegationApprover)
+	b = appendVarint(b, 5, uint64(m.StakerOptOutWindowBlocks))
+	for _, s := range m.Shares {
+		b = appendMessage(b, 6, s.marshal)
+	}
+	for _, avs := range m.AVSs {
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		b = protowire.AppendString(b, avs)
+	}
+	return b
+}
+
+// GetStrategyTVLRequest requests the TVL of strategies.
+type GetStrategyTVLRequest struct {
+	Strategies  []string `json:"strategies"`
+	BlockNumber uint64   `json:"blockNumber"`
+}
+
+func (m *GetStrategyTVLRequest) unmarshal(b []byte) error {
+	return consumeFields(b, func(num protowire.Number, v uint64, data []byte) {
+		switch num {
+		case 1:
+			m.Strategies = append(m.Strategies, string(data))
+		case 2:
+			m.BlockNumber = v
+		}
+	})
+}
+
+// StrategyTVLRow is the TVL of a single strategy.
+type StrategyTVLRow struct {
+	Strategy     string `json:"strategy"`
+	Token        string `json:"token"`
+	Symbol       string `json:"symbol"`
+	Decimals     uint32 `json:"decimals"`
+	TotalShares  string `json:"totalShares"`
+	Underlying   string `json:"underlying"`
+	TokenBalance string `json:"tokenBalance"`
+}
+
+func (m *StrategyTVLRow) marshal(b []byte) []byte {
+	b = appendString(b, 1, m.Strategy)
+	b = appendString(b, 2, m.Token)
+	b = appendString(b, 3, m.Symbol)
+	b = appendVarint(b, 4, uint64(m.Decimals))
+	b = appendString(b, 5, m.TotalShares)
+	b = appendString(b, 6, m.Underlying)
+	return appendString(b, 7, m.TokenBalance)
+}
+
+// StrategyTVL is the TVL of a set of strategies at a block.
+type StrategyTVL struct {
+	BlockNumber uint64 `json:"blockNumber"`
+	// Time is the timestamp of the block, in seconds since the Unix epoch.
+	Time int64             `json:"time"`
+	Rows []*StrategyTVLRow `json:"rows"`
+}
+
+func (m *StrategyTVL) marshal(b []byte) []byte {
+	b = appendVarint(b, 1, m.BlockNumber)
+	b = appendVarint(b, 2, uint64(m.Time))
+	for _, r := range m.Rows {
+		b = appendMessage(b, 3, r.marshal)
+	}
+	return b
+}
+
+// appendString, appendVarint and appendBool append fields, omitting zero values as proto3 does.
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBool(b []byte, num protowire.Number, v bool) []byte {
+	if !v {
+		return b
+	}
+	return appendVarint(b, num, 1)
+}
+
+func appendMessage(b []byte, num protowire.Number, marshal func([]byte) []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, marshal(nil))
+}
+
+// consumeFields calls field with each varint and length-delimited field of the message b, with
+// the value of varints as v and the contents of length-delimited fields as data.
+func consumeFields(b []byte, field func(num protowire.Number, v uint64, data []byte)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("invalid message: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %w", num, protowire.ParseError(n))
+			}
+			field(num, v, nil)
+			b = b[n:]
+		case protowire.BytesType:
+			data, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %w", num, protowire.ParseError(n))
+			}
+			field(num, 0, data)
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return fmt.Errorf("invalid field %d: %w", num, protowire.ParseError(n))
+			}
+			b = b[n:]
+		}
+	}
+	return nil
+}
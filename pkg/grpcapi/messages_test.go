@@ -0,0 +1,280 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const protoPath = "../../proto/eigenlayer/restaking/v1/restaking.proto"
+
+var (
+	protoPackage = regexp.MustCompile(`(?m)^package ([\w.]+);`)
+	protoMessage = regexp.MustCompile(`(?s)message (\w+) \{(.*?)\n\}`)
+	protoField   = regexp.MustCompile(`(?m)^\s*(repeated )?(\w+) (\w+) = (\d+);`)
+	protoRPC     = regexp.MustCompile(`rpc (\w+)\((\w+)\) returns \((\w+)\);`)
+)
+
+var protoScalars = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bool":   descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"uint32": descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"uint64": descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int64":  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+}
+
+// loadProto builds the descriptor of restaking.proto, which declares only scalar and message
+// fields, so that messages can be encoded as a generated client would.
+func loadProto(t testing.TB) protoreflect.FileDescriptor {
+	t.Helper()
+	src, err := os.ReadFile(protoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := protoPackage.FindSubmatch(src)
+	if pkg == nil {
+		t.Fatal("no package in restaking.proto")
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("eigenlayer/restaking/v1/restaking.proto"),
+		Package: proto.String(string(pkg[1])),
+		Syntax:  proto.String("proto3"),
+	}
+	for _, m := range protoMessage.FindAllSubmatch(src, -1) {
+		message := &descriptorpb.DescriptorProto{Name: proto.String(string(m[1]))}
+		for _, f := range protoField.FindAllSubmatch(m[2], -1) {
+			number, err := strconv.Atoi(string(f[4]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			field := &descriptorpb.FieldDescriptorProto{
+				Name:   proto.String(string(f[3])),
+				Number: proto.Int32(int32(number)),
+				Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}
+			if len(f[1]) > 0 {
+				field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			}
+			if typ, ok := protoScalars[string(f[2])]; ok {
+				field.Type = typ.Enum()
+			} else {
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+				field.TypeName = proto.String("." + string(pkg[1]) + "." + string(f[2]))
+			}
+			message.Field = append(message.Field, field)
+		}
+		file.MessageType = append(file.MessageType, message)
+	}
+	service := &descriptorpb.ServiceDescriptorProto{Name: proto.String(ServiceName[strings.LastIndex(ServiceName, ".")+1:])}
+	for _, rpc := range protoRPC.FindAllSubmatch(src, -1) {
+		service.Method = append(service.Method, &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(string(rpc[1])),
+			InputType:  proto.String("." + string(pkg[1]) + "." + string(rpc[2])),
+			OutputType: proto.String("." + string(pkg[1]) + "." + string(rpc[3])),
+		})
+	}
+	file.Service = append(file.Service, service)
+
+	fd, err := protodesc.NewFile(file, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+// newMessage returns a dynamic message of the named message of fd, holding the fields of v,
+// which are matched to the message fields by their JSON names.
+func newMessage(t testing.TB, fd protoreflect.FileDescriptor, name string, v interface{}) *dynamicpb.Message {
+	t.Helper()
+	desc := fd.Messages().ByName(protoreflect.Name(name))
+	if desc == nil {
+		t.Fatalf("no message %s in restaking.proto", name)
+	}
+	msg := dynamicpb.NewMessage(desc)
+	if v == nil {
+		return msg
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		t.Fatalf("%s does not match restaking.proto: %v", name, err)
+	}
+	return msg
+}
+
+func TestServiceMatchesProto(t *testing.T) {
+	fd := loadProto(t)
+	if got := string(fd.Services().Get(0).FullName()); got != ServiceName {
+		t.Errorf("ServiceName = %s, restaking.proto declares %s", ServiceName, got)
+	}
+	rpcs := fd.Services().Get(0).Methods()
+	if rpcs.Len() != len(methods) {
+		t.Errorf("serving %d methods, restaking.proto declares %d", len(methods), rpcs.Len())
+	}
+	for i := 0; i < rpcs.Len(); i++ {
+		if path := "/" + ServiceName + "/" + string(rpcs.Get(i).Name()); methods[path] == nil {
+			t.Errorf("method %s is not served", path)
+		}
+	}
+}
+
+func TestMarshalResponses(t *testing.T) {
+	fd := loadProto(t)
+	shares := []*StrategyShares{
+		{Strategy: "0x93c4b944D05dfe6df7645A86cd2206016c51564D", Shares: "1000000000000000000"},
+		{Strategy: "0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0", Shares: "32000000000000000000"},
+	}
+
+	tests := []struct {
+		name    string
+		message interface{ marshal([]byte) []byte }
+	}{
+		{"StakerState", &StakerState{
+			Staker:      "0x0000000000000000000000000000000000000001",
+			BlockNumber: 19000000,
+			DelegatedTo: "0x0000000000000000000000000000000000000002",
+			Deposits:    shares,
+			Withdrawals: []*PendingWithdrawal{{
+				Root:        "0x" + strings.Repeat("ab", 32),
+				Staker:      "0x0000000000000000000000000000000000000001",
+				DelegatedTo: "0x0000000000000000000000000000000000000002",
+				Withdrawer:  "0x0000000000000000000000000000000000000001",
+				Nonce:       "7",
+				StartBlock:  18999000,
+				Shares:      shares[:1],
+				UnlockBlock: 19049400,
+			}},
+			EigenPod:       "0x0000000000000000000000000000000000000003",
+			HasPod:         true,
+			PodOwnerShares: "-1",
+			Claimer:        "0x0000000000000000000000000000000000000004",
+			Rewards: []*RewardClaim{{
+				Token:              "0x0000000000000000000000000000000000000005",
+				CumulativeEarnings: "300",
+				CumulativeClaimed:  "100",
+				Claimable:          "200",
+			}},
+		}},
+		{"StakerState", &StakerState{Staker: "0x0000000000000000000000000000000000000001", BlockNumber: 1}},
+		{"OperatorState", &OperatorState{
+			Operator:                 "0x0000000000000000000000000000000000000002",
+			BlockNumber:              19000000,
+			IsOperator:               true,
+			DelegationApprover:       "0x0000000000000000000000000000000000000006",
+			StakerOptOutWindowBlocks: 50400,
+			Shares:                   shares,
+			AVSs:                     []string{"0x0000000000000000000000000000000000000007", "0x0000000000000000000000000000000000000008"},
+		}},
+		{"StrategyTVL", &StrategyTVL{
+			BlockNumber: 19000000,
+			Time:        1706000000,
+			Rows: []*StrategyTVLRow{{
+				Strategy:     "0x93c4b944D05dfe6df7645A86cd2206016c51564D",
+				Token:        "0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84",
+				Symbol:       "stETH",
+				Decimals:     18,
+				TotalShares:  "1000",
+				Underlying:   "1001",
+				TokenBalance: "1002",
+			}},
+		}},
+		{"StrategyTVL", &StrategyTVL{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := newMessage(t, fd, tt.name, tt.message)
+			got := newMessage(t, fd, tt.name, nil)
+			if err := proto.Unmarshal(tt.message.marshal(nil), got); err != nil {
+				t.Fatalf("failed to decode %s: %v", tt.name, err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("decoded %s = %v, want %v", tt.name, got, want)
+			}
+			// The encoding is the canonical one, with fields in number order and zero values
+			// omitted.
+			canonical, err := proto.MarshalOptions{Deterministic: true}.Marshal(want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if encoded := tt.message.marshal(nil); string(encoded) != string(canonical) {
+				t.Errorf("encoded %s = %x, want %x", tt.name, encoded, canonical)
+			}
+		})
+	}
+}
+
+func TestUnmarshalRequests(t *testing.T) {
+	fd := loadProto(t)
+
+	tests := []struct {
+		name string
+		want interface{ unmarshal([]byte) error }
+		got  interface{ unmarshal([]byte) error }
+	}{
+		{"GetStakerStateRequest", &GetStakerStateRequest{Staker: "0x0000000000000000000000000000000000000001", BlockNumber: 19000000}, new(GetStakerStateRequest)},
+		{"GetStakerStateRequest", &GetStakerStateRequest{}, new(GetStakerStateRequest)},
+		{"GetOperatorStateRequest", &GetOperatorStateRequest{
+			Operator:    "0x0000000000000000000000000000000000000002",
+			Strategies:  []string{"0x93c4b944D05dfe6df7645A86cd2206016c51564D", "0x54945180dB7943c0ed0FEE7EdaB2Bd24620256bc"},
+			AVSs:        []string{"0x0000000000000000000000000000000000000007"},
+			BlockNumber: 1,
+		}, new(GetOperatorStateRequest)},
+		{"GetStrategyTVLRequest", &GetStrategyTVLRequest{Strategies: []string{"0x93c4b944D05dfe6df7645A86cd2206016c51564D"}, BlockNumber: 2}, new(GetStrategyTVLRequest)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := proto.Marshal(newMessage(t, fd, tt.name, tt.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Fields added to the request by newer clients are skipped.
+			data = protowire.AppendTag(data, 100, protowire.Fixed64Type)
+			data = protowire.AppendFixed64(data, 1)
+			data = protowire.AppendTag(data, 101, protowire.BytesType)
+			data = protowire.AppendString(data, "unknown")
+			if err := tt.got.unmarshal(data); err != nil {
+				t.Fatalf("failed to decode %s: %v", tt.name, err)
+			}
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("decoded %s = %+v, want %+v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated tag", []byte{0x80}},
+		{"truncated varint", []byte{0x10, 0xff}},
+		{"truncated string", []byte{0x0a, 0x05, 'a', 'b'}},
+		{"truncated fixed64", []byte{0x19, 0x01, 0x02}},
+		{"field number zero", []byte{0x00, 0x01}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := new(GetStakerStateRequest).unmarshal(tt.data); err == nil {
+				t.Errorf("unmarshal(%x) succeeded", tt.data)
+			}
+		})
+	}
+}
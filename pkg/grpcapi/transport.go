@@ -0,0 +1,159 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// maxMessageBytes bounds the size of a request message, as the 4 MiB default of grpc-go does.
+const maxMessageBytes = 4 << 20
+
+// method calls a method of the service with an encoded request and returns the encoded response.
+type method func(s *Server, ctx context.Context, body []byte) ([]byte, error)
+
+var methods = map[string]method{
+	"/" + ServiceName + "/GetStakerState": func(s *Server, ctx context.Context, body []byte) ([]byte, error) {
+		req := new(GetStakerStateRequest)
+		if err := req.unmarshal(body); err != nil {
+			return nil, errorf(CodeInvalidArgument, "%v", err)
+		}
+		resp, err := s.GetStakerState(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.marshal(nil), nil
+	},
+	"/" + ServiceName + "/GetOperatorState": func(s *Server, ctx context.Context, body []byte) ([]byte, error) {
+		req := new(GetOperatorStateRequest)
+		if err := req.unmarshal(body); err != nil {
+			return nil, errorf(CodeInvalidArgument, "%v", err)
+		}
+		resp, err := s.GetOperatorState(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.marshal(nil), nil
+	},
+	"/" + ServiceName + "/GetStrategyTVL": func(s *Server, ctx context.Context, body []byte) ([]byte, error) {
+		req := new(GetStrategyTVLRequest)
+		if err := req.unmarshal(body); err != nil {
+			return nil, errorf(CodeInvalidArgument, "%v", err)
+		}
+		resp, err := s.GetStrategyTVL(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.marshal(nil), nil
+	},
+}
+
+// Handler returns an HTTP handler serving the service to gRPC clients, over HTTP/2 with TLS or
+// plaintext HTTP/2 (h2c).
+func (s *Server) Handler() http.Handler {
+	return h2c.NewHandler(s.handler(), &http2.Server{})
+}
+
+func (s *Server) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			http.Error(w, "gRPC requests only", http.StatusUnsupportedMediaType)
+			return
+		}
+		w.Header().Set("Content-Type", "application/grpc+proto")
+		w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+
+		call, ok := methods[r.URL.Path]
+		if !ok {
+			s.finish(w, r, errorf(CodeUnimplemented, "unknown method %s", r.URL.Path))
+			return
+		}
+		ctx := r.Context()
+		if timeout := r.Header.Get("Grpc-Timeout"); timeout != "" {
+			d, err := parseTimeout(timeout)
+			if err != nil {
+				s.finish(w, r, errorf(CodeInvalidArgument, "%v", err))
+				return
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+		body, err := readMessage(r.Body)
+		if err != nil {
+			s.finish(w, r, err)
+			return
+		}
+		resp, err := call(s, ctx, body)
+		if err != nil {
+			s.finish(w, r, err)
+			return
+		}
+		frame := make([]byte, 5, 5+len(resp))
+		binary.BigEndian.PutUint32(frame[1:], uint32(len(resp)))
+		if _, err := w.Write(append(frame, resp...)); err != nil {
+			return
+		}
+		s.finish(w, r, nil)
+	})
+}
+
+// finish writes the status of the call in the trailers.
+func (s *Server) finish(w http.ResponseWriter, r *http.Request, err error) {
+	status := &Error{Code: CodeOK}
+	if err != nil {
+		status = statusOf(err)
+		s.cfg.Logger.Warn("grpc call failed", "method", r.URL.Path, "code", status.Code, "err", status.Message)
+	}
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(status.Code)))
+	if status.Message != "" {
+		w.Header().Set("Grpc-Message", url.PathEscape(status.Message))
+	}
+}
+
+// readMessage reads the single length-prefixed message of a unary request.
+func readMessage(body io.Reader) ([]byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(body, prefix[:]); err != nil {
+		return nil, errorf(CodeInvalidArgument, "failed to read request: %v", err)
+	}
+	if prefix[0] != 0 {
+		return nil, errorf(CodeUnimplemented, "compressed requests are not supported")
+	}
+	n := binary.BigEndian.Uint32(prefix[1:])
+	if n > maxMessageBytes {
+		return nil, errorf(CodeInvalidArgument, "request of %d bytes exceeds %d", n, maxMessageBytes)
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(body, msg); err != nil {
+		return nil, errorf(CodeInvalidArgument, "failed to read request: %v", err)
+	}
+	return msg, nil
+}
+
+// parseTimeout parses a grpc-timeout header, such as "500m" or "10S".
+func parseTimeout(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, errors.New("invalid grpc-timeout")
+	}
+	units := map[byte]time.Duration{
+		'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+		'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond,
+	}
+	unit, ok := units[s[len(s)-1]]
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if !ok || err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid grpc-timeout %q", s)
+	}
+	return time.Duration(n) * unit, nil
+}
@@ -0,0 +1,201 @@
+package grpcapi
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+)
+
+const aggregate3ABI = `[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`
+
+// fakeChain is a client.Backend at a fixed head block that answers the Multicall3 calls of the
+// client with the results of views, keyed by method name. Other methods of the backend are not
+// implemented.
+type fakeChain struct {
+	bind.ContractBackend
+	bind.DeployBackend
+
+	head  uint64
+	abis  []*abi.ABI
+	views map[string][]interface{}
+}
+
+func (c *fakeChain) ChainID(context.Context) (*big.Int, error) {
+	return new(big.Int).SetUint64(addresses.ChainIDHolesky), nil
+}
+
+func (c *fakeChain) BlockNumber(context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x00}, nil
+}
+
+func (c *fakeChain) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	multicall, err := abi.JSON(strings.NewReader(aggregate3ABI))
+	if err != nil {
+		return nil, err
+	}
+	args, err := multicall.Methods["aggregate3"].Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	var calls []struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+	abi.ConvertType(args[0], &calls)
+
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, len(calls))
+	for i, call := range calls {
+		for _, parsed := range c.abis {
+			method, err := parsed.MethodById(call.CallData[:4])
+			if err != nil {
+				continue
+			}
+			if values, ok := c.views[method.Name]; ok {
+				results[i].ReturnData, err = method.Outputs.Pack(values...)
+				results[i].Success = err == nil
+			}
+		}
+	}
+	return multicall.Methods["aggregate3"].Outputs.Pack(results)
+}
+
+// dial serves srv over plaintext HTTP/2 and returns a grpc-go connection to it.
+func dial(t *testing.T, srv *Server) *grpc.ClientConn {
+	t.Helper()
+	httpServer := httptest.NewServer(srv.Handler())
+	t.Cleanup(httpServer.Close)
+	conn, err := grpc.NewClient("passthrough:///"+strings.TrimPrefix(httpServer.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestGRPCClient(t *testing.T) {
+	fd := loadProto(t)
+	delegationABI, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	directoryABI, err := AVSDirectory.AVSDirectoryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operator := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	strategy := common.HexToAddress("0x93c4b944D05dfe6df7645A86cd2206016c51564D")
+	avs := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	approver := common.HexToAddress("0x0000000000000000000000000000000000000006")
+	chain := &fakeChain{
+		head: 1000,
+		abis: []*abi.ABI{delegationABI, directoryABI},
+		views: map[string][]interface{}{
+			"isOperator": {true},
+			"operatorDetails": {DelegationManager.IDelegationManagerOperatorDetails{
+				DeprecatedEarningsReceiver: operator,
+				DelegationApprover:         approver,
+				StakerOptOutWindowBlocks:   50400,
+			}},
+			"delegationApprover": {approver},
+			"getOperatorShares":  {[]*big.Int{big.NewInt(5e18), new(big.Int).Mul(big.NewInt(32), big.NewInt(1e18))}},
+			"avsOperatorStatus":  {uint8(1)},
+		},
+	}
+	c, err := client.NewEigenLayerClientWithBackend(chain, addresses.ChainIDHolesky, addresses.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := dial(t, NewServer(c, Config{}))
+
+	invoke := func(ctx context.Context, name string, req interface{}) (*dynamicpb.Message, error) {
+		t.Helper()
+		method := fd.Services().Get(0).Methods().ByName(protoreflect.Name(name))
+		resp := dynamicpb.NewMessage(method.Output())
+		err := conn.Invoke(ctx, "/"+ServiceName+"/"+name, newMessage(t, fd, string(method.Input().Name()), req), resp)
+		return resp, err
+	}
+
+	t.Run("GetOperatorState", func(t *testing.T) {
+		got, err := invoke(context.Background(), "GetOperatorState", &GetOperatorStateRequest{
+			Operator:   operator.Hex(),
+			Strategies: []string{strategy.Hex()},
+			AVSs:       []string{avs.Hex()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := newMessage(t, fd, "OperatorState", &OperatorState{
+			Operator:                 operator.Hex(),
+			BlockNumber:              1000,
+			IsOperator:               true,
+			DelegationApprover:       approver.Hex(),
+			StakerOptOutWindowBlocks: 50400,
+			Shares: []*StrategyShares{
+				{Strategy: strategy.Hex(), Shares: "5000000000000000000"},
+				{Strategy: addresses.BeaconChainETHStrategy.Hex(), Shares: "32000000000000000000"},
+			},
+			AVSs: []string{avs.Hex()},
+		})
+		if !proto.Equal(got, want) {
+			t.Errorf("GetOperatorState() = %v, want %v", got, want)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		req      interface{}
+		code     codes.Code
+		contains string
+	}{
+		{"invalid address", "GetOperatorState", &GetOperatorStateRequest{Operator: "0x1234"}, codes.InvalidArgument, `operator "0x1234" is not an address`},
+		{"no strategies", "GetStrategyTVL", &GetStrategyTVLRequest{}, codes.FailedPrecondition, "name them in the request"},
+		{"escaped message", "GetStakerState", &GetStakerStateRequest{Staker: "not an address: 100%"}, codes.InvalidArgument, `staker "not an address: 100%" is not an address`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := invoke(context.Background(), tt.method, tt.req)
+			st := status.Convert(err)
+			if st.Code() != tt.code || !strings.Contains(st.Message(), tt.contains) {
+				t.Errorf("%s() status = %v %q, want %v containing %q", tt.method, st.Code(), st.Message(), tt.code, tt.contains)
+			}
+		})
+	}
+
+	t.Run("unknown method", func(t *testing.T) {
+		in := newMessage(t, fd, "GetStakerStateRequest", nil)
+		err := conn.Invoke(context.Background(), "/"+ServiceName+"/GetUnknown", in, newMessage(t, fd, "StakerState", nil))
+		if code := status.Code(err); code != codes.Unimplemented {
+			t.Errorf("status = %v, want %v", code, codes.Unimplemented)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+// Package guard refuses to send transactions that were built for another chain than the one the
+// backend is connected to, such as mainnet calldata sent to Holesky.
+//
+// A Backend checks every transaction before sending it:
+//
+//   - the chain ID the transaction is signed for, and the chain ID the node reports, must both
+//     be the chain the guard was created for;
+//   - a transaction must not target an EigenLayer contract known only on another chain of the
+//     registry;
+//   - a transaction with calldata must target an address with code.
+//
+// Since the bindings send every transaction through their backend, wrapping it guards all of
+// them:
+//
+//	backend := guard.NewBackend(ethClient, addresses.Default, addresses.ChainIDHolesky, guard.Config{})
+//	c, err := client.NewEigenLayerClientWithBackend(backend, addresses.ChainIDHolesky, addresses.Default)
+package guard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+var (
+	// ErrChainMismatch is returned for transactions signed for, or sent to a node of, another
+	// chain than the guard's.
+	ErrChainMismatch = errors.New("chain ID mismatch")
+	// ErrWrongChain is returned for transactions targeting a contract of another chain's
+	// deployment.
+	ErrWrongChain = errors.New("target is deployed on another chain")
+	// ErrNoCode is returned for transactions with calldata targeting an address without code.
+	ErrNoCode = errors.New("target has no code")
+	// ErrNotAllowed is returned in strict mode for transactions targeting an address that is
+	// neither in the chain's deployment nor allowed.
+	ErrNotAllowed = errors.New("target is not allowed")
+)
+
+// Config configures a Backend.
+type Config struct {
+	// Strict refuses transactions to any address that is neither in the registry's deployment
+	// for the chain nor in Allowed, such as tokens and strategies that were not allowed.
+	Strict bool
+	// Allowed are the addresses, besides the chain's deployment, that transactions may target
+	// in strict mode.
+	Allowed []common.Address
+}
+
+// Backend is an ethbackend.Backend that checks transactions before sending them.
+type Backend struct {
+	ethbackend.Backend
+	chainID uint64
+	cfg     Config
+
+	// known maps the addresses of the chain's deployment and of Allowed to their names, and
+	// foreign those of the other chains' deployments to where they are deployed.
+	known   map[common.Address]string
+	foreign map[common.Address]string
+
+	mu       sync.Mutex
+	checked  bool
+	chainErr error
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend guarded to only send transactions for chainID, whose contracts are
+// resolved by registry.
+func NewBackend(backend ethbackend.Backend, registry *addresses.Registry, chainID uint64, cfg Config) *Backend {
+	b := &Backend{
+		Backend: backend,
+		chainID: chainID,
+		cfg:     cfg,
+		known:   make(map[common.Address]string),
+		foreign: make(map[common.Address]string),
+	}
+	for _, id := range registry.Chains() {
+		deployment, err := registry.Deployment(id)
+		if err != nil {
+			continue
+		}
+		for name, addr := range deployment {
+			if id == chainID {
+				b.known[addr] = name
+			} else {
+				b.foreign[addr] = fmt.Sprintf("%s on chain %d", name, id)
+			}
+		}
+	}
+	for _, addr := range cfg.Allowed {
+		if _, ok := b.known[addr]; !ok {
+			b.known[addr] = "allowed address"
+		}
+	}
+	return b
+}
+
+// Check returns the reason tx must not be sent, or nil. Legacy transactions without EIP-155
+// replay protection are refused, as signed for chain 0.
+func (b *Backend) Check(ctx context.Context, tx *types.Transaction) error {
+	if signed := tx.ChainId(); !signed.IsUint64() || signed.Uint64() != b.chainID {
+		return fmt.Errorf("%w: transaction %s is signed for chain %s, expected %d", ErrChainMismatch, tx.Hash(), signed, b.chainID)
+	}
+	if err := b.checkChain(ctx); err != nil {
+		return err
+	}
+
+	to := tx.To()
+	if to == nil {
+		return nil
+	}
+	if _, ok := b.known[*to]; !ok {
+		if where, ok := b.foreign[*to]; ok {
+			return fmt.Errorf("%w: %s is %s, connected to chain %d", ErrWrongChain, to, where, b.chainID)
+		}
+		if b.cfg.Strict {
+			return fmt.Errorf("%w: %s on chain %d", ErrNotAllowed, to, b.chainID)
+		}
+	}
+	if len(tx.Data()) == 0 {
+		return nil
+	}
+	code, err := b.Backend.CodeAt(ctx, *to, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch code at %s: %w", to, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("%w: %s on chain %d", ErrNoCode, to, b.chainID)
+	}
+	return nil
+}
+
+// checkChain checks once that the node reports the guard's chain ID. Failures to fetch the
+// chain ID are retried on the next transaction.
+func (b *Backend) checkChain(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.checked {
+		return b.chainErr
+	}
+	remote, err := b.Backend.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	if !remote.IsUint64() || remote.Uint64() != b.chainID {
+		b.chainErr = fmt.Errorf("%w: node reports chain %s, expected %d", ErrChainMismatch, remote, b.chainID)
+	}
+	b.checked = true
+	return b.chainErr
+}
+
+// SendTransaction sends tx if Check passes.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Check(ctx, tx); err != nil {
+		return err
+	}
+	return b.Backend.SendTransaction(ctx, tx)
+}
@@ -0,0 +1,357 @@
+// Package health checks the dependencies of long-running deployments, such as monitors, indexers
+// and API servers: the RPC endpoint, its chain, the contracts of the address registry, log
+// subscriptions and the lag of an indexer.
+//
+// Healthcheck returns a Report of every check. Handler serves it for liveness and readiness
+// probes, responding 200 when every check passes and 503 otherwise:
+//
+//	checker := health.New(c.Backend, health.Config{ChainID: c.ChainID, Indexer: store})
+//	mux.Handle("/healthz", checker.Handler())
+//
+// Long-running subscriptions report their liveness through a Heartbeat, beaten for every event
+// or head they receive.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a Healthcheck.
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxHeadAge is the default age of the head block beyond which the node is considered
+	// out of sync.
+	DefaultMaxHeadAge = 2 * time.Minute
+	// DefaultMaxSilence is the default time a Heartbeat may go without a beat.
+	DefaultMaxSilence = 5 * time.Minute
+	// DefaultMaxIndexerLag is the default number of blocks an indexer may lag the head.
+	DefaultMaxIndexerLag = 100
+)
+
+// Names of the checks of a Report. Heartbeat checks are named "heartbeat:" followed by the name
+// of the heartbeat.
+const (
+	CheckRPC          = "rpc"
+	CheckChainID      = "chain_id"
+	CheckContracts    = "contracts"
+	CheckSubscription = "subscription"
+	CheckIndexer      = "indexer"
+)
+
+// Backend is the chain access required by Checker.
+type Backend interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+}
+
+// Checkpointer is the checkpoint of an indexer, such as an indexer.Store.
+type Checkpointer interface {
+	// Checkpoint returns the last indexed block, and false if none was indexed yet.
+	Checkpoint(ctx context.Context) (uint64, bool, error)
+}
+
+// Config configures a Checker.
+type Config struct {
+	// ChainID is the chain the backend must be connected to.
+	ChainID uint64
+	// Registry resolves the contracts whose bytecode is checked. It defaults to
+	// addresses.Default.
+	Registry *addresses.Registry
+	// Subscribe checks that the backend accepts log subscriptions, which HTTP endpoints do not.
+	Subscribe bool
+	// Heartbeats are the heartbeats of running subscriptions, by name.
+	Heartbeats map[string]*Heartbeat
+	// Indexer, if set, is checked to lag the head by at most MaxIndexerLag blocks.
+	Indexer Checkpointer
+	// Timeout bounds a Healthcheck. It defaults to DefaultTimeout.
+	Timeout time.Duration
+	// MaxHeadAge is the age of the head block beyond which the node is out of sync. It defaults
+	// to DefaultMaxHeadAge.
+	MaxHeadAge time.Duration
+	// MaxSilence is the time a Heartbeat may go without a beat. It defaults to
+	// DefaultMaxSilence.
+	MaxSilence time.Duration
+	// MaxIndexerLag is the number of blocks the indexer may lag the head. It defaults to
+	// DefaultMaxIndexerLag.
+	MaxIndexerLag uint64
+}
+
+// Check is the result of a check.
+type Check struct {
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+	// Detail describes what was checked, such as the lag of the indexer.
+	Detail string `json:"detail,omitempty"`
+	// Error is why the check failed.
+	Error string `json:"error,omitempty"`
+	// DurationMS is the time the check took, in milliseconds.
+	DurationMS int64 `json:"duration_ms"`
+}
+
+// Report is the result of a Healthcheck.
+type Report struct {
+	// Healthy is set if every check passed.
+	Healthy bool      `json:"healthy"`
+	ChainID uint64    `json:"chain_id"`
+	Time    time.Time `json:"time"`
+	// BlockNumber is the head block of the node, zero if it is unreachable.
+	BlockNumber uint64  `json:"block_number"`
+	Checks      []Check `json:"checks"`
+}
+
+// Checker checks the health of a deployment.
+type Checker struct {
+	backend Backend
+	cfg     Config
+}
+
+// New returns a Checker of backend.
+func New(backend Backend, cfg Config) *Checker {
+	if cfg.Registry == nil {
+		cfg.Registry = addresses.Default
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxHeadAge == 0 {
+		cfg.MaxHeadAge = DefaultMaxHeadAge
+	}
+	if cfg.MaxSilence == 0 {
+		cfg.MaxSilence = DefaultMaxSilence
+	}
+	if cfg.MaxIndexerLag == 0 {
+		cfg.MaxIndexerLag = DefaultMaxIndexerLag
+	}
+	return &Checker{backend: backend, cfg: cfg}
+}
+
+// Healthcheck runs every check. When the node is unreachable, the checks that need it fail
+// without being run.
+func (c *Checker) Healthcheck(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	report := &Report{ChainID: c.cfg.ChainID, Time: time.Now().UTC()}
+
+	var head *types.Header
+	report.run(CheckRPC, func() (string, error) {
+		var err error
+		if head, err = c.backend.HeaderByNumber(ctx, nil); err != nil {
+			return "", fmt.Errorf("failed to fetch head: %w", err)
+		}
+		age := time.Since(time.Unix(int64(head.Time), 0)).Truncate(time.Second)
+		detail := fmt.Sprintf("head %d, %s old", head.Number, age)
+		if age > c.cfg.MaxHeadAge {
+			return detail, fmt.Errorf("head is older than %s", c.cfg.MaxHeadAge)
+		}
+		return detail, nil
+	})
+	if head == nil {
+		for _, name := range c.checkNames()[1:] {
+			report.Checks = append(report.Checks, Check{Name: name, Error: "rpc unreachable"})
+		}
+		return report
+	}
+	report.BlockNumber = head.Number.Uint64()
+
+	report.run(CheckChainID, func() (string, error) {
+		chainID, err := c.backend.ChainID(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch chain ID: %w", err)
+		}
+		if !chainID.IsUint64() || chainID.Uint64() != c.cfg.ChainID {
+			return "", fmt.Errorf("chain ID mismatch: expected %d, node reports %s", c.cfg.ChainID, chainID)
+		}
+		return fmt.Sprintf("chain %d", chainID), nil
+	})
+	report.run(CheckContracts, func() (string, error) {
+		return c.checkContracts(ctx, head.Number)
+	})
+	if c.cfg.Subscribe {
+		report.run(CheckSubscription, func() (string, error) {
+			return "", c.checkSubscription(ctx)
+		})
+	}
+	for _, name := range sortedNames(c.cfg.Heartbeats) {
+		h := c.cfg.Heartbeats[name]
+		report.run("heartbeat:"+name, func() (string, error) {
+			return h.check(c.cfg.MaxSilence)
+		})
+	}
+	if c.cfg.Indexer != nil {
+		report.run(CheckIndexer, func() (string, error) {
+			return c.checkIndexer(ctx, head.Number.Uint64())
+		})
+	}
+	return report
+}
+
+// run runs the check name and adds its result to the report.
+func (r *Report) run(name string, check func() (string, error)) {
+	start := time.Now()
+	detail, err := check()
+	result := Check{Name: name, OK: err == nil, Detail: detail, DurationMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	r.Checks = append(r.Checks, result)
+	r.Healthy = true
+	for _, c := range r.Checks {
+		r.Healthy = r.Healthy && c.OK
+	}
+}
+
+// checkNames returns the names of the checks run, in order.
+func (c *Checker) checkNames() []string {
+	names := []string{CheckRPC, CheckChainID, CheckContracts}
+	if c.cfg.Subscribe {
+		names = append(names, CheckSubscription)
+	}
+	for _, name := range sortedNames(c.cfg.Heartbeats) {
+		names = append(names, "heartbeat:"+name)
+	}
+	if c.cfg.Indexer != nil {
+		names = append(names, CheckIndexer)
+	}
+	return names
+}
+
+// checkContracts checks that every contract of the registry on the chain has bytecode.
+func (c *Checker) checkContracts(ctx context.Context, blockNumber *big.Int) (string, error) {
+	deployment, err := c.cfg.Registry.Deployment(c.cfg.ChainID)
+	if err != nil {
+		return "", err
+	}
+	var missing []string
+	for _, name := range sortedNames(deployment) {
+		code, err := c.backend.CodeAt(ctx, deployment[name], blockNumber)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch code of %s: %w", name, err)
+		}
+		if len(code) == 0 {
+			missing = append(missing, fmt.Sprintf("%s (%s)", name, deployment[name].Hex()))
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("no code at %d of %d contracts: %v", len(missing), len(deployment), missing)
+	}
+	return fmt.Sprintf("%d contracts", len(deployment)), nil
+}
+
+// checkSubscription subscribes to the logs of the DelegationManager and unsubscribes again.
+func (c *Checker) checkSubscription(ctx context.Context) error {
+	query := ethereum.FilterQuery{}
+	if addr, err := c.cfg.Registry.Resolve(c.cfg.ChainID, addresses.DelegationManager); err == nil {
+		query.Addresses = []common.Address{addr}
+	}
+	sub, err := c.backend.SubscribeFilterLogs(ctx, query, make(chan types.Log))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to logs: %w", err)
+	}
+	sub.Unsubscribe()
+	return nil
+}
+
+func (c *Checker) checkIndexer(ctx context.Context, head uint64) (string, error) {
+	checkpoint, ok, err := c.cfg.Indexer.Checkpoint(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	if !ok {
+		return "", errors.New("nothing indexed yet")
+	}
+	var lag uint64
+	if head > checkpoint {
+		lag = head - checkpoint
+	}
+	detail := fmt.Sprintf("indexed to block %d, %d blocks behind", checkpoint, lag)
+	if lag > c.cfg.MaxIndexerLag {
+		return detail, fmt.Errorf("lag exceeds %d blocks", c.cfg.MaxIndexerLag)
+	}
+	return detail, nil
+}
+
+// Handler serves the Report of a Healthcheck as JSON, with status 200 if it is healthy and 503
+// otherwise.
+func (c *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Healthcheck(r.Context())
+		status := http.StatusOK
+		if !report.Healthy {
+			status = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// Heartbeat tracks the liveness of a subscription. Call Beat for every event or head it
+// delivers, and Fail when it fails. It is safe for concurrent use.
+type Heartbeat struct {
+	mu   sync.Mutex
+	last time.Time
+	err  error
+}
+
+// NewHeartbeat returns a Heartbeat beaten now, so that a new subscription is given MaxSilence to
+// deliver.
+func NewHeartbeat() *Heartbeat {
+	return &Heartbeat{last: time.Now()}
+}
+
+// Beat records a delivery, clearing any failure.
+func (h *Heartbeat) Beat() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last, h.err = time.Now(), nil
+}
+
+// Fail records that the subscription failed with err, until the next Beat.
+func (h *Heartbeat) Fail(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.err = err
+}
+
+func (h *Heartbeat) check(maxSilence time.Duration) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.err != nil {
+		return "", h.err
+	}
+	if h.last.IsZero() {
+		return "", errors.New("never beaten")
+	}
+	silence := time.Since(h.last).Truncate(time.Second)
+	detail := fmt.Sprintf("last beat %s ago", silence)
+	if silence > maxSilence {
+		return detail, fmt.Errorf("silent for more than %s", maxSilence)
+	}
+	return detail, nil
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
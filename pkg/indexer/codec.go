@@ -0,0 +1,580 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// Format is a serialization of events for data pipelines. Each event type has its own schema,
+// derived from the event's ABI: a message of ProtoFile or a record of AvroSchema. The first field
+// holds the EventMetadata, and the arguments follow in the order of the event's signature, so the
+// schema of an event type never changes. Addresses, hashes and byte strings are 0x-prefixed hex
+// strings and integers that do not fit a signed or unsigned 64-bit field decimal strings.
+type Format int
+
+const (
+	// Protobuf encodes an event as a proto3 message of ProtoFile.
+	Protobuf Format = iota
+	// Avro encodes an event in the Avro binary encoding of its AvroSchema, without a header.
+	Avro
+)
+
+// String returns the name of f.
+func (f Format) String() string {
+	switch f {
+	case Protobuf:
+		return "protobuf"
+	case Avro:
+		return "avro"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// ParseFormat returns the Format named name.
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "protobuf":
+		return Protobuf, nil
+	case "avro":
+		return Avro, nil
+	default:
+		return 0, fmt.Errorf("unknown format %q, expected protobuf or avro", name)
+	}
+}
+
+// Encode serializes e, whose Args may be the values decoded from its log or the JSON values a
+// FileStore or SQLStore returns.
+func (f Format) Encode(e Event) ([]byte, error) {
+	event, err := eventABI(e.Contract, e.Name)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(event.Inputs))
+	for i, input := range event.Inputs {
+		if values[i], err = normalize(input.Type, e.Args[input.Name]); err != nil {
+			return nil, fmt.Errorf("invalid argument %s of %s.%s: %w", input.Name, e.Contract, e.Name, err)
+		}
+	}
+	switch f {
+	case Protobuf:
+		b := appendProtoMessage(nil, 1, func(b []byte) []byte { return appendProtoMetadata(b, e) })
+		for i, input := range event.Inputs {
+			b = appendProtoValue(b, protowire.Number(i+2), input.Type, values[i])
+		}
+		return b, nil
+	case Avro:
+		b := appendAvroMetadata(nil, e)
+		for i, input := range event.Inputs {
+			b = appendAvroValue(b, input.Type, values[i])
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown format %d", int(f))
+	}
+}
+
+// eventABI returns the event of the contract's binding named name.
+func eventABI(contract, name string) (abi.Event, error) {
+	parsed, err := abis.ABI(contract)
+	if err != nil {
+		return abi.Event{}, err
+	}
+	event, ok := parsed.Events[name]
+	if !ok {
+		return abi.Event{}, fmt.Errorf("no event %s in %s", name, contract)
+	}
+	return event, nil
+}
+
+// narrow reports whether the integer type t fits a 64-bit protobuf field.
+func narrow(t abi.Type) bool {
+	return t.Size <= 64
+}
+
+// avroLong reports whether the integer type t fits an Avro long, which is signed.
+func avroLong(t abi.Type) bool {
+	return t.Size < 64 || (t.T == abi.IntTy && t.Size == 64)
+}
+
+// normalize converts v, a value of type t, into its canonical form: bool, *big.Int,
+// common.Address, []byte, string, or []interface{} for arrays and the fields of tuples.
+func normalize(t abi.Type, v interface{}) (interface{}, error) {
+	switch t.T {
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%T is not a bool", v)
+		}
+		return b, nil
+	case abi.IntTy, abi.UintTy:
+		return toBig(v)
+	case abi.AddressTy:
+		switch a := v.(type) {
+		case common.Address:
+			return a, nil
+		case string:
+			if common.IsHexAddress(a) {
+				return common.HexToAddress(a), nil
+			}
+		}
+		return nil, fmt.Errorf("%v is not an address", v)
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%T is not a string", v)
+		}
+		return s, nil
+	case abi.BytesTy, abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		switch b := v.(type) {
+		case []byte:
+			return b, nil
+		case string:
+			return hexutil.Decode(b)
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+			out := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(out), rv)
+			return out, nil
+		}
+		return nil, fmt.Errorf("%T is not a byte string", v)
+	case abi.SliceTy, abi.ArrayTy:
+		if v == nil {
+			return []interface{}{}, nil
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, fmt.Errorf("%T is not an array", v)
+		}
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			elem, err := normalize(*t.Elem, rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			out[i] = elem
+		}
+		return out, nil
+	case abi.TupleTy:
+		out := make([]interface{}, len(t.TupleElems))
+		for i, name := range t.TupleRawNames {
+			var field interface{}
+			if m, ok := v.(map[string]interface{}); ok {
+				field = m[name]
+			} else {
+				rv := reflect.Indirect(reflect.ValueOf(v))
+				if rv.Kind() != reflect.Struct {
+					return nil, fmt.Errorf("%T is not a tuple", v)
+				}
+				f := rv.FieldByName(abi.ToCamelCase(name))
+				if !f.IsValid() {
+					return nil, fmt.Errorf("tuple has no field %s", name)
+				}
+				field = f.Interface()
+			}
+			elem, err := normalize(*t.TupleElems[i], field)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			out[i] = elem
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// toBig converts a decoded integer, or a decimal string or JSON number, into a *big.Int.
+func toBig(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		return n, nil
+	case string:
+		if i, ok := new(big.Int).SetString(n, 10); ok {
+			return i, nil
+		}
+		return nil, fmt.Errorf("%q is not an integer", n)
+	case json.Number:
+		return toBig(n.String())
+	case float64:
+		i, _ := big.NewFloat(n).Int(nil)
+		return i, nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	}
+	return nil, fmt.Errorf("%T is not an integer", v)
+}
+
+// scalarString returns the string a canonical scalar is encoded as.
+func scalarString(v interface{}) string {
+	switch v := v.(type) {
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case *big.Int:
+		return v.String()
+	case string:
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+func appendProtoMessage(b []byte, num protowire.Number, fields func([]byte) []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, fields(nil))
+}
+
+func appendProtoMetadata(b []byte, e Event) []byte {
+	for i, s := range []string{e.Contract, e.Address.Hex(), e.Name} {
+		b = protowire.AppendTag(b, protowire.Number(i+1), protowire.BytesType)
+		b = protowire.AppendString(b, s)
+	}
+	b = protowire.AppendTag(b, 4, protowire.VarintType)
+	b = protowire.AppendVarint(b, e.BlockNumber)
+	for i, s := range []string{e.BlockHash.Hex(), e.TxHash.Hex()} {
+		b = protowire.AppendTag(b, protowire.Number(i+5), protowire.BytesType)
+		b = protowire.AppendString(b, s)
+	}
+	b = protowire.AppendTag(b, 7, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(e.TxIndex))
+	b = protowire.AppendTag(b, 8, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(e.LogIndex))
+}
+
+// appendProtoValue appends the canonical value v of type t as field num. Every value is written,
+// including zero values, so that the elements of repeated fields are kept.
+func appendProtoValue(b []byte, num protowire.Number, t abi.Type, v interface{}) []byte {
+	switch t.T {
+	case abi.SliceTy, abi.ArrayTy:
+		for _, elem := range v.([]interface{}) {
+			b = appendProtoValue(b, num, *t.Elem, elem)
+		}
+		return b
+	case abi.TupleTy:
+		return appendProtoMessage(b, num, func(b []byte) []byte {
+			for i, field := range v.([]interface{}) {
+				b = appendProtoValue(b, protowire.Number(i+1), *t.TupleElems[i], field)
+			}
+			return b
+		})
+	case abi.BoolTy:
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.(bool)))
+	case abi.IntTy, abi.UintTy:
+		if narrow(t) {
+			b = protowire.AppendTag(b, num, protowire.VarintType)
+			n := v.(*big.Int)
+			if t.T == abi.IntTy {
+				return protowire.AppendVarint(b, uint64(n.Int64()))
+			}
+			return protowire.AppendVarint(b, n.Uint64())
+		}
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, scalarString(v))
+}
+
+func appendAvroLong(b []byte, v int64) []byte {
+	return protowire.AppendVarint(b, protowire.EncodeZigZag(v))
+}
+
+func appendAvroString(b []byte, s string) []byte {
+	b = appendAvroLong(b, int64(len(s)))
+	return append(b, s...)
+}
+
+func appendAvroMetadata(b []byte, e Event) []byte {
+	b = appendAvroString(b, e.Contract)
+	b = appendAvroString(b, e.Address.Hex())
+	b = appendAvroString(b, e.Name)
+	b = appendAvroLong(b, int64(e.BlockNumber))
+	b = appendAvroString(b, e.BlockHash.Hex())
+	b = appendAvroString(b, e.TxHash.Hex())
+	b = appendAvroLong(b, int64(e.TxIndex))
+	return appendAvroLong(b, int64(e.LogIndex))
+}
+
+// appendAvroValue appends the canonical value v of type t in the Avro binary encoding.
+func appendAvroValue(b []byte, t abi.Type, v interface{}) []byte {
+	switch t.T {
+	case abi.SliceTy, abi.ArrayTy:
+		elems := v.([]interface{})
+		if len(elems) > 0 {
+			b = appendAvroLong(b, int64(len(elems)))
+			for _, elem := range elems {
+				b = appendAvroValue(b, *t.Elem, elem)
+			}
+		}
+		return appendAvroLong(b, 0)
+	case abi.TupleTy:
+		for i, field := range v.([]interface{}) {
+			b = appendAvroValue(b, *t.TupleElems[i], field)
+		}
+		return b
+	case abi.BoolTy:
+		if v.(bool) {
+			return append(b, 1)
+		}
+		return append(b, 0)
+	case abi.IntTy, abi.UintTy:
+		if avroLong(t) {
+			return appendAvroLong(b, v.(*big.Int).Int64())
+		}
+	}
+	return appendAvroString(b, scalarString(v))
+}
+
+// ProtoFile returns the proto3 definitions of the events of contract, in package
+// eigenlayer.events.v1.<contract in lower case>.
+func ProtoFile(contract string) (string, error) {
+	parsed, err := abis.ABI(contract)
+	if err != nil {
+		return "", err
+	}
+	var out strings.Builder
+	fmt.Fprintf(&out, `// Events of %s, as encoded by the Protobuf format of pkg/indexer.
+//
+// Addresses, hashes and byte strings are 0x-prefixed hex strings. Integers wider than 64 bits are
+// decimal strings. The fields of each event follow the order of its arguments.
+syntax = "proto3";
+
+package eigenlayer.events.v1.%s;
+
+message EventMetadata {
+  string contract = 1;
+  string address = 2;
+  string name = 3;
+  uint64 block_number = 4;
+  string block_hash = 5;
+  string tx_hash = 6;
+  uint64 tx_index = 7;
+  uint64 log_index = 8;
+}
+`, contract, strings.ToLower(contract))
+
+	tuples, defs := make(map[string]string), make(map[string]string)
+	for _, name := range sortedEvents(parsed) {
+		event := parsed.Events[name]
+		fmt.Fprintf(&out, "\n// %s\nmessage %s {\n  EventMetadata metadata = 1;\n", event.Sig, name)
+		for i, input := range event.Inputs {
+			typ, err := protoType(input.Type, input.Name, tuples, defs)
+			if err != nil {
+				return "", fmt.Errorf("argument %s of %s: %w", input.Name, name, err)
+			}
+			fmt.Fprintf(&out, "  %s %s = %d;\n", typ, snakeCase(input.Name), i+2)
+		}
+		out.WriteString("}\n")
+	}
+	names := make([]string, 0, len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out.WriteString("\n" + defs[name])
+	}
+	return out.String(), nil
+}
+
+// protoType returns the field type of t, naming its tuples in tuples, as by tupleName, and
+// writing their messages to defs.
+func protoType(t abi.Type, name string, tuples, defs map[string]string) (string, error) {
+	switch t.T {
+	case abi.SliceTy, abi.ArrayTy:
+		if t.Elem.T == abi.SliceTy || t.Elem.T == abi.ArrayTy {
+			return "", fmt.Errorf("nested array %s has no protobuf field type", t)
+		}
+		elem, err := protoType(*t.Elem, name, tuples, defs)
+		if err != nil {
+			return "", err
+		}
+		return "repeated " + elem, nil
+	case abi.TupleTy:
+		message, ok := tupleName(t, name, tuples)
+		if !ok {
+			return message, nil
+		}
+		var def strings.Builder
+		fmt.Fprintf(&def, "message %s {\n", message)
+		for i, field := range t.TupleRawNames {
+			typ, err := protoType(*t.TupleElems[i], field, tuples, defs)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&def, "  %s %s = %d;\n", typ, snakeCase(field), i+1)
+		}
+		def.WriteString("}\n")
+		defs[message] = def.String()
+		return message, nil
+	case abi.BoolTy:
+		return "bool", nil
+	case abi.IntTy:
+		if narrow(t) {
+			return "int64", nil
+		}
+	case abi.UintTy:
+		if narrow(t) {
+			return "uint64", nil
+		}
+	}
+	return "string", nil
+}
+
+// avroField and avroRecord are the JSON of Avro schemas.
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+}
+
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Doc       string      `json:"doc,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+type avroArray struct {
+	Type  string      `json:"type"`
+	Items interface{} `json:"items"`
+}
+
+// AvroSchema returns the Avro schema of the event of contract named event, a record in
+// namespace eigenlayer.events.v1.<contract in lower case>.
+func AvroSchema(contract, event string) (string, error) {
+	e, err := eventABI(contract, event)
+	if err != nil {
+		return "", err
+	}
+	record := avroRecord{
+		Type:      "record",
+		Name:      event,
+		Namespace: "eigenlayer.events.v1." + strings.ToLower(contract),
+		Doc:       e.Sig,
+		Fields: []avroField{{Name: "metadata", Type: avroRecord{
+			Type: "record",
+			Name: "EventMetadata",
+			Fields: []avroField{
+				{"contract", "string"}, {"address", "string"}, {"name", "string"}, {"blockNumber", "long"},
+				{"blockHash", "string"}, {"txHash", "string"}, {"txIndex", "long"}, {"logIndex", "long"},
+			},
+		}}},
+	}
+	defined := make(map[string]string)
+	for _, input := range e.Inputs {
+		typ, err := avroType(input.Type, input.Name, defined)
+		if err != nil {
+			return "", fmt.Errorf("argument %s of %s: %w", input.Name, event, err)
+		}
+		record.Fields = append(record.Fields, avroField{Name: input.Name, Type: typ})
+	}
+	schema, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	return string(schema), nil
+}
+
+// avroType returns the schema of t, defining each record once and referring to it by name after.
+func avroType(t abi.Type, name string, defined map[string]string) (interface{}, error) {
+	switch t.T {
+	case abi.SliceTy, abi.ArrayTy:
+		elem, err := avroType(*t.Elem, name, defined)
+		if err != nil {
+			return nil, err
+		}
+		return avroArray{Type: "array", Items: elem}, nil
+	case abi.TupleTy:
+		record, ok := tupleName(t, name, defined)
+		if !ok {
+			return record, nil
+		}
+		r := avroRecord{Type: "record", Name: record, Fields: []avroField{}}
+		for i, field := range t.TupleRawNames {
+			typ, err := avroType(*t.TupleElems[i], field, defined)
+			if err != nil {
+				return nil, err
+			}
+			r.Fields = append(r.Fields, avroField{Name: field, Type: typ})
+		}
+		return r, nil
+	case abi.BoolTy:
+		return "boolean", nil
+	case abi.IntTy, abi.UintTy:
+		if avroLong(t) {
+			return "long", nil
+		}
+	}
+	return "string", nil
+}
+
+// tupleName returns the name of the message or record of the tuple t: the name of its struct, or
+// one derived from the name of the argument or field holding it if the ABI does not name it,
+// suffixed with a number if another tuple type already has that name in seen, which maps names
+// to the types they were given to. It reports whether the name is new to seen, and so must be
+// defined.
+func tupleName(t abi.Type, name string, seen map[string]string) (string, bool) {
+	base := t.TupleRawName
+	if base == "" {
+		base = abi.ToCamelCase(strings.TrimLeft(name, "_"))
+	}
+	for i := 1; ; i++ {
+		candidate := base
+		if i > 1 {
+			candidate = fmt.Sprintf("%s%d", base, i)
+		}
+		typ, ok := seen[candidate]
+		if !ok {
+			seen[candidate] = t.String()
+			return candidate, true
+		}
+		if typ == t.String() {
+			return candidate, false
+		}
+	}
+}
+
+// sortedEvents returns the names of the events of parsed in order.
+func sortedEvents(parsed *abi.ABI) []string {
+	names := make([]string, 0, len(parsed.Events))
+	for name := range parsed.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// snakeCase converts a Solidity argument name, such as newOperatorDetails or _operator, into a
+// protobuf field name, such as new_operator_details or operator.
+func snakeCase(name string) string {
+	name = strings.TrimLeft(name, "_")
+	var out strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 && name[i-1] != '_' && !(name[i-1] >= 'A' && name[i-1] <= 'Z') {
+				out.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
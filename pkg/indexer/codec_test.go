@@ -0,0 +1,414 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+)
+
+var update = flag.Bool("update", false, "rewrite the schemas in testdata")
+
+// schemaContracts are the contracts whose event schemas are pinned in testdata: those deployed by
+// EigenLayer, and the implementations behind its beacons.
+var schemaContracts = []string{
+	"AVSDirectory", "BackingEigen", "DelegationManager", "Eigen", "EigenPod", "EigenPodManager",
+	"EigenStrategy", "PauserRegistry", "RewardsCoordinator", "StrategyBase", "StrategyBaseTVLLimits",
+	"StrategyFactory", "StrategyManager",
+}
+
+// TestSchemas pins the ProtoFile of each contract, and the AvroSchema of each of its events, one
+// per line. A change to either breaks the consumers of exported events, so it must be deliberate:
+// run the test with -update to accept it.
+func TestSchemas(t *testing.T) {
+	for _, contract := range schemaContracts {
+		t.Run(contract, func(t *testing.T) {
+			proto, err := ProtoFile(contract)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := abis.ABI(contract)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var avro strings.Builder
+			for _, event := range sortedEvents(parsed) {
+				schema, err := AvroSchema(contract, event)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !json.Valid([]byte(schema)) {
+					t.Fatalf("schema of %s is not JSON: %s", event, schema)
+				}
+				avro.WriteString(schema + "\n")
+			}
+			checkGolden(t, filepath.Join("testdata", contract+".proto"), proto)
+			checkGolden(t, filepath.Join("testdata", contract+".avsc"), avro.String())
+		})
+	}
+}
+
+func checkGolden(t *testing.T, path, got string) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s changed, run the test with -update if that is intended:\n%s", path, got)
+	}
+}
+
+var (
+	testStaker     = common.HexToAddress("0x5a8c9E0D4dB47B4F7C3e8E19a8f0dF6C2a1B4e07")
+	testOperator   = common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+	testStrategies = []common.Address{
+		common.HexToAddress("0x93c4b944D05dfe6df7645A86cd2206016c51564D"),
+		common.HexToAddress("0x54945180dB7943c0ed0FEE7EdaB2Bd24620256bc"),
+	}
+	testRoot = common.HexToHash("0x9e4f8c1a76d1d0fbb1b55e6a2d4bde5a3a0cbb0e2e3bb0b4e5bc3a7d8f1c2e3d")
+)
+
+// testEvent returns an event of contract named name, with args, and metadata that exercises
+// multi-byte varints.
+func testEvent(contract, name string, args map[string]interface{}) Event {
+	return Event{
+		Contract:    contract,
+		Address:     common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+		Name:        name,
+		BlockNumber: 19000000,
+		BlockHash:   common.HexToHash("0x0b"),
+		TxHash:      common.HexToHash("0x7e"),
+		TxIndex:     131,
+		LogIndex:    300,
+		Args:        args,
+	}
+}
+
+// withdrawalQueued returns a DelegationManager WithdrawalQueued event decoded from its log, with
+// an uint256 nonce wider than 64 bits.
+func withdrawalQueued(t *testing.T) Event {
+	t.Helper()
+	d, err := newDecoder(Source{Contract: "DelegationManager"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := d.abi.Events["WithdrawalQueued"]
+	nonce, _ := new(big.Int).SetString("18446744073709551616", 10)
+	data, err := event.Inputs.NonIndexed().Pack(testRoot, DelegationManager.IDelegationManagerWithdrawal{
+		Staker:      testStaker,
+		DelegatedTo: testOperator,
+		Withdrawer:  testStaker,
+		Nonce:       nonce,
+		StartBlock:  18999990,
+		Strategies:  testStrategies,
+		Shares:      []*big.Int{big.NewInt(0), big.NewInt(1e18)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testEvent("DelegationManager", "WithdrawalQueued", nil)
+	decoded, err := d.decode(types.Log{
+		Address:     e.Address,
+		Topics:      []common.Hash{event.ID},
+		Data:        data,
+		BlockNumber: e.BlockNumber,
+		BlockHash:   e.BlockHash,
+		TxHash:      e.TxHash,
+		TxIndex:     e.TxIndex,
+		Index:       e.LogIndex,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+// stored returns e as a FileStore or SQLStore returns it, with its arguments through JSON.
+func stored(t *testing.T, e Event) Event {
+	t.Helper()
+	data, err := json.Marshal(JSONArgs(e.Args))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Args = nil
+	if err := json.Unmarshal(data, &e.Args); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+// protoFields decodes a protobuf message into the values of its fields by number, in order:
+// uint64 for varints and []byte for length-delimited fields.
+func protoFields(t *testing.T, b []byte) map[protowire.Number][]interface{} {
+	t.Helper()
+	fields := make(map[protowire.Number][]interface{})
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			t.Fatalf("invalid tag: %v", protowire.ParseError(n))
+		}
+		b = b[n:]
+		var v interface{}
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		default:
+			t.Fatalf("field %d has wire type %d", num, typ)
+		}
+		if n < 0 {
+			t.Fatalf("invalid field %d: %v", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+		fields[num] = append(fields[num], v)
+	}
+	return fields
+}
+
+func checkProtoFields(t *testing.T, name string, got, want map[protowire.Number][]interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s fields = %v\nwant %v", name, got, want)
+	}
+}
+
+func bytesOf(s string) []byte { return []byte(s) }
+
+func checkProtoMetadata(t *testing.T, fields map[protowire.Number][]interface{}, e Event) {
+	t.Helper()
+	if len(fields[1]) != 1 {
+		t.Fatalf("event has %d metadata fields, want 1", len(fields[1]))
+	}
+	checkProtoFields(t, "metadata", protoFields(t, fields[1][0].([]byte)), map[protowire.Number][]interface{}{
+		1: {bytesOf(e.Contract)},
+		2: {bytesOf(e.Address.Hex())},
+		3: {bytesOf(e.Name)},
+		4: {e.BlockNumber},
+		5: {bytesOf(e.BlockHash.Hex())},
+		6: {bytesOf(e.TxHash.Hex())},
+		7: {uint64(e.TxIndex)},
+		8: {uint64(e.LogIndex)},
+	})
+}
+
+func TestProtobufEncode(t *testing.T) {
+	decoded := withdrawalQueued(t)
+	for name, e := range map[string]Event{"decoded": decoded, "stored": stored(t, decoded)} {
+		t.Run(name, func(t *testing.T) {
+			b, err := Protobuf.Encode(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := protoFields(t, b)
+			checkProtoMetadata(t, fields, e)
+			if len(fields) != 3 {
+				t.Errorf("event has fields %v, want 1 to 3", fields)
+			}
+			if !reflect.DeepEqual(fields[2], []interface{}{bytesOf(testRoot.Hex())}) {
+				t.Errorf("withdrawal_root = %s, want %s", fields[2], testRoot.Hex())
+			}
+			if len(fields[3]) != 1 {
+				t.Fatalf("event has %d withdrawal fields, want 1", len(fields[3]))
+			}
+			checkProtoFields(t, "withdrawal", protoFields(t, fields[3][0].([]byte)), map[protowire.Number][]interface{}{
+				1: {bytesOf(testStaker.Hex())},
+				2: {bytesOf(testOperator.Hex())},
+				3: {bytesOf(testStaker.Hex())},
+				4: {bytesOf("18446744073709551616")},
+				5: {uint64(18999990)},
+				6: {bytesOf(testStrategies[0].Hex()), bytesOf(testStrategies[1].Hex())},
+				// The zero share is written, so that shares stays aligned with strategies.
+				7: {bytesOf("0"), bytesOf("1000000000000000000")},
+			})
+		})
+	}
+}
+
+func TestProtobufEncodeScalars(t *testing.T) {
+	for _, test := range []struct {
+		e    Event
+		want map[protowire.Number][]interface{}
+	}{
+		{
+			testEvent("StrategyManager", "UpdatedThirdPartyTransfersForbidden", map[string]interface{}{"strategy": testStrategies[0], "value": false}),
+			map[protowire.Number][]interface{}{2: {bytesOf(testStrategies[0].Hex())}, 3: {uint64(0)}},
+		},
+		{
+			// uint64 fits a protobuf uint64, and uint40 the varint of one.
+			testEvent("EigenPod", "ValidatorBalanceUpdated", map[string]interface{}{
+				"validatorIndex": uint64(1<<40 - 1), "balanceTimestamp": uint64(1<<64 - 1), "newValidatorBalanceGwei": uint64(32e9),
+			}),
+			map[protowire.Number][]interface{}{2: {uint64(1<<40 - 1)}, 3: {uint64(1<<64 - 1)}, 4: {uint64(32e9)}},
+		},
+		{
+			testEvent("EigenPodManager", "PodSharesUpdated", map[string]interface{}{"podOwner": testStaker, "sharesDelta": big.NewInt(-32e9)}),
+			map[protowire.Number][]interface{}{2: {bytesOf(testStaker.Hex())}, 3: {bytesOf("-32000000000")}},
+		},
+	} {
+		t.Run(test.e.Name, func(t *testing.T) {
+			b, err := Protobuf.Encode(test.e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := protoFields(t, b)
+			checkProtoMetadata(t, fields, test.e)
+			delete(fields, 1)
+			checkProtoFields(t, "event", fields, test.want)
+		})
+	}
+}
+
+// avroReader decodes the Avro binary encoding.
+type avroReader struct {
+	t    *testing.T
+	data []byte
+}
+
+func (r *avroReader) long() int64 {
+	r.t.Helper()
+	v, n := protowire.ConsumeVarint(r.data)
+	if n < 0 {
+		r.t.Fatalf("invalid long: %v", protowire.ParseError(n))
+	}
+	r.data = r.data[n:]
+	return protowire.DecodeZigZag(v)
+}
+
+func (r *avroReader) bytes(n int) []byte {
+	r.t.Helper()
+	if n < 0 || n > len(r.data) {
+		r.t.Fatalf("cannot read %d bytes of %d", n, len(r.data))
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *avroReader) string() string {
+	r.t.Helper()
+	return string(r.bytes(int(r.long())))
+}
+
+// strings reads an array of strings.
+func (r *avroReader) strings() []string {
+	r.t.Helper()
+	out := []string{}
+	for {
+		n := r.long()
+		if n == 0 {
+			return out
+		}
+		for i := int64(0); i < n; i++ {
+			out = append(out, r.string())
+		}
+	}
+}
+
+func checkAvroMetadata(t *testing.T, r *avroReader, e Event) {
+	t.Helper()
+	got := []interface{}{r.string(), r.string(), r.string(), r.long(), r.string(), r.string(), r.long(), r.long()}
+	want := []interface{}{
+		e.Contract, e.Address.Hex(), e.Name, int64(e.BlockNumber), e.BlockHash.Hex(), e.TxHash.Hex(), int64(e.TxIndex), int64(e.LogIndex),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("metadata = %v, want %v", got, want)
+	}
+}
+
+func TestAvroEncode(t *testing.T) {
+	decoded := withdrawalQueued(t)
+	for name, e := range map[string]Event{"decoded": decoded, "stored": stored(t, decoded)} {
+		t.Run(name, func(t *testing.T) {
+			b, err := Avro.Encode(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &avroReader{t: t, data: b}
+			checkAvroMetadata(t, r, e)
+			got := []interface{}{r.string(), r.string(), r.string(), r.string(), r.string(), r.long(), r.strings(), r.strings()}
+			want := []interface{}{
+				testRoot.Hex(), testStaker.Hex(), testOperator.Hex(), testStaker.Hex(), "18446744073709551616", int64(18999990),
+				[]string{testStrategies[0].Hex(), testStrategies[1].Hex()}, []string{"0", "1000000000000000000"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("arguments = %v\nwant %v", got, want)
+			}
+			if len(r.data) != 0 {
+				t.Errorf("%d trailing bytes", len(r.data))
+			}
+		})
+	}
+}
+
+func TestAvroEncodeScalars(t *testing.T) {
+	e := testEvent("StrategyManager", "UpdatedThirdPartyTransfersForbidden", map[string]interface{}{"strategy": testStrategies[0], "value": true})
+	b, err := Avro.Encode(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &avroReader{t: t, data: b}
+	checkAvroMetadata(t, r, e)
+	if got := r.string(); got != testStrategies[0].Hex() {
+		t.Errorf("strategy = %s, want %s", got, testStrategies[0].Hex())
+	}
+	if got := r.bytes(1); !bytes.Equal(got, []byte{1}) {
+		t.Errorf("value = %x, want 01", got)
+	}
+
+	// An Avro long is signed, so uint64 is a string while uint40 fits.
+	e = testEvent("EigenPod", "ValidatorBalanceUpdated", map[string]interface{}{
+		"validatorIndex": uint64(1<<40 - 1), "balanceTimestamp": uint64(1<<64 - 1), "newValidatorBalanceGwei": uint64(32e9),
+	})
+	if b, err = Avro.Encode(e); err != nil {
+		t.Fatal(err)
+	}
+	r = &avroReader{t: t, data: b}
+	checkAvroMetadata(t, r, e)
+	got := []interface{}{r.long(), r.string(), r.string()}
+	if want := []interface{}{int64(1<<40 - 1), "18446744073709551615", "32000000000"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("arguments = %v, want %v", got, want)
+	}
+}
+
+// TestEncodeEmptyArrays checks that an empty array is a single Avro block of no items, and no
+// protobuf field.
+func TestEncodeEmptyArrays(t *testing.T) {
+	e := withdrawalQueued(t)
+	e.Args = stored(t, e).Args
+	withdrawal := e.Args["withdrawal"].(map[string]interface{})
+	withdrawal["strategies"], withdrawal["shares"] = []interface{}{}, nil
+	b, err := Avro.Encode(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasSuffix(b, []byte{0, 0}) {
+		t.Errorf("encoding of empty arrays ends with %x, want 0000", b[len(b)-2:])
+	}
+	if b, err = Protobuf.Encode(e); err != nil {
+		t.Fatal(err)
+	}
+	withdrawalFields := protoFields(t, protoFields(t, b)[3][0].([]byte))
+	if _, ok := withdrawalFields[6]; ok {
+		t.Errorf("empty strategies encoded as %v", withdrawalFields[6])
+	}
+}
@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// Event is a decoded contract event.
+type Event struct {
+	// Contract is the name of the binding the event was decoded with, such as "DelegationManager".
+	Contract string
+	Address  common.Address
+	// Name is the event name, such as "OperatorSharesIncreased".
+	Name string
+
+	BlockNumber uint64
+	BlockHash   common.Hash
+	TxHash      common.Hash
+	TxIndex     uint
+	LogIndex    uint
+
+	// Args holds the decoded event arguments, indexed and non-indexed, keyed by name.
+	Args map[string]interface{}
+}
+
+// Source is a deployed contract to index.
+type Source struct {
+	// Contract is the name of the contract's binding in pkg/bindings.
+	Contract string
+	Address  common.Address
+}
+
+// errUnknownEvent is returned when a log's topic does not match any event of its source.
+var errUnknownEvent = errors.New("unknown event")
+
+// decoder decodes the logs of a single source.
+type decoder struct {
+	source Source
+	abi    *abi.ABI
+}
+
+func newDecoder(source Source) (*decoder, error) {
+	parsed, err := abis.ABI(source.Contract)
+	if err != nil {
+		return nil, err
+	}
+	return &decoder{source: source, abi: parsed}, nil
+}
+
+func (d *decoder) decode(log types.Log) (Event, error) {
+	if len(log.Topics) == 0 {
+		return Event{}, errUnknownEvent
+	}
+	event, err := d.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return Event{}, errUnknownEvent
+	}
+
+	args := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		if err := event.Inputs.UnpackIntoMap(args, log.Data); err != nil {
+			return Event{}, fmt.Errorf("failed to unpack %s.%s: %w", d.source.Contract, event.Name, err)
+		}
+	}
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return Event{}, fmt.Errorf("failed to parse topics of %s.%s: %w", d.source.Contract, event.Name, err)
+	}
+
+	return Event{
+		Contract:    d.source.Contract,
+		Address:     log.Address,
+		Name:        event.Name,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Args:        args,
+	}, nil
+}
@@ -0,0 +1,287 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Exporter exports indexed events to a data pipeline.
+type Exporter interface {
+	// Export exports events, in order. An event may be exported again after a failed Save, so
+	// consumers should deduplicate events by transaction hash and log index.
+	Export(ctx context.Context, events []Event) error
+}
+
+// exportingStore is a Store that exports the events of each Save before saving them.
+type exportingStore struct {
+	Store
+	exporters []Exporter
+}
+
+// Exporting returns a Store that passes the events of each Save to exporters before saving them
+// to store, so that the checkpoint only advances past events that were exported:
+//
+//	files, _ := indexer.NewFileExporter("exports", indexer.Avro)
+//	ix, _ := indexer.New(backend, indexer.Exporting(store, files), cfg)
+func Exporting(store Store, exporters ...Exporter) Store {
+	return &exportingStore{Store: store, exporters: exporters}
+}
+
+// Save implements Store.
+func (s *exportingStore) Save(ctx context.Context, events []Event, checkpoint uint64) error {
+	for _, exporter := range s.exporters {
+		if err := exporter.Export(ctx, events); err != nil {
+			return fmt.Errorf("failed to export events: %w", err)
+		}
+	}
+	return s.Store.Save(ctx, events, checkpoint)
+}
+
+// FileExporter is an Exporter that appends the events of each type to a file of their own in a
+// directory, named <Contract>.<Event> with the extension of its format:
+//
+//   - .binpb for Protobuf, a stream of messages each prefixed with its varint length, as written
+//     by writeDelimitedTo in Java and read by parseDelimitedFrom.
+//   - .avro for Avro, an uncompressed object container file holding the event's AvroSchema.
+type FileExporter struct {
+	dir    string
+	format Format
+
+	mu sync.Mutex
+}
+
+var _ Exporter = (*FileExporter)(nil)
+
+// NewFileExporter returns a FileExporter writing events encoded in format to dir, which is
+// created if it does not exist.
+func NewFileExporter(dir string, format Format) (*FileExporter, error) {
+	if format != Protobuf && format != Avro {
+		return nil, fmt.Errorf("unknown format %d", int(format))
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	return &FileExporter{dir: dir, format: format}, nil
+}
+
+// Path returns the file the events of contract named event are written to.
+func (x *FileExporter) Path(contract, event string) string {
+	ext := ".binpb"
+	if x.format == Avro {
+		ext = ".avro"
+	}
+	return filepath.Join(x.dir, contract+"."+event+ext)
+}
+
+// Export implements Exporter.
+func (x *FileExporter) Export(_ context.Context, events []Event) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	type eventType struct{ contract, name string }
+	var order []eventType
+	encoded := make(map[eventType][][]byte)
+	for _, e := range events {
+		data, err := x.format.Encode(e)
+		if err != nil {
+			return err
+		}
+		key := eventType{e.Contract, e.Name}
+		if _, ok := encoded[key]; !ok {
+			order = append(order, key)
+		}
+		encoded[key] = append(encoded[key], data)
+	}
+	for _, key := range order {
+		if err := x.append(key.contract, key.name, encoded[key]); err != nil {
+			return fmt.Errorf("failed to export %s.%s: %w", key.contract, key.name, err)
+		}
+	}
+	return nil
+}
+
+// append writes the encoded events of contract named event to the end of their file.
+func (x *FileExporter) append(contract, event string, records [][]byte) error {
+	f, err := os.OpenFile(x.Path(contract, event), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	switch x.format {
+	case Protobuf:
+		for _, record := range records {
+			buf = protowire.AppendBytes(buf, record)
+		}
+	case Avro:
+		var sync []byte
+		if info.Size() == 0 {
+			schema, err := AvroSchema(contract, event)
+			if err != nil {
+				return err
+			}
+			sync = make([]byte, 16)
+			if _, err := rand.Read(sync); err != nil {
+				return err
+			}
+			buf = appendAvroHeader(buf, schema, sync)
+		} else if sync, err = readAvroSync(f); err != nil {
+			return err
+		}
+		var block []byte
+		for _, record := range records {
+			block = append(block, record...)
+		}
+		buf = appendAvroLong(buf, int64(len(records)))
+		buf = appendAvroLong(buf, int64(len(block)))
+		buf = append(buf, block...)
+		buf = append(buf, sync...)
+	}
+	if _, err := f.WriteAt(buf, info.Size()); err != nil {
+		// Drop what was written, so the file stays readable.
+		_ = f.Truncate(info.Size())
+		return err
+	}
+	return f.Sync()
+}
+
+// avroMagic starts Avro object container files.
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// appendAvroHeader appends the header of an uncompressed Avro object container file.
+func appendAvroHeader(b []byte, schema string, sync []byte) []byte {
+	b = append(b, avroMagic...)
+	b = appendAvroLong(b, 2)
+	b = appendAvroString(b, "avro.codec")
+	b = appendAvroString(b, "null")
+	b = appendAvroString(b, "avro.schema")
+	b = appendAvroString(b, schema)
+	b = appendAvroLong(b, 0)
+	return append(b, sync...)
+}
+
+// readAvroSync returns the sync marker of the Avro object container file r, read from its header.
+func readAvroSync(r io.ReaderAt) ([]byte, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, 0, 1<<62))
+	magic := make([]byte, len(avroMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic, avroMagic) {
+		return nil, errors.New("not an Avro object container file")
+	}
+	readLong := func() (int64, error) {
+		v, err := readUvarint(br)
+		return protowire.DecodeZigZag(v), err
+	}
+	skip := func() error {
+		n, err := readLong()
+		if err != nil {
+			return err
+		}
+		_, err = br.Discard(int(n))
+		return err
+	}
+	for {
+		count, err := readLong()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			break
+		}
+		if count < 0 {
+			// A negative count is followed by the size of the block.
+			count = -count
+			if _, err := readLong(); err != nil {
+				return nil, err
+			}
+		}
+		for i := int64(0); i < 2*count; i++ {
+			if err := skip(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sync := make([]byte, 16)
+	if _, err := io.ReadFull(br, sync); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+// readUvarint reads a varint, as encoded by protowire.AppendVarint.
+func readUvarint(r io.ByteReader) (uint64, error) {
+	var v uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		c, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint64(c&0x7f) << shift
+		if c < 0x80 {
+			return v, nil
+		}
+	}
+	return 0, errors.New("varint overflows 64 bits")
+}
+
+// Publisher publishes messages to a broker such as Kafka. No Kafka client is a dependency of this
+// module; implement Publisher with the producer of a client such as franz-go or sarama. A message
+// carries the bare encoding of its Format; a producer using a schema registry frames it with the
+// registry's header, such as the Confluent wire format's schema ID.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key, value []byte) error
+}
+
+// PublishExporter is an Exporter that publishes each event as a message to the topic
+// <prefix><Contract>.<Event>, keyed by its transaction hash and log index so that the events of
+// a transaction land in one partition and duplicates can be compacted.
+type PublishExporter struct {
+	publisher Publisher
+	format    Format
+	prefix    string
+}
+
+var _ Exporter = (*PublishExporter)(nil)
+
+// NewPublishExporter returns a PublishExporter publishing events encoded in format through
+// publisher, to topics prefixed with prefix.
+func NewPublishExporter(publisher Publisher, format Format, prefix string) *PublishExporter {
+	return &PublishExporter{publisher: publisher, format: format, prefix: prefix}
+}
+
+// Topic returns the topic e is published to.
+func (x *PublishExporter) Topic(e Event) string {
+	return x.prefix + e.Contract + "." + e.Name
+}
+
+// Export implements Exporter.
+func (x *PublishExporter) Export(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		value, err := x.format.Encode(e)
+		if err != nil {
+			return err
+		}
+		key := []byte(fmt.Sprintf("%s:%d", e.TxHash.Hex(), e.LogIndex))
+		if err := x.publisher.Publish(ctx, x.Topic(e), key, value); err != nil {
+			return fmt.Errorf("failed to publish %s.%s of %s: %w", e.Contract, e.Name, e.TxHash.Hex(), err)
+		}
+	}
+	return nil
+}
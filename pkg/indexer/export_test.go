@@ -0,0 +1,241 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// forbidden returns a StrategyManager UpdatedThirdPartyTransfersForbidden event at log index i.
+func forbidden(i uint, value bool) Event {
+	e := testEvent("StrategyManager", "UpdatedThirdPartyTransfersForbidden", map[string]interface{}{
+		"strategy": testStrategies[i%2], "value": value,
+	})
+	e.LogIndex = i
+	return e
+}
+
+func encodeAll(t *testing.T, format Format, events ...Event) [][]byte {
+	t.Helper()
+	var out [][]byte
+	for _, e := range events {
+		b, err := format.Encode(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// avroBlock is a data block of an Avro object container file.
+type avroBlock struct {
+	count int64
+	data  []byte
+}
+
+// readAvroContainer decodes an uncompressed Avro object container file into its metadata and
+// blocks, checking that each block ends with the sync marker of the header.
+func readAvroContainer(t *testing.T, file []byte) (map[string]string, []avroBlock) {
+	t.Helper()
+	if !bytes.HasPrefix(file, []byte("Obj\x01")) {
+		t.Fatalf("file starts with %q, want Obj\\x01", file[:min(4, len(file))])
+	}
+	r := &avroReader{t: t, data: file[4:]}
+	meta := make(map[string]string)
+	for {
+		n := r.long()
+		if n == 0 {
+			break
+		}
+		if n < 0 {
+			n = -n
+			r.long()
+		}
+		for i := int64(0); i < n; i++ {
+			key := r.string()
+			meta[key] = r.string()
+		}
+	}
+	sync := r.bytes(16)
+	if got, err := readAvroSync(bytes.NewReader(file)); err != nil || !bytes.Equal(got, sync) {
+		t.Errorf("readAvroSync = %x, %v, want %x", got, err, sync)
+	}
+
+	var blocks []avroBlock
+	for len(r.data) > 0 {
+		count := r.long()
+		size := r.long()
+		block := avroBlock{count: count, data: r.bytes(int(size))}
+		if marker := r.bytes(16); !bytes.Equal(marker, sync) {
+			t.Fatalf("block %d ends with %x, want the sync marker %x", len(blocks), marker, sync)
+		}
+		blocks = append(blocks, block)
+	}
+	return meta, blocks
+}
+
+func TestFileExporterAvro(t *testing.T) {
+	dir := t.TempDir()
+	x, err := NewFileExporter(dir, Avro)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := []Event{forbidden(1, true), forbidden(2, false)}
+	second := []Event{forbidden(3, true)}
+	ctx := context.Background()
+	if err := x.Export(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := x.Export(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+
+	path := x.Path("StrategyManager", "UpdatedThirdPartyTransfersForbidden")
+	if want := filepath.Join(dir, "StrategyManager.UpdatedThirdPartyTransfersForbidden.avro"); path != want {
+		t.Errorf("Path = %s, want %s", path, want)
+	}
+	file, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta, blocks := readAvroContainer(t, file)
+	schema, err := AvroSchema("StrategyManager", "UpdatedThirdPartyTransfersForbidden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"avro.codec": "null", "avro.schema": schema}; !reflect.DeepEqual(meta, want) {
+		t.Errorf("metadata = %v, want %v", meta, want)
+	}
+
+	// Each Export appends a block, after the same sync marker.
+	want := []avroBlock{
+		{2, bytes.Join(encodeAll(t, Avro, first...), nil)},
+		{1, bytes.Join(encodeAll(t, Avro, second...), nil)},
+	}
+	if !reflect.DeepEqual(blocks, want) {
+		t.Errorf("blocks = %v\nwant %v", blocks, want)
+	}
+}
+
+func TestFileExporterProtobuf(t *testing.T) {
+	dir := t.TempDir()
+	x, err := NewFileExporter(dir, Protobuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pod := testEvent("EigenPod", "ValidatorRestaked", map[string]interface{}{"validatorIndex": uint64(1)})
+	events := []Event{forbidden(1, true), pod, forbidden(2, false)}
+	if err := x.Export(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		contract, event string
+		want            [][]byte
+	}{
+		{"StrategyManager", "UpdatedThirdPartyTransfersForbidden", encodeAll(t, Protobuf, events[0], events[2])},
+		{"EigenPod", "ValidatorRestaked", encodeAll(t, Protobuf, pod)},
+	} {
+		path := x.Path(test.contract, test.event)
+		if filepath.Ext(path) != ".binpb" {
+			t.Errorf("Path = %s, want a .binpb file", path)
+		}
+		file, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var messages [][]byte
+		for len(file) > 0 {
+			m, n := protowire.ConsumeBytes(file)
+			if n < 0 {
+				t.Fatalf("invalid message in %s: %v", path, protowire.ParseError(n))
+			}
+			messages = append(messages, m)
+			file = file[n:]
+		}
+		if !reflect.DeepEqual(messages, test.want) {
+			t.Errorf("%s holds %x\nwant %x", path, messages, test.want)
+		}
+	}
+}
+
+func TestReadAvroSync(t *testing.T) {
+	sync := bytes.Repeat([]byte{0xa5}, 16)
+	header := appendAvroHeader(nil, `"string"`, sync)
+	if got, err := readAvroSync(bytes.NewReader(header)); err != nil || !bytes.Equal(got, sync) {
+		t.Errorf("readAvroSync = %x, %v, want %x", got, err, sync)
+	}
+
+	// A metadata block may have a negative count, followed by its size in bytes.
+	var b []byte
+	b = append(b, avroMagic...)
+	b = appendAvroLong(b, -1)
+	b = appendAvroLong(b, int64(len(appendAvroString(appendAvroString(nil, "avro.codec"), "null"))))
+	b = appendAvroString(b, "avro.codec")
+	b = appendAvroString(b, "null")
+	b = appendAvroLong(b, 0)
+	b = append(b, sync...)
+	if got, err := readAvroSync(bytes.NewReader(b)); err != nil || !bytes.Equal(got, sync) {
+		t.Errorf("readAvroSync of a sized block = %x, %v, want %x", got, err, sync)
+	}
+
+	if _, err := readAvroSync(bytes.NewReader([]byte("PAR1"))); err == nil {
+		t.Error("readAvroSync of a file without the Avro magic succeeded")
+	}
+	if _, err := readAvroSync(bytes.NewReader(header[:len(header)-1])); err == nil {
+		t.Error("readAvroSync of a truncated header succeeded")
+	}
+}
+
+type message struct {
+	topic      string
+	key, value []byte
+}
+
+type testPublisher struct {
+	messages []message
+	err      error
+}
+
+func (p *testPublisher) Publish(_ context.Context, topic string, key, value []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, message{topic, key, value})
+	return nil
+}
+
+func TestPublishExporter(t *testing.T) {
+	p := &testPublisher{}
+	x := NewPublishExporter(p, Avro, "eigenlayer.")
+	events := []Event{forbidden(1, true), forbidden(2, false)}
+	if err := x.Export(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+	values := encodeAll(t, Avro, events...)
+	var want []message
+	for i, e := range events {
+		want = append(want, message{
+			topic: "eigenlayer.StrategyManager.UpdatedThirdPartyTransfersForbidden",
+			key:   []byte(fmt.Sprintf("%s:%d", common.HexToHash("0x7e").Hex(), e.LogIndex)),
+			value: values[i],
+		})
+	}
+	if !reflect.DeepEqual(p.messages, want) {
+		t.Errorf("published %v\nwant %v", p.messages, want)
+	}
+
+	p.err = errors.New("broker unavailable")
+	if err := x.Export(context.Background(), events); !errors.Is(err, p.err) {
+		t.Errorf("Export = %v, want %v", err, p.err)
+	}
+}
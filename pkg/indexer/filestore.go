@@ -0,0 +1,186 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FileStore is a Store backed by an append-only file of JSON lines, for indexing without a
+// database. Each Save appends the saved events followed by the checkpoint and syncs the file;
+// events after the last checkpoint, left by an interrupted Save, are truncated when the file is
+// opened. The events are also held in memory to serve Events. As with SQLStore, event arguments
+// are stored as JSON, so Events returns addresses, hashes, byte strings and integers as strings.
+type FileStore struct {
+	mu   sync.Mutex
+	file *os.File
+	mem  *MemoryStore
+}
+
+// fileRecord is a line of a FileStore: an event or a checkpoint.
+type fileRecord struct {
+	Event      *fileEvent `json:"event,omitempty"`
+	Checkpoint *uint64    `json:"checkpoint,omitempty"`
+}
+
+type fileEvent struct {
+	Contract    string                 `json:"contract"`
+	Address     common.Address         `json:"address"`
+	Name        string                 `json:"name"`
+	BlockNumber uint64                 `json:"blockNumber"`
+	BlockHash   common.Hash            `json:"blockHash"`
+	TxHash      common.Hash            `json:"txHash"`
+	TxIndex     uint                   `json:"txIndex"`
+	LogIndex    uint                   `json:"logIndex"`
+	Args        map[string]interface{} `json:"args"`
+}
+
+func (e *fileEvent) event() Event {
+	return Event{
+		Contract:    e.Contract,
+		Address:     e.Address,
+		Name:        e.Name,
+		BlockNumber: e.BlockNumber,
+		BlockHash:   e.BlockHash,
+		TxHash:      e.TxHash,
+		TxIndex:     e.TxIndex,
+		LogIndex:    e.LogIndex,
+		Args:        e.Args,
+	}
+}
+
+// OpenFileStore opens the FileStore at path, creating the file if it does not exist, and loads
+// the events it holds.
+func OpenFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	s := &FileStore{file: f, mem: NewMemoryStore()}
+	if err := s.load(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// load reads the file into memory, then truncates it after its last checkpoint and positions it
+// for appending.
+func (s *FileStore) load() error {
+	var (
+		r         = bufio.NewReader(s.file)
+		offset    int64
+		committed int64
+		pending   []Event
+	)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A line without its newline is the tail of an interrupted Save.
+			break
+		}
+		if err != nil {
+			return err
+		}
+		offset += int64(len(line))
+		var record fileRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("invalid record at offset %d: %w", offset-int64(len(line)), err)
+		}
+		switch {
+		case record.Event != nil:
+			pending = append(pending, record.Event.event())
+		case record.Checkpoint != nil:
+			if err := s.mem.Save(context.Background(), pending, *record.Checkpoint); err != nil {
+				return err
+			}
+			pending, committed = nil, offset
+		}
+	}
+	if err := s.file.Truncate(committed); err != nil {
+		return err
+	}
+	_, err := s.file.Seek(committed, io.SeekStart)
+	return err
+}
+
+// Save implements Store.
+func (s *FileStore) Save(ctx context.Context, events []Event, checkpoint uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	stored := make([]Event, 0, len(events))
+	for _, e := range events {
+		record := fileEvent{
+			Contract:    e.Contract,
+			Address:     e.Address,
+			Name:        e.Name,
+			BlockNumber: e.BlockNumber,
+			BlockHash:   e.BlockHash,
+			TxHash:      e.TxHash,
+			TxIndex:     e.TxIndex,
+			LogIndex:    e.LogIndex,
+			Args:        JSONArgs(e.Args),
+		}
+		start := buf.Len()
+		if err := enc.Encode(fileRecord{Event: &record}); err != nil {
+			return fmt.Errorf("failed to encode %s.%s: %w", e.Contract, e.Name, err)
+		}
+		// Hold the event as it will be loaded from the file, so Events returns the same values
+		// before and after a restart.
+		var decoded fileRecord
+		if err := json.Unmarshal(buf.Bytes()[start:], &decoded); err != nil {
+			return fmt.Errorf("failed to decode %s.%s: %w", e.Contract, e.Name, err)
+		}
+		stored = append(stored, decoded.Event.event())
+	}
+	if err := enc.Encode(fileRecord{Checkpoint: &checkpoint}); err != nil {
+		return err
+	}
+	offset, err := s.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(buf.Bytes()); err != nil {
+		s.rollback(offset)
+		return fmt.Errorf("failed to write events: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		s.rollback(offset)
+		return fmt.Errorf("failed to sync events: %w", err)
+	}
+	return s.mem.Save(ctx, stored, checkpoint)
+}
+
+// rollback drops a partially written Save, so the next one does not append after it.
+func (s *FileStore) rollback(offset int64) {
+	_ = s.file.Truncate(offset)
+	_, _ = s.file.Seek(offset, io.SeekStart)
+}
+
+// Checkpoint implements Store.
+func (s *FileStore) Checkpoint(ctx context.Context) (uint64, bool, error) {
+	return s.mem.Checkpoint(ctx)
+}
+
+// Events implements Store.
+func (s *FileStore) Events(ctx context.Context, filter Filter) ([]Event, error) {
+	return s.mem.Events(ctx, filter)
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
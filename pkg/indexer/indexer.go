@@ -0,0 +1,191 @@
+// Package indexer indexes the events of EigenLayer contracts into a Store.
+//
+// Each Source names a binding in pkg/bindings and the address it is deployed at. The Indexer
+// fetches the logs of all sources in block ranges, decodes them with the binding's ABI into
+// Events and saves each range to the Store together with a checkpoint, so an interrupted run
+// resumes after the last saved range.
+//
+// For data pipelines, Exporting wraps a Store with Exporters that serialize each saved event
+// with a Format, Protobuf or Avro, whose schema per event type is derived from its ABI (see
+// ProtoFile and AvroSchema), and write it to files or publish it to a broker such as Kafka.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+const (
+	// DefaultBatchSize is the default number of blocks fetched per log query.
+	DefaultBatchSize = 2000
+	// DefaultPollInterval is the default interval at which Run checks for new blocks, one slot.
+	DefaultPollInterval = 12 * time.Second
+)
+
+// Backend is the chain access required by Indexer.
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures an Indexer.
+type Config struct {
+	// Sources are the contracts to index.
+	Sources []Source
+	// StartBlock is the first block indexed when the Store has no checkpoint.
+	StartBlock uint64
+	// BatchSize is the number of blocks fetched per log query. It defaults to DefaultBatchSize.
+	BatchSize uint64
+	// PollInterval is how often Run checks for new blocks. It defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// Confirmations is the number of blocks Run stays behind the chain head, so that events
+	// in blocks that may still be reorganized are not indexed.
+	Confirmations uint64
+	// Logger receives the saved ranges and, at debug level, every event indexed and log
+	// skipped. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Indexer indexes the events of a set of sources into a Store.
+type Indexer struct {
+	backend   Backend
+	store     Store
+	cfg       Config
+	addresses []common.Address
+	decoders  map[common.Address]*decoder
+}
+
+// New returns an Indexer of the sources in cfg.
+func New(backend Backend, store Store, cfg Config) (*Indexer, error) {
+	if len(cfg.Sources) == 0 {
+		return nil, errors.New("no sources to index")
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	ix := &Indexer{
+		backend:  backend,
+		store:    store,
+		cfg:      cfg,
+		decoders: make(map[common.Address]*decoder, len(cfg.Sources)),
+	}
+	for _, source := range cfg.Sources {
+		if _, ok := ix.decoders[source.Address]; ok {
+			return nil, fmt.Errorf("duplicate source address %s", source.Address)
+		}
+		d, err := newDecoder(source)
+		if err != nil {
+			return nil, err
+		}
+		ix.decoders[source.Address] = d
+		ix.addresses = append(ix.addresses, source.Address)
+	}
+	return ix, nil
+}
+
+// Next returns the next block to index: the block after the Store's checkpoint, or
+// Config.StartBlock if there is none.
+func (ix *Indexer) Next(ctx context.Context) (uint64, error) {
+	checkpoint, ok, err := ix.store.Checkpoint(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	if !ok {
+		return ix.cfg.StartBlock, nil
+	}
+	return checkpoint + 1, nil
+}
+
+// Backfill indexes every block from Next up to and including toBlock, saving a checkpoint
+// after each batch.
+func (ix *Indexer) Backfill(ctx context.Context, toBlock uint64) error {
+	from, err := ix.Next(ctx)
+	if err != nil {
+		return err
+	}
+	for from <= toBlock {
+		to := from + ix.cfg.BatchSize - 1
+		if to > toBlock {
+			to = toBlock
+		}
+		events, err := ix.fetch(ctx, from, to)
+		if err != nil {
+			return err
+		}
+		if err := ix.store.Save(ctx, events, to); err != nil {
+			return fmt.Errorf("failed to save blocks %d-%d: %w", from, to, err)
+		}
+		ix.cfg.Logger.Info("indexed blocks", "from", from, "to", to, "events", len(events))
+		from = to + 1
+	}
+	return nil
+}
+
+// Run backfills to the chain head less Config.Confirmations, then keeps indexing new blocks
+// every Config.PollInterval until ctx is done.
+func (ix *Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(ix.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		head, err := ix.backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get block number: %w", err)
+		}
+		if head >= ix.cfg.Confirmations {
+			if err := ix.Backfill(ctx, head-ix.cfg.Confirmations); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch returns the decoded events of all sources in blocks from to to, inclusive. Logs that
+// do not match an event in their source's ABI are skipped.
+func (ix *Indexer) fetch(ctx context.Context, from, to uint64) ([]Event, error) {
+	logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: ix.addresses,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter logs in blocks %d-%d: %w", from, to, err)
+	}
+	events := make([]Event, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		d, ok := ix.decoders[log.Address]
+		if !ok {
+			continue
+		}
+		event, err := d.decode(log)
+		if errors.Is(err, errUnknownEvent) {
+			ix.cfg.Logger.Debug("skipped unknown event", "address", log.Address.Hex(), "topics", log.Topics, "block", log.BlockNumber, "tx", log.TxHash.Hex())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ix.cfg.Logger.Debug("indexed event", "contract", event.Contract, "event", event.Name, "block", event.BlockNumber, "tx", event.TxHash.Hex(), "logIndex", event.LogIndex)
+		events = append(events, event)
+	}
+	return events, nil
+}
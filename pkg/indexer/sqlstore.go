@@ -0,0 +1,206 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Dialect is the SQL dialect spoken by a SQLStore's database.
+type Dialect int
+
+const (
+	// SQLite is the dialect of SQLite 3.24 and later.
+	SQLite Dialect = iota
+	// Postgres is the dialect of PostgreSQL 9.5 and later.
+	Postgres
+)
+
+// Placeholder returns the placeholder of the nth parameter of a query, counting from 1.
+func (d Dialect) Placeholder(n int) string {
+	if d == Postgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// SQLStore is a Store backed by a SQLite or Postgres database. The caller registers the
+// database/sql driver and opens db. Event arguments are stored as JSON, so Events returns
+// addresses, hashes, byte strings and integers as strings.
+type SQLStore struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// NewSQLStore returns a SQLStore using db, creating its tables if they do not exist.
+func NewSQLStore(ctx context.Context, db *sql.DB, dialect Dialect) (*SQLStore, error) {
+	schema := []string{
+		`CREATE TABLE IF NOT EXISTS indexer_events (
+			contract     TEXT NOT NULL,
+			address      TEXT NOT NULL,
+			name         TEXT NOT NULL,
+			block_number BIGINT NOT NULL,
+			block_hash   TEXT NOT NULL,
+			tx_hash      TEXT NOT NULL,
+			tx_index     BIGINT NOT NULL,
+			log_index    BIGINT NOT NULL,
+			args         TEXT NOT NULL,
+			PRIMARY KEY (tx_hash, log_index)
+		)`,
+		`CREATE INDEX IF NOT EXISTS indexer_events_block ON indexer_events (block_number, log_index)`,
+		`CREATE TABLE IF NOT EXISTS indexer_checkpoint (
+			id           INTEGER PRIMARY KEY,
+			block_number BIGINT NOT NULL
+		)`,
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to create indexer tables: %w", err)
+		}
+	}
+	return &SQLStore{db: db, dialect: dialect}, nil
+}
+
+// Save implements Store.
+func (s *SQLStore) Save(ctx context.Context, events []Event, checkpoint uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	insert := fmt.Sprintf(
+		`INSERT INTO indexer_events (contract, address, name, block_number, block_hash, tx_hash, tx_index, log_index, args)
+		VALUES (%s) ON CONFLICT (tx_hash, log_index) DO NOTHING`,
+		s.placeholders(1, 9),
+	)
+	for _, e := range events {
+		args, err := json.Marshal(JSONArgs(e.Args))
+		if err != nil {
+			return fmt.Errorf("failed to encode args of %s.%s: %w", e.Contract, e.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx, insert,
+			e.Contract, e.Address.Hex(), e.Name, int64(e.BlockNumber), e.BlockHash.Hex(),
+			e.TxHash.Hex(), int64(e.TxIndex), int64(e.LogIndex), string(args),
+		); err != nil {
+			return fmt.Errorf("failed to insert event: %w", err)
+		}
+	}
+
+	upsert := fmt.Sprintf(
+		`INSERT INTO indexer_checkpoint (id, block_number) VALUES (1, %s)
+		ON CONFLICT (id) DO UPDATE SET block_number = excluded.block_number`,
+		s.dialect.Placeholder(1),
+	)
+	if _, err := tx.ExecContext(ctx, upsert, int64(checkpoint)); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return tx.Commit()
+}
+
+// Checkpoint implements Store.
+func (s *SQLStore) Checkpoint(ctx context.Context) (uint64, bool, error) {
+	var block int64
+	err := s.db.QueryRowContext(ctx, `SELECT block_number FROM indexer_checkpoint WHERE id = 1`).Scan(&block)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(block), true, nil
+}
+
+// Events implements Store.
+func (s *SQLStore) Events(ctx context.Context, filter Filter) ([]Event, error) {
+	var where []string
+	var params []interface{}
+	add := func(cond string, param interface{}) {
+		params = append(params, param)
+		where = append(where, fmt.Sprintf(cond, s.dialect.Placeholder(len(params))))
+	}
+	if filter.Contract != "" {
+		add("contract = %s", filter.Contract)
+	}
+	if filter.Address != (common.Address{}) {
+		add("address = %s", filter.Address.Hex())
+	}
+	if filter.Name != "" {
+		add("name = %s", filter.Name)
+	}
+	if filter.FromBlock != 0 {
+		add("block_number >= %s", int64(filter.FromBlock))
+	}
+	if filter.ToBlock != 0 {
+		add("block_number <= %s", int64(filter.ToBlock))
+	}
+
+	query := `SELECT contract, address, name, block_number, block_hash, tx_hash, tx_index, log_index, args FROM indexer_events`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY block_number, log_index"
+
+	rows, err := s.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var (
+			e                                Event
+			address, blockHash, txHash, args string
+			blockNumber, txIndex, logIndex   int64
+		)
+		if err := rows.Scan(&e.Contract, &address, &e.Name, &blockNumber, &blockHash, &txHash, &txIndex, &logIndex, &args); err != nil {
+			return nil, err
+		}
+		e.Address = common.HexToAddress(address)
+		e.BlockHash = common.HexToHash(blockHash)
+		e.TxHash = common.HexToHash(txHash)
+		e.BlockNumber, e.TxIndex, e.LogIndex = uint64(blockNumber), uint(txIndex), uint(logIndex)
+		if err := json.Unmarshal([]byte(args), &e.Args); err != nil {
+			return nil, fmt.Errorf("failed to decode args of %s.%s: %w", e.Contract, e.Name, err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (s *SQLStore) placeholders(from, n int) string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = s.dialect.Placeholder(from + i)
+	}
+	return strings.Join(out, ", ")
+}
+
+// JSONArgs converts decoded event arguments into values that encode readably as JSON.
+func JSONArgs(args map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(args))
+	for name, value := range args {
+		out[name] = jsonValue(value)
+	}
+	return out
+}
+
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case []byte:
+		return hexutil.Encode(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Filter selects stored events. Zero-valued fields match every event.
+type Filter struct {
+	Contract  string
+	Address   common.Address
+	Name      string
+	FromBlock uint64
+	// ToBlock is inclusive. Zero means no upper bound.
+	ToBlock uint64
+}
+
+// Store persists indexed events and the indexer's checkpoint.
+type Store interface {
+	// Save stores events and records checkpoint as the last fully indexed block. Both must be
+	// written atomically, and saving an event that is already stored must not duplicate it.
+	Save(ctx context.Context, events []Event, checkpoint uint64) error
+	// Checkpoint returns the last fully indexed block, if any.
+	Checkpoint(ctx context.Context) (uint64, bool, error)
+	// Events returns the stored events matching filter, ordered by block number and log index.
+	Events(ctx context.Context, filter Filter) ([]Event, error)
+}
+
+func (f Filter) matches(e Event) bool {
+	return (f.Contract == "" || e.Contract == f.Contract) &&
+		(f.Address == common.Address{} || e.Address == f.Address) &&
+		(f.Name == "" || e.Name == f.Name) &&
+		e.BlockNumber >= f.FromBlock &&
+		(f.ToBlock == 0 || e.BlockNumber <= f.ToBlock)
+}
+
+type eventKey struct {
+	txHash   common.Hash
+	logIndex uint
+}
+
+// MemoryStore is a Store that keeps events in memory.
+type MemoryStore struct {
+	mu            sync.RWMutex
+	events        []Event
+	seen          map[eventKey]bool
+	checkpoint    uint64
+	hasCheckpoint bool
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{seen: make(map[eventKey]bool)}
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(_ context.Context, events []Event, checkpoint uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range events {
+		key := eventKey{e.TxHash, e.LogIndex}
+		if s.seen[key] {
+			continue
+		}
+		s.seen[key] = true
+		s.events = append(s.events, e)
+	}
+	sort.SliceStable(s.events, func(i, j int) bool {
+		if s.events[i].BlockNumber != s.events[j].BlockNumber {
+			return s.events[i].BlockNumber < s.events[j].BlockNumber
+		}
+		return s.events[i].LogIndex < s.events[j].LogIndex
+	})
+	s.checkpoint, s.hasCheckpoint = checkpoint, true
+	return nil
+}
+
+// Checkpoint implements Store.
+func (s *MemoryStore) Checkpoint(context.Context) (uint64, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.checkpoint, s.hasCheckpoint, nil
+}
+
+// Events implements Store.
+func (s *MemoryStore) Events(_ context.Context, filter Filter) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []Event
+	for _, e := range s.events {
+		if filter.matches(e) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
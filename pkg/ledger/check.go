@@ -0,0 +1,126 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// ErrNotSynced is returned by Check when the ledger has not replayed any block.
+var ErrNotSynced = errors.New("ledger is not synced")
+
+// Mismatch is a balance of the ledger that differs from the contracts.
+type Mismatch struct {
+	// Account is the staker, or the operator if Operator is set.
+	Account  common.Address
+	Operator bool
+	Strategy common.Address
+	Ledger   *big.Int
+	OnChain  *big.Int
+}
+
+func (m Mismatch) String() string {
+	kind := "staker"
+	if m.Operator {
+		kind = "operator"
+	}
+	return fmt.Sprintf("%s %s in strategy %s: ledger has %s shares, chain has %s", kind, m.Account.Hex(), m.Strategy.Hex(), m.Ledger, m.OnChain)
+}
+
+// Check compares every balance in the ledger with the contracts at the last block replayed: the
+// shares of stakers with each strategy's shares(staker), and those of operators with the
+// DelegationManager's operatorShares. Balances are read in a single multicall, and mismatches are
+// returned ordered by account and strategy.
+func (l *Ledger) Check(ctx context.Context) ([]Mismatch, error) {
+	l.mu.RLock()
+	if l.next <= l.cfg.FromBlock {
+		l.mu.RUnlock()
+		return nil, ErrNotSynced
+	}
+	block := new(big.Int).SetUint64(l.next - 1)
+	expected := l.entries()
+	l.mu.RUnlock()
+
+	batch := multicall.NewBatch(l.backend)
+	delegation, err := DelegationManager.NewDelegationManagerCaller(l.cfg.DelegationManager, batch)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*multicall.Result[*big.Int], len(expected))
+	for i, e := range expected {
+		e := e
+		if e.Operator {
+			results[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+				return delegation.OperatorShares(opts, e.Account, e.Strategy)
+			})
+			continue
+		}
+		caller, err := StrategyBase.NewStrategyBaseCaller(e.Strategy, batch)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return caller.Shares(opts, e.Account)
+		})
+	}
+	if err := batch.Execute(&bind.CallOpts{Context: ctx, BlockNumber: block}); err != nil {
+		return nil, err
+	}
+
+	var mismatches []Mismatch
+	for i, e := range expected {
+		onChain, err := results[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch shares of %s in %s: %w", e.Account.Hex(), e.Strategy.Hex(), err)
+		}
+		if onChain.Cmp(e.Ledger) != 0 {
+			e.OnChain = onChain
+			mismatches = append(mismatches, e)
+		}
+	}
+	return mismatches, nil
+}
+
+// entries returns every balance of the ledger as a Mismatch without OnChain, ordered by kind,
+// account and strategy. Staker balances in the beacon chain ETH strategy are not tracked.
+func (l *Ledger) entries() []Mismatch {
+	var out []Mismatch
+	for _, b := range []struct {
+		balances Balances
+		operator bool
+	}{{l.stakers, false}, {l.operators, true}} {
+		for account, shares := range b.balances {
+			for strategy, amount := range shares {
+				if !b.operator && strategy == addresses.BeaconChainETHStrategy {
+					continue
+				}
+				out = append(out, Mismatch{
+					Account:  account,
+					Operator: b.operator,
+					Strategy: strategy,
+					Ledger:   new(big.Int).Set(amount),
+				})
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Operator != out[j].Operator {
+			return !out[i].Operator
+		}
+		if c := out[i].Account.Cmp(out[j].Account); c != 0 {
+			return c < 0
+		}
+		return out[i].Strategy.Cmp(out[j].Strategy) < 0
+	})
+	return out
+}
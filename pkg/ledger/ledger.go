@@ -0,0 +1,323 @@
+// Package ledger reconstructs the share balances of stakers and operators from events.
+//
+// A Ledger replays the StrategyManager's Deposit events and the DelegationManager's
+// WithdrawalQueued, WithdrawalCompleted, OperatorSharesIncreased and OperatorSharesDecreased
+// events, in log order, into per-staker and per-operator share balances for every strategy:
+//
+//   - Deposit adds shares to the staker. The StrategyManager also emits it when a withdrawal is
+//     completed as shares, so no separate accounting is needed for completions.
+//   - WithdrawalQueued removes the withdrawal's shares from the staker and records it as pending
+//     until WithdrawalCompleted.
+//   - OperatorSharesIncreased and OperatorSharesDecreased move the operator's delegated shares.
+//
+// Beacon chain ETH shares are held by the EigenPodManager rather than the StrategyManager, so
+// they are tracked for operators but not for stakers. Check diffs the ledger against the
+// contracts at the last block replayed.
+//
+//	l, _ := ledger.New(client, ledger.Config{
+//		StrategyManager:   strategyManager,
+//		DelegationManager: delegationManager,
+//		FromBlock:         deploymentBlock,
+//	})
+//	if _, err := l.Sync(ctx); err != nil {
+//		...
+//	}
+//	shares := l.StakerShares(staker, strategy)
+//	mismatches, err := l.Check(ctx)
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+)
+
+// DefaultBatchSize is the default number of blocks fetched per log query.
+const DefaultBatchSize = 2000
+
+// Backend is the chain access required by Ledger.
+type Backend interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures a Ledger.
+type Config struct {
+	StrategyManager   common.Address
+	DelegationManager common.Address
+	// FromBlock is the first block replayed. Balances are only complete when it is at or before
+	// the deployment of both contracts.
+	FromBlock uint64
+	// BatchSize is the number of blocks fetched per log query. It defaults to DefaultBatchSize.
+	BatchSize uint64
+}
+
+// Balances maps accounts to their shares in each strategy.
+type Balances map[common.Address]map[common.Address]*big.Int
+
+func (b Balances) add(account, strategy common.Address, delta *big.Int) {
+	shares, ok := b[account]
+	if !ok {
+		shares = make(map[common.Address]*big.Int)
+		b[account] = shares
+	}
+	if shares[strategy] == nil {
+		shares[strategy] = new(big.Int)
+	}
+	shares[strategy].Add(shares[strategy], delta)
+}
+
+func (b Balances) get(account, strategy common.Address) *big.Int {
+	if shares := b[account][strategy]; shares != nil {
+		return new(big.Int).Set(shares)
+	}
+	return new(big.Int)
+}
+
+// Withdrawal is a queued withdrawal that has not been completed.
+type Withdrawal struct {
+	Root common.Hash
+	DelegationManager.IDelegationManagerWithdrawal
+	// QueuedAt is the block the withdrawal was queued at.
+	QueuedAt uint64
+}
+
+// Ledger holds the share balances replayed from events.
+type Ledger struct {
+	backend  Backend
+	cfg      Config
+	query    ethereum.FilterQuery
+	manager  *StrategyManager.StrategyManagerFilterer
+	delegate *DelegationManager.DelegationManagerFilterer
+
+	mu sync.RWMutex
+	// next is the first block not yet replayed.
+	next      uint64
+	stakers   Balances
+	operators Balances
+	pending   map[common.Hash]*Withdrawal
+	// applied records the last applied log, so a log replayed twice is applied once.
+	applied logPosition
+}
+
+type logPosition struct {
+	block, index uint64
+	set          bool
+}
+
+func (p logPosition) before(log types.Log) bool {
+	return !p.set || p.block < log.BlockNumber || (p.block == log.BlockNumber && p.index < uint64(log.Index))
+}
+
+// New returns an empty Ledger. Call Sync to replay events into it.
+func New(backend Backend, cfg Config) (*Ledger, error) {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	managerABI, err := StrategyManager.StrategyManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	delegationABI, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	manager, err := StrategyManager.NewStrategyManagerFilterer(cfg.StrategyManager, nil)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := DelegationManager.NewDelegationManagerFilterer(cfg.DelegationManager, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ledger{
+		backend: backend,
+		cfg:     cfg,
+		query: ethereum.FilterQuery{
+			Addresses: []common.Address{cfg.StrategyManager, cfg.DelegationManager},
+			Topics: [][]common.Hash{{
+				managerABI.Events["Deposit"].ID,
+				delegationABI.Events["WithdrawalQueued"].ID,
+				delegationABI.Events["WithdrawalCompleted"].ID,
+				delegationABI.Events["OperatorSharesIncreased"].ID,
+				delegationABI.Events["OperatorSharesDecreased"].ID,
+			}},
+		},
+		manager:   manager,
+		delegate:  delegate,
+		next:      cfg.FromBlock,
+		stakers:   make(Balances),
+		operators: make(Balances),
+		pending:   make(map[common.Hash]*Withdrawal),
+	}, nil
+}
+
+// Sync replays the events emitted since the last sync, up to the latest block, and returns the
+// last block replayed.
+func (l *Ledger) Sync(ctx context.Context) (uint64, error) {
+	head, err := l.backend.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	return head, l.SyncTo(ctx, head)
+}
+
+// SyncTo replays the events emitted since the last sync, up to toBlock.
+func (l *Ledger) SyncTo(ctx context.Context, toBlock uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.next <= toBlock {
+		to := l.next + l.cfg.BatchSize - 1
+		if to > toBlock {
+			to = toBlock
+		}
+		query := l.query
+		query.FromBlock = new(big.Int).SetUint64(l.next)
+		query.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := l.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to fetch logs of blocks %d-%d: %w", l.next, to, err)
+		}
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+		for _, log := range logs {
+			if err := l.apply(log); err != nil {
+				return err
+			}
+		}
+		l.next = to + 1
+	}
+	return nil
+}
+
+// Apply applies a single log of the StrategyManager or DelegationManager. Logs must be applied
+// in order; a log at or before the last applied one is ignored. Logs of other events are
+// ignored.
+func (l *Ledger) Apply(log types.Log) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.apply(log)
+}
+
+func (l *Ledger) apply(log types.Log) error {
+	if log.Removed || !l.applied.before(log) || len(log.Topics) == 0 {
+		return nil
+	}
+	if err := l.applyEvent(log); err != nil {
+		return fmt.Errorf("failed to apply log %d of tx %s: %w", log.Index, log.TxHash, err)
+	}
+	l.applied = logPosition{block: log.BlockNumber, index: uint64(log.Index), set: true}
+	return nil
+}
+
+func (l *Ledger) applyEvent(log types.Log) error {
+	switch {
+	case log.Address == l.cfg.StrategyManager:
+		if ev, err := l.manager.ParseDeposit(log); err == nil {
+			l.stakers.add(ev.Staker, ev.Strategy, ev.Shares)
+		}
+		return nil
+	case log.Address != l.cfg.DelegationManager:
+		return nil
+	}
+
+	if ev, err := l.delegate.ParseOperatorSharesIncreased(log); err == nil {
+		l.operators.add(ev.Operator, ev.Strategy, ev.Shares)
+		return nil
+	}
+	if ev, err := l.delegate.ParseOperatorSharesDecreased(log); err == nil {
+		l.operators.add(ev.Operator, ev.Strategy, new(big.Int).Neg(ev.Shares))
+		return nil
+	}
+	if ev, err := l.delegate.ParseWithdrawalQueued(log); err == nil {
+		w := ev.Withdrawal
+		if len(w.Strategies) != len(w.Shares) {
+			return fmt.Errorf("withdrawal %x has %d strategies and %d shares", ev.WithdrawalRoot, len(w.Strategies), len(w.Shares))
+		}
+		for i, strategy := range w.Strategies {
+			if strategy != addresses.BeaconChainETHStrategy {
+				l.stakers.add(w.Staker, strategy, new(big.Int).Neg(w.Shares[i]))
+			}
+		}
+		l.pending[ev.WithdrawalRoot] = &Withdrawal{Root: ev.WithdrawalRoot, IDelegationManagerWithdrawal: w, QueuedAt: log.BlockNumber}
+		return nil
+	}
+	if ev, err := l.delegate.ParseWithdrawalCompleted(log); err == nil {
+		delete(l.pending, ev.WithdrawalRoot)
+	}
+	return nil
+}
+
+// StakerShares returns the shares staker holds in strategy, excluding queued withdrawals.
+func (l *Ledger) StakerShares(staker, strategy common.Address) *big.Int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.stakers.get(staker, strategy)
+}
+
+// OperatorShares returns the shares delegated to operator in strategy.
+func (l *Ledger) OperatorShares(operator, strategy common.Address) *big.Int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.operators.get(operator, strategy)
+}
+
+// Stakers returns a copy of the share balances of every staker.
+func (l *Ledger) Stakers() Balances {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.stakers.copy()
+}
+
+// Operators returns a copy of the delegated share balances of every operator.
+func (l *Ledger) Operators() Balances {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.operators.copy()
+}
+
+// PendingWithdrawals returns the withdrawals queued by staker that have not been completed,
+// ordered by the block they were queued at.
+func (l *Ledger) PendingWithdrawals(staker common.Address) []Withdrawal {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var out []Withdrawal
+	for _, w := range l.pending {
+		if w.Staker == staker {
+			out = append(out, *w)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].QueuedAt != out[j].QueuedAt {
+			return out[i].QueuedAt < out[j].QueuedAt
+		}
+		return out[i].Nonce.Cmp(out[j].Nonce) < 0
+	})
+	return out
+}
+
+func (b Balances) copy() Balances {
+	out := make(Balances, len(b))
+	for account, shares := range b {
+		for strategy, amount := range shares {
+			out.add(account, strategy, amount)
+		}
+	}
+	return out
+}
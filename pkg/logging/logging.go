@@ -0,0 +1,133 @@
+// Package logging defines the Logger through which the client and indexer report what they do,
+// and a backend wrapper that logs every transaction sent through it, the status of its
+// receipt and the decoded revert of calls that fail.
+//
+// *slog.Logger satisfies Logger as is. Other structured loggers are adapted by implementing its
+// four methods, which take a message followed by alternating keys and values:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+//	backend := logging.NewBackend(ethClient, logger, nil)
+//	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+//	c.Logger = logger
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/decoder"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+// Logger is a leveled structured logger. args are alternating keys and values.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+var _ Logger = (*slog.Logger)(nil)
+
+// Nop is a Logger that discards everything.
+var Nop Logger = nop{}
+
+type nop struct{}
+
+func (nop) Debug(string, ...interface{}) {}
+func (nop) Info(string, ...interface{})  {}
+func (nop) Warn(string, ...interface{})  {}
+func (nop) Error(string, ...interface{}) {}
+
+// OrNop returns logger, or Nop if logger is nil.
+func OrNop(logger Logger) Logger {
+	if logger == nil {
+		return Nop
+	}
+	return logger
+}
+
+// Backend is an ethbackend.Backend that logs the transactions sent through it and their receipts,
+// and the decoded revert of failed calls and gas estimations. Other methods are passed on to
+// the wrapped backend.
+type Backend struct {
+	ethbackend.Backend
+	logger  Logger
+	decoder *decoder.Decoder
+}
+
+// NewBackend returns backend logging to logger. Calldata is decoded by d, or, if d is nil, by
+// a Decoder that knows no addresses.
+func NewBackend(backend ethbackend.Backend, logger Logger, d *decoder.Decoder) *Backend {
+	if d == nil {
+		d = decoder.New(nil)
+	}
+	return &Backend{Backend: backend, logger: OrNop(logger), decoder: d}
+}
+
+// callArgs returns the log arguments identifying a call carrying data to to.
+func (b *Backend) callArgs(to *common.Address, data []byte) []interface{} {
+	if to == nil {
+		return []interface{}{"to", "create"}
+	}
+	args := []interface{}{"to", to.Hex()}
+	if call, err := b.decoder.DecodeCalldata(*to, data); err == nil {
+		args = append(args, "contract", call.Contract, "method", call.Name)
+	}
+	return args
+}
+
+// CallContract logs the decoded revert of a failed eth_call at debug level, since reverting
+// calls are often expected.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	out, err := b.Backend.CallContract(ctx, call, blockNumber)
+	if err != nil {
+		b.logger.Debug("call failed", append(b.callArgs(call.To, call.Data), "from", call.From.Hex(), "error", elerrors.Decode(err))...)
+	}
+	return out, err
+}
+
+// EstimateGas logs the decoded revert of a failed gas estimation, which is how a transaction
+// that would revert fails before it is sent.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	gas, err := b.Backend.EstimateGas(ctx, call)
+	if err != nil {
+		b.logger.Warn("gas estimation failed", append(b.callArgs(call.To, call.Data), "from", call.From.Hex(), "error", elerrors.Decode(err))...)
+	}
+	return gas, err
+}
+
+// SendTransaction logs tx once it is sent, or the error that prevented sending it.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := b.Backend.SendTransaction(ctx, tx)
+	args := append(b.callArgs(tx.To(), tx.Data()), "tx", tx.Hash().Hex(), "nonce", tx.Nonce(), "gas", tx.Gas())
+	if err != nil {
+		b.logger.Error("failed to send transaction", append(args, "error", elerrors.Decode(err))...)
+		return err
+	}
+	b.logger.Info("sent transaction", args...)
+	return nil
+}
+
+// TransactionReceipt logs the status of receipts found. Lookups of transactions not mined yet,
+// as when bind.WaitMined polls for them, are not logged.
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := b.Backend.TransactionReceipt(ctx, txHash)
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+	case err != nil:
+		b.logger.Warn("failed to fetch receipt", "tx", txHash.Hex(), "error", err)
+	case receipt.Status != types.ReceiptStatusSuccessful:
+		b.logger.Error("transaction reverted", "tx", txHash.Hex(), "block", receipt.BlockNumber, "gasUsed", receipt.GasUsed)
+	default:
+		b.logger.Info("transaction mined", "tx", txHash.Hex(), "block", receipt.BlockNumber, "gasUsed", receipt.GasUsed)
+	}
+	return receipt, err
+}
@@ -0,0 +1,210 @@
+// Package logpager splits log queries over wide block ranges into pages that stay within the
+// limits RPC providers put on eth_getLogs.
+//
+// The size of the pages adapts to the density of the logs: it grows while pages hold fewer logs
+// than Config.TargetLogs, shrinks when they hold more, and is halved and the page retried when
+// the provider rejects a range as too large or returning too many results.
+//
+// A Pager implements bind.ContractFilterer, so the Filter methods of the bindings page
+// transparently through it and return their usual iterators:
+//
+//	pager := logpager.New(client, logpager.Config{})
+//	filterer, _ := DelegationManager.NewDelegationManagerFilterer(dmAddress, pager)
+//	it, err := filterer.FilterOperatorSharesIncreased(&bind.FilterOpts{Start: deployBlock, Context: ctx})
+package logpager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultInitialPageSize is the default number of blocks of the first page.
+	DefaultInitialPageSize = 2000
+	// DefaultMaxPageSize is the default maximum number of blocks of a page.
+	DefaultMaxPageSize = 100_000
+	// DefaultTargetLogs is the default number of logs pages are sized to hold.
+	DefaultTargetLogs = 5000
+)
+
+// tooLargeMessages are fragments of the errors providers return for queries over too many
+// blocks or returning too many logs.
+var tooLargeMessages = []string{
+	"query returned more than",
+	"block range",
+	"range is too",
+	"too many",
+	"response size",
+	"limited to",
+}
+
+// Backend is the chain access required by Pager.
+type Backend interface {
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures a Pager.
+type Config struct {
+	// InitialPageSize is the number of blocks of the first page. It defaults to
+	// DefaultInitialPageSize.
+	InitialPageSize uint64
+	// MinPageSize is the number of blocks below which pages are not split further; a page of
+	// that size that the provider rejects fails the query. It defaults to one block.
+	MinPageSize uint64
+	// MaxPageSize is the maximum number of blocks of a page. It defaults to
+	// DefaultMaxPageSize.
+	MaxPageSize uint64
+	// TargetLogs is the number of logs pages are sized to hold. It defaults to
+	// DefaultTargetLogs.
+	TargetLogs int
+	// TooLarge reports whether an error of the provider rejects a page as too large. It
+	// defaults to matching the messages of common providers.
+	TooLarge func(error) bool
+}
+
+// Pager pages log queries. The page size it learns is shared by all its queries. It is safe for
+// concurrent use.
+type Pager struct {
+	backend Backend
+	cfg     Config
+
+	mu       sync.Mutex
+	pageSize uint64
+}
+
+var _ bind.ContractFilterer = (*Pager)(nil)
+
+// New returns a Pager querying backend.
+func New(backend Backend, cfg Config) *Pager {
+	if cfg.InitialPageSize == 0 {
+		cfg.InitialPageSize = DefaultInitialPageSize
+	}
+	if cfg.MinPageSize == 0 {
+		cfg.MinPageSize = 1
+	}
+	if cfg.MaxPageSize == 0 {
+		cfg.MaxPageSize = DefaultMaxPageSize
+	}
+	if cfg.TargetLogs == 0 {
+		cfg.TargetLogs = DefaultTargetLogs
+	}
+	if cfg.TooLarge == nil {
+		cfg.TooLarge = TooLarge
+	}
+	return &Pager{backend: backend, cfg: cfg, pageSize: cfg.InitialPageSize}
+}
+
+// TooLarge reports whether err is a common provider's rejection of a query over too many blocks
+// or returning too many logs.
+func TooLarge(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range tooLargeMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterLogs returns the logs matching query, fetched in pages. A query without ToBlock ends at
+// the current head; a query by block hash is not paged.
+func (p *Pager) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	err := p.Each(ctx, query, func(page []types.Log, _, _ uint64) error {
+		logs = append(logs, page...)
+		return nil
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes through the backend.
+func (p *Pager) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return p.backend.SubscribeFilterLogs(ctx, query, ch)
+}
+
+// Each calls fn with the logs of each page of query, from the first to the last, without holding
+// them all in memory. Iteration stops at the first error fn returns.
+func (p *Pager) Each(ctx context.Context, query ethereum.FilterQuery, fn func(logs []types.Log, from, to uint64) error) error {
+	if query.BlockHash != nil {
+		logs, err := p.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+		return fn(logs, 0, 0)
+	}
+
+	var from, last uint64
+	if query.FromBlock != nil {
+		from = query.FromBlock.Uint64()
+	}
+	if query.ToBlock != nil && query.ToBlock.Sign() >= 0 {
+		last = query.ToBlock.Uint64()
+	} else {
+		head, err := p.backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block number: %w", err)
+		}
+		last = head
+	}
+
+	for from <= last {
+		size := p.size()
+		to := min(from+size-1, last)
+		page := query
+		page.FromBlock = new(big.Int).SetUint64(from)
+		page.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := p.backend.FilterLogs(ctx, page)
+		if err != nil {
+			if to > from && size > p.cfg.MinPageSize && p.cfg.TooLarge(err) {
+				p.resize(max(p.cfg.MinPageSize, (to-from+1)/2))
+				continue
+			}
+			return fmt.Errorf("failed to fetch logs of blocks %d-%d: %w", from, to, err)
+		}
+		p.adapt(to-from+1, len(logs))
+		if err := fn(logs, from, to); err != nil {
+			return err
+		}
+		from = to + 1
+	}
+	return nil
+}
+
+func (p *Pager) size() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pageSize
+}
+
+func (p *Pager) resize(size uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pageSize = size
+}
+
+// adapt sizes the next page so that it holds about TargetLogs logs at the density of a page of
+// blocks blocks holding n logs, at most doubling or halving it.
+func (p *Pager) adapt(blocks uint64, n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var size uint64
+	if n == 0 {
+		size = 2 * blocks
+	} else {
+		size = blocks * uint64(p.cfg.TargetLogs) / uint64(n)
+		size = min(max(size, blocks/2), 2*blocks)
+	}
+	// a short last page says little about the density of full ones
+	if blocks < p.pageSize && size < p.pageSize {
+		return
+	}
+	p.pageSize = min(max(size, p.cfg.MinPageSize), p.cfg.MaxPageSize)
+}
@@ -0,0 +1,31 @@
+package merkle
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Leaf salts prepended to leaves by the RewardsCoordinator, so that a leaf of one tree cannot be
+// proven as a leaf of the other.
+const (
+	EarnerLeafSalt uint8 = 0
+	TokenLeafSalt  uint8 = 1
+)
+
+// EarnerLeafHash returns the hash of the EarnerTreeMerkleLeaf of earner with the root of its
+// token tree, as RewardsCoordinator.calculateEarnerLeafHash does.
+func EarnerLeafHash(earner common.Address, earnerTokenRoot common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{EarnerLeafSalt}, earner.Bytes(), earnerTokenRoot.Bytes())
+}
+
+// TokenLeafHash returns the hash of the TokenTreeMerkleLeaf of the cumulative earnings of an
+// earner in token, as RewardsCoordinator.calculateTokenLeafHash does. A nil cumulativeEarnings
+// hashes as zero.
+func TokenLeafHash(token common.Address, cumulativeEarnings *big.Int) common.Hash {
+	if cumulativeEarnings == nil {
+		cumulativeEarnings = new(big.Int)
+	}
+	return crypto.Keccak256Hash([]byte{TokenLeafSalt}, token.Bytes(), common.LeftPadBytes(cumulativeEarnings.Bytes(), 32))
+}
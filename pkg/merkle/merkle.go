@@ -0,0 +1,121 @@
+// Package merkle builds and verifies the keccak256 merkle trees of EigenLayer, as checked by the
+// Merkle library of the contracts, and hashes the leaves of the RewardsCoordinator's distribution
+// trees. It depends on nothing but go-ethereum, so that tools can verify EigenLayer proofs
+// without the bindings:
+//
+//	tree, _ := merkle.NewTree(leaves)
+//	proof, _ := tree.Proof(3)
+//	ok := merkle.VerifyProof(tree.Root(), leaves[3], 3, proof)
+//
+// Proofs are the concatenated sibling hashes from the leaf layer up. The index of a leaf encodes
+// its path: bit i of the index is set if the node at height i is a right child.
+package merkle
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrInvalidProofLength is returned for proofs whose length is not a multiple of 32 bytes.
+var ErrInvalidProofLength = errors.New("proof length is not a multiple of 32")
+
+// Tree is a binary keccak256 merkle tree laid out as Merkle.verifyInclusionKeccak expects:
+// the leaf at index i is a left child if bit 0 of i is unset, and so on up the tree. Leaves are
+// padded with zero hashes up to the next power of two.
+type Tree struct {
+	// layers[0] holds the padded leaves and the last layer holds the root.
+	layers [][]common.Hash
+	// numLeaves is the number of leaves before padding.
+	numLeaves int
+}
+
+// NewTree builds the tree over leaves, which must not be empty.
+func NewTree(leaves []common.Hash) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("cannot build a merkle tree with no leaves")
+	}
+
+	width := 1
+	for width < len(leaves) {
+		width *= 2
+	}
+	layer := make([]common.Hash, width)
+	copy(layer, leaves)
+
+	layers := [][]common.Hash{layer}
+	for len(layer) > 1 {
+		next := make([]common.Hash, len(layer)/2)
+		for i := range next {
+			next[i] = ParentHash(layer[2*i], layer[2*i+1])
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+	return &Tree{layers: layers, numLeaves: len(leaves)}, nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() common.Hash {
+	return t.layers[len(t.layers)-1][0]
+}
+
+// Len returns the number of leaves of the tree, before padding.
+func (t *Tree) Len() int {
+	return t.numLeaves
+}
+
+// Depth returns the number of layers above the leaves, the number of hashes in each proof.
+func (t *Tree) Depth() int {
+	return len(t.layers) - 1
+}
+
+// Proof returns the concatenated sibling hashes proving the leaf at index, from the leaf layer up.
+func (t *Tree) Proof(index int) ([]byte, error) {
+	if index < 0 || index >= t.numLeaves {
+		return nil, fmt.Errorf("leaf index %d out of range [0, %d)", index, t.numLeaves)
+	}
+	proof := make([]byte, 0, 32*(len(t.layers)-1))
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		proof = append(proof, layer[index^1].Bytes()...)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// ParentHash returns the hash of the node whose children are left and right.
+func ParentHash(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash(left.Bytes(), right.Bytes())
+}
+
+// ProcessProof returns the root computed from leaf at index and proof, as
+// Merkle.processInclusionProofKeccak does. Bits of index beyond the depth of the proof are
+// ignored.
+func ProcessProof(leaf common.Hash, index uint64, proof []byte) (common.Hash, error) {
+	if len(proof)%32 != 0 {
+		return common.Hash{}, ErrInvalidProofLength
+	}
+	computed := leaf
+	for i := 0; i < len(proof); i += 32 {
+		sibling := common.BytesToHash(proof[i : i+32])
+		if index%2 == 0 {
+			computed = ParentHash(computed, sibling)
+		} else {
+			computed = ParentHash(sibling, computed)
+		}
+		index /= 2
+	}
+	return computed, nil
+}
+
+// VerifyProof reports whether proof proves leaf at index under root. Like the RewardsCoordinator it
+// rejects an index that does not fit in the proof's depth, so each leaf has exactly one valid index.
+func VerifyProof(root, leaf common.Hash, index uint32, proof []byte) bool {
+	if depth := len(proof) / 32; depth < 32 && uint64(index) >= uint64(1)<<depth {
+		return false
+	}
+	computed, err := ProcessProof(leaf, uint64(index), proof)
+	return err == nil && computed == root
+}
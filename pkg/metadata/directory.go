@@ -0,0 +1,235 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logpager"
+)
+
+// Config configures fetching metadata and a Directory.
+type Config struct {
+	// FromBlock is the first block searched for metadata URIs, typically the deployment block of
+	// the DelegationManager.
+	FromBlock uint64
+	// IPFSGateway is the HTTP gateway ipfs:// URIs are fetched through, DefaultIPFSGateway if
+	// empty.
+	IPFSGateway string
+	// Timeout bounds each fetch, DefaultTimeout if zero.
+	Timeout time.Duration
+	// MaxSize is the maximum size of a metadata document, DefaultMaxSize if zero.
+	MaxSize int64
+	// TTL is how long fetched metadata is cached before Sync fetches it again, DefaultTTL if
+	// zero. Metadata is fetched again as soon as its URI changes.
+	TTL time.Duration
+	// Concurrency is the number of metadata fetched at once, DefaultConcurrency if zero.
+	Concurrency int
+	// HTTPClient sends the requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+func (cfg *Config) setDefaults() {
+	if cfg.IPFSGateway == "" {
+		cfg.IPFSGateway = DefaultIPFSGateway
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxSize == 0 {
+		cfg.MaxSize = DefaultMaxSize
+	}
+	if cfg.TTL == 0 {
+		cfg.TTL = DefaultTTL
+	}
+	if cfg.Concurrency == 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+}
+
+// Backend is the chain access required by a Directory.
+type Backend interface {
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Entry is the metadata of an operator.
+type Entry struct {
+	Operator common.Address
+	// URI is the latest metadata URI of the operator, set at BlockNumber.
+	URI         string
+	BlockNumber uint64
+	// Metadata is the metadata fetched from URI, nil if it could not be fetched or decoded.
+	Metadata *Metadata
+	// Err is the error fetching or validating the metadata, nil if it is valid.
+	Err       error
+	FetchedAt time.Time
+}
+
+// Valid reports whether the metadata of the entry was fetched and is valid.
+func (e *Entry) Valid() bool {
+	return e.Metadata != nil && e.Err == nil
+}
+
+// Directory is the metadata of every operator, kept up to date by Sync. It is safe for
+// concurrent use.
+type Directory struct {
+	backend  Backend
+	filterer *DelegationManager.DelegationManagerFilterer
+	cfg      Config
+
+	syncMu sync.Mutex
+	// next is the next block searched for metadata URIs.
+	next uint64
+
+	mu      sync.RWMutex
+	entries map[common.Address]*Entry
+}
+
+// NewDirectory returns a directory of the operators of the DelegationManager at
+// delegationManager.
+func NewDirectory(backend Backend, delegationManager common.Address, cfg Config) (*Directory, error) {
+	cfg.setDefaults()
+	filterer, err := DelegationManager.NewDelegationManagerFilterer(delegationManager, logpager.New(backend, logpager.Config{}))
+	if err != nil {
+		return nil, err
+	}
+	return &Directory{
+		backend:  backend,
+		filterer: filterer,
+		cfg:      cfg,
+		next:     cfg.FromBlock,
+		entries:  make(map[common.Address]*Entry),
+	}, nil
+}
+
+// Sync reads the metadata URIs set since the last sync and fetches the metadata of operators
+// whose URI changed or whose cached metadata expired.
+func (d *Directory) Sync(ctx context.Context) error {
+	d.syncMu.Lock()
+	defer d.syncMu.Unlock()
+	head, err := d.backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	uris := make(map[common.Address]*Entry)
+	if head >= d.next {
+		it, err := d.filterer.FilterOperatorMetadataURIUpdated(&bind.FilterOpts{Start: d.next, End: &head, Context: ctx}, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch metadata URIs: %w", err)
+		}
+		for it.Next() {
+			uris[it.Event.Operator] = &Entry{Operator: it.Event.Operator, URI: it.Event.MetadataURI, BlockNumber: it.Event.Raw.BlockNumber}
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return fmt.Errorf("failed to fetch metadata URIs: %w", err)
+		}
+	}
+
+	// Fetch the metadata of changed URIs and expired entries.
+	now := time.Now()
+	d.mu.RLock()
+	var stale []*Entry
+	for _, entry := range d.entries {
+		if _, changed := uris[entry.Operator]; !changed && now.Sub(entry.FetchedAt) >= d.cfg.TTL {
+			refreshed := *entry
+			stale = append(stale, &refreshed)
+		}
+	}
+	d.mu.RUnlock()
+	for _, entry := range uris {
+		stale = append(stale, entry)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.cfg.Concurrency)
+	for _, entry := range stale {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(entry *Entry) {
+			defer func() { <-sem; wg.Done() }()
+			entry.Metadata, entry.Err = Fetch(ctx, entry.URI, d.cfg)
+			entry.FetchedAt = time.Now()
+		}(entry)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	for _, entry := range stale {
+		d.entries[entry.Operator] = entry
+	}
+	d.mu.Unlock()
+	d.next = head + 1
+	return nil
+}
+
+// Lookup returns the metadata of operator.
+func (d *Directory) Lookup(operator common.Address) (Entry, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	entry, ok := d.entries[operator]
+	if !ok {
+		return Entry{}, false
+	}
+	return *entry, true
+}
+
+// Operators returns the metadata of every operator that set a metadata URI, operators with
+// valid metadata first, sorted by name, then the others by address.
+func (d *Directory) Operators() []Entry {
+	d.mu.RLock()
+	entries := make([]Entry, 0, len(d.entries))
+	for _, entry := range d.entries {
+		entries = append(entries, *entry)
+	}
+	d.mu.RUnlock()
+	sortEntries(entries)
+	return entries
+}
+
+// Search returns the operators with valid metadata whose name contains query, case-insensitively,
+// sorted by name.
+func (d *Directory) Search(query string) []Entry {
+	query = strings.ToLower(strings.TrimSpace(query))
+	d.mu.RLock()
+	var entries []Entry
+	for _, entry := range d.entries {
+		if entry.Valid() && strings.Contains(strings.ToLower(entry.Metadata.Name), query) {
+			entries = append(entries, *entry)
+		}
+	}
+	d.mu.RUnlock()
+	sortEntries(entries)
+	return entries
+}
+
+func sortEntries(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := &entries[i], &entries[j]
+		if a.Valid() != b.Valid() {
+			return a.Valid()
+		}
+		if a.Valid() {
+			if an, bn := strings.ToLower(a.Metadata.Name), strings.ToLower(b.Metadata.Name); an != bn {
+				return an < bn
+			}
+		}
+		return a.Operator.Hex() < b.Operator.Hex()
+	})
+}
@@ -0,0 +1,79 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Defaults of Config.
+const (
+	DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+	DefaultTimeout     = 10 * time.Second
+	DefaultMaxSize     = 1 << 20
+	DefaultTTL         = time.Hour
+	DefaultConcurrency = 8
+)
+
+// Fetch fetches and validates the metadata at uri: an http(s) URL, or an ipfs:// URI fetched
+// through cfg.IPFSGateway. The metadata is returned along with the validation error, if any.
+func Fetch(ctx context.Context, uri string, cfg Config) (*Metadata, error) {
+	cfg.setDefaults()
+	endpoint, err := resolve(uri, cfg.IPFSGateway)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metadata from %s: %w", uri, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch metadata from %s: %s", uri, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, cfg.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch metadata from %s: %w", uri, err)
+	}
+	if int64(len(body)) > cfg.MaxSize {
+		return nil, fmt.Errorf("%w: metadata at %s exceeds %d bytes", ErrInvalid, uri, cfg.MaxSize)
+	}
+	var m Metadata
+	if err := json.Unmarshal(bytes.TrimPrefix(body, []byte("\xef\xbb\xbf")), &m); err != nil {
+		return nil, fmt.Errorf("%w: metadata at %s is not a JSON object: %v", ErrInvalid, uri, err)
+	}
+	return &m, m.Validate()
+}
+
+// resolve returns the http(s) URL metadata at uri is fetched from.
+func resolve(uri, gateway string) (string, error) {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/")
+		if path == "" {
+			return "", fmt.Errorf("%w: empty IPFS URI %q", ErrInvalid, uri)
+		}
+		return strings.TrimRight(gateway, "/") + "/" + path, nil
+	case strings.HasPrefix(uri, "https://"), strings.HasPrefix(uri, "http://"):
+		if _, err := httpURL(uri); err != nil {
+			return "", fmt.Errorf("%w: metadata URI %v", ErrInvalid, err)
+		}
+		return uri, nil
+	case uri == "":
+		return "", fmt.Errorf("%w: empty metadata URI", ErrInvalid)
+	}
+	return "", fmt.Errorf("%w: metadata URI %q is neither http(s) nor ipfs", ErrInvalid, uri)
+}
@@ -0,0 +1,97 @@
+// Package metadata fetches and validates the metadata of operators, the JSON document their
+// metadata URI points to, for operator directories.
+//
+// A Directory follows the OperatorMetadataURIUpdated events of the DelegationManager, fetches the
+// latest metadata of every operator over HTTP or IPFS, validates it and caches it:
+//
+//	dir, err := metadata.NewDirectory(client.Backend, dmAddress, metadata.Config{FromBlock: deployBlock})
+//	err = dir.Sync(ctx)
+//	entry, ok := dir.Lookup(operator)
+//	fmt.Println(entry.Metadata.Name, entry.Err)
+//
+// Metadata that fails to fetch or validate is kept with its error, so that directories can list
+// the operator and flag its metadata.
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of the metadata fields.
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 500
+)
+
+// ErrInvalid is wrapped by the errors of metadata that does not match the schema.
+var ErrInvalid = errors.New("invalid operator metadata")
+
+// Metadata is the metadata of an operator.
+type Metadata struct {
+	Name        string `json:"name"`
+	Website     string `json:"website"`
+	Description string `json:"description"`
+	// Logo is the URL of a PNG logo.
+	Logo    string `json:"logo"`
+	Twitter string `json:"twitter,omitempty"`
+}
+
+// Validate checks the metadata against the schema: a name and a description within their
+// limits, an http(s) website, a PNG logo and, if set, a Twitter or X profile. It returns all the
+// problems found, each wrapping ErrInvalid.
+func (m *Metadata) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalid}, args...)...))
+	}
+	switch n := utf8.RuneCountInString(strings.TrimSpace(m.Name)); {
+	case n == 0:
+		invalid("name is required")
+	case n > MaxNameLength:
+		invalid("name is longer than %d characters", MaxNameLength)
+	}
+	switch n := utf8.RuneCountInString(strings.TrimSpace(m.Description)); {
+	case n == 0:
+		invalid("description is required")
+	case n > MaxDescriptionLength:
+		invalid("description is longer than %d characters", MaxDescriptionLength)
+	}
+	if _, err := httpURL(m.Website); err != nil {
+		invalid("website: %v", err)
+	}
+	if u, err := httpURL(m.Logo); err != nil {
+		invalid("logo: %v", err)
+	} else if !strings.HasSuffix(strings.ToLower(u.Path), ".png") {
+		invalid("logo must be a PNG image")
+	}
+	if m.Twitter != "" {
+		u, err := httpURL(m.Twitter)
+		switch {
+		case err != nil:
+			invalid("twitter: %v", err)
+		case !isTwitterHost(u.Hostname()) || strings.Trim(u.Path, "/") == "":
+			invalid("twitter must be the URL of a twitter.com or x.com profile")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func httpURL(s string) (*url.URL, error) {
+	if s == "" {
+		return nil, errors.New("is required")
+	}
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%q is not an http(s) URL", s)
+	}
+	return u, nil
+}
+
+func isTwitterHost(host string) bool {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	return host == "twitter.com" || host == "x.com"
+}
@@ -0,0 +1,126 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// PagerDutyEventsURL is the PagerDuty Events API v2 endpoint.
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// Alert is a notification raised by a monitor.
+type Alert struct {
+	// Summary is a one-line description of what happened.
+	Summary string `json:"summary"`
+	// Source identifies what the alert is about, such as a contract name and address.
+	Source string `json:"source"`
+	// Details holds structured context for the alert.
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// Alerter delivers alerts.
+type Alerter interface {
+	Alert(ctx context.Context, alert Alert) error
+}
+
+// Alerters delivers every alert to each of its alerters, returning the first error.
+type Alerters []Alerter
+
+// Alert implements Alerter.
+func (a Alerters) Alert(ctx context.Context, alert Alert) error {
+	var first error
+	for _, alerter := range a {
+		if err := alerter.Alert(ctx, alert); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// WebhookAlerter posts every alert as JSON to URL.
+type WebhookAlerter struct {
+	URL string
+	// Client sends the requests. It defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Alert implements Alerter.
+func (w *WebhookAlerter) Alert(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, w.Client, w.URL, alert)
+}
+
+// SlackAlerter posts every alert to a Slack incoming webhook.
+type SlackAlerter struct {
+	WebhookURL string
+	// Client sends the requests. It defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Alert implements Alerter.
+func (s *SlackAlerter) Alert(ctx context.Context, alert Alert) error {
+	text := fmt.Sprintf("*%s*\n%s", alert.Summary, alert.Source)
+	return postJSON(ctx, s.Client, s.WebhookURL, map[string]string{"text": text})
+}
+
+// PagerDutyAlerter triggers a PagerDuty incident for every alert through the Events API v2.
+type PagerDutyAlerter struct {
+	// RoutingKey is the integration key of the PagerDuty service.
+	RoutingKey string
+	// Severity is the severity of triggered incidents. It defaults to "critical".
+	Severity string
+	// URL is the Events API endpoint. It defaults to PagerDutyEventsURL.
+	URL string
+	// Client sends the requests. It defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Alert implements Alerter.
+func (p *PagerDutyAlerter) Alert(ctx context.Context, alert Alert) error {
+	severity := p.Severity
+	if severity == "" {
+		severity = "critical"
+	}
+	url := p.URL
+	if url == "" {
+		url = PagerDutyEventsURL
+	}
+	return postJSON(ctx, p.Client, url, map[string]interface{}{
+		"routing_key":  p.RoutingKey,
+		"event_action": "trigger",
+		"payload": map[string]interface{}{
+			"summary":        alert.Summary,
+			"source":         alert.Source,
+			"severity":       severity,
+			"custom_details": alert.Details,
+		},
+	})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body interface{}) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send alert: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to send alert: %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
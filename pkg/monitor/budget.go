@@ -0,0 +1,44 @@
+// Package monitor contains helpers for monitoring deployed EigenLayer contracts.
+package monitor
+
+import (
+	"math"
+	"time"
+)
+
+// StrategyPollCalls is the number of eth_calls issued to snapshot a single
+// StrategyBaseTVLLimits instance: totalShares, getTVLLimits, paused and
+// sharesToUnderlyingView.
+const StrategyPollCalls = 4
+
+// RPCBudget describes the RPC load generated by a fleet monitor.
+type RPCBudget struct {
+	// RequestsPerPoll is the number of calls issued on every poll of the fleet.
+	RequestsPerPoll int
+	// RequestsPerSecond is the sustained request rate.
+	RequestsPerSecond float64
+	// RequestsPerDay is the daily request volume, rounded up.
+	RequestsPerDay uint64
+}
+
+// EstimateMonitoringRPCLoad computes the RPC load generated by polling
+// numStrategies strategies every pollInterval, issuing methodsPerPoll calls
+// against each strategy per poll. Pass StrategyPollCalls as methodsPerPoll to
+// size a monitor that snapshots full strategy state.
+//
+// A non-positive argument yields a zero budget.
+func EstimateMonitoringRPCLoad(numStrategies int, pollInterval time.Duration, methodsPerPoll int) RPCBudget {
+	if numStrategies <= 0 || pollInterval <= 0 || methodsPerPoll <= 0 {
+		return RPCBudget{}
+	}
+
+	perPoll := numStrategies * methodsPerPoll
+	perSecond := float64(perPoll) / pollInterval.Seconds()
+	perDay := math.Ceil(float64(perPoll) * float64(24*time.Hour) / float64(pollInterval))
+
+	return RPCBudget{
+		RequestsPerPoll:   perPoll,
+		RequestsPerSecond: perSecond,
+		RequestsPerDay:    uint64(perDay),
+	}
+}
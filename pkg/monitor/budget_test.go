@@ -0,0 +1,79 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateMonitoringRPCLoad(t *testing.T) {
+	tests := []struct {
+		name           string
+		numStrategies  int
+		pollInterval   time.Duration
+		methodsPerPoll int
+		want           RPCBudget
+	}{
+		{
+			name:           "full strategy snapshot",
+			numStrategies:  10,
+			pollInterval:   12 * time.Second,
+			methodsPerPoll: StrategyPollCalls,
+			want:           RPCBudget{RequestsPerPoll: 40, RequestsPerSecond: 40.0 / 12, RequestsPerDay: 288000},
+		},
+		{
+			name:           "single method per strategy",
+			numStrategies:  3,
+			pollInterval:   time.Minute,
+			methodsPerPoll: 1,
+			want:           RPCBudget{RequestsPerPoll: 3, RequestsPerSecond: 0.05, RequestsPerDay: 4320},
+		},
+		{
+			name:           "daily volume rounds up",
+			numStrategies:  1,
+			pollInterval:   7 * time.Hour,
+			methodsPerPoll: StrategyPollCalls,
+			want:           RPCBudget{RequestsPerPoll: 4, RequestsPerSecond: 4.0 / (7 * 3600), RequestsPerDay: 14},
+		},
+		{
+			name:           "sub-second interval",
+			numStrategies:  2,
+			pollInterval:   500 * time.Millisecond,
+			methodsPerPoll: StrategyPollCalls,
+			want:           RPCBudget{RequestsPerPoll: 8, RequestsPerSecond: 16, RequestsPerDay: 1382400},
+		},
+		{
+			name:           "zero interval",
+			numStrategies:  10,
+			pollInterval:   0,
+			methodsPerPoll: StrategyPollCalls,
+		},
+		{
+			name:           "negative interval",
+			numStrategies:  10,
+			pollInterval:   -time.Second,
+			methodsPerPoll: StrategyPollCalls,
+		},
+		{
+			name:           "zero strategies",
+			numStrategies:  0,
+			pollInterval:   12 * time.Second,
+			methodsPerPoll: StrategyPollCalls,
+		},
+		{
+			name:           "zero methods",
+			numStrategies:  10,
+			pollInterval:   12 * time.Second,
+			methodsPerPoll: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EstimateMonitoringRPCLoad(tt.numStrategies, tt.pollInterval, tt.methodsPerPoll)
+			if got != tt.want {
+				t.Errorf("EstimateMonitoringRPCLoad(%d, %v, %d) = %+v, want %+v",
+					tt.numStrategies, tt.pollInterval, tt.methodsPerPoll, got, tt.want)
+			}
+		})
+	}
+}
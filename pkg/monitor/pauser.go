@@ -0,0 +1,266 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Pausable"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/pausing"
+)
+
+// Names of the events watched by PauserMonitor.
+const (
+	EventPaused            = "Paused"
+	EventUnpaused          = "Unpaused"
+	EventPauserRegistrySet = "PauserRegistrySet"
+)
+
+// PauseChange is a change to the pausing of a contract.
+type PauseChange struct {
+	// Contract is the name of the contract's binding in pkg/bindings.
+	Contract string
+	Address  common.Address
+	// Event is EventPaused, EventUnpaused or EventPauserRegistrySet.
+	Event string
+
+	// Account is the pauser or unpauser that changed the paused status.
+	Account common.Address
+	// PreviousStatus and NewStatus are the paused status before and after a Paused or
+	// Unpaused event.
+	PreviousStatus, NewStatus *big.Int
+	// Paused and Unpaused are the flags the event set and cleared.
+	Paused, Unpaused []pausing.Flag
+
+	// PreviousRegistry and NewRegistry are the pauser registries before and after a
+	// PauserRegistrySet event.
+	PreviousRegistry, NewRegistry common.Address
+
+	Log types.Log
+}
+
+// Alert describes the change as an alert.
+func (c *PauseChange) Alert() Alert {
+	details := map[string]interface{}{
+		"contract":    c.Contract,
+		"address":     c.Address.Hex(),
+		"event":       c.Event,
+		"blockNumber": c.Log.BlockNumber,
+		"txHash":      c.Log.TxHash.Hex(),
+	}
+	var summary string
+	switch c.Event {
+	case EventPauserRegistrySet:
+		summary = fmt.Sprintf("%s pauser registry changed from %s to %s", c.Contract, c.PreviousRegistry.Hex(), c.NewRegistry.Hex())
+		details["previousRegistry"] = c.PreviousRegistry.Hex()
+		details["newRegistry"] = c.NewRegistry.Hex()
+	default:
+		var parts []string
+		if len(c.Paused) > 0 {
+			parts = append(parts, "paused "+flagNames(c.Paused))
+		}
+		if len(c.Unpaused) > 0 {
+			parts = append(parts, "unpaused "+flagNames(c.Unpaused))
+		}
+		if len(parts) == 0 {
+			parts = append(parts, "set an unchanged paused status")
+		}
+		summary = fmt.Sprintf("%s %s by %s", c.Contract, strings.Join(parts, " and "), c.Account.Hex())
+		details["account"] = c.Account.Hex()
+		details["previousStatus"] = c.PreviousStatus.String()
+		details["newStatus"] = c.NewStatus.String()
+	}
+	return Alert{
+		Summary: summary,
+		Source:  fmt.Sprintf("%s %s", c.Contract, c.Address.Hex()),
+		Details: details,
+	}
+}
+
+func flagNames(flags []pausing.Flag) string {
+	names := make([]string, len(flags))
+	for i, flag := range flags {
+		names[i] = flag.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// PauserBackend is the chain access required by PauserMonitor.
+type PauserBackend interface {
+	events.Backend
+	bind.ContractCaller
+}
+
+// PauserMonitorConfig configures a PauserMonitor.
+type PauserMonitorConfig struct {
+	// Contracts maps the address of every watched contract to the name of its binding.
+	Contracts map[common.Address]string
+	// FromBlock, Confirmations and PollInterval configure the underlying events.Stream.
+	// Changes are alerted once Confirmations deep.
+	FromBlock     uint64
+	Confirmations uint64
+	PollInterval  time.Duration
+	// OnAlertError, if set, is called with every error returned by the Alerter. Failing to
+	// deliver an alert does not stop the monitor.
+	OnAlertError func(change *PauseChange, err error)
+}
+
+// CorePausableContracts returns the Pausable core contracts deployed on chainID, as resolved
+// by addresses.Default, keyed by address.
+func CorePausableContracts(chainID uint64) (map[common.Address]string, error) {
+	names := []string{
+		addresses.DelegationManager,
+		addresses.StrategyManager,
+		addresses.EigenPodManager,
+		addresses.AVSDirectory,
+		addresses.RewardsCoordinator,
+		addresses.StrategyFactory,
+	}
+	contracts := make(map[common.Address]string, len(names))
+	for _, name := range names {
+		addr, err := addresses.Resolve(chainID, name)
+		if err != nil {
+			return nil, err
+		}
+		contracts[addr] = name
+	}
+	return contracts, nil
+}
+
+// PauserMonitor watches the Paused, Unpaused and PauserRegistrySet events of a set of
+// contracts and raises an alert for each, with the flags that were paused or unpaused.
+type PauserMonitor struct {
+	backend  PauserBackend
+	alerter  Alerter
+	cfg      PauserMonitorConfig
+	filterer *Pausable.PausableFilterer
+	stream   *events.Stream[types.Log]
+
+	// status holds the last known paused status of each contract.
+	status map[common.Address]*big.Int
+}
+
+// NewPauserMonitor returns a PauserMonitor delivering alerts to alerter.
+func NewPauserMonitor(backend PauserBackend, alerter Alerter, cfg PauserMonitorConfig) (*PauserMonitor, error) {
+	if len(cfg.Contracts) == 0 {
+		return nil, errors.New("no contracts to monitor")
+	}
+	parsed, err := Pausable.PausableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := Pausable.NewPausableFilterer(common.Address{}, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	query := ethereum.FilterQuery{
+		Topics: [][]common.Hash{{
+			parsed.Events[EventPaused].ID,
+			parsed.Events[EventUnpaused].ID,
+			parsed.Events[EventPauserRegistrySet].ID,
+		}},
+	}
+	for addr := range cfg.Contracts {
+		query.Addresses = append(query.Addresses, addr)
+	}
+	stream := events.NewStream(backend, query, func(log types.Log) (*types.Log, error) {
+		return &log, nil
+	}, events.Config{
+		FromBlock:     cfg.FromBlock,
+		Confirmations: cfg.Confirmations,
+		PollInterval:  cfg.PollInterval,
+	})
+
+	return &PauserMonitor{
+		backend:  backend,
+		alerter:  alerter,
+		cfg:      cfg,
+		filterer: filterer,
+		stream:   stream,
+		status:   make(map[common.Address]*big.Int),
+	}, nil
+}
+
+// Run polls for changes and alerts on them until ctx is done or polling fails.
+func (m *PauserMonitor) Run(ctx context.Context) error {
+	interval := m.cfg.PollInterval
+	if interval == 0 {
+		interval = events.DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		notifications, err := m.stream.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if n.Kind != events.Finalized {
+				continue
+			}
+			change, err := m.change(ctx, *n.Event)
+			if err != nil {
+				return err
+			}
+			if err := m.alerter.Alert(ctx, change.Alert()); err != nil && m.cfg.OnAlertError != nil {
+				m.cfg.OnAlertError(change, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// change decodes log into a PauseChange, diffing the new paused status against the last known
+// one, or the one on chain at the previous block if none is known.
+func (m *PauserMonitor) change(ctx context.Context, log types.Log) (*PauseChange, error) {
+	change := &PauseChange{Contract: m.cfg.Contracts[log.Address], Address: log.Address, Log: log}
+
+	if ev, err := m.filterer.ParsePauserRegistrySet(log); err == nil {
+		change.Event = EventPauserRegistrySet
+		change.PreviousRegistry, change.NewRegistry = ev.PauserRegistry, ev.NewPauserRegistry
+		return change, nil
+	}
+	if ev, err := m.filterer.ParsePaused(log); err == nil {
+		change.Event, change.Account, change.NewStatus = EventPaused, ev.Account, ev.NewPausedStatus
+	} else if ev, err := m.filterer.ParseUnpaused(log); err == nil {
+		change.Event, change.Account, change.NewStatus = EventUnpaused, ev.Account, ev.NewPausedStatus
+	} else {
+		return nil, fmt.Errorf("failed to parse log %d of tx %s: %w", log.Index, log.TxHash, err)
+	}
+
+	previous, ok := m.status[log.Address]
+	if !ok {
+		caller, err := Pausable.NewPausableCaller(log.Address, m.backend)
+		if err != nil {
+			return nil, err
+		}
+		opts := &bind.CallOpts{Context: ctx}
+		if log.BlockNumber > 0 {
+			opts.BlockNumber = new(big.Int).SetUint64(log.BlockNumber - 1)
+		}
+		if previous, err = caller.Paused0(opts); err != nil {
+			return nil, fmt.Errorf("failed to get paused status of %s: %w", change.Contract, err)
+		}
+	}
+	m.status[log.Address] = change.NewStatus
+
+	change.PreviousStatus = previous
+	change.Paused = pausing.DecodePausedStatus(change.Contract, new(big.Int).AndNot(change.NewStatus, previous))
+	change.Unpaused = pausing.DecodePausedStatus(change.Contract, new(big.Int).AndNot(previous, change.NewStatus))
+	return change, nil
+}
@@ -0,0 +1,253 @@
+// Package multicall batches view calls made through the generated bindings into a single
+// Multicall3 aggregate3 call.
+//
+// A Batch implements bind.ContractCaller, so callers from pkg/bindings are bound to it in
+// place of a real backend. Each call is then registered with Add, and Execute issues them
+// all in one eth_call:
+//
+//	batch := multicall.NewBatch(client)
+//	strategy, _ := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(addr, batch)
+//	totalShares := multicall.Add(batch, strategy.TotalShares)
+//	limits := multicall.Add2(batch, strategy.GetTVLLimits)
+//	shares := multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+//		return strategy.Shares(opts, staker)
+//	})
+//	if err := batch.Execute(&bind.CallOpts{Context: ctx}); err != nil {
+//		...
+//	}
+//	total, err := totalShares.Get()
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// Multicall3Address is the address of the Multicall3 deployment shared by mainnet, Holesky
+// and most other EVM chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var parsedMulticall3ABI = abis.MustParse(multicall3ABI)
+
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// errRecorded is returned to the bindings while calls are being recorded.
+var errRecorded = errors.New("multicall: call recorded")
+
+// Result holds the outcome of a call registered with Add. It is populated by Batch.Execute.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Get returns the value and error of the call.
+func (r *Result[T]) Get() (T, error) {
+	return r.Value, r.Err
+}
+
+type call struct {
+	invoke func(opts *bind.CallOpts) error
+
+	target     common.Address
+	data       []byte
+	success    bool
+	returnData []byte
+	err        error
+}
+
+// Batch collects binding calls to be issued through Multicall3. A Batch is not safe for
+// concurrent use.
+type Batch struct {
+	backend bind.ContractCaller
+	address common.Address
+
+	calls   []*call
+	current *call
+	// replaying is set while results are being unpacked by the bindings.
+	replaying bool
+	block     *big.Int
+}
+
+var _ bind.ContractCaller = (*Batch)(nil)
+
+// NewBatch returns a Batch that executes against the canonical Multicall3 deployment.
+func NewBatch(backend bind.ContractCaller) *Batch {
+	return NewBatchAt(backend, Multicall3Address)
+}
+
+// NewBatchAt returns a Batch that executes against the Multicall3 deployed at address.
+func NewBatchAt(backend bind.ContractCaller, address common.Address) *Batch {
+	return &Batch{backend: backend, address: address}
+}
+
+// Add registers method, a view method of a caller bound to b, and returns the Result that
+// Execute populates. A failed call sets Result.Err to the decoded revert without failing the
+// rest of the batch.
+func Add[T any](b *Batch, method func(opts *bind.CallOpts) (T, error)) *Result[T] {
+	result := new(Result[T])
+	b.calls = append(b.calls, &call{
+		invoke: func(opts *bind.CallOpts) error {
+			result.Value, result.Err = method(opts)
+			return result.Err
+		},
+	})
+	return result
+}
+
+// Result2 holds the outcome of a call registered with Add2.
+type Result2[A, B any] struct {
+	First  A
+	Second B
+	Err    error
+}
+
+// Get returns the values and error of the call.
+func (r *Result2[A, B]) Get() (A, B, error) {
+	return r.First, r.Second, r.Err
+}
+
+// Add2 is Add for methods with two return values, such as GetTVLLimits.
+func Add2[A, B any](b *Batch, method func(opts *bind.CallOpts) (A, B, error)) *Result2[A, B] {
+	result := new(Result2[A, B])
+	b.calls = append(b.calls, &call{
+		invoke: func(opts *bind.CallOpts) error {
+			result.First, result.Second, result.Err = method(opts)
+			return result.Err
+		},
+	})
+	return result
+}
+
+// Len returns the number of calls registered with b.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Execute issues every registered call in a single aggregate3 call made with opts, then
+// populates their Results. Execute may be called again to refresh the results. If the aggregate
+// call itself fails, its error is returned and set on every Result.
+func (b *Batch) Execute(opts *bind.CallOpts) error {
+	if opts == nil {
+		opts = new(bind.CallOpts)
+	}
+	if opts.Pending {
+		return errors.New("multicall: pending calls are not supported")
+	}
+	if opts.BlockHash != (common.Hash{}) {
+		return errors.New("multicall: calls by block hash are not supported")
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	callOpts := &bind.CallOpts{Context: ctx, From: opts.From}
+
+	// Record the calldata of each call by letting the bindings pack it.
+	b.replaying = false
+	b.block = opts.BlockNumber
+	var calls []call3
+	var recorded []*call
+	for _, c := range b.calls {
+		c.target, c.data, c.success, c.returnData, c.err = common.Address{}, nil, false, nil, nil
+		b.current = c
+		// Methods that fail to pack their arguments keep the error as their result.
+		if err := c.invoke(callOpts); !errors.Is(err, errRecorded) {
+			continue
+		}
+		calls = append(calls, call3{Target: c.target, AllowFailure: true, CallData: c.data})
+		recorded = append(recorded, c)
+	}
+	b.current = nil
+	if len(calls) == 0 {
+		return nil
+	}
+
+	results, err := b.aggregate(ctx, opts, calls)
+
+	// Replay each call, handing the bindings its return data to unpack.
+	b.replaying = true
+	defer func() { b.replaying, b.current = false, nil }()
+	for i, c := range recorded {
+		if err != nil {
+			c.err = err
+		} else {
+			c.success, c.returnData = results[i].Success, results[i].ReturnData
+		}
+		b.current = c
+		c.invoke(callOpts)
+	}
+	return err
+}
+
+// aggregate issues calls through aggregate3 and returns one result per call.
+func (b *Batch) aggregate(ctx context.Context, opts *bind.CallOpts, calls []call3) ([]result3, error) {
+	input, err := parsedMulticall3ABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack aggregate3: %w", err)
+	}
+	output, err := b.backend.CallContract(ctx, ethereum.CallMsg{From: opts.From, To: &b.address, Data: input}, opts.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate3 call failed: %w", elerrors.Decode(err))
+	}
+	unpacked, err := parsedMulticall3ABI.Unpack("aggregate3", output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack aggregate3: %w", err)
+	}
+	results := *abi.ConvertType(unpacked[0], new([]result3)).(*[]result3)
+	if len(results) != len(calls) {
+		return nil, fmt.Errorf("aggregate3 returned %d results for %d calls", len(results), len(calls))
+	}
+	return results, nil
+}
+
+// CallContract records the call while Execute records calls, and returns the call's
+// aggregate3 result while Execute replays them. Outside of Execute it forwards to the backend.
+func (b *Batch) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.current == nil {
+		return b.backend.CallContract(ctx, msg, blockNumber)
+	}
+	if !b.replaying {
+		if msg.To == nil {
+			return nil, errors.New("multicall: cannot batch contract creation")
+		}
+		b.current.target, b.current.data = *msg.To, msg.Data
+		return nil, errRecorded
+	}
+	if b.current.err != nil {
+		return nil, b.current.err
+	}
+	if !b.current.success {
+		return nil, elerrors.DecodeRevertData(b.current.returnData)
+	}
+	return b.current.returnData, nil
+}
+
+// CodeAt forwards to the backend at the block being executed. The bindings use it to tell an
+// empty result from a missing contract.
+func (b *Batch) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if b.current != nil {
+		blockNumber = b.block
+	}
+	return b.backend.CodeAt(ctx, contract, blockNumber)
+}
@@ -0,0 +1,547 @@
+// Package params snapshots the governance-settable parameters of the EigenLayer core contracts at
+// a block and diffs two snapshots, to audit what an upgrade or a governance action changed:
+//
+//	deployment, _ := addresses.Default.Deployment(addresses.ChainIDMainnet)
+//	before, _ := params.Take(ctx, client, deployment, list, big.NewInt(20_000_000))
+//	after, _ := params.Take(ctx, client, deployment, list, big.NewInt(20_100_000))
+//	err := params.WriteText(os.Stdout, params.Compare(before, after))
+//
+// A snapshot holds the owners, pauser registries and paused statuses of the core contracts, the
+// implementations and admins of their proxies, the strategy whitelister, the minimum and
+// per-strategy withdrawal delays, the rewards updater, activation delay and default operator split
+// of the RewardsCoordinator and its immutable constraints, and the whitelisting, third-party
+// transfer, TVL limits and implementation of each given strategy. Parameters that cannot be read
+// at a block, such as the getters of a contract added by a later upgrade or the TVL limits of a
+// strategy without them, are left out of its snapshot, so that they show up in a diff as added
+// or removed.
+//
+// This deployment predates the AllocationManager, so no allocation delays are read. The pausers of
+// the PauserRegistry are a mapping and cannot be enumerated, so only its unpauser is read.
+package params
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyFactory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/pausing"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategy"
+)
+
+// Strategy is the Contract of the parameters of strategies, which are keyed by strategy address.
+const Strategy = "Strategy"
+
+// proxied lists the contracts of a deployment deployed behind a TransparentUpgradeableProxy.
+var proxied = []string{
+	addresses.DelegationManager,
+	addresses.StrategyManager,
+	addresses.EigenPodManager,
+	addresses.AVSDirectory,
+	addresses.Slasher,
+	addresses.RewardsCoordinator,
+	addresses.StrategyFactory,
+	addresses.DelayedWithdrawalRouter,
+	addresses.EigenStrategy,
+	addresses.Eigen,
+	addresses.BackingEigen,
+}
+
+// pauseAll is the paused status set by Pausable.pauseAll.
+var pauseAll = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Backend is the chain access required by Take.
+type Backend interface {
+	bind.ContractCaller
+	deploy.StorageReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Param is the value of a single parameter.
+type Param struct {
+	// Contract is the name of the contract in addresses, or Strategy.
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	// Name is the name of the parameter's getter, or of the EIP-1967 slot it is read from.
+	Name string `json:"name"`
+	// Key is the hex address of the strategy of a per-strategy parameter, and empty otherwise.
+	Key string `json:"key,omitempty"`
+	// Value is the parameter formatted for display: addresses in hex, numbers in decimal and
+	// paused statuses with the names of their flags.
+	Value string `json:"value"`
+}
+
+// ID identifies the parameter across snapshots.
+func (p *Param) ID() string {
+	return paramID(p.Contract, p.Name, p.Key)
+}
+
+func paramID(contract, name, key string) string {
+	if key == "" {
+		return contract + "." + name
+	}
+	return contract + "." + name + "[" + key + "]"
+}
+
+// Snapshot is the value of every readable parameter at a block.
+type Snapshot struct {
+	BlockNumber uint64    `json:"blockNumber"`
+	Time        time.Time `json:"time"`
+	Params      []Param   `json:"params"`
+}
+
+// Take snapshots the parameters of the contracts of deployment, a map of contract names to
+// addresses as returned by addresses.Registry.Deployment, and of strategies at blockNumber, or
+// the latest block if nil. Contracts missing from deployment are skipped. The withdrawal delay of
+// the beacon chain ETH strategy is always read.
+func Take(ctx context.Context, backend Backend, deployment map[string]common.Address, strategies []common.Address, blockNumber *big.Int) (*Snapshot, error) {
+	strategies = uniqueStrategies(strategies)
+	header, err := backend.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header: %w", err)
+	}
+	r := &reader{
+		backend: backend,
+		batch:   multicall.NewBatch(backend),
+		opts:    &bind.CallOpts{Context: ctx, BlockNumber: header.Number},
+	}
+	if err := r.core(deployment, strategies); err != nil {
+		return nil, err
+	}
+	for _, s := range strategies {
+		if err := r.strategy(s); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.batch.Execute(r.opts); err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{
+		BlockNumber: header.Number.Uint64(),
+		Time:        time.Unix(int64(header.Time), 0).UTC(),
+	}
+	for _, read := range r.reads {
+		if value, ok := read.value(); ok {
+			read.param.Value = value
+			snap.Params = append(snap.Params, read.param)
+		}
+	}
+
+	slots, err := r.proxies(ctx, deployment, strategies)
+	if err != nil {
+		return nil, err
+	}
+	snap.Params = append(snap.Params, slots...)
+	return snap, nil
+}
+
+// Get returns the parameter with id, as returned by Param.ID.
+func (s *Snapshot) Get(id string) (Param, bool) {
+	for _, p := range s.Params {
+		if p.ID() == id {
+			return p, true
+		}
+	}
+	return Param{}, false
+}
+
+// reader collects the reads of a snapshot into a single multicall batch.
+type reader struct {
+	backend Backend
+	batch   *multicall.Batch
+	opts    *bind.CallOpts
+	reads   []read
+}
+
+// read is a parameter whose value is available once the batch has executed. value reports false
+// if the call failed.
+type read struct {
+	param Param
+	value func() (string, bool)
+}
+
+// add registers the read of a parameter through method, a view of a caller bound to r.batch.
+func add[T any](r *reader, contract string, addr common.Address, name, key string, method func(opts *bind.CallOpts) (T, error), format func(T) string) {
+	result := multicall.Add(r.batch, method)
+	r.reads = append(r.reads, read{
+		param: Param{Contract: contract, Address: addr, Name: name, Key: key},
+		value: func() (string, bool) {
+			value, err := result.Get()
+			if err != nil {
+				return "", false
+			}
+			return format(value), true
+		},
+	})
+}
+
+// pausable is the Pausable interface shared by the bindings of the core contracts.
+type pausable interface {
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// addPausable registers the reads of the pauser registry and paused status of a contract whose
+// flags are named by binding in pkg/pausing.
+func addPausable(r *reader, contract, binding string, addr common.Address, key string, caller pausable) {
+	add(r, contract, addr, "pauserRegistry", key, caller.PauserRegistry, formatAddress)
+	add(r, contract, addr, "paused", key, caller.Paused0, func(status *big.Int) string {
+		return formatPaused(binding, status)
+	})
+}
+
+// core registers the reads of the parameters of the core contracts.
+func (r *reader) core(deployment map[string]common.Address, strategies []common.Address) error {
+	withdrawalDelays := append([]common.Address{addresses.BeaconChainETHStrategy}, strategies...)
+
+	if addr, ok := deployment[addresses.DelegationManager]; ok {
+		dm, err := DelegationManager.NewDelegationManagerCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.DelegationManager
+		add(r, contract, addr, "owner", "", dm.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", dm)
+		add(r, contract, addr, "minWithdrawalDelayBlocks", "", dm.MinWithdrawalDelayBlocks, formatBig)
+		for _, s := range withdrawalDelays {
+			s := s
+			add(r, contract, addr, "strategyWithdrawalDelayBlocks", s.Hex(), func(opts *bind.CallOpts) (*big.Int, error) {
+				return dm.StrategyWithdrawalDelayBlocks(opts, s)
+			}, formatBig)
+		}
+	}
+
+	if addr, ok := deployment[addresses.StrategyManager]; ok {
+		sm, err := StrategyManager.NewStrategyManagerCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.StrategyManager
+		add(r, contract, addr, "owner", "", sm.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", sm)
+		add(r, contract, addr, "strategyWhitelister", "", sm.StrategyWhitelister, formatAddress)
+		for _, s := range strategies {
+			s := s
+			add(r, contract, addr, "strategyIsWhitelistedForDeposit", s.Hex(), func(opts *bind.CallOpts) (bool, error) {
+				return sm.StrategyIsWhitelistedForDeposit(opts, s)
+			}, strconv.FormatBool)
+			add(r, contract, addr, "thirdPartyTransfersForbidden", s.Hex(), func(opts *bind.CallOpts) (bool, error) {
+				return sm.ThirdPartyTransfersForbidden(opts, s)
+			}, strconv.FormatBool)
+		}
+	}
+
+	if addr, ok := deployment[addresses.EigenPodManager]; ok {
+		epm, err := EigenPodManager.NewEigenPodManagerCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.EigenPodManager
+		add(r, contract, addr, "owner", "", epm.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", epm)
+		add(r, contract, addr, "eigenPodBeacon", "", epm.EigenPodBeacon, formatAddress)
+	}
+
+	if addr, ok := deployment[addresses.AVSDirectory]; ok {
+		avsd, err := AVSDirectory.NewAVSDirectoryCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.AVSDirectory
+		add(r, contract, addr, "owner", "", avsd.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", avsd)
+	}
+
+	if addr, ok := deployment[addresses.RewardsCoordinator]; ok {
+		rc, err := RewardsCoordinator.NewRewardsCoordinatorCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.RewardsCoordinator
+		add(r, contract, addr, "owner", "", rc.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", rc)
+		add(r, contract, addr, "rewardsUpdater", "", rc.RewardsUpdater, formatAddress)
+		add(r, contract, addr, "activationDelay", "", rc.ActivationDelay, formatUint[uint32])
+		add(r, contract, addr, "defaultOperatorSplitBips", "", rc.DefaultOperatorSplitBips, formatUint[uint16])
+		add(r, contract, addr, "CALCULATION_INTERVAL_SECONDS", "", rc.CALCULATIONINTERVALSECONDS, formatUint[uint32])
+		add(r, contract, addr, "MAX_REWARDS_DURATION", "", rc.MAXREWARDSDURATION, formatUint[uint32])
+		add(r, contract, addr, "MAX_RETROACTIVE_LENGTH", "", rc.MAXRETROACTIVELENGTH, formatUint[uint32])
+		add(r, contract, addr, "MAX_FUTURE_LENGTH", "", rc.MAXFUTURELENGTH, formatUint[uint32])
+		add(r, contract, addr, "GENESIS_REWARDS_TIMESTAMP", "", rc.GENESISREWARDSTIMESTAMP, formatUint[uint32])
+	}
+
+	if addr, ok := deployment[addresses.StrategyFactory]; ok {
+		sf, err := StrategyFactory.NewStrategyFactoryCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		contract := addresses.StrategyFactory
+		add(r, contract, addr, "owner", "", sf.Owner, formatAddress)
+		addPausable(r, contract, contract, addr, "", sf)
+		add(r, contract, addr, "strategyBeacon", "", sf.StrategyBeacon, formatAddress)
+	}
+
+	if addr, ok := deployment[addresses.PauserRegistry]; ok {
+		pr, err := PauserRegistry.NewPauserRegistryCaller(addr, r.batch)
+		if err != nil {
+			return err
+		}
+		add(r, addresses.PauserRegistry, addr, "unpauser", "", pr.Unpauser, formatAddress)
+	}
+
+	for _, name := range []string{addresses.StrategyBeacon, addresses.EigenPodBeacon} {
+		addr, ok := deployment[name]
+		if !ok {
+			continue
+		}
+		contract := bind.NewBoundContract(addr, abis.UpgradeableBeacon, r.batch, nil, nil)
+		add(r, name, addr, "owner", "", addressGetter(contract, "owner"), formatAddress)
+		add(r, name, addr, "implementation", "", addressGetter(contract, "implementation"), formatAddress)
+	}
+	if addr, ok := deployment[addresses.ProxyAdmin]; ok {
+		contract := bind.NewBoundContract(addr, abis.ProxyAdmin, r.batch, nil, nil)
+		add(r, addresses.ProxyAdmin, addr, "owner", "", addressGetter(contract, "owner"), formatAddress)
+	}
+	return nil
+}
+
+// strategy registers the reads of the parameters of the strategy at addr.
+func (r *reader) strategy(addr common.Address) error {
+	s, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(addr, r.batch)
+	if err != nil {
+		return err
+	}
+	key := addr.Hex()
+	addPausable(r, Strategy, "StrategyBase", addr, key, s)
+	add(r, Strategy, addr, "maxPerDeposit", key, s.MaxPerDeposit, formatBig)
+	add(r, Strategy, addr, "maxTotalDeposits", key, s.MaxTotalDeposits, formatBig)
+	return nil
+}
+
+// proxies reads the EIP-1967 implementation and admin of the proxied contracts of deployment, and
+// the implementation or beacon of each strategy. Empty slots are left out.
+func (r *reader) proxies(ctx context.Context, deployment map[string]common.Address, strategies []common.Address) ([]Param, error) {
+	var params []Param
+	readSlot := func(contract string, addr common.Address, name, key string, slot common.Hash) error {
+		value, err := r.backend.StorageAt(ctx, addr, slot, r.opts.BlockNumber)
+		if err != nil {
+			return fmt.Errorf("failed to read %s of %s: %w", name, addr.Hex(), err)
+		}
+		if target := common.BytesToAddress(value); target != (common.Address{}) {
+			params = append(params, Param{Contract: contract, Address: addr, Name: name, Key: key, Value: target.Hex()})
+		}
+		return nil
+	}
+
+	for _, name := range proxied {
+		addr, ok := deployment[name]
+		if !ok {
+			continue
+		}
+		if err := readSlot(name, addr, "implementation", "", deploy.ImplementationSlot); err != nil {
+			return nil, err
+		}
+		if err := readSlot(name, addr, "admin", "", deploy.AdminSlot); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range strategies {
+		// Strategies deployed by the StrategyFactory are beacon proxies, and the others
+		// transparent proxies.
+		if err := readSlot(Strategy, s, "implementation", s.Hex(), strategy.ImplementationSlot); err != nil {
+			return nil, err
+		}
+		if err := readSlot(Strategy, s, "beacon", s.Hex(), strategy.BeaconSlot); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// uniqueStrategies returns strategies without duplicates and the beacon chain ETH strategy,
+// which is not a contract.
+func uniqueStrategies(strategies []common.Address) []common.Address {
+	seen := make(map[common.Address]bool)
+	var unique []common.Address
+	for _, s := range strategies {
+		if s == addresses.BeaconChainETHStrategy || seen[s] {
+			continue
+		}
+		seen[s] = true
+		unique = append(unique, s)
+	}
+	return unique
+}
+
+// addressGetter returns a view of contract that returns the address returned by method.
+func addressGetter(contract *bind.BoundContract, method string) func(opts *bind.CallOpts) (common.Address, error) {
+	return func(opts *bind.CallOpts) (common.Address, error) {
+		var out []interface{}
+		if err := contract.Call(opts, &out, method); err != nil {
+			return common.Address{}, err
+		}
+		return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+	}
+}
+
+func formatAddress(addr common.Address) string {
+	return addr.Hex()
+}
+
+func formatBig(value *big.Int) string {
+	return value.String()
+}
+
+func formatUint[T uint16 | uint32](value T) string {
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// formatPaused formats a paused status with the names of its flags, as defined by binding.
+func formatPaused(binding string, status *big.Int) string {
+	switch {
+	case status.Sign() == 0:
+		return "0"
+	case status.Cmp(pauseAll) == 0:
+		return "all"
+	}
+	var names []string
+	for _, flag := range pausing.DecodePausedStatus(binding, status) {
+		if flag.Name == "" {
+			names = append(names, "bit"+strconv.Itoa(int(flag.Index)))
+		} else {
+			names = append(names, flag.Name)
+		}
+	}
+	return status.String() + " (" + strings.Join(names, ",") + ")"
+}
+
+// Change is a parameter whose value differs between two snapshots.
+type Change struct {
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Name     string         `json:"name"`
+	Key      string         `json:"key,omitempty"`
+	// Before and After are the values of the parameter in each snapshot, or nil if it could not
+	// be read in that snapshot.
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// ID identifies the parameter, as Param.ID does.
+func (c *Change) ID() string {
+	return paramID(c.Contract, c.Name, c.Key)
+}
+
+// Diff is the set of parameters that changed between two snapshots.
+type Diff struct {
+	BeforeBlock uint64    `json:"beforeBlock"`
+	BeforeTime  time.Time `json:"beforeTime"`
+	AfterBlock  uint64    `json:"afterBlock"`
+	AfterTime   time.Time `json:"afterTime"`
+	// Changes are ordered by contract, name and key.
+	Changes []Change `json:"changes"`
+}
+
+// Compare returns the parameters whose values differ between before and after, including those
+// only present in one of them.
+func Compare(before, after *Snapshot) *Diff {
+	d := &Diff{
+		BeforeBlock: before.BlockNumber,
+		BeforeTime:  before.Time,
+		AfterBlock:  after.BlockNumber,
+		AfterTime:   after.Time,
+		Changes:     []Change{},
+	}
+	changes := make(map[string]*Change)
+	change := func(p *Param) *Change {
+		id := p.ID()
+		c, ok := changes[id]
+		if !ok {
+			c = &Change{Contract: p.Contract, Address: p.Address, Name: p.Name, Key: p.Key}
+			changes[id] = c
+		}
+		return c
+	}
+	for i := range before.Params {
+		value := before.Params[i].Value
+		change(&before.Params[i]).Before = &value
+	}
+	for i := range after.Params {
+		value := after.Params[i].Value
+		change(&after.Params[i]).After = &value
+	}
+	for _, c := range changes {
+		if c.Before != nil && c.After != nil && *c.Before == *c.After {
+			continue
+		}
+		d.Changes = append(d.Changes, *c)
+	}
+	sort.Slice(d.Changes, func(i, j int) bool {
+		a, b := &d.Changes[i], &d.Changes[j]
+		if a.Contract != b.Contract {
+			return a.Contract < b.Contract
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Key < b.Key
+	})
+	return d
+}
+
+// WriteText writes d as a table of the changed parameters, with "-" for values that could not be
+// read.
+func WriteText(w io.Writer, d *Diff) error {
+	if _, err := fmt.Fprintf(w, "block %d (%s) -> block %d (%s): %d changes\n",
+		d.BeforeBlock, d.BeforeTime.Format(time.RFC3339), d.AfterBlock, d.AfterTime.Format(time.RFC3339), len(d.Changes)); err != nil {
+		return err
+	}
+	if len(d.Changes) == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PARAMETER\tBEFORE\tAFTER")
+	for i := range d.Changes {
+		c := &d.Changes[i]
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.ID(), orDash(c.Before), orDash(c.After))
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes d as indented JSON.
+func WriteJSON(w io.Writer, d *Diff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+func orDash(value *string) string {
+	if value == nil {
+		return "-"
+	}
+	return *value
+}
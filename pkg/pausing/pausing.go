@@ -0,0 +1,156 @@
+// Package pausing names the bits of the paused status bitmaps of EigenLayer's Pausable contracts.
+//
+// Each contract pauses functionality by bit index, as defined by its PAUSED_* constants.
+// DecodePausedStatus turns the bitmap returned by paused() into those flags, and
+// ComposePausedStatus builds the bitmap passed to pause and unpause:
+//
+//	status, _ := strategyManager.Paused0(nil)
+//	for _, flag := range pausing.DecodePausedStatus("StrategyManager", status) {
+//		fmt.Println(flag) // StrategyManager.PAUSED_DEPOSITS
+//	}
+//	tx, _ := strategyManager.Pause(opts, pausing.Pause(status, pausing.StrategyManagerDeposits))
+package pausing
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Flag is a single pausable bit of a contract.
+type Flag struct {
+	// Contract is the name of the contract's binding in pkg/bindings.
+	Contract string
+	// Index is the bit index of the flag in the paused status.
+	Index uint8
+	// Name is the name of the contract's PAUSED_* constant for the flag, or empty for a bit the
+	// contract does not define.
+	Name string
+}
+
+func (f Flag) String() string {
+	if f.Name == "" {
+		return fmt.Sprintf("%s.bit%d", f.Contract, f.Index)
+	}
+	return f.Contract + "." + f.Name
+}
+
+// StrategyManager flags.
+var (
+	StrategyManagerDeposits = Flag{"StrategyManager", 0, "PAUSED_DEPOSITS"}
+)
+
+// DelegationManager flags.
+var (
+	DelegationManagerNewDelegation        = Flag{"DelegationManager", 0, "PAUSED_NEW_DELEGATION"}
+	DelegationManagerEnterWithdrawalQueue = Flag{"DelegationManager", 1, "PAUSED_ENTER_WITHDRAWAL_QUEUE"}
+	DelegationManagerExitWithdrawalQueue  = Flag{"DelegationManager", 2, "PAUSED_EXIT_WITHDRAWAL_QUEUE"}
+)
+
+// EigenPodManager flags. EigenPods have no paused status of their own and check these flags on
+// the EigenPodManager. Bits 3 and 4 are deprecated.
+var (
+	EigenPodManagerNewEigenPods                   = Flag{"EigenPodManager", 0, "PAUSED_NEW_EIGENPODS"}
+	EigenPodManagerWithdrawRestakedETH            = Flag{"EigenPodManager", 1, "PAUSED_WITHDRAW_RESTAKED_ETH"}
+	EigenPodManagerEigenPodsVerifyCredentials     = Flag{"EigenPodManager", 2, "PAUSED_EIGENPODS_VERIFY_CREDENTIALS"}
+	EigenPodManagerNonProofWithdrawals            = Flag{"EigenPodManager", 5, "PAUSED_NON_PROOF_WITHDRAWALS"}
+	EigenPodManagerStartCheckpoint                = Flag{"EigenPodManager", 6, "PAUSED_START_CHECKPOINT"}
+	EigenPodManagerEigenPodsVerifyCheckpointProof = Flag{"EigenPodManager", 7, "PAUSED_EIGENPODS_VERIFY_CHECKPOINT_PROOFS"}
+	EigenPodManagerVerifyStaleBalance             = Flag{"EigenPodManager", 8, "PAUSED_VERIFY_STALE_BALANCE"}
+)
+
+// AVSDirectory flags.
+var (
+	AVSDirectoryOperatorRegisterDeregisterToAVS = Flag{"AVSDirectory", 0, "PAUSED_OPERATOR_REGISTER_DEREGISTER_TO_AVS"}
+)
+
+// RewardsCoordinator flags.
+var (
+	RewardsCoordinatorAVSRewardsSubmission                 = Flag{"RewardsCoordinator", 0, "PAUSED_AVS_REWARDS_SUBMISSION"}
+	RewardsCoordinatorRewardsForAllSubmission              = Flag{"RewardsCoordinator", 1, "PAUSED_REWARDS_FOR_ALL_SUBMISSION"}
+	RewardsCoordinatorProcessClaim                         = Flag{"RewardsCoordinator", 2, "PAUSED_PROCESS_CLAIM"}
+	RewardsCoordinatorSubmitDisableRoots                   = Flag{"RewardsCoordinator", 3, "PAUSED_SUBMIT_DISABLE_ROOTS"}
+	RewardsCoordinatorRewardAllStakersAndOperators         = Flag{"RewardsCoordinator", 4, "PAUSED_REWARD_ALL_STAKERS_AND_OPERATORS"}
+	RewardsCoordinatorOperatorDirectedAVSRewardsSubmission = Flag{"RewardsCoordinator", 5, "PAUSED_OPERATOR_DIRECTED_AVS_REWARDS_SUBMISSION"}
+	RewardsCoordinatorOperatorAVSSplit                     = Flag{"RewardsCoordinator", 6, "PAUSED_OPERATOR_AVS_SPLIT"}
+	RewardsCoordinatorOperatorPISplit                      = Flag{"RewardsCoordinator", 7, "PAUSED_OPERATOR_PI_SPLIT"}
+)
+
+// StrategyFactory flags.
+var (
+	StrategyFactoryNewStrategies = Flag{"StrategyFactory", 0, "PAUSED_NEW_STRATEGIES"}
+)
+
+// StrategyBase flags, which StrategyBaseTVLLimits inherits.
+var (
+	StrategyBaseDeposits    = Flag{"StrategyBase", 0, "PAUSED_DEPOSITS"}
+	StrategyBaseWithdrawals = Flag{"StrategyBase", 1, "PAUSED_WITHDRAWALS"}
+)
+
+// flags holds the flags of every contract, by binding name.
+var flags = map[string][]Flag{
+	"StrategyManager":   {StrategyManagerDeposits},
+	"DelegationManager": {DelegationManagerNewDelegation, DelegationManagerEnterWithdrawalQueue, DelegationManagerExitWithdrawalQueue},
+	"EigenPodManager": {
+		EigenPodManagerNewEigenPods, EigenPodManagerWithdrawRestakedETH, EigenPodManagerEigenPodsVerifyCredentials,
+		EigenPodManagerNonProofWithdrawals, EigenPodManagerStartCheckpoint, EigenPodManagerEigenPodsVerifyCheckpointProof,
+		EigenPodManagerVerifyStaleBalance,
+	},
+	"AVSDirectory": {AVSDirectoryOperatorRegisterDeregisterToAVS},
+	"RewardsCoordinator": {
+		RewardsCoordinatorAVSRewardsSubmission, RewardsCoordinatorRewardsForAllSubmission, RewardsCoordinatorProcessClaim,
+		RewardsCoordinatorSubmitDisableRoots, RewardsCoordinatorRewardAllStakersAndOperators,
+		RewardsCoordinatorOperatorDirectedAVSRewardsSubmission, RewardsCoordinatorOperatorAVSSplit, RewardsCoordinatorOperatorPISplit,
+	},
+	"StrategyFactory":       {StrategyFactoryNewStrategies},
+	"StrategyBase":          {StrategyBaseDeposits, StrategyBaseWithdrawals},
+	"StrategyBaseTVLLimits": {StrategyBaseDeposits, StrategyBaseWithdrawals},
+}
+
+// Flags returns the flags defined by contract, ordered by index, or nil if contract is not a
+// known Pausable contract.
+func Flags(contract string) []Flag {
+	return append([]Flag(nil), flags[contract]...)
+}
+
+// DecodePausedStatus returns the flags set in the paused status of contract, ordered by index.
+// Set bits that contract does not define are returned as flags with an empty Name.
+func DecodePausedStatus(contract string, status *big.Int) []Flag {
+	byIndex := make(map[int]Flag)
+	for _, flag := range flags[contract] {
+		byIndex[int(flag.Index)] = flag
+	}
+	var set []Flag
+	for i := 0; i < status.BitLen() && i < 256; i++ {
+		if status.Bit(i) == 0 {
+			continue
+		}
+		flag, ok := byIndex[i]
+		if !ok {
+			flag = Flag{Contract: contract, Index: uint8(i)}
+		}
+		set = append(set, flag)
+	}
+	return set
+}
+
+// ComposePausedStatus returns the paused status with exactly flags set.
+func ComposePausedStatus(flags ...Flag) *big.Int {
+	status := new(big.Int)
+	for _, flag := range flags {
+		status.SetBit(status, int(flag.Index), 1)
+	}
+	return status
+}
+
+// Pause returns the argument to pause that additionally pauses flags. Pausable.pause requires
+// its argument to keep every currently paused flag set, so current must be the contract's
+// current paused status.
+func Pause(current *big.Int, flags ...Flag) *big.Int {
+	return new(big.Int).Or(current, ComposePausedStatus(flags...))
+}
+
+// Unpause returns the argument to unpause that unpauses flags and leaves every other flag of
+// current unchanged.
+func Unpause(current *big.Int, flags ...Flag) *big.Int {
+	return new(big.Int).AndNot(current, ComposePausedStatus(flags...))
+}
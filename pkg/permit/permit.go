@@ -0,0 +1,171 @@
+// Package permit signs and submits EIP-2612 permits for the underlying tokens of strategies, such
+// as EIGEN and wrapped stETH, so that a deposit needs no separate approve transaction.
+//
+// The StrategyManager itself does not accept permits, so DepositWithPermit sends the permit and
+// the deposit back to back with consecutive nonces and waits only for the deposit:
+//
+//	receipt, err := permit.DepositWithPermit(ctx, client, opts, key, strategyManager, strategy, token, amount, nil)
+package permit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/sigutils"
+)
+
+const erc20PermitABI = `[
+{"type":"function","name":"permit","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"outputs":[]},
+{"type":"function","name":"nonces","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"DOMAIN_SEPARATOR","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]}
+]`
+
+var parsedERC20PermitABI = abis.MustParse(erc20PermitABI)
+
+// Backend is the chain access required by DepositWithPermit.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Permit is a signed EIP-2612 permit.
+type Permit struct {
+	Token    common.Address
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+
+	V    uint8
+	R, S [32]byte
+}
+
+// DomainSeparator returns the DOMAIN_SEPARATOR() of token.
+func DomainSeparator(ctx context.Context, caller bind.ContractCaller, token common.Address) (common.Hash, error) {
+	var out []interface{}
+	contract := bind.NewBoundContract(token, parsedERC20PermitABI, caller, nil, nil)
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, "DOMAIN_SEPARATOR"); err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(*abi.ConvertType(out[0], new([32]byte)).(*[32]byte)), nil
+}
+
+// Nonce returns the permit nonce of owner in token.
+func Nonce(ctx context.Context, caller bind.ContractCaller, token, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	contract := bind.NewBoundContract(token, parsedERC20PermitABI, caller, nil, nil)
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, "nonces", owner); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Supported reports whether token exposes the EIP-2612 DOMAIN_SEPARATOR and nonces views. It
+// cannot tell whether permit itself is implemented correctly.
+func Supported(ctx context.Context, caller bind.ContractCaller, token common.Address) bool {
+	if _, err := DomainSeparator(ctx, caller, token); err != nil {
+		return false
+	}
+	_, err := Nonce(ctx, caller, token, common.Address{})
+	return err == nil
+}
+
+// Sign signs a permit from the owner controlling key for spender to transfer value of token,
+// reading the token's domain separator and the owner's current nonce. A nil deadline never
+// expires.
+func Sign(ctx context.Context, caller bind.ContractCaller, key *ecdsa.PrivateKey, token, spender common.Address, value, deadline *big.Int) (*Permit, error) {
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	domainSeparator, err := DomainSeparator(ctx, caller, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch domain separator: %w", err)
+	}
+	nonce, err := Nonce(ctx, caller, token, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch permit nonce: %w", err)
+	}
+	if deadline == nil {
+		deadline = abi.MaxUint256
+	}
+	sig, err := sigutils.SignPermit(key, domainSeparator, spender, value, nonce, deadline)
+	if err != nil {
+		return nil, err
+	}
+	p := &Permit{Token: token, Owner: owner, Spender: spender, Value: value, Nonce: nonce, Deadline: deadline, V: sig[64]}
+	copy(p.R[:], sig[:32])
+	copy(p.S[:], sig[32:64])
+	return p, nil
+}
+
+// Calldata returns the calldata of the token's permit call for p.
+func (p *Permit) Calldata() ([]byte, error) {
+	return parsedERC20PermitABI.Pack("permit", p.Owner, p.Spender, p.Value, p.Deadline, p.V, p.R, p.S)
+}
+
+// Submit sends p to its token. Any account can submit a permit.
+func Submit(opts *bind.TransactOpts, backend bind.ContractBackend, p *Permit) (*types.Transaction, error) {
+	contract := bind.NewBoundContract(p.Token, parsedERC20PermitABI, backend, backend, backend)
+	return contract.Transact(opts, "permit", p.Owner, p.Spender, p.Value, p.Deadline, p.V, p.R, p.S)
+}
+
+// DepositWithPermit deposits amount of token into strategy through the StrategyManager at
+// strategyManager, authorizing the transfer with a permit signed by key, which must control
+// opts.From. The permit and deposit are sent with consecutive nonces without waiting in between,
+// and the deposit's receipt is returned. A nil deadline never expires.
+//
+// Both transactions use opts.GasLimit, which must be set: the deposit cannot be estimated before
+// the permit is mined.
+func DepositWithPermit(ctx context.Context, backend Backend, opts *bind.TransactOpts, key *ecdsa.PrivateKey, strategyManager, strategy, token common.Address, amount, deadline *big.Int) (*types.Receipt, error) {
+	if crypto.PubkeyToAddress(key.PublicKey) != opts.From {
+		return nil, errors.New("permit key does not control opts.From")
+	}
+	if opts.GasLimit == 0 {
+		return nil, errors.New("opts.GasLimit must be set for the deposit")
+	}
+	manager, err := StrategyManager.NewStrategyManagerTransactor(strategyManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	p, err := Sign(ctx, backend, key, token, strategyManager, amount, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	txOpts := *opts
+	txOpts.Context = ctx
+	if txOpts.Nonce == nil {
+		nonce, err := backend.PendingNonceAt(ctx, opts.From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch nonce: %w", err)
+		}
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	}
+	if _, err := Submit(&txOpts, backend, p); err != nil {
+		return nil, fmt.Errorf("failed to submit permit: %w", err)
+	}
+	txOpts.Nonce = new(big.Int).Add(txOpts.Nonce, big.NewInt(1))
+	tx, err := manager.DepositIntoStrategy(&txOpts, strategy, token, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
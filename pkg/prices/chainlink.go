@@ -0,0 +1,62 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+const aggregatorV3ABI = `[
+{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+{"type":"function","name":"latestRoundData","stateMutability":"view","inputs":[],"outputs":[{"name":"roundId","type":"uint80"},{"name":"answer","type":"int256"},{"name":"startedAt","type":"uint256"},{"name":"updatedAt","type":"uint256"},{"name":"answeredInRound","type":"uint80"}]}
+]`
+
+var parsedAggregatorV3ABI = abis.MustParse(aggregatorV3ABI)
+
+// Chainlink reads prices from Chainlink AggregatorV3 price feeds.
+type Chainlink struct {
+	Caller bind.ContractCaller
+	// Feeds maps tokens to the address of their USD price feed, such as ETH / USD for the zero
+	// address.
+	Feeds map[common.Address]common.Address
+}
+
+// Price implements Provider. The price is the answer of the latest round of the token's feed.
+func (c *Chainlink) Price(ctx context.Context, token common.Address) (Price, error) {
+	feed, ok := c.Feeds[token]
+	if !ok {
+		return Price{}, fmt.Errorf("%w for %s: no chainlink feed", ErrNoPrice, token.Hex())
+	}
+	aggregator := bind.NewBoundContract(feed, parsedAggregatorV3ABI, c.Caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+	var decimalsOut, roundOut []interface{}
+	if err := aggregator.Call(opts, &decimalsOut, "decimals"); err != nil {
+		return Price{}, fmt.Errorf("failed to fetch decimals of feed %s: %w", feed.Hex(), err)
+	}
+	if err := aggregator.Call(opts, &roundOut, "latestRoundData"); err != nil {
+		return Price{}, fmt.Errorf("failed to fetch latest round of feed %s: %w", feed.Hex(), err)
+	}
+	decimals := *abi.ConvertType(decimalsOut[0], new(uint8)).(*uint8)
+	answer := *abi.ConvertType(roundOut[1], new(*big.Int)).(**big.Int)
+	updatedAt := *abi.ConvertType(roundOut[3], new(*big.Int)).(**big.Int)
+	if answer.Sign() <= 0 {
+		return Price{}, fmt.Errorf("feed %s answered non-positive price %s", feed.Hex(), answer)
+	}
+	return Price{
+		Token:     token,
+		USD:       new(big.Rat).SetFrac(answer, pow10(decimals)),
+		UpdatedAt: time.Unix(updatedAt.Int64(), 0),
+		Source:    "chainlink",
+	}, nil
+}
+
+func pow10(n uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
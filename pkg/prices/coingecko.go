@@ -0,0 +1,109 @@
+package prices
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Defaults of CoinGecko.
+const (
+	DefaultCoinGeckoURL       = "https://api.coingecko.com/api/v3"
+	DefaultCoinGeckoPlatform  = "ethereum"
+	DefaultCoinGeckoKeyHeader = "x-cg-demo-api-key"
+)
+
+// CoinGecko reads prices from the CoinGecko API. Tokens are looked up by contract address on
+// Platform, and native ether, the zero address, by its coin id.
+type CoinGecko struct {
+	// URL is the base URL of the API, DefaultCoinGeckoURL if empty.
+	URL string
+	// Platform is the asset platform of the tokens, DefaultCoinGeckoPlatform if empty.
+	Platform string
+	// APIKey is sent in the KeyHeader header, DefaultCoinGeckoKeyHeader if empty. Pro API keys
+	// use the x-cg-pro-api-key header and the pro API URL.
+	APIKey    string
+	KeyHeader string
+	// Client sends the requests, http.DefaultClient if nil.
+	Client *http.Client
+}
+
+// Price implements Provider.
+func (c *CoinGecko) Price(ctx context.Context, token common.Address) (Price, error) {
+	base := c.URL
+	if base == "" {
+		base = DefaultCoinGeckoURL
+	}
+	platform := c.Platform
+	if platform == "" {
+		platform = DefaultCoinGeckoPlatform
+	}
+	var (
+		endpoint string
+		id       string
+	)
+	query := url.Values{"vs_currencies": {"usd"}, "include_last_updated_at": {"true"}}
+	if token == (common.Address{}) {
+		id = "ethereum"
+		query.Set("ids", id)
+		endpoint = strings.TrimRight(base, "/") + "/simple/price?" + query.Encode()
+	} else {
+		id = strings.ToLower(token.Hex())
+		query.Set("contract_addresses", id)
+		endpoint = strings.TrimRight(base, "/") + "/simple/token_price/" + url.PathEscape(platform) + "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Price{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.APIKey != "" {
+		header := c.KeyHeader
+		if header == "" {
+			header = DefaultCoinGeckoKeyHeader
+		}
+		req.Header.Set(header, c.APIKey)
+	}
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Price{}, fmt.Errorf("failed to fetch coingecko price of %s: %w", token.Hex(), err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Price{}, fmt.Errorf("failed to read coingecko response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Price{}, fmt.Errorf("coingecko returned %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	var prices map[string]struct {
+		USD           json.Number `json:"usd"`
+		LastUpdatedAt int64       `json:"last_updated_at"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&prices); err != nil {
+		return Price{}, fmt.Errorf("failed to decode coingecko response: %w", err)
+	}
+	entry, ok := prices[id]
+	if !ok || entry.USD == "" {
+		return Price{}, fmt.Errorf("%w for %s on coingecko", ErrNoPrice, token.Hex())
+	}
+	usd, err := parseDecimal(entry.USD.String())
+	if err != nil {
+		return Price{}, err
+	}
+	return Price{Token: token, USD: usd, UpdatedAt: time.Unix(entry.LastUpdatedAt, 0), Source: "coingecko"}, nil
+}
@@ -0,0 +1,158 @@
+// Package prices values token amounts in USD using pluggable price providers.
+//
+// A Provider returns the USD price of a token. Chainlink reads on-chain price feeds, CoinGecko
+// queries the CoinGecko API and Fixed returns configured prices, which is useful for stablecoins
+// and tests. Providers are combined with First and cached with Cached, and a Valuer turns token
+// amounts, snapshots and positions into USD, rejecting prices older than its maximum age:
+//
+//	provider := prices.First(
+//		&prices.Chainlink{Caller: client, Feeds: feeds},
+//		&prices.CoinGecko{},
+//	)
+//	valuer := prices.NewValuer(provider, prices.Config{})
+//	usd, err := valuer.ValueInUSD(ctx, amount)
+//	fmt.Println(prices.FormatUSD(usd)) // 1234.56
+//
+// Native ether, the token of beacon chain ETH shares, is the zero address.
+package prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrNoPrice is returned by providers that have no price for a token.
+	ErrNoPrice = errors.New("no price")
+	// ErrStale is returned by a Valuer for prices older than its maximum age.
+	ErrStale = errors.New("stale price")
+)
+
+// Price is the USD price of one whole token.
+type Price struct {
+	Token common.Address
+	// USD is the price of one whole token in US dollars.
+	USD *big.Rat
+	// UpdatedAt is when the source last updated the price.
+	UpdatedAt time.Time
+	// Source names the provider of the price.
+	Source string
+}
+
+// Provider returns USD prices of tokens.
+type Provider interface {
+	// Price returns the price of token. It returns an error wrapping ErrNoPrice if the provider
+	// has no price for the token.
+	Price(ctx context.Context, token common.Address) (Price, error)
+}
+
+// Fixed is a provider of constant prices, keyed by token and given as decimals such as "1.00".
+type Fixed map[common.Address]string
+
+// Price implements Provider. Fixed prices are always reported as updated now.
+func (f Fixed) Price(_ context.Context, token common.Address) (Price, error) {
+	s, ok := f[token]
+	if !ok {
+		return Price{}, fmt.Errorf("%w for %s in fixed prices", ErrNoPrice, token.Hex())
+	}
+	usd, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Price{}, fmt.Errorf("invalid fixed price %q for %s", s, token.Hex())
+	}
+	return Price{Token: token, USD: usd, UpdatedAt: time.Now(), Source: "fixed"}, nil
+}
+
+// First returns a provider that tries each of providers in turn and returns the first price
+// found. If none has a price, it returns the errors of all of them.
+func First(providers ...Provider) Provider {
+	return first(providers)
+}
+
+type first []Provider
+
+func (f first) Price(ctx context.Context, token common.Address) (Price, error) {
+	errs := make([]error, 0, len(f))
+	for _, provider := range f {
+		price, err := provider.Price(ctx, token)
+		if err == nil {
+			return price, nil
+		}
+		if ctx.Err() != nil {
+			return Price{}, ctx.Err()
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Price{}, fmt.Errorf("%w for %s: no providers", ErrNoPrice, token.Hex())
+	}
+	return Price{}, errors.Join(errs...)
+}
+
+// Cached caches the prices returned by a provider for a time to live. Errors are not cached.
+type Cached struct {
+	provider Provider
+	ttl      time.Duration
+
+	mu     sync.Mutex
+	prices map[common.Address]cachedPrice
+}
+
+type cachedPrice struct {
+	price   Price
+	expires time.Time
+}
+
+// NewCached returns a cache of the prices of provider that keeps each price for ttl.
+func NewCached(provider Provider, ttl time.Duration) *Cached {
+	return &Cached{provider: provider, ttl: ttl, prices: make(map[common.Address]cachedPrice)}
+}
+
+// Price implements Provider.
+func (c *Cached) Price(ctx context.Context, token common.Address) (Price, error) {
+	now := time.Now()
+	c.mu.Lock()
+	cached, ok := c.prices[token]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.price, nil
+	}
+	price, err := c.provider.Price(ctx, token)
+	if err != nil {
+		return Price{}, err
+	}
+	c.mu.Lock()
+	c.prices[token] = cachedPrice{price: price, expires: now.Add(c.ttl)}
+	c.mu.Unlock()
+	return price, nil
+}
+
+// Reset drops all cached prices.
+func (c *Cached) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prices = make(map[common.Address]cachedPrice)
+}
+
+// FormatUSD formats a USD value with two decimals, such as 1234.56.
+func FormatUSD(usd *big.Rat) string {
+	if usd == nil {
+		return "0.00"
+	}
+	return usd.FloatString(2)
+}
+
+// parseDecimal parses a decimal number, as found in JSON price responses, into a rational.
+func parseDecimal(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("invalid price %q", s)
+	}
+	return r, nil
+}
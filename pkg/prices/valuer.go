@@ -0,0 +1,125 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/monitor"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/snapshot"
+)
+
+// Defaults of Config.
+const (
+	DefaultMaxAge   = 2 * time.Hour
+	DefaultCacheTTL = time.Minute
+)
+
+// Config configures a Valuer.
+type Config struct {
+	// MaxAge is the age beyond which prices are rejected as stale, DefaultMaxAge if zero.
+	// Chainlink feeds update at least once per heartbeat, an hour or a day depending on the feed.
+	MaxAge time.Duration
+	// CacheTTL is how long prices are cached, DefaultCacheTTL if zero. Negative disables caching.
+	CacheTTL time.Duration
+}
+
+// Valuer values token amounts in USD.
+type Valuer struct {
+	provider Provider
+	maxAge   time.Duration
+}
+
+// NewValuer returns a valuer of amounts at the prices of provider, cached as configured.
+func NewValuer(provider Provider, cfg Config) *Valuer {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = DefaultMaxAge
+	}
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	if cfg.CacheTTL > 0 {
+		provider = NewCached(provider, cfg.CacheTTL)
+	}
+	return &Valuer{provider: provider, maxAge: cfg.MaxAge}
+}
+
+// Price returns the price of token, failing with ErrStale if it is older than the maximum age.
+func (v *Valuer) Price(ctx context.Context, token common.Address) (Price, error) {
+	price, err := v.provider.Price(ctx, token)
+	if err != nil {
+		return Price{}, err
+	}
+	if age := time.Since(price.UpdatedAt); age > v.maxAge {
+		return Price{}, fmt.Errorf("%w for %s from %s: updated %s ago", ErrStale, token.Hex(), price.Source, age.Truncate(time.Second))
+	}
+	return price, nil
+}
+
+// ValueInUSD returns the value of amount in USD.
+func (v *Valuer) ValueInUSD(ctx context.Context, amount amounts.TokenAmount) (*big.Rat, error) {
+	token := amount.Token()
+	price, err := v.Price(ctx, token.Address)
+	if err != nil {
+		return nil, err
+	}
+	value := new(big.Rat).SetFrac(amount.Units(), pow10(token.Decimals))
+	return value.Mul(value, price.USD), nil
+}
+
+// ValuePositions returns the total value in USD of positions, such as the underlying value of a
+// staker's shares in each strategy.
+func (v *Valuer) ValuePositions(ctx context.Context, positions []amounts.TokenAmount) (*big.Rat, error) {
+	total := new(big.Rat)
+	for _, position := range positions {
+		value, err := v.ValueInUSD(ctx, position)
+		if err != nil {
+			return nil, fmt.Errorf("failed to value %s: %w", position, err)
+		}
+		total.Add(total, value)
+	}
+	return total, nil
+}
+
+// SnapshotValue is the TVL of a snapshot in USD.
+type SnapshotValue struct {
+	// Rows holds the USD value of the underlying tokens of each row of the snapshot, in order.
+	Rows []*big.Rat
+	// Total is the sum of Rows.
+	Total *big.Rat
+}
+
+// ValueSnapshot values the underlying tokens of every strategy of snap in USD.
+func (v *Valuer) ValueSnapshot(ctx context.Context, snap *snapshot.Snapshot) (*SnapshotValue, error) {
+	value := &SnapshotValue{Rows: make([]*big.Rat, len(snap.Rows)), Total: new(big.Rat)}
+	for i := range snap.Rows {
+		row := &snap.Rows[i]
+		token := amounts.Token{Address: row.Token, Symbol: row.Symbol, Decimals: row.Decimals}
+		usd, err := v.ValueInUSD(ctx, token.Amount(row.Underlying))
+		if err != nil {
+			return nil, fmt.Errorf("failed to value strategy %s: %w", row.Strategy.Hex(), err)
+		}
+		value.Rows[i] = usd
+		value.Total.Add(value.Total, usd)
+	}
+	return value, nil
+}
+
+// AnnotateAlert adds the USD value of amount to the details of alert as value_usd, such as the
+// amount slashed or withdrawn. If the amount cannot be valued, the error is recorded as
+// value_usd_error instead so that the alert is still delivered.
+func (v *Valuer) AnnotateAlert(ctx context.Context, alert *monitor.Alert, amount amounts.TokenAmount) {
+	if alert.Details == nil {
+		alert.Details = make(map[string]interface{})
+	}
+	usd, err := v.ValueInUSD(ctx, amount)
+	if err != nil {
+		alert.Details["value_usd_error"] = err.Error()
+		return
+	}
+	alert.Details["value_usd"] = FormatUSD(usd)
+}
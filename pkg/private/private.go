@@ -0,0 +1,243 @@
+// Package private sends sensitive transactions, such as large withdrawals or unpausing a
+// contract, through Flashbots Protect instead of the public mempool, where they could be
+// front-run or sandwiched before inclusion.
+//
+// A Backend wraps the backend the bindings send transactions through. Transactions selected by
+// Config.Private are sent to the Protect RPC, and their status is polled from the Protect status
+// API; a transaction that Protect fails to include, or does not include in time, is sent to the
+// public mempool if Config.Fallback is set. The Protect RPC URL selects its options, such as the
+// MEV-Share hints shared with searchers:
+//
+//	protect, _ := rpc.DialContext(ctx, "https://rpc.flashbots.net?hint=hash")
+//	unpause, _ := private.Methods("StrategyManager", "unpause")
+//	backend := private.NewBackend(ethClient, protect, private.Config{Private: unpause, Fallback: true})
+//	defer backend.Close()
+//	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+const (
+	// DefaultStatusURL is the default URL of the Protect status API, followed by the
+	// transaction hash.
+	DefaultStatusURL = "https://protect.flashbots.net/tx/"
+	// DefaultPollInterval is the default interval at which the status of private transactions
+	// is polled.
+	DefaultPollInterval = 12 * time.Second
+	// DefaultTimeout is the default time after which a private transaction that is still
+	// pending is sent publicly. Protect itself gives up after 25 blocks.
+	DefaultTimeout = 5 * time.Minute
+)
+
+// Status is the status of a transaction sent to Flashbots Protect.
+type Status string
+
+// Statuses reported by the Protect status API.
+const (
+	StatusPending   Status = "PENDING"
+	StatusIncluded  Status = "INCLUDED"
+	StatusFailed    Status = "FAILED"
+	StatusCancelled Status = "CANCELLED"
+	StatusUnknown   Status = "UNKNOWN"
+)
+
+// RPCClient makes JSON-RPC requests to the Protect RPC. *rpc.Client satisfies it.
+type RPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Config configures a Backend.
+type Config struct {
+	// Private reports whether tx is sent privately. It defaults to sending every transaction
+	// privately.
+	Private func(tx *types.Transaction) bool
+	// Fallback sends a private transaction publicly when Protect rejects it, reports it failed
+	// or cancelled, or has not included it after Timeout.
+	Fallback bool
+	// Timeout is the time after which a pending private transaction is sent publicly. It
+	// defaults to DefaultTimeout.
+	Timeout time.Duration
+	// PollInterval is the interval at which the status of private transactions is polled. It
+	// defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// StatusURL is the URL of the Protect status API. It defaults to DefaultStatusURL.
+	StatusURL string
+	// HTTPClient queries the status API. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Logger reports private submissions and fallbacks. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Backend is an ethbackend.Backend that sends selected transactions through Flashbots Protect.
+type Backend struct {
+	ethbackend.Backend
+	protect RPCClient
+	cfg     Config
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend with the transactions selected by cfg sent through protect. Close
+// stops watching the private transactions in flight.
+func NewBackend(backend ethbackend.Backend, protect RPCClient, cfg Config) *Backend {
+	if cfg.Private == nil {
+		cfg.Private = func(*types.Transaction) bool { return true }
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.StatusURL == "" {
+		cfg.StatusURL = DefaultStatusURL
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Backend{Backend: backend, protect: protect, cfg: cfg, ctx: ctx, cancel: cancel}
+}
+
+// Methods returns a Config.Private selecting the transactions calling methods of the contract
+// whose binding in pkg/bindings is named contract, such as "StrategyManager" and "unpause".
+func Methods(contract string, methods ...string) (func(*types.Transaction) bool, error) {
+	parsed, err := abis.ABI(contract)
+	if err != nil {
+		return nil, err
+	}
+	selectors := make(map[[4]byte]bool, len(methods))
+	for _, name := range methods {
+		method, ok := parsed.Methods[name]
+		if !ok {
+			return nil, fmt.Errorf("no method %s in %s", name, contract)
+		}
+		selectors[[4]byte(method.ID)] = true
+	}
+	return func(tx *types.Transaction) bool {
+		return len(tx.Data()) >= 4 && selectors[[4]byte(tx.Data()[:4])]
+	}, nil
+}
+
+// SendTransaction sends tx through Protect if it is selected, and publicly otherwise. A private
+// transaction is watched in the background until it is included, or sent publicly on failure
+// if Fallback is set.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if !b.cfg.Private(tx) {
+		return b.Backend.SendTransaction(ctx, tx)
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	var hash common.Hash
+	if err := b.protect.CallContext(ctx, &hash, "eth_sendRawTransaction", hexutil.Bytes(raw)); err != nil {
+		if !b.cfg.Fallback {
+			return fmt.Errorf("failed to send private transaction: %w", err)
+		}
+		b.cfg.Logger.Warn("private transaction rejected, sending publicly", "tx", tx.Hash().Hex(), "err", err)
+		return b.Backend.SendTransaction(ctx, tx)
+	}
+	b.cfg.Logger.Info("sent private transaction", "tx", tx.Hash().Hex())
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.watch(tx)
+	}()
+	return nil
+}
+
+// watch polls the status of the private transaction tx until it is included, fails or times
+// out, and sends it publicly in the latter cases if Fallback is set.
+func (b *Backend) watch(tx *types.Transaction) {
+	ctx, cancel := context.WithTimeout(b.ctx, b.cfg.Timeout)
+	defer cancel()
+	ticker := time.NewTicker(b.cfg.PollInterval)
+	defer ticker.Stop()
+
+	status := StatusPending
+poll:
+	for {
+		select {
+		case <-ctx.Done():
+			break poll
+		case <-ticker.C:
+		}
+		current, err := b.Status(ctx, tx.Hash())
+		if err != nil {
+			b.cfg.Logger.Debug("failed to fetch private transaction status", "tx", tx.Hash().Hex(), "err", err)
+			continue
+		}
+		status = current
+		switch status {
+		case StatusIncluded:
+			return
+		case StatusFailed, StatusCancelled:
+			break poll
+		}
+	}
+	// Close cancels watching without falling back
+	if b.ctx.Err() != nil || !b.cfg.Fallback {
+		b.cfg.Logger.Warn("private transaction not included", "tx", tx.Hash().Hex(), "status", string(status))
+		return
+	}
+	b.cfg.Logger.Warn("private transaction not included, sending publicly", "tx", tx.Hash().Hex(), "status", string(status))
+	sendCtx, cancel := context.WithTimeout(b.ctx, b.cfg.PollInterval)
+	defer cancel()
+	if err := b.Backend.SendTransaction(sendCtx, tx); err != nil && !strings.Contains(err.Error(), "already known") {
+		b.cfg.Logger.Error("failed to send transaction publicly", "tx", tx.Hash().Hex(), "err", err)
+	}
+}
+
+// Status returns the status of the private transaction with hash, as reported by the Protect
+// status API.
+func (b *Backend) Status(ctx context.Context, hash common.Hash) (Status, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.cfg.StatusURL+hash.Hex(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := b.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch status of %s: %w", hash, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch status of %s: %s", hash, resp.Status)
+	}
+	var body struct {
+		Status Status `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("invalid status of %s: %w", hash, err)
+	}
+	return body.Status, nil
+}
+
+// Close stops watching the private transactions in flight, without sending them publicly, and
+// waits for the watchers to return.
+func (b *Backend) Close() {
+	b.cancel()
+	b.wg.Wait()
+}
@@ -0,0 +1,230 @@
+// Package ratelimit wraps a chain backend so that the requests made through it stay within the
+// rate and concurrency limits of RPC providers, which ban clients that exceed them.
+//
+// Requests are grouped into classes, each with its own token bucket and maximum number of
+// requests in flight. Requests over a limit wait for it, or fail when their context is done:
+//
+//	backend := ratelimit.NewBackend(ethClient, ratelimit.Config{
+//		Calls: ratelimit.Limit{Rate: 25, MaxInFlight: 8},
+//		Logs:  ratelimit.Limit{Rate: 2, MaxInFlight: 1},
+//	})
+//	ix, err := indexer.New(backend, store, cfg)
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+// Limit configures the Limiter of a class of requests. The zero Limit does not limit.
+type Limit struct {
+	// Rate is the number of requests per second. Zero does not limit the rate.
+	Rate float64
+	// Burst is the number of requests that can be made at once after a quiet period. It
+	// defaults to Rate, rounded up.
+	Burst int
+	// MaxInFlight is the number of requests awaiting a response at once. Zero does not limit
+	// concurrency.
+	MaxInFlight int
+}
+
+// Limiter limits the rate of requests with a token bucket, and their concurrency with a
+// semaphore. It is safe for concurrent use.
+type Limiter struct {
+	rate  float64
+	burst float64
+	sem   chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter enforcing limit. Its bucket starts full.
+func NewLimiter(limit Limit) *Limiter {
+	l := &Limiter{rate: limit.Rate}
+	if limit.Rate > 0 {
+		l.burst = float64(limit.Burst)
+		if limit.Burst <= 0 {
+			l.burst = math.Ceil(limit.Rate)
+		}
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+	if limit.MaxInFlight > 0 {
+		l.sem = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// Acquire waits until a request may be made, and returns the function to call once its
+// response is received.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	if err := l.wait(ctx); err != nil {
+		return nil, err
+	}
+	if l.sem == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return func() { <-l.sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// wait takes a token from the bucket, waiting for one to be added if it is empty.
+func (l *Limiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// Config configures the limits of each class of requests of a Backend.
+type Config struct {
+	// Calls limits eth_call, eth_estimateGas and eth_getCode.
+	Calls Limit
+	// Logs limits eth_getLogs and log subscriptions.
+	Logs Limit
+	// Sends limits eth_sendRawTransaction.
+	Sends Limit
+	// Other limits every other request, such as block numbers, receipts, nonces and gas
+	// prices.
+	Other Limit
+}
+
+// Backend is an ethbackend.Backend whose requests are limited per class.
+type Backend struct {
+	backend ethbackend.Backend
+	calls   *Limiter
+	logs    *Limiter
+	sends   *Limiter
+	other   *Limiter
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend limited by cfg.
+func NewBackend(backend ethbackend.Backend, cfg Config) *Backend {
+	return &Backend{
+		backend: backend,
+		calls:   NewLimiter(cfg.Calls),
+		logs:    NewLimiter(cfg.Logs),
+		sends:   NewLimiter(cfg.Sends),
+		other:   NewLimiter(cfg.Other),
+	}
+}
+
+// limited calls f once l allows it.
+func limited[T any](ctx context.Context, l *Limiter, f func() (T, error)) (T, error) {
+	release, err := l.Acquire(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer release()
+	return f()
+}
+
+// CodeAt is limited as a call.
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return limited(ctx, b.calls, func() ([]byte, error) { return b.backend.CodeAt(ctx, contract, blockNumber) })
+}
+
+// CallContract is limited as a call.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return limited(ctx, b.calls, func() ([]byte, error) { return b.backend.CallContract(ctx, call, blockNumber) })
+}
+
+// EstimateGas is limited as a call.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return limited(ctx, b.calls, func() (uint64, error) { return b.backend.EstimateGas(ctx, call) })
+}
+
+// PendingCodeAt is limited as a call.
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return limited(ctx, b.calls, func() ([]byte, error) { return b.backend.PendingCodeAt(ctx, account) })
+}
+
+// FilterLogs is limited as a log request.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return limited(ctx, b.logs, func() ([]types.Log, error) { return b.backend.FilterLogs(ctx, query) })
+}
+
+// SubscribeFilterLogs is limited as a log request. The subscription does not count as in
+// flight once it is established.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return limited(ctx, b.logs, func() (ethereum.Subscription, error) { return b.backend.SubscribeFilterLogs(ctx, query, ch) })
+}
+
+// SendTransaction is limited as a send.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := limited(ctx, b.sends, func() (struct{}, error) { return struct{}{}, b.backend.SendTransaction(ctx, tx) })
+	return err
+}
+
+// SuggestGasPrice is limited as another request.
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return limited(ctx, b.other, func() (*big.Int, error) { return b.backend.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap is limited as another request.
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return limited(ctx, b.other, func() (*big.Int, error) { return b.backend.SuggestGasTipCap(ctx) })
+}
+
+// HeaderByNumber is limited as another request.
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return limited(ctx, b.other, func() (*types.Header, error) { return b.backend.HeaderByNumber(ctx, number) })
+}
+
+// PendingNonceAt is limited as another request.
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return limited(ctx, b.other, func() (uint64, error) { return b.backend.PendingNonceAt(ctx, account) })
+}
+
+// TransactionReceipt is limited as another request.
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return limited(ctx, b.other, func() (*types.Receipt, error) { return b.backend.TransactionReceipt(ctx, txHash) })
+}
+
+// ChainID is limited as another request.
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return limited(ctx, b.other, func() (*big.Int, error) { return b.backend.ChainID(ctx) })
+}
+
+// BlockNumber is limited as another request.
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return limited(ctx, b.other, func() (uint64, error) { return b.backend.BlockNumber(ctx) })
+}
@@ -0,0 +1,165 @@
+// Package receipts waits for transactions to be mined and extracts the events they emitted,
+// parsed by the bindings.
+//
+// Unlike bind.WaitMined, Wait fails on reverted transactions, gives up after a timeout, and can
+// wait for a number of confirmations, checking that the transaction was not reorged out
+// meanwhile. WaitEvent and WaitEvents also return the events of a contract in the receipt,
+// parsed with the Parse method of any event in the bindings:
+//
+//	tx, _ := strategyManager.DepositIntoStrategy(opts, strategy, token, amount)
+//	deposit, _, err := receipts.WaitEvent(ctx, client, tx, smAddress, strategyManager.ParseDeposit, receipts.Options{Confirmations: 2})
+//	fmt.Println(deposit.Shares)
+//
+//	tx, _ = delegationManager.QueueWithdrawals(opts, params)
+//	queued, _, err := receipts.WaitEvents(ctx, client, tx, dmAddress, delegationManager.ParseWithdrawalQueued, receipts.Options{})
+//	root := queued[0].WithdrawalRoot
+package receipts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultPollInterval is the default interval at which Wait polls for the receipt and new
+// blocks.
+const DefaultPollInterval = time.Second
+
+var (
+	// ErrReverted is returned, along with the receipt, for transactions mined with a failed
+	// status.
+	ErrReverted = errors.New("transaction reverted")
+	// ErrNoEvent is returned by WaitEvent and WaitEvents when the receipt holds no event of the
+	// contract that the parser accepts.
+	ErrNoEvent = errors.New("no matching event in receipt")
+)
+
+// Backend is the chain access required to wait for receipts.
+type Backend interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Options configures waiting for a receipt.
+type Options struct {
+	// Timeout bounds the wait, in addition to the context. Zero waits as long as the context.
+	Timeout time.Duration
+	// Confirmations is the number of blocks mined on top of the transaction's block before it is
+	// returned. Zero returns it as soon as it is mined.
+	Confirmations uint64
+	// PollInterval is the interval at which the receipt and the head are polled. It defaults
+	// to DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Wait waits for tx to be mined with opts.Confirmations confirmations and returns its receipt.
+// A transaction whose block is reorged out while it is being confirmed is waited for again.
+// It fails with ErrReverted, along with the receipt, if tx reverted.
+func Wait(ctx context.Context, backend Backend, tx *types.Transaction, opts Options) (*types.Receipt, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	if opts.PollInterval == 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := confirmed(ctx, backend, tx.Hash(), opts.Confirmations)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return receipt, fmt.Errorf("%w: %s", ErrReverted, tx.Hash())
+			}
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for %s: %w", tx.Hash(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// confirmed returns the receipt of the transaction with hash if it is mined with confirmations
+// confirmations, and nil otherwise.
+func confirmed(ctx context.Context, backend Backend, hash common.Hash, confirmations uint64) (*types.Receipt, error) {
+	receipt, err := backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipt of %s: %w", hash, err)
+	}
+	if confirmations == 0 {
+		return receipt, nil
+	}
+	head, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	if head < receipt.BlockNumber.Uint64()+confirmations {
+		return nil, nil
+	}
+	// the receipt must still be in the canonical chain once confirmed
+	again, err := backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipt of %s: %w", hash, err)
+	}
+	if again.BlockHash != receipt.BlockHash {
+		return nil, nil
+	}
+	return again, nil
+}
+
+// WaitEvents waits for tx as Wait does, and returns the events emitted by contract in it that
+// parse accepts, in log order.
+func WaitEvents[T any](ctx context.Context, backend Backend, tx *types.Transaction, contract common.Address, parse func(types.Log) (*T, error), opts Options) ([]*T, *types.Receipt, error) {
+	receipt, err := Wait(ctx, backend, tx, opts)
+	if err != nil {
+		return nil, receipt, err
+	}
+	events := Events(receipt, contract, parse)
+	if len(events) == 0 {
+		return nil, receipt, fmt.Errorf("%w %s", ErrNoEvent, tx.Hash())
+	}
+	return events, receipt, nil
+}
+
+// WaitEvent waits for tx as Wait does, and returns the first event emitted by contract in it
+// that parse accepts.
+func WaitEvent[T any](ctx context.Context, backend Backend, tx *types.Transaction, contract common.Address, parse func(types.Log) (*T, error), opts Options) (*T, *types.Receipt, error) {
+	events, receipt, err := WaitEvents(ctx, backend, tx, contract, parse, opts)
+	if err != nil {
+		return nil, receipt, err
+	}
+	return events[0], receipt, nil
+}
+
+// Events returns the events emitted by contract in receipt that parse accepts. The Parse
+// methods of the bindings reject logs of other events.
+func Events[T any](receipt *types.Receipt, contract common.Address, parse func(types.Log) (*T, error)) []*T {
+	var events []*T
+	for _, log := range receipt.Logs {
+		if log.Address != contract {
+			continue
+		}
+		if event, err := parse(*log); err == nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -0,0 +1,354 @@
+// Package restapi serves the most common EigenLayer reads as a REST/JSON API, with webhook
+// subscriptions to indexed events, as a self-hosted lightweight EigenLayer API.
+//
+// The reads are those of the gRPC API of pkg/grpcapi, returned as JSON:
+//
+//	GET    /v1/strategies                     whitelisted strategies
+//	GET    /v1/tvl?strategy=0x…               TVL of the strategies, or of all of them
+//	GET    /v1/stakers/{staker}               state of a staker
+//	GET    /v1/stakers/{staker}/shares        deposited shares of a staker
+//	GET    /v1/stakers/{staker}/withdrawals   pending withdrawals of a staker
+//	GET    /v1/stakers/{staker}/rewards       claimable rewards of a staker
+//	GET    /v1/operators/{operator}?avs=0x…   state of an operator
+//	GET    /v1/withdrawals/{root}             whether a withdrawal is pending
+//	GET    /v1/webhooks                       webhook subscriptions
+//	POST   /v1/webhooks                       subscribe a webhook
+//	DELETE /v1/webhooks/{id}                  unsubscribe a webhook
+//
+// Reads take an optional block parameter. Amounts are decimal strings in base units. Errors are
+// returned as {"error": message}.
+//
+// Webhooks receive the events of an indexer.Store as they are indexed, and are delivered by Run:
+//
+//	server := restapi.NewServer(c, restapi.Config{Strategies: registry, Withdrawals: ledger, Events: store})
+//	go server.Run(ctx)
+//	err := http.ListenAndServe(":8080", server.Handler())
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/grpcapi"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+// maxRequestBytes bounds the size of a request body.
+const maxRequestBytes = 16 << 10
+
+// Config configures a Server.
+type Config struct {
+	// Strategies lists the whitelisted strategies. The strategies and TVL endpoints require it.
+	Strategies grpcapi.StrategyLister
+	// Withdrawals, if set, provides the queued withdrawals of stakers. The withdrawals endpoint
+	// requires it.
+	Withdrawals grpcapi.WithdrawalSource
+	// Earnings, if set, provides the current rewards earnings. The rewards endpoint requires it.
+	Earnings grpcapi.EarningsSource
+	// Events, if set, is the store of indexed events delivered to webhooks. Webhooks cannot be
+	// subscribed without it.
+	Events indexer.Store
+	// PollInterval is how often Run checks the store for new events. It defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+	// HTTPClient delivers webhooks. It defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+	// Logger receives failed requests and webhook deliveries. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Server serves the REST API.
+type Server struct {
+	client   *client.EigenLayerClient
+	state    *grpcapi.Server
+	cfg      Config
+	webhooks *webhooks
+}
+
+// NewServer returns a Server reading state through c.
+func NewServer(c *client.EigenLayerClient, cfg Config) *Server {
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	return &Server{
+		client: c,
+		state: grpcapi.NewServer(c, grpcapi.Config{
+			Strategies:  cfg.Strategies,
+			Withdrawals: cfg.Withdrawals,
+			Earnings:    cfg.Earnings,
+			Logger:      cfg.Logger,
+		}),
+		cfg:      cfg,
+		webhooks: newWebhooks(),
+	}
+}
+
+// errNotFound is returned for unknown routes and resources.
+var errNotFound = errors.New("not found")
+
+// Handler returns the HTTP handler of the API.
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, err := s.route(w, r)
+		if err != nil {
+			status := statusOf(err)
+			if status >= http.StatusInternalServerError {
+				s.cfg.Logger.Warn("request failed", "method", r.Method, "path", r.URL.Path, "err", err)
+			}
+			writeError(w, status, err)
+			return
+		}
+		if v == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		status := http.StatusOK
+		if r.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+		writeJSON(w, status, v)
+	})
+}
+
+// route serves r and returns the response body, or nil for no content.
+func (s *Server) route(w http.ResponseWriter, r *http.Request) (interface{}, error) {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(path) < 2 || path[0] != "v1" {
+		return nil, errNotFound
+	}
+	ctx, query := r.Context(), r.URL.Query()
+	if path[1] == "webhooks" {
+		return s.routeWebhooks(w, r, path[2:])
+	}
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		return nil, errMethod
+	}
+	block, err := blockOf(query)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(path) == 2 && path[1] == "strategies":
+		return s.strategies(ctx)
+	case len(path) == 2 && path[1] == "tvl":
+		return s.state.GetStrategyTVL(ctx, &grpcapi.GetStrategyTVLRequest{Strategies: query["strategy"], BlockNumber: block})
+	case len(path) == 3 && path[1] == "operators":
+		return s.state.GetOperatorState(ctx, &grpcapi.GetOperatorStateRequest{
+			Operator:    path[2],
+			Strategies:  query["strategy"],
+			AVSs:        query["avs"],
+			BlockNumber: block,
+		})
+	case len(path) == 3 && path[1] == "withdrawals":
+		return s.withdrawal(ctx, path[2], block)
+	case (len(path) == 3 || len(path) == 4) && path[1] == "stakers":
+		state, err := s.state.GetStakerState(ctx, &grpcapi.GetStakerStateRequest{Staker: path[2], BlockNumber: block})
+		if err != nil || len(path) == 3 {
+			return state, err
+		}
+		return s.stakerPart(state, path[3])
+	}
+	return nil, errNotFound
+}
+
+// Shares are the deposits of a staker.
+type Shares struct {
+	Staker      string                    `json:"staker"`
+	BlockNumber uint64                    `json:"blockNumber"`
+	Deposits    []*grpcapi.StrategyShares `json:"deposits"`
+	// PodOwnerShares are the staker's beacon chain ETH shares.
+	PodOwnerShares string `json:"podOwnerShares"`
+}
+
+// Withdrawals are the pending withdrawals of a staker.
+type Withdrawals struct {
+	Staker      string `json:"staker"`
+	BlockNumber uint64 `json:"blockNumber"`
+	// Withdrawals are completable from their unlock block.
+	Withdrawals []*grpcapi.PendingWithdrawal `json:"withdrawals"`
+}
+
+// Rewards are the rewards a staker can claim.
+type Rewards struct {
+	Staker      string                 `json:"staker"`
+	BlockNumber uint64                 `json:"blockNumber"`
+	Claimer     string                 `json:"claimer"`
+	Rewards     []*grpcapi.RewardClaim `json:"rewards"`
+}
+
+func (s *Server) stakerPart(state *grpcapi.StakerState, part string) (interface{}, error) {
+	switch part {
+	case "shares":
+		return &Shares{
+			Staker:         state.Staker,
+			BlockNumber:    state.BlockNumber,
+			Deposits:       state.Deposits,
+			PodOwnerShares: state.PodOwnerShares,
+		}, nil
+	case "withdrawals":
+		if s.cfg.Withdrawals == nil {
+			return nil, errNotConfigured("queued withdrawals are not tracked")
+		}
+		return &Withdrawals{Staker: state.Staker, BlockNumber: state.BlockNumber, Withdrawals: state.Withdrawals}, nil
+	case "rewards":
+		if s.cfg.Earnings == nil {
+			return nil, errNotConfigured("no rewards distribution is configured")
+		}
+		return &Rewards{Staker: state.Staker, BlockNumber: state.BlockNumber, Claimer: state.Claimer, Rewards: state.Rewards}, nil
+	}
+	return nil, errNotFound
+}
+
+// Strategy is a whitelisted strategy.
+type Strategy struct {
+	Address  string `json:"address"`
+	Token    string `json:"token"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+func (s *Server) strategies(ctx context.Context) ([]Strategy, error) {
+	if s.cfg.Strategies == nil {
+		return nil, errNotConfigured("strategies are not listed")
+	}
+	list, err := s.cfg.Strategies.ListStrategies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list strategies: %w", err)
+	}
+	out := make([]Strategy, len(list))
+	for i, strategy := range list {
+		out[i] = Strategy{Address: strategy.Address.Hex(), Token: strategy.Token.Hex(), Symbol: strategy.Symbol, Decimals: strategy.Decimals}
+	}
+	return out, nil
+}
+
+// WithdrawalStatus is the status of a withdrawal in the DelegationManager.
+type WithdrawalStatus struct {
+	Root        string `json:"root"`
+	BlockNumber uint64 `json:"blockNumber"`
+	// Pending is false for withdrawals that were completed or never queued.
+	Pending bool `json:"pending"`
+}
+
+func (s *Server) withdrawal(ctx context.Context, root string, block uint64) (*WithdrawalStatus, error) {
+	b, err := hexHash(root)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := DelegationManager.NewDelegationManagerCaller(s.addressOf(addresses.DelegationManager), s.client.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if block == 0 {
+		if block, err = s.client.Backend.BlockNumber(ctx); err != nil {
+			return nil, fmt.Errorf("failed to fetch block number: %w", err)
+		}
+	}
+	pending, err := delegation.PendingWithdrawals(client.CallOpts(ctx, client.At(block)), b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status of withdrawal %s: %w", b.Hex(), err)
+	}
+	return &WithdrawalStatus{Root: b.Hex(), BlockNumber: block, Pending: pending}, nil
+}
+
+func (s *Server) addressOf(name string) common.Address {
+	addr, _ := s.client.Address(name)
+	return addr
+}
+
+func blockOf(query map[string][]string) (uint64, error) {
+	v := ""
+	if values := query["block"]; len(values) > 0 {
+		v = values[0]
+	}
+	if v == "" || v == "latest" {
+		return 0, nil
+	}
+	block, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, badRequest("invalid block %q", v)
+	}
+	return block, nil
+}
+
+func hexHash(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, badRequest("%q is not a 32-byte hex hash", s)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// requestError is an error caused by the request, returned with its status.
+type requestError struct {
+	status int
+	msg    string
+}
+
+func (e *requestError) Error() string { return e.msg }
+
+var errMethod = &requestError{status: http.StatusMethodNotAllowed, msg: "method not allowed"}
+
+func badRequest(format string, args ...interface{}) error {
+	return &requestError{status: http.StatusBadRequest, msg: fmt.Sprintf(format, args...)}
+}
+
+func errNotConfigured(msg string) error {
+	return &requestError{status: http.StatusNotImplemented, msg: msg}
+}
+
+// statusOf returns the HTTP status of err, mapping the status codes of the gRPC API.
+func statusOf(err error) int {
+	var reqErr *requestError
+	var status *grpcapi.Error
+	switch {
+	case errors.Is(err, errNotFound):
+		return http.StatusNotFound
+	case errors.As(err, &reqErr):
+		return reqErr.status
+	case errors.As(err, &status):
+		switch status.Code {
+		case grpcapi.CodeInvalidArgument:
+			return http.StatusBadRequest
+		case grpcapi.CodeFailedPrecondition:
+			return http.StatusNotImplemented
+		}
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusBadGateway
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	msg := err.Error()
+	var grpcErr *grpcapi.Error
+	if errors.As(err, &grpcErr) {
+		msg = grpcErr.Message
+	}
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,293 @@
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which Run checks the store for new events.
+	DefaultPollInterval = 5 * time.Second
+	// deliveryAttempts is the number of attempts to deliver an event to a webhook.
+	deliveryAttempts = 3
+)
+
+// SignatureHeader is the header holding the hex HMAC-SHA256 of the body of a delivery, keyed
+// with the secret of the subscription, as "sha256=<hex>".
+const SignatureHeader = "X-EigenAPI-Signature"
+
+// Subscription is a webhook subscription.
+type Subscription struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Events are the events delivered, as "Contract.Event" or "Contract.*". Empty delivers every
+	// event.
+	Events []string `json:"events,omitempty"`
+	// Args, if set, only delivers events whose arguments have these values, such as
+	// {"staker": "0x…"}. Values are compared case-insensitively.
+	Args map[string]string `json:"args,omitempty"`
+	// Secret, if set, signs deliveries in SignatureHeader. It is never returned.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (sub *Subscription) matches(e indexer.Event) bool {
+	if len(sub.Events) > 0 {
+		found := false
+		for _, name := range sub.Events {
+			contract, event, _ := strings.Cut(name, ".")
+			found = found || (contract == e.Contract && (event == "*" || event == e.Name))
+		}
+		if !found {
+			return false
+		}
+	}
+	for name, want := range sub.Args {
+		value, ok := e.Args[name]
+		if !ok || !strings.EqualFold(fmt.Sprint(value), want) {
+			return false
+		}
+	}
+	return true
+}
+
+// Delivery is the body posted to webhooks.
+type Delivery struct {
+	Subscription string `json:"subscription"`
+	Event        Event  `json:"event"`
+}
+
+// Event is an indexed event, as delivered to webhooks.
+type Event struct {
+	Contract        string                 `json:"contract"`
+	Address         string                 `json:"address"`
+	Name            string                 `json:"name"`
+	BlockNumber     uint64                 `json:"blockNumber"`
+	BlockHash       string                 `json:"blockHash"`
+	TransactionHash string                 `json:"transactionHash"`
+	LogIndex        uint                   `json:"logIndex"`
+	Args            map[string]interface{} `json:"args"`
+}
+
+// webhooks holds the subscriptions, in memory: they do not survive restarts.
+type webhooks struct {
+	mu   sync.Mutex
+	subs map[string]*Subscription
+}
+
+func newWebhooks() *webhooks {
+	return &webhooks{subs: make(map[string]*Subscription)}
+}
+
+func (h *webhooks) list() []*Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := make([]*Subscription, 0, len(h.subs))
+	for _, sub := range h.subs {
+		out = append(out, sub)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// routeWebhooks serves the webhooks endpoints, under /v1/webhooks.
+func (s *Server) routeWebhooks(w http.ResponseWriter, r *http.Request, path []string) (interface{}, error) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		subs := s.webhooks.list()
+		out := make([]Subscription, len(subs))
+		for i, sub := range subs {
+			out[i] = *sub
+			out[i].Secret = ""
+		}
+		return out, nil
+	case len(path) == 0 && r.Method == http.MethodPost:
+		var sub Subscription
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&sub); err != nil {
+			return nil, badRequest("invalid subscription: %v", err)
+		}
+		created, err := s.Subscribe(sub)
+		if err != nil {
+			return nil, err
+		}
+		created.Secret = ""
+		return created, nil
+	case len(path) == 0:
+		w.Header().Set("Allow", "GET, POST")
+		return nil, errMethod
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		return nil, s.Unsubscribe(path[0])
+	case len(path) == 1:
+		w.Header().Set("Allow", http.MethodDelete)
+		return nil, errMethod
+	}
+	return nil, errNotFound
+}
+
+// Subscribe adds a webhook subscription and returns it with its ID.
+func (s *Server) Subscribe(sub Subscription) (Subscription, error) {
+	if s.cfg.Events == nil {
+		return Subscription{}, errNotConfigured("events are not indexed")
+	}
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Subscription{}, badRequest("invalid webhook URL %q", sub.URL)
+	}
+	for _, name := range sub.Events {
+		if contract, event, ok := strings.Cut(name, "."); !ok || contract == "" || event == "" {
+			return Subscription{}, badRequest("event %q is not named as Contract.Event", name)
+		}
+	}
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return Subscription{}, err
+	}
+	sub.ID, sub.CreatedAt = hex.EncodeToString(id[:]), time.Now().UTC()
+
+	s.webhooks.mu.Lock()
+	defer s.webhooks.mu.Unlock()
+	s.webhooks.subs[sub.ID] = &sub
+	return sub, nil
+}
+
+// Unsubscribe removes the webhook subscription with id.
+func (s *Server) Unsubscribe(id string) error {
+	s.webhooks.mu.Lock()
+	defer s.webhooks.mu.Unlock()
+	if _, ok := s.webhooks.subs[id]; !ok {
+		return errNotFound
+	}
+	delete(s.webhooks.subs, id)
+	return nil
+}
+
+// Run delivers the events indexed from now on to the matching webhooks until ctx is done. Each
+// event is delivered to each matching webhook in order, with up to 3 attempts; events that cannot
+// be delivered are logged and dropped.
+func (s *Server) Run(ctx context.Context) error {
+	if s.cfg.Events == nil {
+		return errors.New("events are not indexed")
+	}
+	next, err := s.next(ctx)
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		checkpoint, ok, err := s.cfg.Events.Checkpoint(ctx)
+		if err != nil {
+			s.cfg.Logger.Warn("failed to read checkpoint", "err", err)
+			continue
+		}
+		if !ok || checkpoint < next {
+			continue
+		}
+		events, err := s.cfg.Events.Events(ctx, indexer.Filter{FromBlock: next, ToBlock: checkpoint})
+		if err != nil {
+			s.cfg.Logger.Warn("failed to read events", "err", err)
+			continue
+		}
+		subs := s.webhooks.list()
+		for _, e := range events {
+			for _, sub := range subs {
+				if sub.matches(e) {
+					s.deliver(ctx, sub, e)
+				}
+			}
+		}
+		next = checkpoint + 1
+	}
+}
+
+// next returns the first block whose events are delivered: the block after the checkpoint.
+func (s *Server) next(ctx context.Context) (uint64, error) {
+	checkpoint, ok, err := s.cfg.Events.Checkpoint(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	if !ok {
+		return 0, nil
+	}
+	return checkpoint + 1, nil
+}
+
+func (s *Server) deliver(ctx context.Context, sub *Subscription, e indexer.Event) {
+	body, err := json.Marshal(Delivery{
+		Subscription: sub.ID,
+		Event: Event{
+			Contract:        e.Contract,
+			Address:         e.Address.Hex(),
+			Name:            e.Name,
+			BlockNumber:     e.BlockNumber,
+			BlockHash:       e.BlockHash.Hex(),
+			TransactionHash: e.TxHash.Hex(),
+			LogIndex:        e.LogIndex,
+			Args:            indexer.JSONArgs(e.Args),
+		},
+	})
+	if err != nil {
+		s.cfg.Logger.Error("failed to encode delivery", "subscription", sub.ID, "err", err)
+		return
+	}
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		err = s.post(ctx, sub, body)
+		if err == nil {
+			return
+		}
+		if attempt == deliveryAttempts || ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	s.cfg.Logger.Warn("failed to deliver webhook", "subscription", sub.ID, "url", sub.URL, "tx", e.TxHash.Hex(), "err", err)
+}
+
+func (s *Server) post(ctx context.Context, sub *Subscription, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if sub.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(sub.Secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
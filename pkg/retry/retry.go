@@ -0,0 +1,269 @@
+// Package retry wraps a chain backend so that requests failing for transient reasons, such as
+// timeouts, rate limiting or dropped connections, are retried with exponential backoff, while
+// reverts are returned at once, decoded by pkg/errors.
+//
+// Since the bindings make every request through their backend, wrapping it applies to all of
+// them:
+//
+//	backend := retry.NewBackend(ethClient, retry.Config{MaxAttempts: 8})
+//	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+const (
+	// DefaultMaxAttempts is the default number of attempts of a request, including the first.
+	DefaultMaxAttempts = 5
+	// DefaultInitialBackoff is the default delay before the first retry.
+	DefaultInitialBackoff = 200 * time.Millisecond
+	// DefaultMaxBackoff is the default maximum delay between attempts.
+	DefaultMaxBackoff = 10 * time.Second
+)
+
+// JSON-RPC error codes providers return when a request is over their limits.
+const (
+	codeLimitExceeded = -32005
+	codeInternal      = -32603
+)
+
+// transientMessages are fragments of the messages of transient errors that carry no usable
+// type or code.
+var transientMessages = []string{
+	"rate limit",
+	"too many requests",
+	"timeout",
+	"timed out",
+	"connection reset",
+	"connection refused",
+	"broken pipe",
+	"header not found",
+	"service unavailable",
+	"bad gateway",
+}
+
+// Class is the classification of a request error.
+type Class int
+
+const (
+	// Permanent errors are returned without retrying, such as invalid requests.
+	Permanent Class = iota
+	// Transient errors are retried.
+	Transient
+	// Revert errors are deterministic reverts of the EVM, returned at once as a
+	// *elerrors.RevertError.
+	Revert
+)
+
+// Classify returns the class of err, as returned by a request to an RPC backend.
+func Classify(err error) Class {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return Permanent
+	}
+	if errors.Is(elerrors.Decode(err), elerrors.ErrReverted) {
+		return Revert
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		if httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= http.StatusInternalServerError {
+			return Transient
+		}
+		return Permanent
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && (rpcErr.ErrorCode() == codeLimitExceeded || rpcErr.ErrorCode() == codeInternal) {
+		return Transient
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return Transient
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return Transient
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range transientMessages {
+		if strings.Contains(msg, fragment) {
+			return Transient
+		}
+	}
+	return Permanent
+}
+
+// Config configures the retries of a Backend.
+type Config struct {
+	// MaxAttempts is the number of attempts of a request, including the first. It defaults to
+	// DefaultMaxAttempts.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled before each further retry. It
+	// defaults to DefaultInitialBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. It defaults to DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// Classify classifies request errors. It defaults to the package's Classify.
+	Classify func(error) Class
+}
+
+// Backend is an ethbackend.Backend that retries requests failing with transient errors.
+type Backend struct {
+	backend ethbackend.Backend
+	cfg     Config
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend with its requests retried according to cfg.
+func NewBackend(backend ethbackend.Backend, cfg Config) *Backend {
+	return &Backend{backend: backend, cfg: cfg.withDefaults()}
+}
+
+func (cfg Config) withDefaults() Config {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.InitialBackoff == 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.Classify == nil {
+		cfg.Classify = Classify
+	}
+	return cfg
+}
+
+// Do calls f until it succeeds, fails with an error that is not transient, or cfg.MaxAttempts
+// attempts were made. Reverts are returned decoded.
+func Do[T any](ctx context.Context, cfg Config, f func() (T, error)) (T, error) {
+	cfg = cfg.withDefaults()
+	backoff := cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		out, err := f()
+		if err == nil {
+			return out, nil
+		}
+		switch cfg.Classify(err) {
+		case Revert:
+			return out, elerrors.Decode(err)
+		case Permanent:
+			return out, err
+		}
+		if attempt >= cfg.MaxAttempts {
+			return out, err
+		}
+
+		// full jitter: sleep a random duration up to the backoff
+		timer := time.NewTimer(time.Duration(rand.Int63n(int64(backoff)) + 1))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return out, err
+		}
+		backoff = min(2*backoff, cfg.MaxBackoff)
+	}
+}
+
+// CodeAt is retried.
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return Do(ctx, b.cfg, func() ([]byte, error) { return b.backend.CodeAt(ctx, contract, blockNumber) })
+}
+
+// CallContract is retried.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return Do(ctx, b.cfg, func() ([]byte, error) { return b.backend.CallContract(ctx, call, blockNumber) })
+}
+
+// EstimateGas is retried.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return Do(ctx, b.cfg, func() (uint64, error) { return b.backend.EstimateGas(ctx, call) })
+}
+
+// PendingCodeAt is retried.
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return Do(ctx, b.cfg, func() ([]byte, error) { return b.backend.PendingCodeAt(ctx, account) })
+}
+
+// PendingNonceAt is retried.
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return Do(ctx, b.cfg, func() (uint64, error) { return b.backend.PendingNonceAt(ctx, account) })
+}
+
+// SuggestGasPrice is retried.
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return Do(ctx, b.cfg, func() (*big.Int, error) { return b.backend.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap is retried.
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return Do(ctx, b.cfg, func() (*big.Int, error) { return b.backend.SuggestGasTipCap(ctx) })
+}
+
+// HeaderByNumber is retried.
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return Do(ctx, b.cfg, func() (*types.Header, error) { return b.backend.HeaderByNumber(ctx, number) })
+}
+
+// SendTransaction is retried. Sending a signed transaction again is harmless, and a node that
+// received it in an attempt that seemed to fail reports it as already known, which is taken as
+// success.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	attempts := 0
+	_, err := Do(ctx, b.cfg, func() (struct{}, error) {
+		attempts++
+		err := b.backend.SendTransaction(ctx, tx)
+		if err != nil && attempts > 1 && strings.Contains(err.Error(), "already known") {
+			return struct{}{}, nil
+		}
+		return struct{}{}, err
+	})
+	return err
+}
+
+// FilterLogs is retried.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return Do(ctx, b.cfg, func() ([]types.Log, error) { return b.backend.FilterLogs(ctx, query) })
+}
+
+// SubscribeFilterLogs retries establishing the subscription. Errors of the established
+// subscription are delivered on its Err channel and not retried.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return Do(ctx, b.cfg, func() (ethereum.Subscription, error) { return b.backend.SubscribeFilterLogs(ctx, query, ch) })
+}
+
+// TransactionReceipt is retried. ethereum.NotFound, returned for transactions not mined yet, is
+// not retried.
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return Do(ctx, b.cfg, func() (*types.Receipt, error) { return b.backend.TransactionReceipt(ctx, txHash) })
+}
+
+// ChainID is retried.
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return Do(ctx, b.cfg, func() (*big.Int, error) { return b.backend.ChainID(ctx) })
+}
+
+// BlockNumber is retried.
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return Do(ctx, b.cfg, func() (uint64, error) { return b.backend.BlockNumber(ctx) })
+}
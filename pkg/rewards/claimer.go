@@ -0,0 +1,412 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/monitor"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prices"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/receipts"
+)
+
+// Defaults of ClaimerConfig.
+const (
+	DefaultClaimInterval = time.Hour
+	// DefaultMinValueToGasCost is the default minimum ratio of the value of a claim to the cost of
+	// its gas.
+	DefaultMinValueToGasCost = 2.0
+)
+
+// ClaimerBackend is the chain access required by a Claimer.
+type ClaimerBackend interface {
+	bind.ContractBackend
+	receipts.Backend
+}
+
+// ClaimerConfig configures a Claimer.
+type ClaimerConfig struct {
+	// Earners are the earners claimed for, whose claimer must be the sender of the Claimer's
+	// TransactOpts. Empty claims for the sender itself.
+	Earners []common.Address
+	// Recipient receives the claimed tokens. Zero pays each earner.
+	Recipient common.Address
+	// Tokens, if set, restricts claims to these tokens.
+	Tokens []common.Address
+	// Distribution returns the distribution of root, such as by downloading its distribution
+	// file. It is required, and called once per root.
+	Distribution func(ctx context.Context, root Root) (*Distribution, error)
+	// Thresholds are the minimum unclaimed amounts, in base units, of each token for it to be
+	// claimed. Tokens without a threshold are claimed when any amount is unclaimed.
+	Thresholds map[common.Address]*big.Int
+	// MaxGasPrice, if set, defers claims while the gas price is above it, in wei.
+	MaxGasPrice *big.Int
+	// Valuer, if set, values claims and their gas in USD, and claims are deferred while their
+	// value is below MinValueToGasCost times the cost of their gas, DefaultMinValueToGasCost if
+	// zero.
+	Valuer            *prices.Valuer
+	MinValueToGasCost float64
+	// Roots, if set, is the tracker the claimable root is read from, and must be run by the
+	// caller. Otherwise the current claimable root is read from the RewardsCoordinator.
+	Roots *RootTracker
+	// Interval is the interval between passes over the earners of Run, DefaultClaimInterval if
+	// zero.
+	Interval time.Duration
+	// Receipts configures waiting for the receipts of the claims.
+	Receipts receipts.Options
+	// Alerter, if set, is alerted of every claim and every failed pass.
+	Alerter monitor.Alerter
+	Logger  logging.Logger
+}
+
+// ClaimResult is the outcome of checking the rewards of an earner.
+type ClaimResult struct {
+	Earner    common.Address
+	RootIndex uint32
+	Root      common.Hash
+	// Unclaimed are the unclaimed amounts of each token with earnings, and Claimed the tokens
+	// claimed, or that would have been claimed if Deferred is set.
+	Unclaimed map[common.Address]*big.Int
+	Claimed   []common.Address
+	// Deferred explains why nothing was claimed, such as the amounts being below their
+	// thresholds or the gas costing too much.
+	Deferred string
+	// GasCost is the estimated cost of the claim in wei at its fee cap, and ValueUSD and
+	// GasCostUSD the values of the claim and of its gas if the config has a Valuer.
+	GasCost    *big.Int
+	ValueUSD   *big.Rat
+	GasCostUSD *big.Rat
+	Tx         common.Hash
+}
+
+// Claimer periodically claims the rewards of a set of earners against the latest claimable
+// distribution root, when their unclaimed amounts exceed their thresholds and are worth the gas.
+type Claimer struct {
+	backend            ClaimerBackend
+	rewardsCoordinator common.Address
+	rc                 *RewardsCoordinator.RewardsCoordinator
+	opts               *bind.TransactOpts
+	cfg                ClaimerConfig
+	logger             logging.Logger
+
+	mu sync.Mutex
+	// distribution is the distribution of the last root claimed against.
+	distribution *Distribution
+	tokens       map[common.Address]amounts.Token
+}
+
+// NewClaimer returns a Claimer sending claims to the RewardsCoordinator at rewardsCoordinator
+// with opts.
+func NewClaimer(backend ClaimerBackend, rewardsCoordinator common.Address, opts *bind.TransactOpts, cfg ClaimerConfig) (*Claimer, error) {
+	if cfg.Distribution == nil {
+		return nil, errors.New("no distribution source")
+	}
+	if len(cfg.Earners) == 0 {
+		cfg.Earners = []common.Address{opts.From}
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultClaimInterval
+	}
+	if cfg.MinValueToGasCost == 0 {
+		cfg.MinValueToGasCost = DefaultMinValueToGasCost
+	}
+	rc, err := RewardsCoordinator.NewRewardsCoordinator(rewardsCoordinator, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Claimer{
+		backend:            backend,
+		rewardsCoordinator: rewardsCoordinator,
+		rc:                 rc,
+		opts:               opts,
+		cfg:                cfg,
+		logger:             logging.OrNop(cfg.Logger),
+		tokens:             make(map[common.Address]amounts.Token),
+	}, nil
+}
+
+// Run claims for the earners every cfg.Interval until ctx is done. Failed passes are logged and
+// alerted, and retried at the next interval.
+func (c *Claimer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.ClaimAll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			c.logger.Error("claim pass failed", "err", err)
+			c.alert(ctx, monitor.Alert{
+				Summary: "rewards claim pass failed",
+				Source:  c.source(),
+				Details: map[string]interface{}{"error": err.Error()},
+			})
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ClaimAll checks the rewards of every earner and claims those worth claiming. The results of
+// the earners checked are returned along with the errors of the others.
+func (c *Claimer) ClaimAll(ctx context.Context) ([]*ClaimResult, error) {
+	root, d, err := c.claimableRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		c.logger.Info("no distribution root is claimable yet")
+		return nil, nil
+	}
+	var results []*ClaimResult
+	var errs []error
+	for _, earner := range c.cfg.Earners {
+		result, err := c.claim(ctx, root, d, earner)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to claim for %s: %w", earner.Hex(), err))
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, errors.Join(errs...)
+}
+
+// claimableRoot returns the latest claimable root and its distribution, nil if no root is
+// claimable.
+func (c *Claimer) claimableRoot(ctx context.Context) (Root, *Distribution, error) {
+	var root Root
+	if c.cfg.Roots != nil {
+		head, err := c.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return Root{}, nil, fmt.Errorf("failed to fetch latest header: %w", err)
+		}
+		var ok bool
+		if root, ok = c.cfg.Roots.LatestClaimable(time.Unix(int64(head.Time), 0)); !ok {
+			return Root{}, nil, nil
+		}
+	} else {
+		opts := &bind.CallOpts{Context: ctx}
+		posted, err := c.rc.GetCurrentClaimableDistributionRoot(opts)
+		if err != nil {
+			return Root{}, nil, fmt.Errorf("failed to fetch claimable distribution root: %w", err)
+		}
+		if posted.Root == ([32]byte{}) {
+			return Root{}, nil, nil
+		}
+		index, err := c.rc.GetRootIndexFromHash(opts, posted.Root)
+		if err != nil {
+			return Root{}, nil, fmt.Errorf("failed to fetch root index: %w", err)
+		}
+		root = *newRoot(index, posted)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.distribution != nil && c.distribution.Root() == root.Root {
+		return root, c.distribution, nil
+	}
+	d, err := c.cfg.Distribution(ctx, root)
+	if err != nil {
+		return Root{}, nil, fmt.Errorf("failed to fetch distribution of root %d: %w", root.Index, err)
+	}
+	if d.Root() != root.Root {
+		return Root{}, nil, fmt.Errorf("distribution of root %d has root %s, not %s", root.Index, d.Root().Hex(), root.Root.Hex())
+	}
+	c.distribution = d
+	return root, d, nil
+}
+
+// claim claims the rewards of earner against root if they are worth claiming.
+func (c *Claimer) claim(ctx context.Context, root Root, d *Distribution, earner common.Address) (*ClaimResult, error) {
+	result := &ClaimResult{Earner: earner, RootIndex: root.Index, Root: root.Root, Unclaimed: make(map[common.Address]*big.Int)}
+	opts := &bind.CallOpts{Context: ctx}
+	claimer, err := c.rc.ClaimerFor(opts, earner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch claimer: %w", err)
+	}
+	if claimer == (common.Address{}) {
+		claimer = earner
+	}
+	if claimer != c.opts.From {
+		return nil, fmt.Errorf("claims for %s must be sent by its claimer %s", earner.Hex(), claimer.Hex())
+	}
+
+	var tokens []common.Address
+	for _, leaf := range d.Earnings(earner) {
+		if len(c.cfg.Tokens) > 0 && !containsAddress(c.cfg.Tokens, leaf.Token) {
+			continue
+		}
+		claimed, err := c.rc.CumulativeClaimed(opts, earner, leaf.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch cumulative claimed: %w", err)
+		}
+		unclaimed := new(big.Int).Sub(leaf.CumulativeEarnings, claimed)
+		if unclaimed.Sign() <= 0 {
+			continue
+		}
+		result.Unclaimed[leaf.Token] = unclaimed
+		if threshold := c.cfg.Thresholds[leaf.Token]; threshold == nil || unclaimed.Cmp(threshold) >= 0 {
+			tokens = append(tokens, leaf.Token)
+		}
+	}
+	if len(tokens) == 0 {
+		result.Deferred = "no unclaimed amount reaches its threshold"
+		c.logger.Debug("claim deferred", "earner", earner.Hex(), "root", root.Index, "reason", result.Deferred)
+		return result, nil
+	}
+	result.Claimed = tokens
+
+	claim, err := d.Claim(root.Index, earner, tokens...)
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyClaim(root.Root, claim); err != nil {
+		return nil, fmt.Errorf("failed to verify claim: %w", err)
+	}
+	recipient := c.cfg.Recipient
+	if recipient == (common.Address{}) {
+		recipient = earner
+	}
+	transact := func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.rc.ProcessClaim(opts, claim, recipient)
+	}
+
+	if result.Deferred, err = c.guard(ctx, result, transact); err != nil {
+		return nil, err
+	}
+	if result.Deferred != "" {
+		c.logger.Info("claim deferred", "earner", earner.Hex(), "root", root.Index, "reason", result.Deferred)
+		return result, nil
+	}
+
+	sendOpts := *c.opts
+	sendOpts.Context = ctx
+	tx, err := transact(&sendOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send claim: %w", err)
+	}
+	receipt, err := receipts.Wait(ctx, c.backend, tx, c.cfg.Receipts)
+	if err != nil {
+		return nil, err
+	}
+	result.Tx = receipt.TxHash
+	c.logger.Info("claimed rewards", "earner", earner.Hex(), "root", root.Index, "tokens", len(tokens), "tx", receipt.TxHash.Hex())
+	details := map[string]interface{}{
+		"earner":    earner.Hex(),
+		"recipient": recipient.Hex(),
+		"rootIndex": root.Index,
+		"tx":        receipt.TxHash.Hex(),
+	}
+	for _, e := range receipts.Events(receipt, c.rewardsCoordinator, c.rc.ParseRewardsClaimed) {
+		details["claimed_"+e.Token.Hex()] = e.ClaimedAmount.String()
+	}
+	if result.ValueUSD != nil {
+		details["value_usd"] = prices.FormatUSD(result.ValueUSD)
+	}
+	c.alert(ctx, monitor.Alert{
+		Summary: fmt.Sprintf("claimed rewards of %s in %d tokens", earner.Hex(), len(tokens)),
+		Source:  c.source(),
+		Details: details,
+	})
+	return result, nil
+}
+
+// guard estimates the gas of the claim and returns why it should be deferred, if it should: the
+// gas price is above cfg.MaxGasPrice, or the claim is not worth cfg.MinValueToGasCost times its
+// gas.
+func (c *Claimer) guard(ctx context.Context, result *ClaimResult, transact func(*bind.TransactOpts) (*types.Transaction, error)) (string, error) {
+	estimateOpts := *c.opts
+	estimateOpts.Context = ctx
+	estimateOpts.NoSend = true
+	estimateOpts.GasLimit = 0
+	tx, err := transact(&estimateOpts)
+	if err != nil {
+		return "", fmt.Errorf("claim would fail: %w", err)
+	}
+	// The fee cap is the gas price of legacy transactions, and bounds the price paid otherwise.
+	gasPrice := tx.GasFeeCap()
+	result.GasCost = new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(tx.Gas()))
+	if c.cfg.MaxGasPrice != nil && gasPrice.Cmp(c.cfg.MaxGasPrice) > 0 {
+		return fmt.Sprintf("gas price %s exceeds the maximum %s", gasPrice, c.cfg.MaxGasPrice), nil
+	}
+	if c.cfg.Valuer == nil {
+		return "", nil
+	}
+
+	value := new(big.Rat)
+	for _, token := range result.Claimed {
+		meta, err := c.token(ctx, token)
+		if err != nil {
+			return "", err
+		}
+		v, err := c.cfg.Valuer.ValueInUSD(ctx, meta.Amount(result.Unclaimed[token]))
+		if err != nil {
+			return "", fmt.Errorf("failed to value %s: %w", meta, err)
+		}
+		value.Add(value, v)
+	}
+	gasCost, err := c.cfg.Valuer.ValueInUSD(ctx, amounts.ETH.Amount(result.GasCost))
+	if err != nil {
+		return "", fmt.Errorf("failed to value gas: %w", err)
+	}
+	result.ValueUSD, result.GasCostUSD = value, gasCost
+	minValue := new(big.Rat).Mul(gasCost, new(big.Rat).SetFloat64(c.cfg.MinValueToGasCost))
+	if value.Cmp(minValue) < 0 {
+		return fmt.Sprintf("claim is worth $%s, less than %g times its gas cost of $%s",
+			prices.FormatUSD(value), c.cfg.MinValueToGasCost, prices.FormatUSD(gasCost)), nil
+	}
+	return "", nil
+}
+
+// token returns the metadata of token, cached.
+func (c *Claimer) token(ctx context.Context, token common.Address) (amounts.Token, error) {
+	c.mu.Lock()
+	meta, ok := c.tokens[token]
+	c.mu.Unlock()
+	if ok {
+		return meta, nil
+	}
+	meta, err := amounts.FetchToken(ctx, c.backend, token)
+	if err != nil {
+		return amounts.Token{}, err
+	}
+	c.mu.Lock()
+	c.tokens[token] = meta
+	c.mu.Unlock()
+	return meta, nil
+}
+
+func (c *Claimer) alert(ctx context.Context, alert monitor.Alert) {
+	if c.cfg.Alerter == nil {
+		return
+	}
+	if err := c.cfg.Alerter.Alert(ctx, alert); err != nil {
+		c.logger.Error("failed to deliver alert", "summary", alert.Summary, "err", err)
+	}
+}
+
+func (c *Claimer) source() string {
+	return fmt.Sprintf("rewards claimer %s", c.opts.From.Hex())
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
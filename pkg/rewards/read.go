@@ -0,0 +1,55 @@
+package rewards
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// earningsRow is a line of a distribution file.
+type earningsRow struct {
+	Earner           common.Address `json:"earner"`
+	Token            common.Address `json:"token"`
+	CumulativeAmount json.Number    `json:"cumulative_amount"`
+}
+
+// ReadDistribution reads the distribution of a distribution file, as published by the rewards
+// calculation for each root: a stream of JSON objects, typically one per line, each holding the
+// cumulative earnings of an earner in a token as a decimal string or number:
+//
+//	{"earner":"0x…","token":"0x…","cumulative_amount":"1000000000000000000"}
+//
+// Other fields, such as the snapshot date, are ignored. Compare the Root of the distribution with
+// the posted root before claiming against it.
+func ReadDistribution(r io.Reader) (*Distribution, error) {
+	earnings := make(map[common.Address]map[common.Address]*big.Int)
+	dec := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var row earningsRow
+		err := dec.Decode(&row)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode row %d: %w", line, err)
+		}
+		amount, ok := new(big.Int).SetString(row.CumulativeAmount.String(), 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("row %d: invalid cumulative amount %q", line, row.CumulativeAmount)
+		}
+		tokens, ok := earnings[row.Earner]
+		if !ok {
+			tokens = make(map[common.Address]*big.Int)
+			earnings[row.Earner] = tokens
+		}
+		if _, ok := tokens[row.Token]; ok {
+			return nil, fmt.Errorf("row %d: duplicate earnings of %s in token %s", line, row.Earner, row.Token)
+		}
+		tokens[row.Token] = amount
+	}
+	return NewDistribution(earnings)
+}
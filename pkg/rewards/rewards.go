@@ -0,0 +1,236 @@
+// Package rewards reconstructs RewardsCoordinator distribution merkle trees and builds and verifies
+// the RewardsMerkleClaim proofs consumed by processClaim.
+//
+// A distribution is a two-level tree. Each earner has a token tree whose leaves hash
+// (token, cumulativeEarnings); the distribution tree's leaves hash (earner, earnerTokenRoot).
+// Earners and tokens are ordered by ascending address. The trees themselves are built by package
+// merkle.
+//
+// On the AVS side, NewRewardsSubmission and NewOperatorDirectedSubmission build the payloads of
+// createAVSRewardsSubmission and createOperatorDirectedAVSRewardsSubmission, and a
+// SubmissionChecker validates them against the RewardsCoordinator's constraints and strategy
+// whitelist and reports the token approvals they require.
+//
+// A RootTracker follows the distribution roots posted by the rewards updater and reports when
+// each becomes claimable, at the end of its activation delay, and a Claimer claims the rewards of
+// a set of earners against the latest claimable root once they are worth their gas.
+package rewards
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+)
+
+// Leaf salts prepended to leaves by the RewardsCoordinator.
+const (
+	EarnerLeafSalt = merkle.EarnerLeafSalt
+	TokenLeafSalt  = merkle.TokenLeafSalt
+)
+
+var (
+	// ErrInvalidEarnerProof is returned when a claim's earner leaf is not proven by its earner proof.
+	ErrInvalidEarnerProof = errors.New("invalid earner claim proof")
+	// ErrInvalidTokenProof is returned when one of a claim's token leaves is not proven by its token proof.
+	ErrInvalidTokenProof = errors.New("invalid token claim proof")
+)
+
+// EarnerLeafHash matches RewardsCoordinator.calculateEarnerLeafHash.
+func EarnerLeafHash(leaf RewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf) common.Hash {
+	return merkle.EarnerLeafHash(leaf.Earner, leaf.EarnerTokenRoot)
+}
+
+// TokenLeafHash matches RewardsCoordinator.calculateTokenLeafHash.
+func TokenLeafHash(leaf RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf) common.Hash {
+	return merkle.TokenLeafHash(leaf.Token, leaf.CumulativeEarnings)
+}
+
+// earnerTokens is a single earner's token tree.
+type earnerTokens struct {
+	index  int
+	leaves []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf
+	tree   *merkle.Tree
+}
+
+// Distribution is a reconstructed distribution tree.
+type Distribution struct {
+	earners map[common.Address]*earnerTokens
+	tree    *merkle.Tree
+}
+
+// NewDistribution builds the distribution tree for earnings, which maps each earner to the
+// cumulative earnings of each of its tokens.
+func NewDistribution(earnings map[common.Address]map[common.Address]*big.Int) (*Distribution, error) {
+	if len(earnings) == 0 {
+		return nil, errors.New("distribution has no earners")
+	}
+
+	earners := sortedAddresses(earnings)
+	d := &Distribution{earners: make(map[common.Address]*earnerTokens, len(earners))}
+	earnerLeaves := make([]common.Hash, len(earners))
+	for i, earner := range earners {
+		tokens := sortedAddresses(earnings[earner])
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("earner %s has no token earnings", earner)
+		}
+
+		et := &earnerTokens{
+			index:  i,
+			leaves: make([]RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf, len(tokens)),
+		}
+		tokenLeaves := make([]common.Hash, len(tokens))
+		for j, token := range tokens {
+			et.leaves[j] = RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf{
+				Token:              token,
+				CumulativeEarnings: earnings[earner][token],
+			}
+			tokenLeaves[j] = TokenLeafHash(et.leaves[j])
+		}
+		tree, err := merkle.NewTree(tokenLeaves)
+		if err != nil {
+			return nil, err
+		}
+		et.tree = tree
+		d.earners[earner] = et
+
+		earnerLeaves[i] = EarnerLeafHash(RewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf{
+			Earner:          earner,
+			EarnerTokenRoot: tree.Root(),
+		})
+	}
+
+	tree, err := merkle.NewTree(earnerLeaves)
+	if err != nil {
+		return nil, err
+	}
+	d.tree = tree
+	return d, nil
+}
+
+// Root returns the distribution root, as submitted with submitRoot.
+func (d *Distribution) Root() common.Hash {
+	return d.tree.Root()
+}
+
+// Earnings returns the token leaves of earner, its cumulative earnings in each token ordered by
+// token address, or nil if earner is not in the distribution.
+func (d *Distribution) Earnings(earner common.Address) []RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf {
+	et, ok := d.earners[earner]
+	if !ok {
+		return nil
+	}
+	return append([]RewardsCoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf(nil), et.leaves...)
+}
+
+// Claim builds the claim of earner against the distribution posted at rootIndex. If tokens is empty
+// the claim covers every token the earner has earnings in.
+func (d *Distribution) Claim(rootIndex uint32, earner common.Address, tokens ...common.Address) (RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, error) {
+	var claim RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim
+	et, ok := d.earners[earner]
+	if !ok {
+		return claim, fmt.Errorf("earner %s not in distribution", earner)
+	}
+	earnerProof, err := d.tree.Proof(et.index)
+	if err != nil {
+		return claim, err
+	}
+
+	claim = RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim{
+		RootIndex:       rootIndex,
+		EarnerIndex:     uint32(et.index),
+		EarnerTreeProof: earnerProof,
+		EarnerLeaf: RewardsCoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf{
+			Earner:          earner,
+			EarnerTokenRoot: et.tree.Root(),
+		},
+	}
+
+	indices := make([]int, 0, len(et.leaves))
+	if len(tokens) == 0 {
+		for i := range et.leaves {
+			indices = append(indices, i)
+		}
+	}
+	for _, token := range tokens {
+		i := sort.Search(len(et.leaves), func(i int) bool {
+			return bytes.Compare(et.leaves[i].Token.Bytes(), token.Bytes()) >= 0
+		})
+		if i == len(et.leaves) || et.leaves[i].Token != token {
+			return claim, fmt.Errorf("earner %s has no earnings in token %s", earner, token)
+		}
+		indices = append(indices, i)
+	}
+
+	for _, i := range indices {
+		proof, err := et.tree.Proof(i)
+		if err != nil {
+			return claim, err
+		}
+		claim.TokenIndices = append(claim.TokenIndices, uint32(i))
+		claim.TokenTreeProofs = append(claim.TokenTreeProofs, proof)
+		claim.TokenLeaves = append(claim.TokenLeaves, et.leaves[i])
+	}
+	return claim, nil
+}
+
+// VerifyClaim checks the earner and token proofs of claim against root, as the RewardsCoordinator
+// does in processClaim.
+func VerifyClaim(root common.Hash, claim RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim) error {
+	if len(claim.TokenIndices) != len(claim.TokenTreeProofs) || len(claim.TokenTreeProofs) != len(claim.TokenLeaves) {
+		return elerrors.ErrInputLengthMismatch
+	}
+	if !merkle.VerifyProof(root, EarnerLeafHash(claim.EarnerLeaf), claim.EarnerIndex, claim.EarnerTreeProof) {
+		return ErrInvalidEarnerProof
+	}
+	for i, leaf := range claim.TokenLeaves {
+		if !merkle.VerifyProof(claim.EarnerLeaf.EarnerTokenRoot, TokenLeafHash(leaf), claim.TokenIndices[i], claim.TokenTreeProofs[i]) {
+			return fmt.Errorf("%w for token %s", ErrInvalidTokenProof, leaf.Token)
+		}
+	}
+	return nil
+}
+
+// VerifyClaimAgainstRoot checks claim against a posted DistributionRoot, as read with
+// getDistributionRootAtIndex(claim.RootIndex), at time now. It returns errors.ErrRootDisabled or
+// errors.ErrRootNotActivated from pkg/errors if the root cannot be claimed against yet.
+func VerifyClaimAgainstRoot(root RewardsCoordinator.IRewardsCoordinatorDistributionRoot, claim RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim, now time.Time) error {
+	if root.Disabled {
+		return elerrors.ErrRootDisabled
+	}
+	if now.Unix() < int64(root.ActivatedAt) {
+		return fmt.Errorf("%w: activates at %s", elerrors.ErrRootNotActivated, time.Unix(int64(root.ActivatedAt), 0).UTC())
+	}
+	return VerifyClaim(root.Root, claim)
+}
+
+// CheckClaim reads the DistributionRoot claim is made against from the RewardsCoordinator behind
+// caller and verifies claim against it at the current time. Run it before submitting processClaim.
+func CheckClaim(ctx context.Context, caller *RewardsCoordinator.RewardsCoordinatorCaller, claim RewardsCoordinator.IRewardsCoordinatorRewardsMerkleClaim) error {
+	root, err := caller.GetDistributionRootAtIndex(&bind.CallOpts{Context: ctx}, big.NewInt(int64(claim.RootIndex)))
+	if err != nil {
+		return fmt.Errorf("failed to fetch distribution root %d: %w", claim.RootIndex, err)
+	}
+	return VerifyClaimAgainstRoot(root, claim, time.Now())
+}
+
+func sortedAddresses[V any](m map[common.Address]V) []common.Address {
+	addrs := make([]common.Address, 0, len(m))
+	for addr := range m {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
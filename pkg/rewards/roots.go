@@ -0,0 +1,343 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/monitor"
+)
+
+// Root is a distribution root posted to the RewardsCoordinator.
+type Root struct {
+	Index                          uint32
+	Root                           common.Hash
+	RewardsCalculationEndTimestamp uint32
+	// ActivatedAt is when claims against the root are accepted, the end of its activation delay,
+	// during which the root can still be disabled.
+	ActivatedAt time.Time
+	Disabled    bool
+	// SubmittedTx and DisabledTx are the transactions that submitted and disabled the root. They
+	// are zero for roots read from the contract when the tracker started.
+	SubmittedTx common.Hash
+	DisabledTx  common.Hash
+}
+
+// IsClaimableAt reports whether claims against the root are accepted at t: it has not been
+// disabled and its activation delay has passed.
+func (r *Root) IsClaimableAt(t time.Time) bool {
+	return !r.Disabled && !t.Before(r.ActivatedAt)
+}
+
+func newRoot(index uint32, root RewardsCoordinator.IRewardsCoordinatorDistributionRoot) *Root {
+	return &Root{
+		Index:                          index,
+		Root:                           root.Root,
+		RewardsCalculationEndTimestamp: root.RewardsCalculationEndTimestamp,
+		ActivatedAt:                    time.Unix(int64(root.ActivatedAt), 0),
+		Disabled:                       root.Disabled,
+	}
+}
+
+// RootTrackerBackend is the chain access required by RootTracker.
+type RootTrackerBackend interface {
+	events.Backend
+	bind.ContractCaller
+}
+
+// RootTrackerConfig configures a RootTracker.
+type RootTrackerConfig struct {
+	// Confirmations and PollInterval configure the underlying events.Stream. Roots are tracked
+	// once Confirmations deep.
+	Confirmations uint64
+	PollInterval  time.Duration
+	// Alerter, if set, is alerted when a root is submitted, activated or disabled.
+	Alerter monitor.Alerter
+	Logger  logging.Logger
+}
+
+// RootTracker tracks the distribution roots of a RewardsCoordinator and their activation, from
+// its DistributionRootSubmitted and DistributionRootDisabled events. Claims against a root are
+// rejected until its activation delay has passed, the window in which the rewards updater can
+// still disable it, so claim automation asks IsClaimableAt before submitting. It is safe for
+// concurrent use.
+type RootTracker struct {
+	backend            RootTrackerBackend
+	rewardsCoordinator common.Address
+	cfg                RootTrackerConfig
+	logger             logging.Logger
+	caller             *RewardsCoordinator.RewardsCoordinatorCaller
+	filterer           *RewardsCoordinator.RewardsCoordinatorFilterer
+
+	// stream is nil until the roots already posted are loaded by the first Poll.
+	stream *events.Stream[types.Log]
+
+	mu    sync.RWMutex
+	roots map[uint32]*Root
+	// pending holds the indices of the roots in their activation delay.
+	pending map[uint32]bool
+}
+
+// NewRootTracker returns a RootTracker of the RewardsCoordinator at rewardsCoordinator.
+func NewRootTracker(backend RootTrackerBackend, rewardsCoordinator common.Address, cfg RootTrackerConfig) (*RootTracker, error) {
+	caller, err := RewardsCoordinator.NewRewardsCoordinatorCaller(rewardsCoordinator, backend)
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := RewardsCoordinator.NewRewardsCoordinatorFilterer(rewardsCoordinator, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RootTracker{
+		backend:            backend,
+		rewardsCoordinator: rewardsCoordinator,
+		cfg:                cfg,
+		logger:             logging.OrNop(cfg.Logger),
+		caller:             caller,
+		filterer:           filterer,
+		roots:              make(map[uint32]*Root),
+		pending:            make(map[uint32]bool),
+	}, nil
+}
+
+// Run polls for roots until ctx is done or polling fails.
+func (t *RootTracker) Run(ctx context.Context) error {
+	interval := t.cfg.PollInterval
+	if interval == 0 {
+		interval = events.DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := t.Poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll applies the roots submitted and disabled since the last poll and alerts on the roots
+// whose activation delay has passed. The first poll reads the roots already posted from the
+// contract.
+func (t *RootTracker) Poll(ctx context.Context) error {
+	head, err := t.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch latest header: %w", err)
+	}
+	if t.stream == nil {
+		if err := t.load(ctx, head); err != nil {
+			return err
+		}
+	} else {
+		notifications, err := t.stream.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if n.Kind == events.Finalized {
+				if err := t.apply(ctx, *n.Event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	now := time.Unix(int64(head.Time), 0)
+	t.mu.Lock()
+	var activated []Root
+	for index := range t.pending {
+		if root := t.roots[index]; root.Disabled || root.IsClaimableAt(now) {
+			delete(t.pending, index)
+			if !root.Disabled {
+				activated = append(activated, *root)
+			}
+		}
+	}
+	t.mu.Unlock()
+	sort.Slice(activated, func(i, j int) bool { return activated[i].Index < activated[j].Index })
+	for _, root := range activated {
+		t.logger.Info("distribution root activated", "index", root.Index, "root", root.Root.Hex())
+		t.alert(ctx, fmt.Sprintf("distribution root %d activated", root.Index), root)
+	}
+	return nil
+}
+
+// load reads the roots posted as of head and starts streaming events after it.
+func (t *RootTracker) load(ctx context.Context, head *types.Header) error {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	n, err := t.caller.GetDistributionRootsLength(opts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch distribution roots length: %w", err)
+	}
+	now := time.Unix(int64(head.Time), 0)
+	roots := make(map[uint32]*Root, n.Uint64())
+	for i := uint64(0); i < n.Uint64(); i++ {
+		root, err := t.caller.GetDistributionRootAtIndex(opts, new(big.Int).SetUint64(i))
+		if err != nil {
+			return fmt.Errorf("failed to fetch distribution root %d: %w", i, err)
+		}
+		roots[uint32(i)] = newRoot(uint32(i), root)
+	}
+
+	parsed, err := RewardsCoordinator.RewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{t.rewardsCoordinator},
+		Topics: [][]common.Hash{{
+			parsed.Events["DistributionRootSubmitted"].ID,
+			parsed.Events["DistributionRootDisabled"].ID,
+		}},
+	}
+	t.stream = events.NewStream(t.backend, query, func(log types.Log) (*types.Log, error) {
+		return &log, nil
+	}, events.Config{
+		FromBlock:     head.Number.Uint64() + 1,
+		Confirmations: t.cfg.Confirmations,
+		PollInterval:  t.cfg.PollInterval,
+	})
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.roots = roots
+	for index, root := range roots {
+		if !root.IsClaimableAt(now) && !root.Disabled {
+			t.pending[index] = true
+		}
+	}
+	t.logger.Info("loaded distribution roots", "roots", len(roots), "pending", len(t.pending), "block", head.Number)
+	return nil
+}
+
+// apply applies a DistributionRootSubmitted or DistributionRootDisabled log.
+func (t *RootTracker) apply(ctx context.Context, log types.Log) error {
+	if ev, err := t.filterer.ParseDistributionRootSubmitted(log); err == nil {
+		root := &Root{
+			Index:                          ev.RootIndex,
+			Root:                           ev.Root,
+			RewardsCalculationEndTimestamp: ev.RewardsCalculationEndTimestamp,
+			ActivatedAt:                    time.Unix(int64(ev.ActivatedAt), 0),
+			SubmittedTx:                    log.TxHash,
+		}
+		t.mu.Lock()
+		t.roots[root.Index] = root
+		t.pending[root.Index] = true
+		t.mu.Unlock()
+		t.logger.Info("distribution root submitted", "index", root.Index, "root", root.Root.Hex(), "activatedAt", root.ActivatedAt)
+		t.alert(ctx, fmt.Sprintf("distribution root %d submitted, claimable from %s", root.Index, root.ActivatedAt.UTC().Format(time.RFC3339)), *root)
+		return nil
+	}
+	ev, err := t.filterer.ParseDistributionRootDisabled(log)
+	if err != nil {
+		return fmt.Errorf("failed to parse log %d of tx %s: %w", log.Index, log.TxHash, err)
+	}
+	t.mu.Lock()
+	root, ok := t.roots[ev.RootIndex]
+	if ok {
+		root.Disabled, root.DisabledTx = true, log.TxHash
+		delete(t.pending, ev.RootIndex)
+	}
+	t.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("distribution root %d was disabled in tx %s but is not known", ev.RootIndex, log.TxHash.Hex())
+	}
+	t.logger.Warn("distribution root disabled", "index", root.Index, "root", root.Root.Hex(), "tx", log.TxHash.Hex())
+	t.alert(ctx, fmt.Sprintf("distribution root %d disabled during its activation delay", root.Index), *root)
+	return nil
+}
+
+func (t *RootTracker) alert(ctx context.Context, summary string, root Root) {
+	if t.cfg.Alerter == nil {
+		return
+	}
+	details := map[string]interface{}{
+		"index":                          root.Index,
+		"root":                           root.Root.Hex(),
+		"rewardsCalculationEndTimestamp": root.RewardsCalculationEndTimestamp,
+		"activatedAt":                    root.ActivatedAt.UTC().Format(time.RFC3339),
+		"disabled":                       root.Disabled,
+	}
+	if root.SubmittedTx != (common.Hash{}) {
+		details["submittedTx"] = root.SubmittedTx.Hex()
+	}
+	if root.DisabledTx != (common.Hash{}) {
+		details["disabledTx"] = root.DisabledTx.Hex()
+	}
+	err := t.cfg.Alerter.Alert(ctx, monitor.Alert{
+		Summary: summary,
+		Source:  fmt.Sprintf("RewardsCoordinator %s", t.rewardsCoordinator.Hex()),
+		Details: details,
+	})
+	if err != nil {
+		t.logger.Error("failed to deliver alert", "summary", summary, "err", err)
+	}
+}
+
+// Root returns the tracked root with hash root.
+func (t *RootTracker) Root(root common.Hash) (Root, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var found *Root
+	for _, r := range t.roots {
+		// A root may be posted again after being disabled; the latest posting wins.
+		if r.Root == root && (found == nil || r.Index > found.Index) {
+			found = r
+		}
+	}
+	if found == nil {
+		return Root{}, false
+	}
+	return *found, true
+}
+
+// IsClaimableAt reports whether claims against root are accepted at time at. Unknown roots are
+// not claimable.
+func (t *RootTracker) IsClaimableAt(root common.Hash, at time.Time) bool {
+	r, ok := t.Root(root)
+	return ok && r.IsClaimableAt(at)
+}
+
+// LatestClaimable returns the most recent root that is claimable at time at, which claims are
+// built against.
+func (t *RootTracker) LatestClaimable(at time.Time) (Root, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var latest *Root
+	for _, r := range t.roots {
+		if r.IsClaimableAt(at) && (latest == nil || r.Index > latest.Index) {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return Root{}, false
+	}
+	return *latest, true
+}
+
+// Roots returns every tracked root, ordered by index.
+func (t *RootTracker) Roots() []Root {
+	t.mu.RLock()
+	roots := make([]Root, 0, len(t.roots))
+	for _, r := range t.roots {
+		roots = append(roots, *r)
+	}
+	t.mu.RUnlock()
+	sort.Slice(roots, func(i, j int) bool { return roots[i].Index < roots[j].Index })
+	return roots
+}
@@ -0,0 +1,374 @@
+package rewards
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategy"
+)
+
+// MaxRewardsAmount is the RewardsCoordinator's MAX_REWARDS_AMOUNT, the largest amount of a
+// submission, 1e38 - 1.
+var MaxRewardsAmount = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil), big.NewInt(1))
+
+// ErrInvalidSubmission is wrapped by the errors of submissions the RewardsCoordinator would
+// reject.
+var ErrInvalidSubmission = errors.New("invalid rewards submission")
+
+// Constraints are the immutable parameters of a RewardsCoordinator that submissions are
+// validated against, in seconds.
+type Constraints struct {
+	CalculationInterval     uint32
+	MaxRewardsDuration      uint32
+	MaxRetroactiveLength    uint32
+	MaxFutureLength         uint32
+	GenesisRewardsTimestamp uint32
+}
+
+// ReadConstraints reads the constraints of the RewardsCoordinator behind caller.
+func ReadConstraints(ctx context.Context, caller *RewardsCoordinator.RewardsCoordinatorCaller) (*Constraints, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	var c Constraints
+	var err error
+	if c.CalculationInterval, err = caller.CALCULATIONINTERVALSECONDS(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch CALCULATION_INTERVAL_SECONDS: %w", err)
+	}
+	if c.MaxRewardsDuration, err = caller.MAXREWARDSDURATION(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch MAX_REWARDS_DURATION: %w", err)
+	}
+	if c.MaxRetroactiveLength, err = caller.MAXRETROACTIVELENGTH(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch MAX_RETROACTIVE_LENGTH: %w", err)
+	}
+	if c.MaxFutureLength, err = caller.MAXFUTURELENGTH(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch MAX_FUTURE_LENGTH: %w", err)
+	}
+	if c.GenesisRewardsTimestamp, err = caller.GENESISREWARDSTIMESTAMP(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch GENESIS_REWARDS_TIMESTAMP: %w", err)
+	}
+	if c.CalculationInterval == 0 {
+		return nil, errors.New("RewardsCoordinator has a zero calculation interval")
+	}
+	return &c, nil
+}
+
+// Align returns the start timestamp and duration of the shortest window aligned to the
+// calculation interval that covers [start, end): start is rounded down and end up to a multiple
+// of the interval.
+func (c *Constraints) Align(start, end time.Time) (startTimestamp, duration uint32) {
+	interval := int64(c.CalculationInterval)
+	from := start.Unix() - start.Unix()%interval
+	to := end.Unix()
+	if rem := to % interval; rem != 0 {
+		to += interval - rem
+	}
+	if to < from {
+		to = from
+	}
+	return uint32(from), uint32(to - from)
+}
+
+// ValidateRewardsSubmission checks s as createAVSRewardsSubmission does at time now, except for
+// strategy whitelisting, which SubmissionChecker reads from the chain. It returns all the
+// problems found, each wrapping ErrInvalidSubmission.
+func (c *Constraints) ValidateRewardsSubmission(s RewardsCoordinator.IRewardsCoordinatorRewardsSubmission, now time.Time) error {
+	errs := c.validateCommon(s.StrategiesAndMultipliers, s.StartTimestamp, s.Duration, now)
+	switch {
+	case s.Amount == nil || s.Amount.Sign() <= 0:
+		errs = append(errs, invalidSubmission("amount must be positive"))
+	case s.Amount.Cmp(MaxRewardsAmount) > 0:
+		errs = append(errs, invalidSubmission("amount exceeds MAX_REWARDS_AMOUNT"))
+	}
+	if int64(s.StartTimestamp) > now.Unix()+int64(c.MaxFutureLength) {
+		errs = append(errs, invalidSubmission("start %d is more than MAX_FUTURE_LENGTH (%ds) in the future", s.StartTimestamp, c.MaxFutureLength))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateOperatorDirectedSubmission checks s as createOperatorDirectedAVSRewardsSubmission does
+// at time now, except for strategy whitelisting. Operator-directed submissions are retroactive:
+// their window must have ended before now. It returns all the problems found, each wrapping
+// ErrInvalidSubmission.
+func (c *Constraints) ValidateOperatorDirectedSubmission(s RewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission, now time.Time) error {
+	errs := c.validateCommon(s.StrategiesAndMultipliers, s.StartTimestamp, s.Duration, now)
+	if len(s.OperatorRewards) == 0 {
+		errs = append(errs, invalidSubmission("no operators rewarded"))
+	}
+	var prev common.Address
+	for i, reward := range s.OperatorRewards {
+		switch {
+		case reward.Operator == (common.Address{}):
+			errs = append(errs, invalidSubmission("operator reward %d is to the zero address", i))
+		case bytes.Compare(prev.Bytes(), reward.Operator.Bytes()) >= 0:
+			errs = append(errs, invalidSubmission("operators must be in strictly ascending order, %s is not", reward.Operator.Hex()))
+		}
+		prev = reward.Operator
+		if reward.Amount == nil || reward.Amount.Sign() <= 0 {
+			errs = append(errs, invalidSubmission("reward of operator %s must be positive", reward.Operator.Hex()))
+		}
+	}
+	if OperatorDirectedTotal(s).Cmp(MaxRewardsAmount) > 0 {
+		errs = append(errs, invalidSubmission("total amount exceeds MAX_REWARDS_AMOUNT"))
+	}
+	if end := int64(s.StartTimestamp) + int64(s.Duration); end >= now.Unix() {
+		errs = append(errs, invalidSubmission("window ends at %d, not before now (%d); operator-directed rewards must be retroactive", end, now.Unix()))
+	}
+	return errors.Join(errs...)
+}
+
+// validateCommon mirrors RewardsCoordinator._validateCommonRewardsSubmission.
+func (c *Constraints) validateCommon(strategies []RewardsCoordinator.IRewardsCoordinatorStrategyAndMultiplier, start, duration uint32, now time.Time) []error {
+	var errs []error
+	if len(strategies) == 0 {
+		errs = append(errs, invalidSubmission("no strategies set"))
+	}
+	if duration > c.MaxRewardsDuration {
+		errs = append(errs, invalidSubmission("duration %ds exceeds MAX_REWARDS_DURATION (%ds)", duration, c.MaxRewardsDuration))
+	}
+	if duration%c.CalculationInterval != 0 {
+		errs = append(errs, invalidSubmission("duration %ds is not a multiple of CALCULATION_INTERVAL_SECONDS (%ds)", duration, c.CalculationInterval))
+	}
+	if start%c.CalculationInterval != 0 {
+		errs = append(errs, invalidSubmission("start %d is not a multiple of CALCULATION_INTERVAL_SECONDS (%ds)", start, c.CalculationInterval))
+	}
+	if int64(start) < now.Unix()-int64(c.MaxRetroactiveLength) {
+		errs = append(errs, invalidSubmission("start %d is more than MAX_RETROACTIVE_LENGTH (%ds) in the past", start, c.MaxRetroactiveLength))
+	}
+	if start < c.GenesisRewardsTimestamp {
+		errs = append(errs, invalidSubmission("start %d is before GENESIS_REWARDS_TIMESTAMP (%d)", start, c.GenesisRewardsTimestamp))
+	}
+	var prev common.Address
+	for _, s := range strategies {
+		if bytes.Compare(prev.Bytes(), s.Strategy.Bytes()) >= 0 {
+			errs = append(errs, invalidSubmission("strategies must be in strictly ascending order, %s is not", s.Strategy.Hex()))
+		}
+		prev = s.Strategy
+	}
+	return errs
+}
+
+func invalidSubmission(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalidSubmission}, args...)...)
+}
+
+// NewRewardsSubmission returns a submission of amount of token over the window of duration
+// seconds from start, to the stakers of the strategies in multipliers and their operators. The
+// strategies are sorted as the RewardsCoordinator requires.
+func NewRewardsSubmission(token common.Address, amount *big.Int, start, duration uint32, multipliers map[common.Address]*big.Int) RewardsCoordinator.IRewardsCoordinatorRewardsSubmission {
+	return RewardsCoordinator.IRewardsCoordinatorRewardsSubmission{
+		StrategiesAndMultipliers: strategiesAndMultipliers(multipliers),
+		Token:                    token,
+		Amount:                   amount,
+		StartTimestamp:           start,
+		Duration:                 duration,
+	}
+}
+
+// NewOperatorDirectedSubmission returns a submission of the amounts of token in rewards, keyed
+// by operator, over the window of duration seconds from start, split between each operator and
+// its stakers in the strategies in multipliers. Operators and strategies are sorted as the
+// RewardsCoordinator requires.
+func NewOperatorDirectedSubmission(token common.Address, rewards map[common.Address]*big.Int, start, duration uint32, multipliers map[common.Address]*big.Int, description string) RewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission {
+	operatorRewards := make([]RewardsCoordinator.IRewardsCoordinatorOperatorReward, 0, len(rewards))
+	for _, operator := range sortedAddresses(rewards) {
+		operatorRewards = append(operatorRewards, RewardsCoordinator.IRewardsCoordinatorOperatorReward{
+			Operator: operator,
+			Amount:   rewards[operator],
+		})
+	}
+	return RewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission{
+		StrategiesAndMultipliers: strategiesAndMultipliers(multipliers),
+		Token:                    token,
+		OperatorRewards:          operatorRewards,
+		StartTimestamp:           start,
+		Duration:                 duration,
+		Description:              description,
+	}
+}
+
+func strategiesAndMultipliers(multipliers map[common.Address]*big.Int) []RewardsCoordinator.IRewardsCoordinatorStrategyAndMultiplier {
+	out := make([]RewardsCoordinator.IRewardsCoordinatorStrategyAndMultiplier, 0, len(multipliers))
+	for _, strategy := range sortedAddresses(multipliers) {
+		out = append(out, RewardsCoordinator.IRewardsCoordinatorStrategyAndMultiplier{
+			Strategy:   strategy,
+			Multiplier: multipliers[strategy],
+		})
+	}
+	return out
+}
+
+// OperatorDirectedTotal returns the amount transferred by s, the sum of its operator rewards.
+func OperatorDirectedTotal(s RewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission) *big.Int {
+	total := new(big.Int)
+	for _, reward := range s.OperatorRewards {
+		if reward.Amount != nil {
+			total.Add(total, reward.Amount)
+		}
+	}
+	return total
+}
+
+// Approval is the allowance of a token an AVS must grant the RewardsCoordinator for its
+// submissions to be transferred.
+type Approval struct {
+	Token common.Address
+	// Required is the total transferred by the submissions, and Allowance the current allowance.
+	Required  *big.Int
+	Allowance *big.Int
+	// Shortfall is how much Allowance falls short of Required, zero if it suffices.
+	Shortfall *big.Int
+}
+
+// SubmissionChecker validates the submissions of an AVS against a RewardsCoordinator: its
+// constraints at the latest block time, the whitelisting of their strategies in the
+// StrategyManager, and the allowances they require.
+type SubmissionChecker struct {
+	backend                bind.ContractBackend
+	rewardsCoordinator     common.Address
+	strategyManager        *StrategyManager.StrategyManagerCaller
+	beaconChainETHStrategy common.Address
+	constraints            Constraints
+}
+
+// NewSubmissionChecker returns a SubmissionChecker of the RewardsCoordinator at
+// rewardsCoordinator, reading its constraints and StrategyManager.
+func NewSubmissionChecker(ctx context.Context, backend bind.ContractBackend, rewardsCoordinator common.Address) (*SubmissionChecker, error) {
+	caller, err := RewardsCoordinator.NewRewardsCoordinatorCaller(rewardsCoordinator, backend)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := ReadConstraints(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	strategyManagerAddress, err := caller.StrategyManager(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch StrategyManager: %w", err)
+	}
+	strategyManager, err := StrategyManager.NewStrategyManagerCaller(strategyManagerAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	beaconChainETHStrategy, err := caller.BeaconChainETHStrategy(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beacon chain ETH strategy: %w", err)
+	}
+	return &SubmissionChecker{
+		backend:                backend,
+		rewardsCoordinator:     rewardsCoordinator,
+		strategyManager:        strategyManager,
+		beaconChainETHStrategy: beaconChainETHStrategy,
+		constraints:            *constraints,
+	}, nil
+}
+
+// Constraints returns the constraints of the RewardsCoordinator, for Align.
+func (c *SubmissionChecker) Constraints() Constraints {
+	return c.constraints
+}
+
+// CheckRewardsSubmissions validates submissions for createAVSRewardsSubmission sent by avs and
+// returns the approvals they require. The approvals are returned along with the validation
+// errors, if any, each wrapping ErrInvalidSubmission.
+func (c *SubmissionChecker) CheckRewardsSubmissions(ctx context.Context, avs common.Address, submissions []RewardsCoordinator.IRewardsCoordinatorRewardsSubmission) ([]Approval, error) {
+	now, err := c.now(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	strategies := make(map[common.Address]bool)
+	totals := make(map[common.Address]*big.Int)
+	for i, s := range submissions {
+		if err := c.constraints.ValidateRewardsSubmission(s, now); err != nil {
+			errs = append(errs, fmt.Errorf("submission %d: %w", i, err))
+		}
+		for _, sm := range s.StrategiesAndMultipliers {
+			strategies[sm.Strategy] = true
+		}
+		if s.Amount != nil {
+			addTotal(totals, s.Token, s.Amount)
+		}
+	}
+	return c.check(ctx, avs, strategies, totals, errs)
+}
+
+// CheckOperatorDirectedSubmissions validates submissions for
+// createOperatorDirectedAVSRewardsSubmission sent by avs and returns the approvals they require.
+// The approvals are returned along with the validation errors, if any, each wrapping
+// ErrInvalidSubmission.
+func (c *SubmissionChecker) CheckOperatorDirectedSubmissions(ctx context.Context, avs common.Address, submissions []RewardsCoordinator.IRewardsCoordinatorOperatorDirectedRewardsSubmission) ([]Approval, error) {
+	now, err := c.now(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	strategies := make(map[common.Address]bool)
+	totals := make(map[common.Address]*big.Int)
+	for i, s := range submissions {
+		if err := c.constraints.ValidateOperatorDirectedSubmission(s, now); err != nil {
+			errs = append(errs, fmt.Errorf("submission %d: %w", i, err))
+		}
+		for _, sm := range s.StrategiesAndMultipliers {
+			strategies[sm.Strategy] = true
+		}
+		addTotal(totals, s.Token, OperatorDirectedTotal(s))
+	}
+	return c.check(ctx, avs, strategies, totals, errs)
+}
+
+// check adds the strategies that are not whitelisted to errs and reads the allowances of
+// totals granted by avs.
+func (c *SubmissionChecker) check(ctx context.Context, avs common.Address, strategies map[common.Address]bool, totals map[common.Address]*big.Int, errs []error) ([]Approval, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	for _, strategy := range sortedAddresses(strategies) {
+		if strategy == c.beaconChainETHStrategy {
+			continue
+		}
+		whitelisted, err := c.strategyManager.StrategyIsWhitelistedForDeposit(opts, strategy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch whitelisting of strategy %s: %w", strategy.Hex(), err)
+		}
+		if !whitelisted {
+			errs = append(errs, invalidSubmission("strategy %s is not whitelisted for deposit", strategy.Hex()))
+		}
+	}
+
+	approvals := make([]Approval, 0, len(totals))
+	for _, token := range sortedAddresses(totals) {
+		allowance, err := strategy.TokenAllowance(opts, c.backend, token, avs, c.rewardsCoordinator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch allowance of token %s: %w", token.Hex(), err)
+		}
+		shortfall := new(big.Int).Sub(totals[token], allowance)
+		if shortfall.Sign() < 0 {
+			shortfall.SetUint64(0)
+		}
+		approvals = append(approvals, Approval{Token: token, Required: totals[token], Allowance: allowance, Shortfall: shortfall})
+	}
+	return approvals, errors.Join(errs...)
+}
+
+// now returns the time of the latest block, which stands in for block.timestamp.
+func (c *SubmissionChecker) now(ctx context.Context) (time.Time, error) {
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to fetch latest header: %w", err)
+	}
+	return time.Unix(int64(header.Time), 0), nil
+}
+
+func addTotal(totals map[common.Address]*big.Int, token common.Address, amount *big.Int) {
+	if totals[token] == nil {
+		totals[token] = new(big.Int)
+	}
+	totals[token].Add(totals[token], amount)
+}
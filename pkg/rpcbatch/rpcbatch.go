@@ -0,0 +1,220 @@
+// Package rpcbatch coalesces the eth_calls made concurrently through the generated bindings into
+// JSON-RPC batch requests.
+//
+// Unlike pkg/multicall, which needs each call registered up front, a Caller is transparent to
+// the bindings: every call waits up to Config.Window for other calls to join its batch, so
+// that goroutines polling many contracts share round trips to the node:
+//
+//	caller := rpcbatch.NewCaller(ethClient.Client(), rpcbatch.Config{})
+//	strategy, _ := StrategyBase.NewStrategyBaseCaller(addr, caller)
+//	// called from many goroutines at once
+//	totalShares, err := strategy.TotalShares(&bind.CallOpts{Context: ctx})
+//
+// Each call in a batch fails or succeeds on its own; reverts carry their revert data as with
+// ethclient, so that pkg/errors decodes them.
+package rpcbatch
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// DefaultWindow is the default time a call waits for others to join its batch.
+	DefaultWindow = 5 * time.Millisecond
+	// DefaultMaxBatchSize is the default number of calls after which a batch is sent without
+	// waiting for the window to close. Providers commonly limit batches to 100 requests.
+	DefaultMaxBatchSize = 100
+	// DefaultTimeout is the default timeout of a batch request.
+	DefaultTimeout = 30 * time.Second
+)
+
+// BatchClient sends JSON-RPC batch requests. *rpc.Client satisfies it.
+type BatchClient interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Config configures a Caller.
+type Config struct {
+	// Window is how long a call waits for others to join its batch. It defaults to
+	// DefaultWindow.
+	Window time.Duration
+	// MaxBatchSize is the number of calls after which a batch is sent. It defaults to
+	// DefaultMaxBatchSize.
+	MaxBatchSize int
+	// Timeout is the timeout of each batch request. Batches are shared by callers with
+	// different contexts, so a batch is not canceled with any of them; a caller whose context is
+	// done returns without waiting for its batch. It defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+type request struct {
+	elem rpc.BatchElem
+	done chan error
+}
+
+// Caller is a bind.ContractCaller that sends the calls made through it in JSON-RPC batches. It
+// is safe for concurrent use.
+type Caller struct {
+	client BatchClient
+	cfg    Config
+
+	mu      sync.Mutex
+	pending []*request
+	timer   *time.Timer
+}
+
+var _ bind.ContractCaller = (*Caller)(nil)
+
+// NewCaller returns a Caller sending batches to client.
+func NewCaller(client BatchClient, cfg Config) *Caller {
+	if cfg.Window == 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.MaxBatchSize == 0 {
+		cfg.MaxBatchSize = DefaultMaxBatchSize
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &Caller{client: client, cfg: cfg}
+}
+
+// CallContract executes an eth_call in the next batch.
+func (c *Caller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var out hexutil.Bytes
+	if err := c.do(ctx, &out, "eth_call", toCallArg(call), toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CodeAt executes an eth_getCode in the next batch.
+func (c *Caller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	var out hexutil.Bytes
+	if err := c.do(ctx, &out, "eth_getCode", contract, toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Flush sends the pending calls without waiting for the window to close.
+func (c *Caller) Flush() {
+	c.mu.Lock()
+	batch := c.take()
+	c.mu.Unlock()
+	if len(batch) > 0 {
+		c.send(batch)
+	}
+}
+
+// do adds a request to the pending batch and waits for its response.
+func (c *Caller) do(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	r := &request{
+		elem: rpc.BatchElem{Method: method, Args: args, Result: result},
+		done: make(chan error, 1),
+	}
+
+	c.mu.Lock()
+	c.pending = append(c.pending, r)
+	if len(c.pending) >= c.cfg.MaxBatchSize {
+		batch := c.take()
+		c.mu.Unlock()
+		go c.send(batch)
+	} else {
+		if c.timer == nil {
+			c.timer = time.AfterFunc(c.cfg.Window, c.Flush)
+		}
+		c.mu.Unlock()
+	}
+
+	select {
+	case err := <-r.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// take returns the pending requests and starts a new batch. c.mu must be held.
+func (c *Caller) take() []*request {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	batch := c.pending
+	c.pending = nil
+	return batch
+}
+
+// send sends batch and delivers each response to its request.
+func (c *Caller) send(batch []*request) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.Timeout)
+	defer cancel()
+
+	elems := make([]rpc.BatchElem, len(batch))
+	for i, r := range batch {
+		elems[i] = r.elem
+	}
+	err := c.client.BatchCallContext(ctx, elems)
+	for i, r := range batch {
+		if err != nil {
+			r.done <- fmt.Errorf("failed to send batch of %d calls: %w", len(batch), err)
+		} else {
+			r.done <- elems[i].Error
+		}
+	}
+}
+
+// toCallArg encodes msg as ethclient does.
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
+}
+
+// toBlockNumArg encodes number as ethclient does, with nil meaning the latest block.
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	if number.Sign() >= 0 {
+		return hexutil.EncodeBig(number)
+	}
+	if number.IsInt64() {
+		return rpc.BlockNumber(number.Int64()).String()
+	}
+	return fmt.Sprintf("<invalid %d>", number)
+}
@@ -0,0 +1,157 @@
+package rpcbatch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// roundTrip is the latency added to every HTTP request, standing in for the round trip to a
+// remote node.
+const roundTrip = time.Millisecond
+
+// ethService answers eth_call and eth_getCode with fixed data.
+type ethService struct{}
+
+func (ethService) Call(args map[string]interface{}, block string) hexutil.Bytes {
+	return common.LeftPadBytes([]byte{0x01}, 32)
+}
+
+func (ethService) GetCode(address common.Address, block string) hexutil.Bytes {
+	return hexutil.Bytes{0x60, 0x00}
+}
+
+// requests counts the HTTP requests served by newClient servers.
+var requests atomic.Int64
+
+// newClient returns a client of an HTTP JSON-RPC server that takes roundTrip to answer each
+// request, whether it is a single call or a batch.
+func newClient(tb testing.TB) *rpc.Client {
+	tb.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", ethService{}); err != nil {
+		tb.Fatal(err)
+	}
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(roundTrip)
+		server.ServeHTTP(w, r)
+	}))
+	client, err := rpc.DialHTTP(httpServer.URL)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() {
+		client.Close()
+		httpServer.Close()
+		server.Stop()
+	})
+	return client
+}
+
+// countingClient counts the batches sent through it.
+type countingClient struct {
+	*rpc.Client
+	batches atomic.Int64
+}
+
+func (c *countingClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	c.batches.Add(1)
+	return c.Client.BatchCallContext(ctx, b)
+}
+
+func TestCallerCoalescesConcurrentCalls(t *testing.T) {
+	client := &countingClient{Client: newClient(t)}
+	caller := NewCaller(client, Config{Window: 50 * time.Millisecond, MaxBatchSize: 10})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out, err := caller.CallContract(context.Background(), benchCall, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(out) != 32 || out[31] != 0x01 {
+				t.Errorf("CallContract() = %x", out)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Two batches fill up and the remaining five calls are sent when the window closes.
+	if got := client.batches.Load(); got != 3 {
+		t.Errorf("sent %d batches, want 3", got)
+	}
+}
+
+var benchCall = ethereum.CallMsg{
+	To:   &common.Address{1},
+	Data: hexutil.MustDecode("0x3a98ef39"), // totalShares()
+}
+
+// reportRequests reports the HTTP requests sent per call since start.
+func reportRequests(b *testing.B, start int64) {
+	b.ReportMetric(float64(requests.Load()-start)/float64(b.N), "requests/op")
+}
+
+// BenchmarkPerCall sends every eth_call in its own request, as ethclient does.
+func BenchmarkPerCall(b *testing.B) {
+	client := newClient(b)
+	ctx := context.Background()
+	b.SetParallelism(16)
+	b.ResetTimer()
+	defer reportRequests(b, requests.Load())
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			var out hexutil.Bytes
+			if err := client.CallContext(ctx, &out, "eth_call", toCallArg(benchCall), "latest"); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkCaller sends the same concurrent eth_calls through a Caller, which coalesces them
+// into batches.
+func BenchmarkCaller(b *testing.B) {
+	caller := NewCaller(newClient(b), Config{Window: time.Millisecond})
+	ctx := context.Background()
+	b.SetParallelism(16)
+	b.ResetTimer()
+	defer reportRequests(b, requests.Load())
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := caller.CallContract(ctx, benchCall, nil); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkCallerSequential measures the cost of the window for a caller with no concurrent
+// calls to batch with.
+func BenchmarkCallerSequential(b *testing.B) {
+	caller := NewCaller(newClient(b), Config{Window: time.Millisecond})
+	ctx := context.Background()
+	b.ResetTimer()
+	defer reportRequests(b, requests.Load())
+	for i := 0; i < b.N; i++ {
+		if _, err := caller.CallContract(ctx, benchCall, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package shares
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// Storage slots of StrategyBase, from docs/storage-report/StrategyBase.md.
+var (
+	slotUnderlyingToken = common.BigToHash(big.NewInt(50))
+	slotTotalShares     = common.BigToHash(big.NewInt(51))
+)
+
+// tokenAddress holds balanceCode, which returns the value of its storage slot 0 for any call, so
+// that a state override of the slot sets the strategy's token balance.
+var (
+	tokenAddress = common.HexToAddress("0x0000000000000000000000000000000000000e20")
+	balanceCode  = []byte{
+		0x60, 0x00, 0x54, 0x60, 0x00, 0x52, // mstore(0, sload(0))
+		0x60, 0x20, 0x60, 0x00, 0xf3, // return(0, 32)
+	}
+)
+
+// revertMaxTotalShares is the revert reason of a deposit that exceeds MaxTotalShares.
+const revertMaxTotalShares = "StrategyBase.deposit: totalShares exceeds `MAX_TOTAL_SHARES`"
+
+// overrideCaller is a bind.ContractCaller that executes calls with the strategy's total shares
+// and token balance overridden to those of a State.
+type overrideCaller struct {
+	bind.ContractCaller
+	geth      *gethclient.Client
+	overrides map[common.Address]gethclient.OverrideAccount
+}
+
+func (c overrideCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.geth.CallContract(ctx, call, blockNumber, &c.overrides)
+}
+
+// deployedStrategy is a StrategyBase deployed on the simulated backend, whose strategy manager is
+// manager, so that calls from manager can deposit.
+type deployedStrategy struct {
+	client   simulated.Client
+	geth     *gethclient.Client
+	address  common.Address
+	manager  common.Address
+	contract *StrategyBase.StrategyBaseCaller
+}
+
+func deployStrategy(t testing.TB) *deployedStrategy {
+	t.Helper()
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := crypto.PubkeyToAddress(key.PublicKey)
+	// The simulated client hides its RPC client, which gethclient needs for state overrides, so
+	// the node also serves its RPC over IPC.
+	ipcPath := filepath.Join(t.TempDir(), "sim.ipc")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		manager:      {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+		tokenAddress: {Code: balanceCode},
+	}, func(nodeConf *node.Config, _ *ethconfig.Config) {
+		nodeConf.IPCPath = ipcPath
+	})
+	t.Cleanup(func() { backend.Close() })
+	client := backend.Client()
+	rpcClient, err := rpc.Dial(ipcPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(rpcClient.Close)
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, tx, _, err := StrategyBase.DeployStrategyBase(opts, client, manager)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if receipt, err := bind.WaitMined(ctx, client, tx); err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("failed to deploy StrategyBase: %v", err)
+	}
+
+	return &deployedStrategy{client: client, geth: gethclient.New(rpcClient), address: address, manager: manager}
+}
+
+// at returns the strategy's binding with its total shares set to s.TotalShares and its token
+// balance set to balance.
+func (d *deployedStrategy) at(t *testing.T, s State, balance *big.Int) *StrategyBase.StrategyBaseCallerRaw {
+	t.Helper()
+	caller := overrideCaller{
+		ContractCaller: d.client,
+		geth:           d.geth,
+		overrides: map[common.Address]gethclient.OverrideAccount{
+			d.address: {StateDiff: map[common.Hash]common.Hash{
+				slotUnderlyingToken: common.BytesToHash(tokenAddress.Bytes()),
+				slotTotalShares:     common.BigToHash(s.TotalShares),
+			}},
+			tokenAddress: {StateDiff: map[common.Hash]common.Hash{{}: common.BigToHash(balance)}},
+		},
+	}
+	contract, err := StrategyBase.NewStrategyBaseCaller(d.address, caller)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &StrategyBase.StrategyBaseCallerRaw{Contract: contract}
+}
+
+// call calls method on strategy from the strategy manager and returns its uint256 result, or the
+// decoded revert.
+func (d *deployedStrategy) call(strategy *StrategyBase.StrategyBaseCallerRaw, method string, args ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	if err := strategy.Call(&bind.CallOpts{From: d.manager}, &out, method, args...); err != nil {
+		return nil, elerrors.Decode(err)
+	}
+	return out[0].(*big.Int), nil
+}
+
+// FuzzDifferential checks the conversions and deposits of State against those of StrategyBase
+// deployed on the simulated backend.
+func FuzzDifferential(f *testing.F) {
+	addFuzzSeeds(f)
+	// A deposit that mints shares at a balance so large that the emitted exchange rate overflows.
+	f.Add([]byte{0x0c, 0x9f, 0x2c, 0x9c, 0xd0, 0x46, 0x74, 0xed, 0xea, 0x40, 0x00, 0x00, 0x00}, pow2(200), []byte{}, pow2(156))
+	d := deployStrategy(f)
+
+	f.Fuzz(func(t *testing.T, totalShares, balance, x, y []byte) {
+		s, ok := fuzzState(totalShares, balance)
+		if !ok {
+			return
+		}
+		a, b := uint256(x), uint256(y)
+		strategy := d.at(t, s, s.Balance)
+
+		want, wantErr := s.SharesToUnderlying(a)
+		got, err := d.call(strategy, "sharesToUnderlyingView", a)
+		if (err != nil) != (wantErr != nil) || (err == nil && got.Cmp(want) != 0) {
+			t.Fatalf("sharesToUnderlyingView(%s) in %+v = (%v, %v), Go gives (%v, %v)", a, s, got, err, want, wantErr)
+		}
+
+		want, wantErr = s.UnderlyingToShares(a)
+		got, err = d.call(strategy, "underlyingToSharesView", a)
+		if (err != nil) != (wantErr != nil) || (err == nil && got.Cmp(want) != 0) {
+			t.Fatalf("underlyingToSharesView(%s) in %+v = (%v, %v), Go gives (%v, %v)", a, s, got, err, want, wantErr)
+		}
+
+		// The strategy manager transfers the deposit before calling deposit, so the contract reads
+		// the balance after the transfer, which must fit in a uint256.
+		balanceAfter, err := add(s.Balance, b)
+		if err != nil {
+			return
+		}
+		want, _, wantErr = s.Deposit(b)
+		got, err = d.call(d.at(t, s, balanceAfter), "deposit", tokenAddress, b)
+		var revertErr *elerrors.RevertError
+		switch {
+		case wantErr == nil:
+			if err != nil || got.Cmp(want) != 0 {
+				t.Fatalf("deposit(%s) in %+v = (%v, %v), Go gives %s", b, s, got, err, want)
+			}
+		case errors.Is(wantErr, ErrMaxTotalShares):
+			if !errors.As(err, &revertErr) || revertErr.Reason != revertMaxTotalShares {
+				t.Fatalf("deposit(%s) in %+v = (%v, %v), Go gives %v", b, s, got, err, wantErr)
+			}
+		default:
+			if !errors.Is(err, wantErr) {
+				t.Fatalf("deposit(%s) in %+v = (%v, %v), Go gives %v", b, s, got, err, wantErr)
+			}
+		}
+	})
+}
+
+// pow2 returns the big-endian bytes of 2^n.
+func pow2(n uint) []byte {
+	return new(big.Int).Lsh(big.NewInt(1), n).Bytes()
+}
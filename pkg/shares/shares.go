@@ -0,0 +1,206 @@
+// Package shares replicates StrategyBase's share accounting, so that the shares minted by a
+// deposit and the tokens paid out by a withdrawal can be predicted exactly off chain.
+//
+// A State holds a strategy's total shares and token balance. Its methods perform the same
+// uint256 arithmetic as the contract, including the virtual share and balance offsets and
+// rounding down:
+//
+//	state, _ := shares.Fetch(ctx, client, strategyAddress)
+//	newShares, after, err := state.Deposit(amount)
+//	if errors.Is(err, elerrors.ErrZeroNewShares) {
+//		...
+//	}
+//	amountOut, _, _ := after.Withdraw(newShares)
+package shares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategy"
+)
+
+// StrategyBase share accounting constants.
+var (
+	// SharesOffset and BalanceOffset are StrategyBase.SHARES_OFFSET and BALANCE_OFFSET, the
+	// virtual shares and balance that protect against share inflation.
+	SharesOffset  = big.NewInt(1e3)
+	BalanceOffset = big.NewInt(1e3)
+	// MaxTotalShares is StrategyBase.MAX_TOTAL_SHARES.
+	MaxTotalShares = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil), big.NewInt(1))
+)
+
+// Errors for StrategyBase reverts that have no typed error in pkg/errors.
+var (
+	ErrMaxTotalShares     = errors.New("total shares exceed max total shares")
+	ErrInsufficientShares = errors.New("amount shares exceeds total shares")
+	// ErrArithmeticOverflow is the panic raised by the contract's checked arithmetic.
+	ErrArithmeticOverflow = elerrors.ErrPanic{Code: 0x11}
+)
+
+// errNegativeAmount is returned for negative amounts, which a uint256 cannot hold.
+var errNegativeAmount = errors.New("negative amount")
+
+// exchangeRateScale is the fixed-point scale of ExchangeRateEmitted.
+var exchangeRateScale = big.NewInt(1e18)
+
+// State is the share accounting state of a strategy.
+type State struct {
+	// TotalShares is the strategy's totalShares.
+	TotalShares *big.Int
+	// Balance is the strategy's balance of its underlying token.
+	Balance *big.Int
+}
+
+// Fetch returns the state of the strategy at strategyAddr at the latest block.
+func Fetch(ctx context.Context, caller bind.ContractCaller, strategyAddr common.Address) (State, error) {
+	strat, err := StrategyBase.NewStrategyBaseCaller(strategyAddr, caller)
+	if err != nil {
+		return State{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	token, err := strat.UnderlyingToken(opts)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to fetch underlying token: %w", err)
+	}
+	totalShares, err := strat.TotalShares(opts)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to fetch total shares: %w", err)
+	}
+	balance, err := strategy.TokenBalance(opts, caller, token, strategyAddr)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to fetch token balance: %w", err)
+	}
+	return State{TotalShares: totalShares, Balance: balance}, nil
+}
+
+// Deposit returns the shares StrategyBase.deposit mints for amount and the state after the
+// deposit. s.Balance is the balance before the deposit's tokens are transferred in. It returns
+// elerrors.ErrZeroNewShares or ErrMaxTotalShares if the deposit would revert.
+func (s State) Deposit(amount *big.Int) (*big.Int, State, error) {
+	if amount.Sign() < 0 {
+		return nil, s, errNegativeAmount
+	}
+	// StrategyBase reads its balance after the transfer, so the balance must fit in a uint256.
+	balanceAfter, err := add(s.Balance, amount)
+	if err != nil {
+		return nil, s, err
+	}
+	virtualShares, err := add(s.TotalShares, SharesOffset)
+	if err != nil {
+		return nil, s, err
+	}
+	if _, err := add(balanceAfter, BalanceOffset); err != nil {
+		return nil, s, err
+	}
+	product, err := mul(amount, virtualShares)
+	if err != nil {
+		return nil, s, err
+	}
+	newShares := product.Div(product, new(big.Int).Add(s.Balance, BalanceOffset))
+	if newShares.Sign() == 0 {
+		return nil, s, elerrors.ErrZeroNewShares
+	}
+	totalShares, err := add(s.TotalShares, newShares)
+	if err != nil {
+		return nil, s, err
+	}
+	if totalShares.Cmp(MaxTotalShares) > 0 {
+		return nil, s, ErrMaxTotalShares
+	}
+	after := State{TotalShares: totalShares, Balance: balanceAfter}
+	if err := after.checkExchangeRate(); err != nil {
+		return nil, s, err
+	}
+	return newShares, after, nil
+}
+
+// Withdraw returns the amount of underlying token StrategyBase.withdraw pays out for
+// amountShares and the state after the withdrawal. It returns ErrInsufficientShares if
+// amountShares exceeds the total shares.
+func (s State) Withdraw(amountShares *big.Int) (*big.Int, State, error) {
+	if amountShares.Sign() < 0 {
+		return nil, s, errNegativeAmount
+	}
+	if amountShares.Cmp(s.TotalShares) > 0 {
+		return nil, s, ErrInsufficientShares
+	}
+	amountOut, err := s.SharesToUnderlying(amountShares)
+	if err != nil {
+		return nil, s, err
+	}
+	after := State{
+		TotalShares: new(big.Int).Sub(s.TotalShares, amountShares),
+		Balance:     new(big.Int).Sub(s.Balance, amountOut),
+	}
+	if err := after.checkExchangeRate(); err != nil {
+		return nil, s, err
+	}
+	return amountOut, after, nil
+}
+
+// SharesToUnderlying returns StrategyBase.sharesToUnderlyingView(amountShares).
+func (s State) SharesToUnderlying(amountShares *big.Int) (*big.Int, error) {
+	virtualBalance, err := add(s.Balance, BalanceOffset)
+	if err != nil {
+		return nil, err
+	}
+	product, err := mul(virtualBalance, amountShares)
+	if err != nil {
+		return nil, err
+	}
+	return product.Div(product, new(big.Int).Add(s.TotalShares, SharesOffset)), nil
+}
+
+// UnderlyingToShares returns StrategyBase.underlyingToSharesView(amountUnderlying).
+func (s State) UnderlyingToShares(amountUnderlying *big.Int) (*big.Int, error) {
+	virtualShares, err := add(s.TotalShares, SharesOffset)
+	if err != nil {
+		return nil, err
+	}
+	product, err := mul(amountUnderlying, virtualShares)
+	if err != nil {
+		return nil, err
+	}
+	return product.Div(product, new(big.Int).Add(s.Balance, BalanceOffset)), nil
+}
+
+// ExchangeRate returns the rate StrategyBase emits in ExchangeRateEmitted for the state: the
+// underlying token per share, scaled by 1e18.
+func (s State) ExchangeRate() *big.Int {
+	rate := new(big.Int).Add(s.Balance, BalanceOffset)
+	rate.Mul(rate, exchangeRateScale)
+	return rate.Div(rate, new(big.Int).Add(s.TotalShares, SharesOffset))
+}
+
+// checkExchangeRate returns ErrArithmeticOverflow if computing the ExchangeRateEmitted of the
+// state overflows, which reverts the deposit or withdrawal that leads to it.
+func (s State) checkExchangeRate() error {
+	_, err := mul(new(big.Int).Add(s.Balance, BalanceOffset), exchangeRateScale)
+	return err
+}
+
+// add and mul return x+y and x*y, or ErrArithmeticOverflow if the result does not fit in a
+// uint256, as the checked arithmetic of the contract would revert.
+func add(x, y *big.Int) (*big.Int, error) {
+	return checked(new(big.Int).Add(x, y))
+}
+
+func mul(x, y *big.Int) (*big.Int, error) {
+	return checked(new(big.Int).Mul(x, y))
+}
+
+func checked(z *big.Int) (*big.Int, error) {
+	if z.Cmp(math.MaxBig256) > 0 {
+		return nil, ErrArithmeticOverflow
+	}
+	return z, nil
+}
@@ -0,0 +1,137 @@
+package shares
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// uint256 decodes b as an unsigned integer of at most 32 bytes.
+func uint256(b []byte) *big.Int {
+	if len(b) > 32 {
+		b = b[:32]
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+// fuzzState decodes a strategy state, reporting false for states the contract cannot reach:
+// total shares above MaxTotalShares, or a balance whose virtual balance overflows.
+func fuzzState(totalShares, balance []byte) (State, bool) {
+	s := State{TotalShares: uint256(totalShares), Balance: uint256(balance)}
+	if s.TotalShares.Cmp(MaxTotalShares) > 0 {
+		return s, false
+	}
+	if _, err := add(s.Balance, BalanceOffset); err != nil {
+		return s, false
+	}
+	return s, true
+}
+
+func addFuzzSeeds(f *testing.F) {
+	f.Add([]byte{}, []byte{}, []byte{0x01}, []byte{0x02})
+	f.Add([]byte{0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, []byte{0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, []byte{0x03, 0xe8}, []byte{0x03, 0xe9})
+	// An inflated strategy: one share backed by 1e18 tokens.
+	f.Add([]byte{0x01}, []byte{0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, []byte{0x01}, []byte{0x0d, 0xe0})
+	f.Add([]byte{0x4b, 0x3b, 0x4c, 0xa8, 0x5a, 0x86, 0xc4, 0x7a, 0x09, 0x8a, 0x22, 0x3f, 0xff, 0xff, 0xff, 0xff}, []byte{0xff, 0xff, 0xff, 0xff, 0xff}, []byte{0xff}, []byte{0xff, 0xff})
+}
+
+func FuzzSharesToUnderlying(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, totalShares, balance, x, y []byte) {
+		s, ok := fuzzState(totalShares, balance)
+		if !ok {
+			return
+		}
+		a, b := uint256(x), uint256(y)
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+
+		ua, err := s.SharesToUnderlying(a)
+		if err != nil {
+			return
+		}
+		ub, err := s.SharesToUnderlying(b)
+		if err != nil {
+			return
+		}
+		if ua.Cmp(ub) > 0 {
+			t.Fatalf("not monotonic: SharesToUnderlying(%s) = %s > SharesToUnderlying(%s) = %s", a, ua, b, ub)
+		}
+
+		// Converting back rounds down twice, so it never yields more shares than were converted.
+		back, err := s.UnderlyingToShares(ua)
+		if err != nil {
+			t.Fatalf("UnderlyingToShares(%s) of a converted amount: %v", ua, err)
+		}
+		if back.Cmp(a) > 0 {
+			t.Fatalf("round trip gained shares: %s -> %s -> %s", a, ua, back)
+		}
+
+		// Withdrawing shares pays out their value, which a strategy holding at least one token per
+		// share can always cover.
+		if a.Cmp(s.TotalShares) <= 0 && s.Balance.Cmp(s.TotalShares) >= 0 {
+			out, after, err := s.Withdraw(a)
+			if err != nil {
+				t.Fatalf("Withdraw(%s): %v", a, err)
+			}
+			if out.Cmp(ua) != 0 {
+				t.Fatalf("Withdraw(%s) paid %s, SharesToUnderlying gives %s", a, out, ua)
+			}
+			if after.Balance.Sign() < 0 {
+				t.Fatalf("Withdraw(%s) paid %s out of a balance of %s", a, out, s.Balance)
+			}
+		}
+	})
+}
+
+func FuzzUnderlyingToShares(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, totalShares, balance, x, y []byte) {
+		s, ok := fuzzState(totalShares, balance)
+		if !ok {
+			return
+		}
+		a, b := uint256(x), uint256(y)
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+
+		sa, err := s.UnderlyingToShares(a)
+		if err != nil {
+			return
+		}
+		sb, err := s.UnderlyingToShares(b)
+		if err != nil {
+			return
+		}
+		if sa.Cmp(sb) > 0 {
+			t.Fatalf("not monotonic: UnderlyingToShares(%s) = %s > UnderlyingToShares(%s) = %s", a, sa, b, sb)
+		}
+
+		// Converting back rounds down twice, so it never yields more tokens than were converted.
+		back, err := s.SharesToUnderlying(sa)
+		if err != nil {
+			t.Fatalf("SharesToUnderlying(%s) of a converted amount: %v", sa, err)
+		}
+		if back.Cmp(a) > 0 {
+			t.Fatalf("round trip gained tokens: %s -> %s -> %s", a, sa, back)
+		}
+
+		// Depositing and withdrawing straight away never returns more than was deposited.
+		newShares, after, err := s.Deposit(a)
+		if err != nil {
+			return
+		}
+		out, _, err := after.Withdraw(newShares)
+		if errors.Is(err, ErrArithmeticOverflow) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("Withdraw(%s) after Deposit(%s): %v", newShares, a, err)
+		}
+		if out.Cmp(a) > 0 {
+			t.Fatalf("Deposit(%s) then Withdraw(%s) paid %s", a, newShares, out)
+		}
+	})
+}
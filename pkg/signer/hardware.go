@@ -0,0 +1,156 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/decoder"
+)
+
+// ErrRejected is returned by the transactors of FromWallet for transactions rejected by the
+// HardwareConfig's Confirm.
+var ErrRejected = errors.New("transaction rejected")
+
+// SignRequest is a transaction about to be sent to a hardware wallet for signing.
+type SignRequest struct {
+	Account accounts.Account
+	Tx      *types.Transaction
+	ChainID *big.Int
+	// Call is the decoded calldata of the transaction, nil if it is no call of a contract in
+	// pkg/bindings.
+	Call *decoder.Decoded
+	// Summary describes the transaction. It spells out deposits, delegations and queued
+	// withdrawals, which devices only show as raw calldata, so that they can be checked before
+	// blind signing on the device.
+	Summary string
+}
+
+// HardwareConfig configures the transactors of FromWallet.
+type HardwareConfig struct {
+	// Confirm is called with every transaction before it is sent to the device, typically to
+	// show its summary and ask for confirmation. An error rejects the transaction, and is
+	// returned wrapped in ErrRejected. It defaults to confirming every transaction.
+	Confirm func(ctx context.Context, req *SignRequest) error
+	// Decoder decodes the calldata of transactions. It defaults to a Decoder that knows no
+	// addresses.
+	Decoder *decoder.Decoder
+}
+
+// HardwareAccount derives the account at the BIP-32 path of the open wallet, such as
+// DefaultDerivationPath, and pins it so that the wallet signs with it.
+func HardwareAccount(wallet accounts.Wallet, path string) (accounts.Account, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("invalid derivation path %q: %w", path, err)
+	}
+	account, err := wallet.Derive(derivationPath, true)
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("failed to derive %s: %w", path, err)
+	}
+	return account, nil
+}
+
+// FromWallet returns a transactor signing with account of wallet for chainID. wallet is any
+// go-ethereum accounts.Wallet, such as the Ledger and Trezor wallets of the usbwallet hubs, and
+// must be open:
+//
+//	hub, _ := usbwallet.NewLedgerHub()
+//	wallet := hub.Wallets()[0]
+//	_ = wallet.Open("")
+//	account, _ := signer.HardwareAccount(wallet, signer.DefaultDerivationPath)
+//	opts, err := signer.FromWallet(wallet, account, chainID, signer.HardwareConfig{Confirm: prompt})
+//
+// Each transaction is summarized and passed to cfg.Confirm before it is sent to the device, where
+// it must be confirmed again.
+func FromWallet(wallet accounts.Wallet, account accounts.Account, chainID *big.Int, cfg HardwareConfig) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, errors.New("no chain ID")
+	}
+	if !wallet.Contains(account) {
+		return nil, fmt.Errorf("wallet %s does not contain %s", wallet.URL(), account.Address)
+	}
+	opts := &bind.TransactOpts{From: account.Address, Context: context.Background()}
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != account.Address {
+			return nil, bind.ErrNotAuthorized
+		}
+		req := &SignRequest{Account: account, Tx: tx, ChainID: chainID}
+		if tx.To() != nil && cfg.Decoder != nil {
+			req.Call, _ = cfg.Decoder.DecodeCalldata(*tx.To(), tx.Data())
+		} else if tx.To() != nil {
+			req.Call, _ = decoder.DecodeCalldata(*tx.To(), tx.Data())
+		}
+		req.Summary = Summarize(tx, req.Call)
+		if cfg.Confirm != nil {
+			if err := cfg.Confirm(opts.Context, req); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrRejected, err)
+			}
+		}
+		signed, err := wallet.SignTx(account, tx, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign with %s: %w", wallet.URL(), err)
+		}
+		return signed, nil
+	}
+	return opts, nil
+}
+
+// Summarize describes tx, whose decoded calldata is call, or nil if it was not decoded.
+func Summarize(tx *types.Transaction, call *decoder.Decoded) string {
+	if tx.To() == nil {
+		return fmt.Sprintf("deploy a contract (%d bytes of init code)", len(tx.Data()))
+	}
+	to := tx.To().Hex()
+	if len(tx.Data()) == 0 {
+		return fmt.Sprintf("send %s wei to %s", tx.Value(), to)
+	}
+	if call == nil {
+		return fmt.Sprintf("call %s with unknown calldata 0x%x", to, tx.Data()[:min(4, len(tx.Data()))])
+	}
+
+	var summary string
+	switch call.Name {
+	case "depositIntoStrategy":
+		summary = fmt.Sprintf("deposit %v of token %s into strategy %s", call.Args["amount"], hex(call.Args["token"]), hex(call.Args["strategy"]))
+	case "delegateTo":
+		summary = fmt.Sprintf("delegate all restaked shares to operator %s", hex(call.Args["operator"]))
+	case "queueWithdrawals":
+		params := *abi.ConvertType(call.Args["queuedWithdrawalParams"], new([]DelegationManager.IDelegationManagerQueuedWithdrawalParams)).(*[]DelegationManager.IDelegationManagerQueuedWithdrawalParams)
+		var b strings.Builder
+		fmt.Fprintf(&b, "queue %d withdrawal(s):", len(params))
+		for _, p := range params {
+			fmt.Fprintf(&b, " [to %s:", p.Withdrawer.Hex())
+			for i, strategy := range p.Strategies {
+				if i < len(p.Shares) {
+					fmt.Fprintf(&b, " %s shares of %s", p.Shares[i], strategy.Hex())
+				}
+			}
+			b.WriteString("]")
+		}
+		summary = b.String()
+	default:
+		summary = fmt.Sprintf("call %s.%s", call.Contract, call.Signature)
+	}
+	summary += " at " + to
+	if tx.Value().Sign() > 0 {
+		summary += fmt.Sprintf(" sending %s wei", tx.Value())
+	}
+	return summary
+}
+
+func hex(v interface{}) string {
+	if addr, ok := v.(common.Address); ok {
+		return addr.Hex()
+	}
+	return fmt.Sprint(v)
+}
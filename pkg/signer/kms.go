@@ -0,0 +1,177 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// KMSKey is a secp256k1 key held by a key management service, which signs digests without
+// exposing the private key.
+type KMSKey interface {
+	// PublicKey returns the DER encoded SubjectPublicKeyInfo of the key.
+	PublicKey(ctx context.Context) ([]byte, error)
+	// SignDigest returns the DER encoded ECDSA signature of the 32 byte digest.
+	SignDigest(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+// AWSKMSClient is the part of the AWS KMS API used by AWSKMSKey. It is implemented over the
+// kms.Client of the AWS SDK by calling GetPublicKey and returning its PublicKey, and by calling
+// Sign with MessageType DIGEST and SigningAlgorithm ECDSA_SHA_256 and returning its Signature.
+type AWSKMSClient interface {
+	GetPublicKey(ctx context.Context, keyID string) ([]byte, error)
+	Sign(ctx context.Context, keyID string, digest []byte) ([]byte, error)
+}
+
+// AWSKMSKey is an AWS KMS key of spec ECC_SECG_P256K1.
+type AWSKMSKey struct {
+	Client AWSKMSClient
+	KeyID  string
+}
+
+// PublicKey returns the public key of the KMS key.
+func (k AWSKMSKey) PublicKey(ctx context.Context) ([]byte, error) {
+	return k.Client.GetPublicKey(ctx, k.KeyID)
+}
+
+// SignDigest signs digest with the KMS key.
+func (k AWSKMSKey) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	return k.Client.Sign(ctx, k.KeyID, digest)
+}
+
+// GCPKMSClient is the part of the Cloud KMS API used by GCPKMSKey. It is implemented over the
+// KeyManagementClient of the Cloud KMS SDK by calling GetPublicKey and returning its Pem, and by
+// calling AsymmetricSign with the digest as its sha256 digest and returning its Signature.
+type GCPKMSClient interface {
+	GetPublicKey(ctx context.Context, name string) (string, error)
+	AsymmetricSign(ctx context.Context, name string, digest []byte) ([]byte, error)
+}
+
+// GCPKMSKey is a Cloud KMS key version of algorithm EC_SIGN_SECP256K1_SHA256, named
+// projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*.
+type GCPKMSKey struct {
+	Client GCPKMSClient
+	Name   string
+}
+
+// PublicKey returns the public key of the key version.
+func (k GCPKMSKey) PublicKey(ctx context.Context) ([]byte, error) {
+	encoded, err := k.Client.GetPublicKey(ctx, k.Name)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	return block.Bytes, nil
+}
+
+// SignDigest signs digest with the key version.
+func (k GCPKMSKey) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	return k.Client.AsymmetricSign(ctx, k.Name, digest)
+}
+
+// FromKMS returns a transactor signing with key for chainID. The public key, and so the
+// transactor's address, is fetched once with ctx. Transactions are signed with
+// context.Background, so the KMS client's own timeouts apply.
+func FromKMS(ctx context.Context, key KMSKey, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, errors.New("no chain ID")
+	}
+	der, err := key.PublicKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch KMS public key: %w", err)
+	}
+	pub, err := parsePublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	from := crypto.PubkeyToAddress(*pub)
+	txSigner := types.LatestSignerForChainID(chainID)
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			hash := txSigner.Hash(tx)
+			sig, err := signKMS(context.Background(), key, pub, hash.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(txSigner, sig)
+		},
+		Context: ctx,
+	}, nil
+}
+
+// signKMS signs digest with key and returns the signature in the [R || S || V] format of
+// crypto.Sign, with S in the lower half of the curve order and V the recovery id of pub.
+func signKMS(ctx context.Context, key KMSKey, pub *ecdsa.PublicKey, digest []byte) ([]byte, error) {
+	der, err := key.SignDigest(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with KMS: %w", err)
+	}
+	var parsed struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid KMS signature: %w", err)
+	}
+	// the KMS may return either of the two valid S; Ethereum only accepts the lower one
+	n := crypto.S256().Params().N
+	if parsed.S.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		parsed.S.Sub(n, parsed.S)
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	parsed.R.FillBytes(sig[:32])
+	parsed.S.FillBytes(sig[32:64])
+	expected := crypto.FromECDSAPub(pub)
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		recovered, err := crypto.Ecrecover(digest, sig)
+		if err == nil && bytes.Equal(recovered, expected) {
+			return sig, nil
+		}
+	}
+	return nil, errors.New("KMS signature does not recover to the key's public key")
+}
+
+// parsePublicKey parses a DER encoded secp256k1 SubjectPublicKeyInfo, which crypto/x509 does
+// not support.
+func parsePublicKey(der []byte) (*ecdsa.PublicKey, error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid KMS public key: %w", err)
+	}
+	var curve asn1.ObjectIdentifier
+	if !info.Algorithm.Algorithm.Equal(oidECPublicKey) {
+		return nil, fmt.Errorf("KMS key is not an EC key: algorithm %s", info.Algorithm.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+		return nil, errors.New("KMS key is not a secp256k1 key")
+	}
+	pub, err := crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KMS public key: %w", err)
+	}
+	return pub, nil
+}
@@ -0,0 +1,175 @@
+// Package signer builds the bind.TransactOpts the bindings send transactions with from the key
+// formats scripts are given: Web3 Secret Storage keystore files, hex private keys, and BIP-39
+// mnemonics derived along a BIP-32 path, as well as secp256k1 keys held by AWS KMS or Cloud KMS,
+// which never leave the service, and hardware wallets. Every transactor signs with the EIP-155 signer of its
+// chain ID, so its transactions cannot be replayed on other chains.
+//
+//	opts, err := signer.FromMnemonic(os.Getenv("MNEMONIC"), "", signer.DefaultDerivationPath, chainID)
+//	tx, err := c.StrategyManager.DepositIntoStrategy(opts, strategy, token, amount)
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultDerivationPath is the path of the first account of Ethereum wallets, as derived by
+// MetaMask, Ledger Live and geth.
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// ErrInvalidMnemonic is returned for mnemonics that do not have 12, 15, 18, 21 or 24 words of
+// the English BIP-39 wordlist, or whose checksum does not match.
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// FromKey returns a transactor signing with key for chainID.
+func FromKey(key *ecdsa.PrivateKey, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, errors.New("no chain ID")
+	}
+	return bind.NewKeyedTransactorWithChainID(key, chainID)
+}
+
+// FromHexKey returns a transactor signing with the hex encoded private key, with or without 0x
+// prefix, for chainID.
+func FromHexKey(hexKey string, chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return FromKey(key, chainID)
+}
+
+// FromKeystore returns a transactor signing with the key of the keystore file at path,
+// decrypted with password, for chainID.
+func FromKeystore(path, password string, chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := ReadKeystore(path, password)
+	if err != nil {
+		return nil, err
+	}
+	return FromKey(key, chainID)
+}
+
+// ReadKeystore returns the private key of the keystore file at path, decrypted with password,
+// for signing more than transactions, such as EIP-712 approvals.
+func ReadKeystore(path, password string) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return key.PrivateKey, nil
+}
+
+// FromKeystoreJSON returns a transactor signing with the key of the encrypted keystore keyJSON,
+// decrypted with password, for chainID.
+func FromKeystoreJSON(keyJSON []byte, password string, chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return FromKey(key.PrivateKey, chainID)
+}
+
+// FromMnemonic returns a transactor signing with the key derived from the BIP-39 mnemonic and
+// passphrase along the BIP-32 path, such as DefaultDerivationPath, for chainID.
+func FromMnemonic(mnemonic, passphrase, path string, chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := DeriveKey(mnemonic, passphrase, path)
+	if err != nil {
+		return nil, err
+	}
+	return FromKey(key, chainID)
+}
+
+// DeriveKey derives the private key of the BIP-39 mnemonic and passphrase at the BIP-32 path.
+// The mnemonic checksum catches most mistyped words, but not a wrong passphrase, which derives
+// a different, valid key; compare the address with the expected one.
+func DeriveKey(mnemonic, passphrase, path string) (*ecdsa.PrivateKey, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+	}
+	seed, err := mnemonicSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return deriveKey(seed, derivationPath)
+}
+
+// mnemonicSeed validates the words and checksum of the BIP-39 mnemonic and returns its seed.
+func mnemonicSeed(mnemonic, passphrase string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("%w: %d words", ErrInvalidMnemonic, len(words))
+	}
+	mnemonic = strings.Join(words, " ")
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); errors.Is(err, bip39.ErrChecksumIncorrect) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidMnemonic)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// deriveKey derives the private key at path from the BIP-32 master seed.
+func deriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	n := crypto.S256().Params().N
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+	if key.Sign() == 0 || key.Cmp(n) >= 0 {
+		return nil, errors.New("invalid master key")
+	}
+
+	for _, index := range path {
+		data := make([]byte, 0, 37)
+		if index >= 0x80000000 {
+			data = append(data, 0)
+			data = append(data, crypto.FromECDSA(toECDSA(key))...)
+		} else {
+			data = append(data, crypto.CompressPubkey(&toECDSA(key).PublicKey)...)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(n) >= 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		key = tweak.Add(tweak, key).Mod(tweak, n)
+		if key.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		chainCode = sum[32:]
+	}
+	return toECDSA(key), nil
+}
+
+func toECDSA(d *big.Int) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(d.FillBytes(make([]byte, 32)))
+	if err != nil {
+		// d is in [1, n) by construction
+		panic(err)
+	}
+	return key
+}
@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const hardened = 0x80000000
+
+// xprvKey returns the private key of a base58check encoded BIP-32 extended private key.
+func xprvKey(t *testing.T, xprv string) []byte {
+	t.Helper()
+	const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	n := new(big.Int)
+	for _, c := range xprv {
+		digit := strings.IndexRune(alphabet, c)
+		if digit < 0 {
+			t.Fatalf("invalid base58 character %q", c)
+		}
+		n.Mul(n, big.NewInt(58)).Add(n, big.NewInt(int64(digit)))
+	}
+	// version (4) | depth (1) | fingerprint (4) | child number (4) | chain code (32) | 0x00 key (33) | checksum (4)
+	data := n.FillBytes(make([]byte, 82))
+	first := sha256.Sum256(data[:78])
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], data[78:]) {
+		t.Fatalf("bad checksum in %s", xprv)
+	}
+	return data[46:78]
+}
+
+// TestDeriveKeyBIP32Vectors checks deriveKey against test vectors 1 to 3 of BIP-32.
+func TestDeriveKeyBIP32Vectors(t *testing.T) {
+	const (
+		seed1 = "000102030405060708090a0b0c0d0e0f"
+		seed2 = "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"
+		seed3 = "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be"
+	)
+
+	tests := []struct {
+		name string
+		seed string
+		path accounts.DerivationPath
+		xprv string
+	}{
+		{"vector 1 m", seed1, accounts.DerivationPath{}, "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+		{"vector 1 m/0H", seed1, accounts.DerivationPath{hardened}, "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+		{"vector 1 m/0H/1", seed1, accounts.DerivationPath{hardened, 1}, "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+		{"vector 1 m/0H/1/2H", seed1, accounts.DerivationPath{hardened, 1, hardened + 2}, "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+		{"vector 1 m/0H/1/2H/2", seed1, accounts.DerivationPath{hardened, 1, hardened + 2, 2}, "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+		{"vector 1 m/0H/1/2H/2/1000000000", seed1, accounts.DerivationPath{hardened, 1, hardened + 2, 2, 1000000000}, "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+		{"vector 2 m", seed2, accounts.DerivationPath{}, "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U"},
+		{"vector 2 m/0", seed2, accounts.DerivationPath{0}, "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt"},
+		{"vector 2 m/0/2147483647H", seed2, accounts.DerivationPath{0, hardened + 2147483647}, "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9"},
+		{"vector 2 m/0/2147483647H/1", seed2, accounts.DerivationPath{0, hardened + 2147483647, 1}, "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef"},
+		{"vector 2 m/0/2147483647H/1/2147483646H", seed2, accounts.DerivationPath{0, hardened + 2147483647, 1, hardened + 2147483646}, "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc"},
+		{"vector 2 m/0/2147483647H/1/2147483646H/2", seed2, accounts.DerivationPath{0, hardened + 2147483647, 1, hardened + 2147483646, 2}, "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j"},
+		// Vector 3 has a private key with a leading zero byte.
+		{"vector 3 m", seed3, accounts.DerivationPath{}, "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6"},
+		{"vector 3 m/0H", seed3, accounts.DerivationPath{hardened}, "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := deriveKey(common.FromHex(tt.seed), tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := crypto.FromECDSA(key), xprvKey(t, tt.xprv); !bytes.Equal(got, want) {
+				t.Errorf("deriveKey() = %x, want %x", got, want)
+			}
+		})
+	}
+}
+
+// TestMnemonicSeedBIP39Vectors checks mnemonicSeed against the English test vectors of BIP-39,
+// which use the passphrase "TREZOR".
+func TestMnemonicSeedBIP39Vectors(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		seed     string
+	}{
+		{
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+			"ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+		},
+		{
+			"legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will",
+			"f2b94508732bcbacbcc020faefecfc89feafa6649a5491b8c952cede496c214a0c7b3c392d168748f2d4a612bada0753b52a1c7ac53c1e93abd5c6320b9e95dd",
+		},
+		{
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo when",
+			"0cd6e5d827bb62eb8fc1e262254223817fd068a74b5b449cc2f667c3f1f985a76379b43348d952e2265b4cd129090758b3e3c2c49103b5051aac2eaeb890a528",
+		},
+		{
+			"beyond stage sleep clip because twist token leaf atom beauty genius food business side grid unable middle armed observe pair crouch tonight away coconut",
+			"b15509eaa2d09d3efd3e006ef42151b30367dc6e3aa5e44caba3fe4d3e352e65101fbdb86a96776b91946ff06f8eac594dc6ee1d3e82a42dfe1b40fef6bcc3fd",
+		},
+	}
+
+	for _, tt := range tests {
+		seed, err := mnemonicSeed(tt.mnemonic, "TREZOR")
+		if err != nil {
+			t.Errorf("mnemonicSeed(%q): %v", tt.mnemonic, err)
+			continue
+		}
+		if got := common.Bytes2Hex(seed); got != tt.seed {
+			t.Errorf("mnemonicSeed(%q) = %s, want %s", tt.mnemonic, got, tt.seed)
+		}
+	}
+}
+
+func TestMnemonicSeedInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+	}{
+		{"too few words", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"},
+		{"13 words", "legal winner thank year wave sausage worth useful legal winner thank yellow yellow"},
+		{"unknown word", "letter advice cage absurd amount doctor acoustic avoid letter advice caged above"},
+		{"bad checksum", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"},
+		{"bad checksum 24 words", "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo voted"},
+		{"punctuation", "renew, stay, biology, evidence, goat, welcome, casual, join, adapt, armor, shuffle, fault, little, machine, walk, stumble, urge, swap"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := mnemonicSeed(tt.mnemonic, ""); !errors.Is(err, ErrInvalidMnemonic) {
+				t.Errorf("mnemonicSeed() error = %v, want %v", err, ErrInvalidMnemonic)
+			}
+		})
+	}
+}
+
+// TestDeriveKey checks the first account of the mnemonic used by Hardhat and Anvil.
+func TestDeriveKey(t *testing.T) {
+	const mnemonic = "test test test test test test test test test test test junk"
+	key, err := DeriveKey(" "+strings.ReplaceAll(mnemonic, " ", "\n  ")+"\n", "", DefaultDerivationPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	if got := crypto.PubkeyToAddress(key.PublicKey); got != want {
+		t.Errorf("DeriveKey() address = %s, want %s", got, want)
+	}
+}
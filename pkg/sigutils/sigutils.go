@@ -0,0 +1,160 @@
+// Package sigutils computes and signs the EIP-712 digests checked by the EigenLayer core
+// contracts.
+//
+// Every contract uses the domain EIP712Domain(string name,uint256 chainId,address verifyingContract)
+// with the name "EigenLayer", and hashes digests as keccak256("\x19\x01" || domainSeparator || structHash).
+// Signatures are verified with EIP1271SignatureUtils, so an EOA signs the digest directly with no
+// "\x19Ethereum Signed Message" prefix.
+package sigutils
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+)
+
+// DomainName is the EIP-712 domain name used by every core contract.
+const DomainName = "EigenLayer"
+
+// Typehashes matching the constants in DelegationManagerStorage, StrategyManagerStorage and
+// AVSDirectoryStorage.
+var (
+	DomainTypehash                  = crypto.Keccak256Hash([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)"))
+	StakerDelegationTypehash        = crypto.Keccak256Hash([]byte("StakerDelegation(address staker,address operator,uint256 nonce,uint256 expiry)"))
+	DelegationApprovalTypehash      = crypto.Keccak256Hash([]byte("DelegationApproval(address delegationApprover,address staker,address operator,bytes32 salt,uint256 expiry)"))
+	DepositTypehash                 = crypto.Keccak256Hash([]byte("Deposit(address staker,address strategy,address token,uint256 amount,uint256 nonce,uint256 expiry)"))
+	OperatorAVSRegistrationTypehash = crypto.Keccak256Hash([]byte("OperatorAVSRegistration(address operator,address avs,bytes32 salt,uint256 expiry)"))
+)
+
+// PermitTypehash is the EIP-2612 Permit typehash. Unlike the core contracts, tokens use their own
+// EIP-712 domain, which is read from the token's DOMAIN_SEPARATOR().
+var PermitTypehash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// DomainSeparator returns the domain separator of the contract at verifyingContract on chainID,
+// as returned by its domainSeparator() view.
+func DomainSeparator(chainID *big.Int, verifyingContract common.Address) common.Hash {
+	return hashWords(DomainTypehash, crypto.Keccak256Hash([]byte(DomainName)), chainID, verifyingContract)
+}
+
+// StakerDelegationDigest returns the digest a staker signs for DelegationManager.delegateToBySignature.
+// It matches DelegationManager.calculateStakerDelegationDigestHash.
+func StakerDelegationDigest(domainSeparator common.Hash, staker, operator common.Address, nonce, expiry *big.Int) common.Hash {
+	return typedDataHash(domainSeparator, hashWords(StakerDelegationTypehash, staker, operator, nonce, expiry))
+}
+
+// DelegationApprovalDigest returns the digest an operator's delegation approver signs to approve
+// staker delegating to operator. It matches DelegationManager.calculateDelegationApprovalDigestHash.
+func DelegationApprovalDigest(domainSeparator common.Hash, approver, staker, operator common.Address, salt [32]byte, expiry *big.Int) common.Hash {
+	return typedDataHash(domainSeparator, hashWords(DelegationApprovalTypehash, approver, staker, operator, common.Hash(salt), expiry))
+}
+
+// DepositDigest returns the digest a staker signs for StrategyManager.depositIntoStrategyWithSignature.
+// nonce is the staker's current StrategyManager.nonces value.
+func DepositDigest(domainSeparator common.Hash, staker, strategy, token common.Address, amount, nonce, expiry *big.Int) common.Hash {
+	return typedDataHash(domainSeparator, hashWords(DepositTypehash, staker, strategy, token, amount, nonce, expiry))
+}
+
+// OperatorAVSRegistrationDigest returns the digest an operator signs for AVSDirectory.registerOperatorToAVS.
+// It matches AVSDirectory.calculateOperatorAVSRegistrationDigestHash.
+func OperatorAVSRegistrationDigest(domainSeparator common.Hash, operator, avs common.Address, salt [32]byte, expiry *big.Int) common.Hash {
+	return typedDataHash(domainSeparator, hashWords(OperatorAVSRegistrationTypehash, operator, avs, common.Hash(salt), expiry))
+}
+
+// PermitDigest returns the digest a token owner signs for an EIP-2612 permit. domainSeparator is
+// the token's DOMAIN_SEPARATOR() and nonce its nonces(owner).
+func PermitDigest(domainSeparator common.Hash, owner, spender common.Address, value, nonce, deadline *big.Int) common.Hash {
+	return typedDataHash(domainSeparator, hashWords(PermitTypehash, owner, spender, value, nonce, deadline))
+}
+
+// Sign signs digest with key, returning a 65-byte signature with v in {27, 28} as expected by
+// OpenZeppelin's ECDSA.recover.
+func Sign(digest common.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// SignStakerDelegation signs a delegateToBySignature authorization from the staker controlling key
+// for the DelegationManager at delegationManager on chainID.
+func SignStakerDelegation(key *ecdsa.PrivateKey, chainID *big.Int, delegationManager, operator common.Address, nonce, expiry *big.Int) (DelegationManager.ISignatureUtilsSignatureWithExpiry, error) {
+	staker := crypto.PubkeyToAddress(key.PublicKey)
+	digest := StakerDelegationDigest(DomainSeparator(chainID, delegationManager), staker, operator, nonce, expiry)
+	sig, err := Sign(digest, key)
+	if err != nil {
+		return DelegationManager.ISignatureUtilsSignatureWithExpiry{}, err
+	}
+	return DelegationManager.ISignatureUtilsSignatureWithExpiry{Signature: sig, Expiry: expiry}, nil
+}
+
+// SignDelegationApproval signs an approval, from the delegation approver controlling key, for
+// staker to delegate to operator through the DelegationManager at delegationManager on chainID.
+func SignDelegationApproval(key *ecdsa.PrivateKey, chainID *big.Int, delegationManager, staker, operator common.Address, salt [32]byte, expiry *big.Int) (DelegationManager.ISignatureUtilsSignatureWithExpiry, error) {
+	approver := crypto.PubkeyToAddress(key.PublicKey)
+	digest := DelegationApprovalDigest(DomainSeparator(chainID, delegationManager), approver, staker, operator, salt, expiry)
+	sig, err := Sign(digest, key)
+	if err != nil {
+		return DelegationManager.ISignatureUtilsSignatureWithExpiry{}, err
+	}
+	return DelegationManager.ISignatureUtilsSignatureWithExpiry{Signature: sig, Expiry: expiry}, nil
+}
+
+// SignDeposit signs a depositIntoStrategyWithSignature authorization from the staker controlling key
+// for the StrategyManager at strategyManager on chainID.
+func SignDeposit(key *ecdsa.PrivateKey, chainID *big.Int, strategyManager, strategy, token common.Address, amount, nonce, expiry *big.Int) ([]byte, error) {
+	staker := crypto.PubkeyToAddress(key.PublicKey)
+	return Sign(DepositDigest(DomainSeparator(chainID, strategyManager), staker, strategy, token, amount, nonce, expiry), key)
+}
+
+// SignOperatorAVSRegistration signs the registration of the operator controlling key to avs
+// through the AVSDirectory at avsDirectory on chainID.
+func SignOperatorAVSRegistration(key *ecdsa.PrivateKey, chainID *big.Int, avsDirectory, avs common.Address, salt [32]byte, expiry *big.Int) (AVSDirectory.ISignatureUtilsSignatureWithSaltAndExpiry, error) {
+	operator := crypto.PubkeyToAddress(key.PublicKey)
+	digest := OperatorAVSRegistrationDigest(DomainSeparator(chainID, avsDirectory), operator, avs, salt, expiry)
+	sig, err := Sign(digest, key)
+	if err != nil {
+		return AVSDirectory.ISignatureUtilsSignatureWithSaltAndExpiry{}, err
+	}
+	return AVSDirectory.ISignatureUtilsSignatureWithSaltAndExpiry{Signature: sig, Salt: salt, Expiry: expiry}, nil
+}
+
+// SignPermit signs an EIP-2612 permit from the owner controlling key, for the token whose
+// DOMAIN_SEPARATOR() is domainSeparator.
+func SignPermit(key *ecdsa.PrivateKey, domainSeparator common.Hash, spender common.Address, value, nonce, deadline *big.Int) ([]byte, error) {
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	return Sign(PermitDigest(domainSeparator, owner, spender, value, nonce, deadline), key)
+}
+
+// typedDataHash returns keccak256("\x19\x01" || domainSeparator || structHash).
+func typedDataHash(domainSeparator, structHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// hashWords returns the keccak256 of the abi.encode of static words, each an address, a hash
+// or a non-negative *big.Int. A nil *big.Int encodes as zero.
+func hashWords(words ...interface{}) common.Hash {
+	encoded := make([]byte, 0, 32*len(words))
+	for _, word := range words {
+		switch w := word.(type) {
+		case common.Hash:
+			encoded = append(encoded, w.Bytes()...)
+		case common.Address:
+			encoded = append(encoded, common.LeftPadBytes(w.Bytes(), 32)...)
+		case *big.Int:
+			if w == nil {
+				w = new(big.Int)
+			}
+			encoded = append(encoded, common.LeftPadBytes(w.Bytes(), 32)...)
+		default:
+			panic("sigutils: unsupported word type")
+		}
+	}
+	return crypto.Keccak256Hash(encoded)
+}
@@ -0,0 +1,89 @@
+// Package simulate executes binding transactions as eth_calls, so that a transaction that would
+// revert is caught, with its decoded revert reason, before any gas is spent.
+//
+// Any transactor method of the bindings can be simulated by wrapping it in a Transact:
+//
+//	_, err := simulate.Call(ctx, client, staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return strategyManager.DepositIntoStrategy(opts, strategy, token, amount)
+//	})
+//	if errors.Is(err, elerrors.ErrMaxPerDepositExceeded) {
+//		...
+//	}
+package simulate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// buildGasLimit is the gas limit of transactions built by Opts. It is only set so that the
+// bindings skip gas estimation, which would fail for a reverting transaction, and is not used
+// by Simulate.
+const buildGasLimit = 30_000_000
+
+// Transact is a call of a binding transactor method, such as a closure over
+// StrategyManager.DepositIntoStrategy.
+type Transact func(opts *bind.TransactOpts) (*types.Transaction, error)
+
+// Opts returns transact options with which a binding transactor builds its transaction from
+// from without signing it, sending it or making any RPC calls. Only the recipient, value and
+// calldata of the built transaction are meaningful.
+func Opts(ctx context.Context, from common.Address) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:     from,
+		Nonce:    new(big.Int),
+		GasPrice: new(big.Int),
+		GasLimit: buildGasLimit,
+		Context:  ctx,
+		NoSend:   true,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+	}
+}
+
+// Build returns the unsigned transaction transact would send from from.
+func Build(ctx context.Context, from common.Address, transact Transact) (*types.Transaction, error) {
+	tx, err := transact(Opts(ctx, from))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// Simulate executes tx as an eth_call from from against the latest block and returns its
+// return data. If tx would revert, the error is decoded with elerrors.Decode, so it can be
+// matched against the typed errors of pkg/errors. The gas price and limit of tx are ignored.
+func Simulate(ctx context.Context, caller bind.ContractCaller, from common.Address, tx *types.Transaction) ([]byte, error) {
+	if tx.To() == nil {
+		return nil, errors.New("cannot simulate a contract creation")
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}, nil)
+	if err != nil {
+		return nil, elerrors.Decode(err)
+	}
+	return out, nil
+}
+
+// Call builds the transaction transact would send from from and simulates it.
+func Call(ctx context.Context, caller bind.ContractCaller, from common.Address, transact Transact) ([]byte, error) {
+	tx, err := Build(ctx, from, transact)
+	if err != nil {
+		return nil, err
+	}
+	return Simulate(ctx, caller, from, tx)
+}
@@ -0,0 +1,314 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+)
+
+// The Parquet file is written with a single row group of one uncompressed, PLAIN encoded page per
+// column, which is all a snapshot of a few hundred strategies needs. The file metadata is encoded
+// with the Thrift compact protocol, as defined in parquet.thrift.
+
+var parquetMagic = []byte("PAR1")
+
+// Physical types, converted types, repetition types, encodings and page types of parquet.thrift.
+const (
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetByteArray = 6
+
+	convertedNone            = -1
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+	convertedUint8           = 11
+	convertedUint64          = 14
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageData = 0
+)
+
+// parquetColumn is a column of the Parquet export. value appends the PLAIN encoding of the value
+// of the column for r to b, and reports false, leaving b unchanged, if the value is null.
+type parquetColumn struct {
+	name      string
+	physical  int32
+	converted int32
+	optional  bool
+	value     func(b []byte, snap *Snapshot, r *Row) ([]byte, bool)
+}
+
+// parquetColumns are the columns written by WriteParquet, which match those of WriteCSV. Amounts
+// are decimal strings, as they do not fit the 76 digits of the widest Parquet decimals readers
+// support.
+var parquetColumns = []parquetColumn{
+	{"block_number", parquetInt64, convertedUint64, false, func(b []byte, snap *Snapshot, _ *Row) ([]byte, bool) {
+		return binary.LittleEndian.AppendUint64(b, snap.BlockNumber), true
+	}},
+	{"time", parquetInt64, convertedTimestampMillis, false, func(b []byte, snap *Snapshot, _ *Row) ([]byte, bool) {
+		return binary.LittleEndian.AppendUint64(b, uint64(snap.Time.UnixMilli())), true
+	}},
+	{"strategy", parquetByteArray, convertedUTF8, false, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendByteArray(b, r.Strategy.Hex()), true
+	}},
+	{"token", parquetByteArray, convertedUTF8, false, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendByteArray(b, r.Token.Hex()), true
+	}},
+	{"symbol", parquetByteArray, convertedUTF8, false, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendByteArray(b, r.Symbol), true
+	}},
+	{"decimals", parquetInt32, convertedUint8, false, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return binary.LittleEndian.AppendUint32(b, uint32(r.Decimals)), true
+	}},
+	{"total_shares", parquetByteArray, convertedUTF8, true, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendAmount(b, r.TotalShares)
+	}},
+	{"underlying", parquetByteArray, convertedUTF8, true, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendAmount(b, r.Underlying)
+	}},
+	{"underlying_units", parquetByteArray, convertedUTF8, true, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		if r.Underlying == nil {
+			return b, false
+		}
+		return appendByteArray(b, r.UnderlyingUnits()), true
+	}},
+	{"token_balance", parquetByteArray, convertedUTF8, true, func(b []byte, _ *Snapshot, r *Row) ([]byte, bool) {
+		return appendAmount(b, r.TokenBalance)
+	}},
+}
+
+func appendByteArray(b []byte, s string) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func appendAmount(b []byte, x *big.Int) ([]byte, bool) {
+	if x == nil {
+		return b, false
+	}
+	return appendByteArray(b, x.String()), true
+}
+
+// WriteParquet writes snap as a Parquet file, one row per strategy, with the columns of WriteCSV.
+// Amounts are decimal strings in base units, and null when they are missing.
+func WriteParquet(w io.Writer, snap *Snapshot) error {
+	out := append([]byte(nil), parquetMagic...)
+
+	type chunk struct {
+		offset, size int64
+	}
+	var chunks []chunk
+	if len(snap.Rows) > 0 {
+		for _, col := range parquetColumns {
+			page := parquetPage(col, snap)
+			header := thriftPageHeader(len(snap.Rows), len(page), col.optional)
+			chunks = append(chunks, chunk{offset: int64(len(out)), size: int64(len(header) + len(page))})
+			out = append(out, header...)
+			out = append(out, page...)
+		}
+	}
+
+	var m compactWriter
+	m.i32(1, 1) // version
+	m.list(2, compactStruct, len(parquetColumns)+1)
+	m.beginStruct()
+	m.binary(4, "schema")
+	m.i32(5, int32(len(parquetColumns)))
+	m.endStruct()
+	for _, col := range parquetColumns {
+		m.beginStruct()
+		m.i32(1, col.physical)
+		repetition := int32(repetitionRequired)
+		if col.optional {
+			repetition = repetitionOptional
+		}
+		m.i32(3, repetition)
+		m.binary(4, col.name)
+		if col.converted != convertedNone {
+			m.i32(6, col.converted)
+		}
+		m.endStruct()
+	}
+	m.i64(3, int64(len(snap.Rows)))
+	if len(chunks) == 0 {
+		m.list(4, compactStruct, 0)
+	} else {
+		m.list(4, compactStruct, 1)
+		m.beginStruct()
+		m.list(1, compactStruct, len(parquetColumns))
+		var total int64
+		for i, col := range parquetColumns {
+			c := chunks[i]
+			total += c.size
+			m.beginStruct()
+			m.i64(2, c.offset)
+			m.beginField(3, compactStruct)
+			m.beginStruct()
+			m.i32(1, col.physical)
+			if col.optional {
+				m.list(2, compactI32, 2)
+				m.varint(zigzag(encodingPlain))
+				m.varint(zigzag(encodingRLE))
+			} else {
+				m.list(2, compactI32, 1)
+				m.varint(zigzag(encodingPlain))
+			}
+			m.list(3, compactBinary, 1)
+			m.bytes(col.name)
+			m.i32(4, 0) // UNCOMPRESSED
+			m.i64(5, int64(len(snap.Rows)))
+			m.i64(6, c.size)
+			m.i64(7, c.size)
+			m.i64(9, c.offset)
+			m.endStruct()
+			m.endStruct()
+		}
+		m.i64(2, total)
+		m.i64(3, int64(len(snap.Rows)))
+		m.endStruct()
+	}
+	m.binary(6, "eigenlayer-contracts snapshot")
+	m.stop()
+
+	out = append(out, m.buf...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(m.buf)))
+	out = append(out, parquetMagic...)
+	_, err := w.Write(out)
+	return err
+}
+
+// parquetPage returns the data of the page of col: the definition levels of an optional column,
+// then the non-null values.
+func parquetPage(col parquetColumn, snap *Snapshot) []byte {
+	var values []byte
+	defined := make([]bool, len(snap.Rows))
+	for i := range snap.Rows {
+		values, defined[i] = col.value(values, snap, &snap.Rows[i])
+	}
+	if !col.optional {
+		return values
+	}
+
+	// The levels are a single bit-packed run of the RLE/bit-packing hybrid with a bit width of 1,
+	// padded to a multiple of 8 values, and prefixed by their length.
+	groups := (len(defined) + 7) / 8
+	levels := binary.AppendUvarint(nil, uint64(groups)<<1|1)
+	packed := make([]byte, groups)
+	for i, ok := range defined {
+		if ok {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	levels = append(levels, packed...)
+
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	page = append(page, levels...)
+	return append(page, values...)
+}
+
+// thriftPageHeader returns the PageHeader of a data page of n values and size bytes.
+func thriftPageHeader(n, size int, optional bool) []byte {
+	var h compactWriter
+	h.i32(1, pageData)
+	h.i32(2, int32(size))
+	h.i32(3, int32(size))
+	h.beginField(5, compactStruct)
+	h.beginStruct()
+	h.i32(1, int32(n))
+	h.i32(2, encodingPlain)
+	h.i32(3, encodingRLE)
+	h.i32(4, encodingRLE)
+	h.endStruct()
+	h.stop()
+	return h.buf
+}
+
+// Types of the Thrift compact protocol.
+const (
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// compactWriter encodes a struct with the Thrift compact protocol. Fields are written in
+// increasing order of their ids within a struct, which the writer tracks to encode their ids as
+// deltas.
+type compactWriter struct {
+	buf  []byte
+	last []int16
+	id   int16
+}
+
+func (w *compactWriter) varint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+func (w *compactWriter) beginField(id int16, typ byte) {
+	if delta := id - w.id; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.varint(zigzag(int64(id)))
+	}
+	w.id = id
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.beginField(id, compactI32)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.beginField(id, compactI64)
+	w.varint(zigzag(v))
+}
+
+func (w *compactWriter) binary(id int16, s string) {
+	w.beginField(id, compactBinary)
+	w.bytes(s)
+}
+
+// bytes writes s without a field header, as an element of a list.
+func (w *compactWriter) bytes(s string) {
+	w.varint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// list writes the header of a list field of n elements of typ, which follow it.
+func (w *compactWriter) list(id int16, typ byte, n int) {
+	w.beginField(id, compactList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|typ)
+	} else {
+		w.buf = append(w.buf, 0xf0|typ)
+		w.varint(uint64(n))
+	}
+}
+
+// beginStruct starts a nested struct, a struct field or list element, which endStruct ends.
+func (w *compactWriter) beginStruct() {
+	w.last = append(w.last, w.id)
+	w.id = 0
+}
+
+func (w *compactWriter) endStruct() {
+	w.stop()
+	w.id = w.last[len(w.last)-1]
+	w.last = w.last[:len(w.last)-1]
+}
+
+// stop ends the outermost struct.
+func (w *compactWriter) stop() {
+	w.buf = append(w.buf, 0)
+}
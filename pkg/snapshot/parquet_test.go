@@ -0,0 +1,263 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// compactReader decodes the Thrift compact protocol into maps of field ids to values: int64 for
+// integers, string for binaries, []interface{} for lists and map[int16]interface{} for structs.
+type compactReader struct {
+	data []byte
+	err  error
+}
+
+func (r *compactReader) byte() byte {
+	if len(r.data) == 0 {
+		r.err = fmt.Errorf("unexpected end of data")
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+func (r *compactReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = fmt.Errorf("invalid varint")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *compactReader) varint() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *compactReader) value(typ byte) interface{} {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case 4, compactI32, compactI64:
+		return r.varint()
+	case compactBinary:
+		n := r.uvarint()
+		if uint64(len(r.data)) < n {
+			r.err = fmt.Errorf("truncated binary")
+			return ""
+		}
+		s := string(r.data[:n])
+		r.data = r.data[n:]
+		return s
+	case compactList:
+		h := r.byte()
+		n := uint64(h >> 4)
+		if n == 15 {
+			n = r.uvarint()
+		}
+		list := []interface{}{}
+		for i := uint64(0); i < n && r.err == nil; i++ {
+			list = append(list, r.value(h&0x0f))
+		}
+		return list
+	case compactStruct:
+		return r.readStruct()
+	}
+	r.err = fmt.Errorf("unexpected type %d", typ)
+	return nil
+}
+
+func (r *compactReader) readStruct() map[int16]interface{} {
+	fields := map[int16]interface{}{}
+	var id int16
+	for r.err == nil {
+		h := r.byte()
+		if h == 0 {
+			break
+		}
+		if delta := int16(h >> 4); delta != 0 {
+			id += delta
+		} else {
+			id = int16(r.varint())
+		}
+		fields[id] = r.value(h & 0x0f)
+	}
+	return fields
+}
+
+// readParquet decodes the file written by WriteParquet into its column names and rows.
+func readParquet(t *testing.T, file []byte) ([]string, [][]interface{}) {
+	t.Helper()
+	if !bytes.HasPrefix(file, parquetMagic) || !bytes.HasSuffix(file, parquetMagic) {
+		t.Fatalf("file is not framed by %s", parquetMagic)
+	}
+	footer := binary.LittleEndian.Uint32(file[len(file)-8:])
+	r := &compactReader{data: file[len(file)-8-int(footer) : len(file)-8]}
+	meta := r.readStruct()
+	if r.err != nil || len(r.data) != 0 {
+		t.Fatalf("invalid file metadata: %v, %d trailing bytes", r.err, len(r.data))
+	}
+
+	schema := meta[2].([]interface{})
+	root := schema[0].(map[int16]interface{})
+	if root[5] != int64(len(schema)-1) {
+		t.Fatalf("root schema has %v children, want %d", root[5], len(schema)-1)
+	}
+	var names []string
+	for _, s := range schema[1:] {
+		names = append(names, s.(map[int16]interface{})[4].(string))
+	}
+
+	numRows := int(meta[3].(int64))
+	rows := make([][]interface{}, numRows)
+	for i := range rows {
+		rows[i] = make([]interface{}, len(names))
+	}
+	groups := meta[4].([]interface{})
+	if numRows == 0 {
+		if len(groups) != 0 {
+			t.Fatalf("empty file has %d row groups", len(groups))
+		}
+		return names, rows
+	}
+	group := groups[0].(map[int16]interface{})
+	if group[3] != int64(numRows) {
+		t.Fatalf("row group has %v rows, want %d", group[3], numRows)
+	}
+	for c, chunk := range group[1].([]interface{}) {
+		element := schema[c+1].(map[int16]interface{})
+		col := chunk.(map[int16]interface{})[3].(map[int16]interface{})
+		if path := col[3].([]interface{}); len(path) != 1 || path[0] != names[c] {
+			t.Fatalf("column %d has path %v, want %s", c, path, names[c])
+		}
+		offset := col[9].(int64)
+		r := &compactReader{data: file[offset : offset+col[7].(int64)]}
+		header := r.readStruct()
+		if r.err != nil {
+			t.Fatalf("invalid page header of %s: %v", names[c], r.err)
+		}
+		if n := header[5].(map[int16]interface{})[1]; n != int64(numRows) {
+			t.Fatalf("page of %s has %v values, want %d", names[c], n, numRows)
+		}
+		page := r.data
+		if len(page) != int(header[2].(int64)) {
+			t.Fatalf("page of %s has %d bytes, header says %v", names[c], len(page), header[2])
+		}
+
+		defined := make([]bool, numRows)
+		for i := range defined {
+			defined[i] = true
+		}
+		if element[3] == int64(repetitionOptional) {
+			n := binary.LittleEndian.Uint32(page)
+			levels := &compactReader{data: page[4 : 4+n]}
+			if run := levels.uvarint(); run != uint64((numRows+7)/8)<<1|1 {
+				t.Fatalf("definition levels of %s start with run header %d", names[c], run)
+			}
+			for i := range defined {
+				defined[i] = levels.data[i/8]>>(i%8)&1 == 1
+			}
+			page = page[4+n:]
+		}
+		for i := range rows {
+			if !defined[i] {
+				continue
+			}
+			switch element[1] {
+			case int64(parquetInt32):
+				rows[i][c] = int64(int32(binary.LittleEndian.Uint32(page)))
+				page = page[4:]
+			case int64(parquetInt64):
+				rows[i][c] = int64(binary.LittleEndian.Uint64(page))
+				page = page[8:]
+			case int64(parquetByteArray):
+				n := binary.LittleEndian.Uint32(page)
+				rows[i][c] = string(page[4 : 4+n])
+				page = page[4+n:]
+			}
+		}
+		if len(page) != 0 {
+			t.Fatalf("page of %s has %d trailing bytes", names[c], len(page))
+		}
+	}
+	return names, rows
+}
+
+func TestWriteParquet(t *testing.T) {
+	at := time.Unix(1706000000, 0).UTC()
+	snap := &Snapshot{
+		BlockNumber: 19000000,
+		Time:        at,
+		Rows: []Row{
+			{
+				Strategy:     common.HexToAddress("0x93c4b944D05dfe6df7645A86cd2206016c51564D"),
+				Token:        common.HexToAddress("0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84"),
+				Symbol:       "stETH",
+				Decimals:     18,
+				TotalShares:  new(big.Int).Exp(big.NewInt(10), big.NewInt(77), nil),
+				Underlying:   big.NewInt(1500000000000000000),
+				TokenBalance: big.NewInt(1002),
+			},
+			{
+				Strategy: common.HexToAddress("0x54945180dB7943c0ed0FEE7EdaB2Bd24620256bc"),
+				Token:    common.HexToAddress("0xBe9895146f7AF43049ca1c1AE358B0541Ea49704"),
+				Symbol:   "cbETH",
+				Decimals: 6,
+			},
+		},
+	}
+	// Nine more rows take the definition levels past a byte.
+	for i := 0; i < 9; i++ {
+		snap.Rows = append(snap.Rows, Row{TotalShares: big.NewInt(int64(i)), Underlying: big.NewInt(0), TokenBalance: big.NewInt(0)})
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatParquet, snap); err != nil {
+		t.Fatal(err)
+	}
+	names, rows := readParquet(t, buf.Bytes())
+	if !reflect.DeepEqual(names, csvHeader) {
+		t.Errorf("columns = %v, want %v", names, csvHeader)
+	}
+	want := [][]interface{}{
+		{
+			int64(19000000), at.UnixMilli(), "0x93c4b944D05dfe6df7645A86cd2206016c51564D", "0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84", "stETH", int64(18),
+			"1" + strings.Repeat("0", 77), "1500000000000000000", "1.5", "1002",
+		},
+		{
+			int64(19000000), at.UnixMilli(), "0x54945180dB7943c0ed0FEE7EdaB2Bd24620256bc", "0xBe9895146f7AF43049ca1c1AE358B0541Ea49704", "cbETH", int64(6),
+			nil, nil, nil, nil,
+		},
+	}
+	for i := 0; i < 9; i++ {
+		zero := common.Address{}.Hex()
+		want = append(want, []interface{}{int64(19000000), at.UnixMilli(), zero, zero, "", int64(0), fmt.Sprint(i), "0", "0", "0"})
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %v\nwant %v", rows, want)
+	}
+}
+
+func TestWriteParquetEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, &Snapshot{BlockNumber: 1}); err != nil {
+		t.Fatal(err)
+	}
+	names, rows := readParquet(t, buf.Bytes())
+	if len(names) != len(csvHeader) || len(rows) != 0 {
+		t.Errorf("columns = %v, %d rows, want %d columns and no rows", names, len(rows), len(csvHeader))
+	}
+}
@@ -0,0 +1,218 @@
+// Package snapshot takes point-in-time snapshots of the TVL of strategies and exports them.
+//
+// Take reads the total shares, the underlying value of those shares and the token balance of
+// every strategy at a block, in two multicalls, and Write exports the result in a registered
+// format:
+//
+//	list, _ := registry.ListStrategies(ctx)
+//	snap, _ := snapshot.Take(ctx, client, list, big.NewInt(20_000_000))
+//	err := snapshot.Write(os.Stdout, snapshot.FormatCSV, snap)
+//
+// CSV, JSON and Parquet are built in. Other formats are added with RegisterFormat.
+package snapshot
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategies"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/strategy"
+)
+
+// Built-in formats.
+const (
+	FormatCSV     = "csv"
+	FormatJSON    = "json"
+	FormatParquet = "parquet"
+)
+
+// Backend is the chain access required by Take.
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Row is the TVL of a single strategy.
+type Row struct {
+	Strategy common.Address `json:"strategy"`
+	Token    common.Address `json:"token"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint8          `json:"decimals"`
+
+	TotalShares *big.Int `json:"totalShares"`
+	// Underlying is the value of TotalShares in the underlying token, in its base units.
+	Underlying *big.Int `json:"underlying"`
+	// TokenBalance is the strategy's balance of the underlying token, in its base units.
+	TokenBalance *big.Int `json:"tokenBalance"`
+}
+
+// UnderlyingUnits returns Underlying in whole tokens, as a decimal string.
+func (r *Row) UnderlyingUnits() string {
+	if r.Underlying == nil {
+		return ""
+	}
+	return amounts.FormatUnits(r.Underlying, r.Decimals)
+}
+
+// Snapshot is the TVL of a set of strategies at a block.
+type Snapshot struct {
+	BlockNumber uint64    `json:"blockNumber"`
+	Time        time.Time `json:"time"`
+	Rows        []Row     `json:"rows"`
+}
+
+// Take snapshots the TVL of list at blockNumber, or the latest block if nil.
+func Take(ctx context.Context, backend Backend, list []strategies.Strategy, blockNumber *big.Int) (*Snapshot, error) {
+	header, err := backend.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	callers := make([]*StrategyBase.StrategyBaseCaller, len(list))
+	batch := multicall.NewBatch(backend)
+	totals := make([]*multicall.Result[*big.Int], len(list))
+	balances := make([]*multicall.Result[*big.Int], len(list))
+	for i, s := range list {
+		if callers[i], err = StrategyBase.NewStrategyBaseCaller(s.Address, batch); err != nil {
+			return nil, err
+		}
+		totals[i] = multicall.Add(batch, callers[i].TotalShares)
+		s := s
+		balances[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return strategy.TokenBalance(opts, batch, s.Token, s.Address)
+		})
+	}
+	if err := batch.Execute(opts); err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{
+		BlockNumber: header.Number.Uint64(),
+		Time:        time.Unix(int64(header.Time), 0).UTC(),
+		Rows:        make([]Row, len(list)),
+	}
+	underlying := multicall.NewBatch(backend)
+	values := make([]*multicall.Result[*big.Int], len(list))
+	for i, s := range list {
+		row := &snap.Rows[i]
+		*row = Row{Strategy: s.Address, Token: s.Token, Symbol: s.Symbol, Decimals: s.Decimals}
+		if row.TotalShares, err = totals[i].Get(); err != nil {
+			return nil, fmt.Errorf("failed to fetch total shares of %s: %w", s.Address.Hex(), err)
+		}
+		if row.TokenBalance, err = balances[i].Get(); err != nil {
+			return nil, fmt.Errorf("failed to fetch token balance of %s: %w", s.Address.Hex(), err)
+		}
+		caller, err := StrategyBase.NewStrategyBaseCaller(s.Address, underlying)
+		if err != nil {
+			return nil, err
+		}
+		totalShares := row.TotalShares
+		values[i] = multicall.Add(underlying, func(opts *bind.CallOpts) (*big.Int, error) {
+			return caller.SharesToUnderlyingView(opts, totalShares)
+		})
+	}
+	if err := underlying.Execute(opts); err != nil {
+		return nil, err
+	}
+	for i := range snap.Rows {
+		if snap.Rows[i].Underlying, err = values[i].Get(); err != nil {
+			return nil, fmt.Errorf("failed to fetch underlying value of %s: %w", list[i].Address.Hex(), err)
+		}
+	}
+	return snap, nil
+}
+
+// Encoder writes a snapshot in some format.
+type Encoder func(w io.Writer, snap *Snapshot) error
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]Encoder{
+		FormatCSV:     WriteCSV,
+		FormatJSON:    WriteJSON,
+		FormatParquet: WriteParquet,
+	}
+)
+
+// RegisterFormat registers the encoder of format, replacing any previous one.
+func RegisterFormat(format string, encode Encoder) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	formats[format] = encode
+}
+
+// Formats returns the registered formats, sorted.
+func Formats() []string {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Write writes snap to w in format.
+func Write(w io.Writer, format string, snap *Snapshot) error {
+	formatsMu.RLock()
+	encode, ok := formats[format]
+	formatsMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("unknown snapshot format %q, expected one of %s", format, strings.Join(Formats(), ", "))
+	}
+	return encode(w, snap)
+}
+
+// csvHeader is the header row written by WriteCSV.
+var csvHeader = []string{
+	"block_number", "time", "strategy", "token", "symbol", "decimals",
+	"total_shares", "underlying", "underlying_units", "token_balance",
+}
+
+// WriteCSV writes snap as CSV, one row per strategy, with amounts in base units and the
+// underlying value also in whole tokens.
+func WriteCSV(w io.Writer, snap *Snapshot) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	block := strconv.FormatUint(snap.BlockNumber, 10)
+	t := snap.Time.Format(time.RFC3339)
+	for i := range snap.Rows {
+		r := &snap.Rows[i]
+		record := []string{
+			block, t, r.Strategy.Hex(), r.Token.Hex(), r.Symbol, strconv.Itoa(int(r.Decimals)),
+			r.TotalShares.String(), r.Underlying.String(), r.UnderlyingUnits(), r.TokenBalance.String(),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes snap as indented JSON. Amounts are encoded as JSON numbers in base units.
+func WriteJSON(w io.Writer, snap *Snapshot) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(snap)
+}
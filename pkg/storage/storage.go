@@ -0,0 +1,252 @@
+// Package storage reads the state of the core contracts and strategies straight from their
+// storage slots with eth_getStorageAt, without calling them. Reads keep working when a call path
+// does not: when a proxy points at a broken implementation, when a getter reverts while paused,
+// or when the ABI of an implementation is unknown:
+//
+//	impl, _ := storage.Implementation(ctx, client, strategyManager, nil)
+//	status, _ := storage.Paused(ctx, client, "StrategyManager", strategyManager, nil)
+//	flags := pausing.DecodePausedStatus("StrategyManager", status)
+//
+// The slots of each contract follow its inheritance order in src/contracts and the storage gaps
+// of OpenZeppelin's upgradeable contracts 4.9, and are listed in Layouts by binding name.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EIP-1967 storage slots of proxies.
+var (
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+	BeaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+)
+
+// Reader is the chain access required to read storage. *ethclient.Client satisfies it.
+type Reader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Layout holds the slots of the state shared by the Pausable contracts of EigenLayer. A zero Owner
+// means the contract is not Ownable.
+type Layout struct {
+	// Initialized holds OpenZeppelin Initializable's `_initialized` version in its lowest byte.
+	Initialized uint64
+	// Owner holds OwnableUpgradeable's `_owner`.
+	Owner uint64
+	// PauserRegistry and Paused hold Pausable's `pauserRegistry` and `_paused` bitmap.
+	PauserRegistry uint64
+	Paused         uint64
+}
+
+// Slots of the state of StrategyBase and of the StrategyBaseTVLLimits that extends it.
+const (
+	StrategyUnderlyingTokenSlot  = 51
+	StrategyTotalSharesSlot      = 52
+	StrategyMaxPerDepositSlot    = 101
+	StrategyMaxTotalDepositsSlot = 102
+)
+
+// Slots of the mappings of the StrategyManager.
+const (
+	StrategyManagerStrategyWhitelisterSlot             = 203
+	StrategyManagerStakerStrategySharesSlot            = 205
+	StrategyManagerStrategyIsWhitelistedForDepositSlot = 209
+	StrategyManagerThirdPartyTransfersForbiddenSlot    = 211
+)
+
+// strategyLayout is the layout of StrategyBase, which is Initializable and Pausable.
+var strategyLayout = Layout{Initialized: 0, PauserRegistry: 1, Paused: 2}
+
+// Layouts holds the layout of each Pausable contract, by the name of its binding in pkg/bindings.
+//
+// The contracts inheriting Initializable, OwnableUpgradeable and then Pausable keep the owner at
+// slot 51, after Initializable and ContextUpgradeable's gap, and Pausable's state after
+// OwnableUpgradeable's gap, and after ReentrancyGuardUpgradeable's if it comes first. The
+// StrategyFactory inherits its storage contract first, which shifts the rest by its 51 slots.
+var Layouts = map[string]Layout{
+	"DelegationManager":     {Initialized: 0, Owner: 51, PauserRegistry: 101, Paused: 102},
+	"StrategyManager":       {Initialized: 0, Owner: 51, PauserRegistry: 151, Paused: 152},
+	"EigenPodManager":       {Initialized: 0, Owner: 51, PauserRegistry: 101, Paused: 102},
+	"AVSDirectory":          {Initialized: 0, Owner: 51, PauserRegistry: 101, Paused: 102},
+	"RewardsCoordinator":    {Initialized: 0, Owner: 51, PauserRegistry: 101, Paused: 102},
+	"StrategyFactory":       {Initialized: 51, Owner: 102, PauserRegistry: 152, Paused: 153},
+	"StrategyBase":          strategyLayout,
+	"StrategyBaseTVLLimits": strategyLayout,
+	"EigenStrategy":         strategyLayout,
+}
+
+// Slot returns the hash of slot number n.
+func Slot(n uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(n))
+}
+
+// MappingSlot returns the slot of key in a Solidity mapping declared at slot.
+func MappingSlot(key common.Hash, slot common.Hash) common.Hash {
+	return crypto.Keccak256Hash(key.Bytes(), slot.Bytes())
+}
+
+// AddressKey returns addr as a mapping key.
+func AddressKey(addr common.Address) common.Hash {
+	return common.BytesToHash(addr.Bytes())
+}
+
+// Word reads slot of contract at blockNumber, or the latest block if nil.
+func Word(ctx context.Context, reader Reader, contract common.Address, slot common.Hash, blockNumber *big.Int) (common.Hash, error) {
+	value, err := reader.StorageAt(ctx, contract, slot, blockNumber)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to read slot %s of %s: %w", slot.Hex(), contract.Hex(), err)
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Address reads slot of contract as an address.
+func Address(ctx context.Context, reader Reader, contract common.Address, slot common.Hash, blockNumber *big.Int) (common.Address, error) {
+	word, err := Word(ctx, reader, contract, slot, blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(word.Bytes()), nil
+}
+
+// Uint reads slot of contract as a uint256.
+func Uint(ctx context.Context, reader Reader, contract common.Address, slot common.Hash, blockNumber *big.Int) (*big.Int, error) {
+	word, err := Word(ctx, reader, contract, slot, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return word.Big(), nil
+}
+
+// Bool reads slot of contract as a bool.
+func Bool(ctx context.Context, reader Reader, contract common.Address, slot common.Hash, blockNumber *big.Int) (bool, error) {
+	word, err := Word(ctx, reader, contract, slot, blockNumber)
+	if err != nil {
+		return false, err
+	}
+	return word[common.HashLength-1] != 0, nil
+}
+
+// Implementation returns the EIP-1967 implementation of proxy, or the zero address if it is not
+// a transparent proxy.
+func Implementation(ctx context.Context, reader Reader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return Address(ctx, reader, proxy, ImplementationSlot, blockNumber)
+}
+
+// Admin returns the EIP-1967 admin of proxy, usually a ProxyAdmin.
+func Admin(ctx context.Context, reader Reader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return Address(ctx, reader, proxy, AdminSlot, blockNumber)
+}
+
+// Beacon returns the EIP-1967 beacon of proxy, or the zero address if it is not a beacon proxy,
+// as the strategies deployed by the StrategyFactory are.
+func Beacon(ctx context.Context, reader Reader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return Address(ctx, reader, proxy, BeaconSlot, blockNumber)
+}
+
+// layout returns the layout of the contract whose binding is named contract.
+func layout(contract string) (Layout, error) {
+	l, ok := Layouts[contract]
+	if !ok {
+		return Layout{}, fmt.Errorf("no storage layout for %s", contract)
+	}
+	return l, nil
+}
+
+// Paused returns the paused status bitmap of addr, a contract whose binding is named contract,
+// to be decoded with pausing.DecodePausedStatus.
+func Paused(ctx context.Context, reader Reader, contract string, addr common.Address, blockNumber *big.Int) (*big.Int, error) {
+	l, err := layout(contract)
+	if err != nil {
+		return nil, err
+	}
+	return Uint(ctx, reader, addr, Slot(l.Paused), blockNumber)
+}
+
+// PauserRegistry returns the pauser registry of addr, a contract whose binding is named contract.
+func PauserRegistry(ctx context.Context, reader Reader, contract string, addr common.Address, blockNumber *big.Int) (common.Address, error) {
+	l, err := layout(contract)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return Address(ctx, reader, addr, Slot(l.PauserRegistry), blockNumber)
+}
+
+// Owner returns the owner of addr, a contract whose binding is named contract.
+func Owner(ctx context.Context, reader Reader, contract string, addr common.Address, blockNumber *big.Int) (common.Address, error) {
+	l, err := layout(contract)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if l.Owner == 0 {
+		return common.Address{}, fmt.Errorf("%s is not ownable", contract)
+	}
+	return Address(ctx, reader, addr, Slot(l.Owner), blockNumber)
+}
+
+// InitializedVersion returns the version last passed to the `reinitializer` of addr, a contract
+// whose binding is named contract, or 255 if its initializers are disabled, as they are for
+// implementations.
+func InitializedVersion(ctx context.Context, reader Reader, contract string, addr common.Address, blockNumber *big.Int) (uint8, error) {
+	l, err := layout(contract)
+	if err != nil {
+		return 0, err
+	}
+	word, err := Word(ctx, reader, addr, Slot(l.Initialized), blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return word[common.HashLength-1], nil
+}
+
+// UnderlyingToken returns the underlying token of strategy.
+func UnderlyingToken(ctx context.Context, reader Reader, strategy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return Address(ctx, reader, strategy, Slot(StrategyUnderlyingTokenSlot), blockNumber)
+}
+
+// TotalShares returns the total shares of strategy.
+func TotalShares(ctx context.Context, reader Reader, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return Uint(ctx, reader, strategy, Slot(StrategyTotalSharesSlot), blockNumber)
+}
+
+// MaxPerDeposit returns the maximum deposit into strategy, a StrategyBaseTVLLimits. The slot is
+// part of StrategyBase's gap in other strategies, where it reads zero.
+func MaxPerDeposit(ctx context.Context, reader Reader, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return Uint(ctx, reader, strategy, Slot(StrategyMaxPerDepositSlot), blockNumber)
+}
+
+// MaxTotalDeposits returns the maximum total deposits of strategy, a StrategyBaseTVLLimits. The
+// slot is part of StrategyBase's gap in other strategies, where it reads zero.
+func MaxTotalDeposits(ctx context.Context, reader Reader, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return Uint(ctx, reader, strategy, Slot(StrategyMaxTotalDepositsSlot), blockNumber)
+}
+
+// StrategyWhitelister returns the strategy whitelister of strategyManager.
+func StrategyWhitelister(ctx context.Context, reader Reader, strategyManager common.Address, blockNumber *big.Int) (common.Address, error) {
+	return Address(ctx, reader, strategyManager, Slot(StrategyManagerStrategyWhitelisterSlot), blockNumber)
+}
+
+// StrategyIsWhitelistedForDeposit reports whether strategy is whitelisted for deposit in
+// strategyManager.
+func StrategyIsWhitelistedForDeposit(ctx context.Context, reader Reader, strategyManager, strategy common.Address, blockNumber *big.Int) (bool, error) {
+	slot := MappingSlot(AddressKey(strategy), Slot(StrategyManagerStrategyIsWhitelistedForDepositSlot))
+	return Bool(ctx, reader, strategyManager, slot, blockNumber)
+}
+
+// ThirdPartyTransfersForbidden reports whether third-party transfers of the shares of strategy
+// are forbidden in strategyManager.
+func ThirdPartyTransfersForbidden(ctx context.Context, reader Reader, strategyManager, strategy common.Address, blockNumber *big.Int) (bool, error) {
+	slot := MappingSlot(AddressKey(strategy), Slot(StrategyManagerThirdPartyTransfersForbiddenSlot))
+	return Bool(ctx, reader, strategyManager, slot, blockNumber)
+}
+
+// StakerStrategyShares returns the shares staker holds in strategy in strategyManager.
+func StakerStrategyShares(ctx context.Context, reader Reader, strategyManager, staker, strategy common.Address, blockNumber *big.Int) (*big.Int, error) {
+	inner := MappingSlot(AddressKey(staker), Slot(StrategyManagerStakerStrategySharesSlot))
+	return Uint(ctx, reader, strategyManager, MappingSlot(AddressKey(strategy), inner), blockNumber)
+}
@@ -0,0 +1,262 @@
+// Package strategies discovers the strategies whitelisted for deposit in the StrategyManager.
+//
+// A Registry replays the StrategyManager's StrategyAddedToDepositWhitelist and
+// StrategyRemovedFromDepositWhitelist events, and the StrategyFactory's StrategySetForToken
+// events, and resolves each whitelisted strategy to its underlying token's symbol and decimals:
+//
+//	registry, _ := strategies.NewRegistry(client, strategies.Config{
+//		StrategyManager: strategyManager,
+//		StrategyFactory: strategyFactory,
+//		FromBlock:       deploymentBlock,
+//	})
+//	list, _ := registry.ListStrategies(ctx)
+//	strategy, ok := registry.StrategyForToken(token)
+package strategies
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/amounts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyFactory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+)
+
+// DefaultBatchSize is the default number of blocks fetched per log query.
+const DefaultBatchSize = 2000
+
+// Backend is the chain access required by Registry.
+type Backend interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures a Registry.
+type Config struct {
+	StrategyManager common.Address
+	// StrategyFactory, if set, is the StrategyFactory whose StrategySetForToken events name the
+	// canonical strategy of a token.
+	StrategyFactory common.Address
+	// FromBlock is the first block replayed, typically the StrategyManager's deployment block.
+	FromBlock uint64
+	// BatchSize is the number of blocks fetched per log query. It defaults to DefaultBatchSize.
+	BatchSize uint64
+}
+
+// Strategy is a whitelisted strategy and its underlying token.
+type Strategy struct {
+	Address  common.Address
+	Token    common.Address
+	Symbol   string
+	Decimals uint8
+	// WhitelistedAt is the block the strategy was last added to the deposit whitelist.
+	WhitelistedAt uint64
+}
+
+// Registry caches the whitelisted strategies of a StrategyManager.
+type Registry struct {
+	backend Backend
+	cfg     Config
+	query   ethereum.FilterQuery
+	manager *StrategyManager.StrategyManagerFilterer
+	factory *StrategyFactory.StrategyFactoryFilterer
+
+	mu sync.Mutex
+	// next is the first block not yet replayed.
+	next       uint64
+	strategies map[common.Address]*Strategy
+	// factoryStrategies maps tokens to the strategy the StrategyFactory set for them.
+	factoryStrategies map[common.Address]common.Address
+}
+
+// NewRegistry returns a Registry of the strategies whitelisted in cfg.StrategyManager. It reads
+// nothing until ListStrategies or Refresh is called.
+func NewRegistry(backend Backend, cfg Config) (*Registry, error) {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	managerABI, err := StrategyManager.StrategyManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	factoryABI, err := StrategyFactory.StrategyFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	manager, err := StrategyManager.NewStrategyManagerFilterer(cfg.StrategyManager, nil)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := StrategyFactory.NewStrategyFactoryFilterer(cfg.StrategyFactory, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{cfg.StrategyManager},
+		Topics: [][]common.Hash{{
+			managerABI.Events["StrategyAddedToDepositWhitelist"].ID,
+			managerABI.Events["StrategyRemovedFromDepositWhitelist"].ID,
+		}},
+	}
+	if cfg.StrategyFactory != (common.Address{}) {
+		query.Addresses = append(query.Addresses, cfg.StrategyFactory)
+		query.Topics[0] = append(query.Topics[0], factoryABI.Events["StrategySetForToken"].ID)
+	}
+
+	return &Registry{
+		backend:           backend,
+		cfg:               cfg,
+		query:             query,
+		manager:           manager,
+		factory:           factory,
+		next:              cfg.FromBlock,
+		strategies:        make(map[common.Address]*Strategy),
+		factoryStrategies: make(map[common.Address]common.Address),
+	}, nil
+}
+
+// Refresh replays the events emitted since the last refresh and resolves the tokens of newly
+// whitelisted strategies.
+func (r *Registry) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	head, err := r.backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	for r.next <= head {
+		to := r.next + r.cfg.BatchSize - 1
+		if to > head {
+			to = head
+		}
+		query := r.query
+		query.FromBlock = new(big.Int).SetUint64(r.next)
+		query.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := r.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to fetch logs of blocks %d-%d: %w", r.next, to, err)
+		}
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+		for _, log := range logs {
+			if err := r.apply(ctx, log); err != nil {
+				return err
+			}
+		}
+		r.next = to + 1
+	}
+	return nil
+}
+
+// apply updates the registry with a single event.
+func (r *Registry) apply(ctx context.Context, log types.Log) error {
+	if log.Address == r.cfg.StrategyFactory {
+		ev, err := r.factory.ParseStrategySetForToken(log)
+		if err != nil {
+			return fmt.Errorf("failed to parse log %d of tx %s: %w", log.Index, log.TxHash, err)
+		}
+		r.factoryStrategies[ev.Token] = ev.Strategy
+		return nil
+	}
+	if ev, err := r.manager.ParseStrategyRemovedFromDepositWhitelist(log); err == nil {
+		delete(r.strategies, ev.Strategy)
+		return nil
+	}
+	ev, err := r.manager.ParseStrategyAddedToDepositWhitelist(log)
+	if err != nil {
+		return fmt.Errorf("failed to parse log %d of tx %s: %w", log.Index, log.TxHash, err)
+	}
+	strategy, err := r.resolve(ctx, ev.Strategy)
+	if err != nil {
+		return err
+	}
+	strategy.WhitelistedAt = log.BlockNumber
+	r.strategies[ev.Strategy] = strategy
+	return nil
+}
+
+// resolve reads the underlying token of the strategy at addr and the token's metadata.
+func (r *Registry) resolve(ctx context.Context, addr common.Address) (*Strategy, error) {
+	if cached, ok := r.strategies[addr]; ok {
+		c := *cached
+		return &c, nil
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	caller, err := StrategyBase.NewStrategyBaseCaller(addr, r.backend)
+	if err != nil {
+		return nil, err
+	}
+	token, err := caller.UnderlyingToken(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch underlying token of %s: %w", addr.Hex(), err)
+	}
+	metadata, err := amounts.FetchToken(ctx, r.backend, token)
+	if err != nil {
+		return nil, err
+	}
+	return &Strategy{Address: addr, Token: token, Symbol: metadata.Symbol, Decimals: metadata.Decimals}, nil
+}
+
+// ListStrategies refreshes the registry and returns the whitelisted strategies, ordered by the
+// block they were whitelisted at.
+func (r *Registry) ListStrategies(ctx context.Context) ([]Strategy, error) {
+	if err := r.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]Strategy, 0, len(r.strategies))
+	for _, s := range r.strategies {
+		list = append(list, *s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].WhitelistedAt != list[j].WhitelistedAt {
+			return list[i].WhitelistedAt < list[j].WhitelistedAt
+		}
+		return list[i].Address.Cmp(list[j].Address) < 0
+	})
+	return list, nil
+}
+
+// StrategyForToken returns the whitelisted strategy of token as of the last refresh. If more
+// than one whitelisted strategy holds token, the one the StrategyFactory set for it is preferred,
+// followed by the earliest whitelisted.
+func (r *Registry) StrategyForToken(token common.Address) (Strategy, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if addr, ok := r.factoryStrategies[token]; ok {
+		if s, ok := r.strategies[addr]; ok {
+			return *s, true
+		}
+	}
+	var found *Strategy
+	for _, s := range r.strategies {
+		if s.Token != token {
+			continue
+		}
+		if found == nil || s.WhitelistedAt < found.WhitelistedAt ||
+			(s.WhitelistedAt == found.WhitelistedAt && s.Address.Cmp(found.Address) < 0) {
+			found = s
+		}
+	}
+	if found == nil {
+		return Strategy{}, false
+	}
+	return *found, true
+}
@@ -0,0 +1,212 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+)
+
+// DefaultCapacityPollInterval is how often a CapacityTracker refreshes by default, one slot.
+const DefaultCapacityPollInterval = 12 * time.Second
+
+// pausedDepositsIndex is StrategyBase.PAUSED_DEPOSITS.
+const pausedDepositsIndex = 0
+
+// Capacity is a snapshot of a strategy's deposit limits and balances.
+type Capacity struct {
+	BlockNumber uint64
+
+	MaxPerDeposit    *big.Int
+	MaxTotalDeposits *big.Int
+	TotalShares      *big.Int
+	// TokenBalance is the strategy's balance of its underlying token, which maxTotalDeposits
+	// is checked against.
+	TokenBalance   *big.Int
+	DepositsPaused bool
+}
+
+// RemainingDepositCapacity returns the largest amount of underlying token that a single
+// deposit can currently add without reverting on the TVL limits: the lesser of maxPerDeposit
+// and the headroom below maxTotalDeposits. It is zero while deposits are paused.
+func (c *Capacity) RemainingDepositCapacity() *big.Int {
+	if c.DepositsPaused || c.TokenBalance.Cmp(c.MaxTotalDeposits) >= 0 {
+		return new(big.Int)
+	}
+	remaining := new(big.Int).Sub(c.MaxTotalDeposits, c.TokenBalance)
+	if c.MaxPerDeposit.Cmp(remaining) < 0 {
+		remaining.Set(c.MaxPerDeposit)
+	}
+	return remaining
+}
+
+// CapacityTracker keeps a Capacity of a StrategyBaseTVLLimits up to date. Run refreshes it
+// every PollInterval and applies MaxPerDepositUpdated and MaxTotalDepositsUpdated events as
+// they arrive, when the backend supports subscriptions.
+type CapacityTracker struct {
+	// PollInterval is how often Run refreshes the capacity. It defaults to
+	// DefaultCapacityPollInterval.
+	PollInterval time.Duration
+
+	backend  bind.ContractBackend
+	address  common.Address
+	strategy *StrategyBaseTVLLimits.StrategyBaseTVLLimits
+	token    common.Address
+
+	mu       sync.RWMutex
+	capacity *Capacity
+}
+
+// NewCapacityTracker returns a CapacityTracker for the strategy at strategyAddr. Call Refresh
+// or Run before reading its capacity.
+func NewCapacityTracker(backend bind.ContractBackend, strategyAddr common.Address) (*CapacityTracker, error) {
+	strategy, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimits(strategyAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CapacityTracker{
+		PollInterval: DefaultCapacityPollInterval,
+		backend:      backend,
+		address:      strategyAddr,
+		strategy:     strategy,
+	}, nil
+}
+
+// Refresh reads the strategy's limits, total shares, token balance and paused status at the
+// latest block.
+func (t *CapacityTracker) Refresh(ctx context.Context) error {
+	header, err := t.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch latest header: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	if t.token == (common.Address{}) {
+		if t.token, err = t.strategy.UnderlyingToken(opts); err != nil {
+			return fmt.Errorf("failed to fetch underlying token: %w", err)
+		}
+	}
+
+	c := &Capacity{BlockNumber: header.Number.Uint64()}
+	if c.MaxPerDeposit, c.MaxTotalDeposits, err = t.strategy.GetTVLLimits(opts); err != nil {
+		return fmt.Errorf("failed to fetch TVL limits: %w", err)
+	}
+	if c.TotalShares, err = t.strategy.TotalShares(opts); err != nil {
+		return fmt.Errorf("failed to fetch total shares: %w", err)
+	}
+	if c.TokenBalance, err = TokenBalance(opts, t.backend, t.token, t.address); err != nil {
+		return fmt.Errorf("failed to fetch token balance: %w", err)
+	}
+	if c.DepositsPaused, err = t.strategy.Paused(opts, pausedDepositsIndex); err != nil {
+		return fmt.Errorf("failed to fetch paused status: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Keep a limit update from an event newer than this snapshot.
+	if t.capacity != nil && t.capacity.BlockNumber > c.BlockNumber {
+		return nil
+	}
+	t.capacity = c
+	return nil
+}
+
+// Capacity returns the last snapshot, or nil before the first successful Refresh.
+func (t *CapacityTracker) Capacity() *Capacity {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.capacity == nil {
+		return nil
+	}
+	c := *t.capacity
+	return &c
+}
+
+// RemainingDepositCapacity returns the remaining deposit capacity of the last snapshot, or nil
+// before the first successful Refresh.
+func (t *CapacityTracker) RemainingDepositCapacity() *big.Int {
+	c := t.Capacity()
+	if c == nil {
+		return nil
+	}
+	return c.RemainingDepositCapacity()
+}
+
+// Run refreshes the capacity every PollInterval and applies limit updates as they are emitted,
+// until ctx is done or a refresh or subscription fails.
+func (t *CapacityTracker) Run(ctx context.Context) error {
+	if err := t.Refresh(ctx); err != nil {
+		return err
+	}
+
+	watchOpts := &bind.WatchOpts{Context: ctx}
+	perDeposit := make(chan *StrategyBaseTVLLimits.StrategyBaseTVLLimitsMaxPerDepositUpdated)
+	totalDeposits := make(chan *StrategyBaseTVLLimits.StrategyBaseTVLLimitsMaxTotalDepositsUpdated)
+	var subErrs []<-chan error
+	if sub, err := t.strategy.WatchMaxPerDepositUpdated(watchOpts, perDeposit); err == nil {
+		defer sub.Unsubscribe()
+		subErrs = append(subErrs, sub.Err())
+	} else if !errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return fmt.Errorf("failed to watch MaxPerDepositUpdated: %w", err)
+	}
+	if sub, err := t.strategy.WatchMaxTotalDepositsUpdated(watchOpts, totalDeposits); err == nil {
+		defer sub.Unsubscribe()
+		subErrs = append(subErrs, sub.Err())
+	} else if !errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return fmt.Errorf("failed to watch MaxTotalDepositsUpdated: %w", err)
+	}
+	// Without subscriptions, limit updates are picked up by the next refresh.
+	for len(subErrs) < 2 {
+		subErrs = append(subErrs, nil)
+	}
+
+	interval := t.PollInterval
+	if interval == 0 {
+		interval = DefaultCapacityPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := t.Refresh(ctx); err != nil {
+				return err
+			}
+		case ev := <-perDeposit:
+			t.applyLimit(ev.Raw.BlockNumber, ev.Raw.Removed, func(c *Capacity) { c.MaxPerDeposit = ev.NewValue })
+		case ev := <-totalDeposits:
+			t.applyLimit(ev.Raw.BlockNumber, ev.Raw.Removed, func(c *Capacity) { c.MaxTotalDeposits = ev.NewValue })
+		case err := <-subErrs[0]:
+			return fmt.Errorf("MaxPerDepositUpdated subscription failed: %w", err)
+		case err := <-subErrs[1]:
+			return fmt.Errorf("MaxTotalDepositsUpdated subscription failed: %w", err)
+		}
+	}
+}
+
+// applyLimit applies a limit update emitted at blockNumber to the snapshot. A removed update is
+// left for the next refresh to correct.
+func (t *CapacityTracker) applyLimit(blockNumber uint64, removed bool, apply func(c *Capacity)) {
+	if removed {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.capacity == nil || blockNumber < t.capacity.BlockNumber {
+		return
+	}
+	c := *t.capacity
+	apply(&c)
+	c.BlockNumber = blockNumber
+	t.capacity = &c
+}
@@ -0,0 +1,47 @@
+// Package strategy contains helpers for operating on deployed strategies, chiefly
+// StrategyBaseTVLLimits contracts.
+package strategy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// Names of the invariants asserted by StrategyBaseTVLLimits.checkInvariants.
+const (
+	InvariantDepositLimits = "maxPerDeposit <= maxTotalDeposits"
+	InvariantSharesBacked  = "totalShares underlying <= token balance"
+	InvariantPauseBits     = "paused bits <= max pause bit"
+)
+
+// invariantReasons maps each checkInvariants revert reason to the invariant it reports.
+var invariantReasons = map[string]string{
+	"StrategyBaseTVLLimits.checkInvariants: maxPerDeposit exceeds maxTotalDeposits": InvariantDepositLimits,
+	"StrategyBaseTVLLimits.checkInvariants: totalShares exceeds token balance":      InvariantSharesBacked,
+	"StrategyBaseTVLLimits.checkInvariants: paused bit exceeds max pause bit":       InvariantPauseBits,
+}
+
+// InvariantsHold calls checkInvariants on the strategy behind caller. If an
+// invariant is violated it returns false along with the invariant's name.
+// A revert that does not originate from checkInvariants is returned as an error.
+func InvariantsHold(ctx context.Context, caller *StrategyBaseTVLLimits.StrategyBaseTVLLimitsCaller) (bool, string, error) {
+	err := caller.CheckInvariants(&bind.CallOpts{Context: ctx})
+	if err == nil {
+		return true, "", nil
+	}
+
+	var revertErr *elerrors.RevertError
+	if !errors.As(elerrors.Decode(err), &revertErr) {
+		return false, "", err
+	}
+	name, ok := invariantReasons[revertErr.Reason]
+	if !ok {
+		return false, "", err
+	}
+	return false, name, nil
+}
@@ -0,0 +1,230 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+)
+
+// revertCaller is a bind.ContractCaller whose calls fail with err.
+type revertCaller struct {
+	err error
+}
+
+func (c revertCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x00}, nil
+}
+
+func (c revertCaller) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, c.err
+}
+
+// rpcRevertError mirrors the JSON-RPC error returned by a node for a reverted eth_call.
+type rpcRevertError struct {
+	data string
+}
+
+func (e rpcRevertError) Error() string          { return "execution reverted" }
+func (e rpcRevertError) ErrorCode() int         { return 3 }
+func (e rpcRevertError) ErrorData() interface{} { return e.data }
+
+// errorStringRevert returns the revert data of require(false, reason).
+func errorStringRevert(t *testing.T, reason string) rpcRevertError {
+	t.Helper()
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append([]byte{0x08, 0xc3, 0x79, 0xa0}, packed...)
+	return rpcRevertError{data: hexutil.Encode(data)}
+}
+
+func TestInvariantsHold(t *testing.T) {
+	ioErr := errors.New("connection refused")
+
+	tests := []struct {
+		name     string
+		callErr  error
+		wantHold bool
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "holds",
+			wantHold: true,
+		},
+		{
+			name:     "deposit limits",
+			callErr:  errorStringRevert(t, "StrategyBaseTVLLimits.checkInvariants: maxPerDeposit exceeds maxTotalDeposits"),
+			wantName: InvariantDepositLimits,
+		},
+		{
+			name:     "shares backed",
+			callErr:  errorStringRevert(t, "StrategyBaseTVLLimits.checkInvariants: totalShares exceeds token balance"),
+			wantName: InvariantSharesBacked,
+		},
+		{
+			name:     "pause bits",
+			callErr:  errorStringRevert(t, "StrategyBaseTVLLimits.checkInvariants: paused bit exceeds max pause bit"),
+			wantName: InvariantPauseBits,
+		},
+		{
+			name:     "reason only in message",
+			callErr:  errors.New("execution reverted: StrategyBaseTVLLimits.checkInvariants: paused bit exceeds max pause bit"),
+			wantName: InvariantPauseBits,
+		},
+		{
+			name:    "unknown revert",
+			callErr: errorStringRevert(t, "Pausable: index is paused"),
+			wantErr: true,
+		},
+		{
+			name:    "transport error",
+			callErr: ioErr,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(common.Address{1}, revertCaller{err: tt.callErr})
+			if err != nil {
+				t.Fatal(err)
+			}
+			hold, name, err := InvariantsHold(context.Background(), caller)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("InvariantsHold() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if hold != tt.wantHold || name != tt.wantName {
+				t.Errorf("InvariantsHold() = (%v, %q), want (%v, %q)", hold, name, tt.wantHold, tt.wantName)
+			}
+		})
+	}
+}
+
+// Storage slots of StrategyBaseTVLLimits, from docs/storage-report/StrategyBaseTVLLimits.md.
+var (
+	slotInitialized      = common.BigToHash(big.NewInt(0))
+	slotPaused           = common.BigToHash(big.NewInt(1))
+	slotUnderlyingToken  = common.BigToHash(big.NewInt(50))
+	slotTotalShares      = common.BigToHash(big.NewInt(51))
+	slotMaxPerDeposit    = common.BigToHash(big.NewInt(100))
+	slotMaxTotalDeposits = common.BigToHash(big.NewInt(101))
+)
+
+// TestInvariantsHoldDeployed checks InvariantsHold against the revert data of the deployed
+// contract. Each case is a proxy to the same implementation, whose storage violates at most one
+// invariant.
+func TestInvariantsHoldDeployed(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	implementation := crypto.CreateAddress(deployer, 0)
+
+	tests := []struct {
+		name     string
+		storage  map[common.Hash]common.Hash
+		wantHold bool
+		wantName string
+	}{
+		{
+			name:     "holds",
+			wantHold: true,
+		},
+		{
+			name: "deposit limits",
+			storage: map[common.Hash]common.Hash{
+				slotMaxPerDeposit:    common.BigToHash(big.NewInt(3e18)),
+				slotMaxTotalDeposits: common.BigToHash(big.NewInt(2e18)),
+			},
+			wantName: InvariantDepositLimits,
+		},
+		{
+			// tokenCode reports a balance of 1e18, which backs fewer underlying tokens than 2e18 shares.
+			name:     "shares backed",
+			storage:  map[common.Hash]common.Hash{slotTotalShares: common.BigToHash(big.NewInt(2e18))},
+			wantName: InvariantSharesBacked,
+		},
+		{
+			name:     "pause bits",
+			storage:  map[common.Hash]common.Hash{slotPaused: common.BigToHash(big.NewInt(1 << 5))},
+			wantName: InvariantPauseBits,
+		},
+	}
+
+	alloc := types.GenesisAlloc{
+		deployer:     {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+		tokenAddress: {Code: tokenCode},
+	}
+	proxies := make([]common.Address, len(tests))
+	for i, tt := range tests {
+		storage := map[common.Hash]common.Hash{
+			ImplementationSlot:   common.BytesToHash(implementation.Bytes()),
+			slotInitialized:      common.BigToHash(big.NewInt(1)),
+			slotUnderlyingToken:  common.BytesToHash(tokenAddress.Bytes()),
+			slotTotalShares:      common.BigToHash(big.NewInt(1e18)),
+			slotMaxPerDeposit:    common.BigToHash(big.NewInt(1e18)),
+			slotMaxTotalDeposits: common.BigToHash(big.NewInt(2e18)),
+		}
+		for slot, value := range tt.storage {
+			storage[slot] = value
+		}
+		proxies[i] = common.BigToAddress(big.NewInt(int64(0x1967 + i)))
+		alloc[proxies[i]] = types.Account{Code: proxyCode(), Storage: storage}
+	}
+	backend := simulated.NewBackend(alloc)
+	defer backend.Close()
+	client := backend.Client()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, tx, _, err := StrategyBaseTVLLimits.DeployStrategyBaseTVLLimits(opts, client, deployer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if receipt, err := bind.WaitMined(ctx, client, tx); err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("failed to deploy the implementation: %v", err)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsCaller(proxies[i], client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hold, name, err := InvariantsHold(ctx, caller)
+			if err != nil {
+				t.Fatalf("InvariantsHold() error = %v", err)
+			}
+			if hold != tt.wantHold || name != tt.wantName {
+				t.Errorf("InvariantsHold() = (%v, %q), want (%v, %q)", hold, name, tt.wantHold, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package strategy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	istrategy "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IStrategy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+)
+
+// IStrategy is the IStrategy interface shared by the StrategyBase, StrategyBaseTVLLimits and
+// EigenStrategy bindings, so that code can operate on any of them. The IStrategy binding itself
+// declares sharesToUnderlying and underlyingToShares as transactions; wrap it with
+// NewIStrategy to call them as views like the other bindings do.
+type IStrategy interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+var (
+	_ IStrategy = (*StrategyBase.StrategyBase)(nil)
+	_ IStrategy = (*StrategyBaseTVLLimits.StrategyBaseTVLLimits)(nil)
+	_ IStrategy = (*EigenStrategy.EigenStrategy)(nil)
+	_ IStrategy = (*iStrategy)(nil)
+)
+
+// iStrategy adapts the IStrategy binding to IStrategy.
+type iStrategy struct {
+	*istrategy.IStrategy
+	raw *istrategy.IStrategyCallerRaw
+}
+
+// NewIStrategy binds the strategy at address through the IStrategy binding.
+func NewIStrategy(address common.Address, backend bind.ContractBackend) (IStrategy, error) {
+	binding, err := istrategy.NewIStrategy(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &iStrategy{IStrategy: binding, raw: &istrategy.IStrategyCallerRaw{Contract: &binding.IStrategyCaller}}, nil
+}
+
+func (s *iStrategy) SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	return s.uint256Call(opts, "sharesToUnderlying", amountShares)
+}
+
+func (s *iStrategy) UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	return s.uint256Call(opts, "underlyingToShares", amountUnderlying)
+}
+
+func (s *iStrategy) uint256Call(opts *bind.CallOpts, method string, args ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	if err := s.raw.Call(opts, &out, method, args...); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -0,0 +1,32 @@
+package strategy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+)
+
+// TokenBalance returns the balance of account in the ERC20 token at token, such as a
+// strategy's underlying token.
+func TokenBalance(opts *bind.CallOpts, caller bind.ContractCaller, token, account common.Address) (*big.Int, error) {
+	return erc20Call(opts, caller, token, "balanceOf", account)
+}
+
+// TokenAllowance returns the allowance owner has granted spender in the ERC20 token at token.
+func TokenAllowance(opts *bind.CallOpts, caller bind.ContractCaller, token, owner, spender common.Address) (*big.Int, error) {
+	return erc20Call(opts, caller, token, "allowance", owner, spender)
+}
+
+// erc20Call calls a uint256 view of the ERC20 token at token.
+func erc20Call(opts *bind.CallOpts, caller bind.ContractCaller, token common.Address, method string, args ...interface{}) (*big.Int, error) {
+	contract := bind.NewBoundContract(token, abis.ERC20, caller, nil, nil)
+	var out []interface{}
+	if err := contract.Call(opts, &out, method, args...); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
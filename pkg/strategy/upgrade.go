@@ -0,0 +1,127 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+)
+
+// EIP-1967 storage slots used by TransparentUpgradeableProxy and BeaconProxy.
+var (
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+	BeaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+)
+
+// initializedSlot holds OpenZeppelin Initializable's `_initialized` version in its lowest byte.
+var initializedSlot = common.Hash{}
+
+// pauseAll is the paused status set by Pausable.pauseAll.
+var pauseAll = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Backend is the chain access needed by the helpers in this package.
+type Backend interface {
+	bind.ContractBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// UpgradeReport gathers the state that should be reviewed before upgrading a strategy proxy.
+// All values are read at BlockNumber.
+type UpgradeReport struct {
+	Strategy    common.Address
+	BlockNumber uint64
+
+	// Implementation is the EIP-1967 implementation, set for transparent proxies.
+	Implementation common.Address
+	// ProxyAdmin is the EIP-1967 admin, set for transparent proxies.
+	ProxyAdmin common.Address
+	// Beacon is the EIP-1967 beacon, set for beacon proxies deployed by the StrategyFactory.
+	Beacon common.Address
+	// InitializedVersion is the version last passed to Initializable's `reinitializer`.
+	InitializedVersion uint8
+
+	PausedStatus *big.Int
+	FullyPaused  bool
+
+	UnderlyingToken common.Address
+	TotalShares     *big.Int
+	// TVL is the amount of underlying token implied by TotalShares.
+	TVL *big.Int
+
+	// Recommendations lists actions to take before performing the upgrade.
+	Recommendations []string
+}
+
+// PreUpgradeReport reads the proxy configuration, pause state and share
+// snapshot of the strategy at strategyAddr.
+func PreUpgradeReport(ctx context.Context, client Backend, strategyAddr common.Address) (*UpgradeReport, error) {
+	blockNumber, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	block := new(big.Int).SetUint64(blockNumber)
+
+	report := &UpgradeReport{
+		Strategy:    strategyAddr,
+		BlockNumber: blockNumber,
+	}
+
+	slots := []struct {
+		slot common.Hash
+		dst  *common.Address
+	}{
+		{ImplementationSlot, &report.Implementation},
+		{AdminSlot, &report.ProxyAdmin},
+		{BeaconSlot, &report.Beacon},
+	}
+	for _, s := range slots {
+		value, err := client.StorageAt(ctx, strategyAddr, s.slot, block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read slot %s: %w", s.slot, err)
+		}
+		*s.dst = common.BytesToAddress(value)
+	}
+
+	initialized, err := client.StorageAt(ctx, strategyAddr, initializedSlot, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read initialized version: %w", err)
+	}
+	if len(initialized) > 0 {
+		report.InitializedVersion = initialized[len(initialized)-1]
+	}
+
+	strategy, err := StrategyBase.NewStrategyBaseCaller(strategyAddr, client)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+
+	if report.PausedStatus, err = strategy.Paused0(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch paused status: %w", err)
+	}
+	if report.UnderlyingToken, err = strategy.UnderlyingToken(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch underlying token: %w", err)
+	}
+	if report.TotalShares, err = strategy.TotalShares(opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch total shares: %w", err)
+	}
+	if report.TVL, err = strategy.SharesToUnderlyingView(opts, report.TotalShares); err != nil {
+		return nil, fmt.Errorf("failed to fetch TVL: %w", err)
+	}
+
+	report.FullyPaused = report.PausedStatus.Cmp(pauseAll) == 0
+	if !report.FullyPaused {
+		report.Recommendations = append(report.Recommendations, "pause the strategy (pauseAll) before upgrading")
+	}
+	if report.Implementation == (common.Address{}) && report.Beacon == (common.Address{}) {
+		report.Recommendations = append(report.Recommendations, "no EIP-1967 implementation or beacon found; confirm the address is a proxy")
+	}
+
+	return report, nil
+}
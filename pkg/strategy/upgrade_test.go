@@ -0,0 +1,154 @@
+package strategy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+)
+
+var (
+	proxyAddress = common.HexToAddress("0x0000000000000000000000000000000000001967")
+	tokenAddress = common.HexToAddress("0x0000000000000000000000000000000000000e20")
+	proxyAdmin   = common.HexToAddress("0x00000000000000000000000000000000000ad111")
+)
+
+// proxyCode returns the runtime code of a minimal EIP-1967 proxy, which delegates every call to
+// the implementation stored in ImplementationSlot.
+func proxyCode() []byte {
+	code := []byte{
+		0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // calldatacopy(0, 0, calldatasize)
+		0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, // retSize, retOffset, argsSize, argsOffset
+		0x7f, // push32 ImplementationSlot
+	}
+	code = append(code, ImplementationSlot.Bytes()...)
+	return append(code,
+		0x54, 0x5a, 0xf4, // delegatecall(gas, sload(slot), ...)
+		0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, // returndatacopy(0, 0, returndatasize)
+		0x60, 0x3e, 0x57, // jumpi(success)
+		0x3d, 0x60, 0x00, 0xfd, // revert(0, returndatasize)
+		0x5b, 0x3d, 0x60, 0x00, 0xf3, // success: return(0, returndatasize)
+	)
+}
+
+// tokenCode is the runtime code of a token reporting 18 decimals and a balance of 1e18 for
+// every other call.
+var tokenCode = []byte{
+	0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c, // calldataload(0) >> 224
+	0x63, 0x31, 0x3c, 0xe5, 0x67, 0x14, // eq(selector, decimals())
+	0x60, 0x20, 0x57, // jumpi(decimals)
+	0x67, 0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00, // push8 1e18
+	0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3, // return 1e18
+	0x5b, 0x60, 0x12, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3, // decimals: return 18
+}
+
+func TestPreUpgradeReport(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	// The implementation is the deployer's first contract.
+	implementation := crypto.CreateAddress(deployer, 0)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		deployer: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+		proxyAddress: {
+			Code: proxyCode(),
+			Storage: map[common.Hash]common.Hash{
+				ImplementationSlot: common.BytesToHash(implementation.Bytes()),
+				AdminSlot:          common.BytesToHash(proxyAdmin.Bytes()),
+			},
+		},
+		tokenAddress: {Code: tokenCode},
+	})
+	defer backend.Close()
+	client := backend.Client()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		backend.Commit()
+		receipt, err := bind.WaitMined(ctx, client, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("transaction %s reverted", tx.Hash())
+		}
+	}
+
+	// The deployer stands in for the StrategyManager, so that it can deposit directly.
+	deployed, tx, _, err := StrategyBaseTVLLimits.DeployStrategyBaseTVLLimits(opts, client, deployer)
+	mine(tx, err)
+	if deployed != implementation {
+		t.Fatalf("implementation deployed at %s, want %s", deployed, implementation)
+	}
+	pauserRegistry, tx, _, err := PauserRegistry.DeployPauserRegistry(opts, client, []common.Address{deployer}, deployer)
+	mine(tx, err)
+
+	strategy, err := StrategyBaseTVLLimits.NewStrategyBaseTVLLimits(proxyAddress, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(strategy.Initialize(opts, big.NewInt(2e18), big.NewInt(5e18), tokenAddress, pauserRegistry))
+	mine(strategy.Deposit(opts, tokenAddress, big.NewInt(1e18)))
+	mine(strategy.Pause(opts, big.NewInt(1)))
+
+	report, err := PreUpgradeReport(ctx, client, proxyAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Strategy != proxyAddress || report.BlockNumber != head {
+		t.Errorf("report of %s at %d, want %s at %d", report.Strategy, report.BlockNumber, proxyAddress, head)
+	}
+	if report.Implementation != implementation {
+		t.Errorf("Implementation = %s, want %s", report.Implementation, implementation)
+	}
+	if report.ProxyAdmin != proxyAdmin {
+		t.Errorf("ProxyAdmin = %s, want %s", report.ProxyAdmin, proxyAdmin)
+	}
+	if report.Beacon != (common.Address{}) {
+		t.Errorf("Beacon = %s, want zero address", report.Beacon)
+	}
+	if report.InitializedVersion != 1 {
+		t.Errorf("InitializedVersion = %d, want 1", report.InitializedVersion)
+	}
+	if report.PausedStatus.Cmp(big.NewInt(1)) != 0 || report.FullyPaused {
+		t.Errorf("paused status = %s (fully paused %v), want 1", report.PausedStatus, report.FullyPaused)
+	}
+	if report.UnderlyingToken != tokenAddress {
+		t.Errorf("UnderlyingToken = %s, want %s", report.UnderlyingToken, tokenAddress)
+	}
+	// The first deposit into an empty strategy mints shares one to one.
+	if want := big.NewInt(1e18); report.TotalShares.Cmp(want) != 0 || report.TVL.Cmp(want) != 0 {
+		t.Errorf("TotalShares = %s, TVL = %s, want %s", report.TotalShares, report.TVL, want)
+	}
+	if len(report.Recommendations) != 1 {
+		t.Errorf("Recommendations = %q, want only the pauseAll recommendation", report.Recommendations)
+	}
+}
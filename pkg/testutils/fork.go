@@ -0,0 +1,269 @@
+package testutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/simulate"
+)
+
+// Environment variables read by SetupFork.
+const (
+	EnvForkURL   = "EIGENLAYER_TEST_FORK_URL"
+	EnvForkBlock = "EIGENLAYER_TEST_FORK_BLOCK"
+)
+
+// pauserMappingSlot is the storage slot of PauserRegistry.isPauser.
+const pauserMappingSlot = 0
+
+// maxBalanceSlot bounds the storage slots SetTokenBalance probes for a token's balance mapping.
+const maxBalanceSlot = 64
+
+// Fork drives an anvil fork of a network with a canonical deployment: it sends transactions as
+// any account, such as the owners and pausers of the core contracts, rewrites storage, and
+// snapshots and reverts the chain between tests.
+//
+//	fork := testutils.SetupFork(t)
+//	fork.Isolate(t)
+//	strategyManager, _ := fork.EigenLayer.Address(addresses.StrategyManager)
+//	owner, _ := fork.Owner(ctx, strategyManager)
+//	_, err := fork.Transact(ctx, owner, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return fork.EigenLayer.StrategyManager.SetStrategyWhitelister(opts, whitelister)
+//	})
+type Fork struct {
+	Backend *ethclient.Client
+	ChainID *big.Int
+	// EigenLayer holds the bindings of the forked network's core contracts, as resolved by
+	// addresses.Default.
+	EigenLayer *client.EigenLayerClient
+
+	rpc *rpc.Client
+}
+
+// SetupFork starts anvil forking the network at $EIGENLAYER_TEST_FORK_URL, at block
+// $EIGENLAYER_TEST_FORK_BLOCK if set, for the duration of t. t is skipped if the URL is not set
+// or anvil is not installed.
+func SetupFork(t testing.TB) *Fork {
+	t.Helper()
+	url := os.Getenv(EnvForkURL)
+	if url == "" {
+		t.Skipf("%s not set", EnvForkURL)
+	}
+	if _, err := exec.LookPath("anvil"); err != nil {
+		t.Skip("anvil not found")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), setupTimeout)
+	defer cancel()
+
+	args := []string{"--fork-url", url}
+	if block := os.Getenv(EnvForkBlock); block != "" {
+		args = append(args, "--fork-block-number", block)
+	}
+	fork, err := NewFork(ctx, startAnvil(ctx, t, args...))
+	if err != nil {
+		t.Fatalf("failed to set up fork: %v", err)
+	}
+	return fork
+}
+
+// NewFork returns a Fork driving the anvil fork behind backend.
+func NewFork(ctx context.Context, backend *ethclient.Client) (*Fork, error) {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	eigenLayer, err := client.NewEigenLayerClientWithBackend(backend, chainID.Uint64(), addresses.Default)
+	if err != nil {
+		return nil, err
+	}
+	return &Fork{Backend: backend, ChainID: chainID, EigenLayer: eigenLayer, rpc: backend.Client()}, nil
+}
+
+// Impersonate lets transactions be sent from account without its key.
+func (f *Fork) Impersonate(ctx context.Context, account common.Address) error {
+	return f.rpc.CallContext(ctx, nil, "anvil_impersonateAccount", account)
+}
+
+// StopImpersonating undoes Impersonate.
+func (f *Fork) StopImpersonating(ctx context.Context, account common.Address) error {
+	return f.rpc.CallContext(ctx, nil, "anvil_stopImpersonatingAccount", account)
+}
+
+// Transact sends the transaction built by transact from the impersonated account from, and
+// waits for it to be mined. A transaction that would revert is not sent, and its error is
+// decoded as by simulate.Simulate. from pays for gas; fund it with SetBalance if needed.
+func (f *Fork) Transact(ctx context.Context, from common.Address, transact simulate.Transact) (*types.Receipt, error) {
+	tx, err := simulate.Build(ctx, from, transact)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := simulate.Simulate(ctx, f.Backend, from, tx); err != nil {
+		return nil, err
+	}
+	if err := f.Impersonate(ctx, from); err != nil {
+		return nil, fmt.Errorf("failed to impersonate %s: %w", from, err)
+	}
+	defer f.StopImpersonating(ctx, from)
+
+	var hash common.Hash
+	if err := f.rpc.CallContext(ctx, &hash, "eth_sendTransaction", map[string]interface{}{
+		"from":  from,
+		"to":    tx.To(),
+		"value": (*hexutil.Big)(tx.Value()),
+		"input": hexutil.Bytes(tx.Data()),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to send transaction from %s: %w", from, err)
+	}
+	for {
+		receipt, err := f.Backend.TransactionReceipt(ctx, hash)
+		if err == nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return receipt, fmt.Errorf("transaction %s reverted", hash)
+			}
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("failed to wait for %s: %w", hash, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for %s: %w", hash, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// Owner returns the owner of an Ownable contract, such as a core contract or the ProxyAdmin.
+func (f *Fork) Owner(ctx context.Context, contract common.Address) (common.Address, error) {
+	var out []interface{}
+	bound := bind.NewBoundContract(contract, abis.Ownable, f.Backend, nil, nil)
+	if err := bound.Call(&bind.CallOpts{Context: ctx}, &out, "owner"); err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch owner of %s: %w", contract, err)
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// Unpauser returns the unpauser of the PauserRegistry at pauserRegistry.
+func (f *Fork) Unpauser(ctx context.Context, pauserRegistry common.Address) (common.Address, error) {
+	caller, err := PauserRegistry.NewPauserRegistryCaller(pauserRegistry, f.Backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return caller.Unpauser(&bind.CallOpts{Context: ctx})
+}
+
+// SetPauser makes account a pauser in the PauserRegistry at pauserRegistry by writing its
+// isPauser mapping. Pausers cannot be enumerated on-chain, so tests that pause make their own.
+func (f *Fork) SetPauser(ctx context.Context, pauserRegistry, account common.Address) error {
+	slot := MappingSlot(common.BytesToHash(account.Bytes()), pauserMappingSlot)
+	return f.SetStorageAt(ctx, pauserRegistry, slot, common.BigToHash(big.NewInt(1)))
+}
+
+// SetBalance sets the ETH balance of account.
+func (f *Fork) SetBalance(ctx context.Context, account common.Address, balance *big.Int) error {
+	return f.rpc.CallContext(ctx, nil, "anvil_setBalance", account, (*hexutil.Big)(balance))
+}
+
+// SetStorageAt writes value to slot of contract.
+func (f *Fork) SetStorageAt(ctx context.Context, contract common.Address, slot, value common.Hash) error {
+	return f.rpc.CallContext(ctx, nil, "anvil_setStorageAt", contract, slot, value)
+}
+
+// MappingSlot returns the storage slot of key in a Solidity mapping declared at slot.
+func MappingSlot(key common.Hash, slot uint64) common.Hash {
+	return crypto.Keccak256Hash(key.Bytes(), common.BigToHash(new(big.Int).SetUint64(slot)).Bytes())
+}
+
+// SetTokenBalance sets the balance of account in the ERC20 token to amount. The token's balance
+// mapping is found by writing candidate slots and reading balanceOf, which covers tokens that
+// keep balances in a plain mapping, such as OpenZeppelin's ERC20.
+func (f *Fork) SetTokenBalance(ctx context.Context, token, account common.Address, amount *big.Int) error {
+	key := common.BytesToHash(account.Bytes())
+	want := common.BigToHash(amount)
+	for i := uint64(0); i < maxBalanceSlot; i++ {
+		slot := MappingSlot(key, i)
+		prev, err := f.Backend.StorageAt(ctx, token, slot, nil)
+		if err != nil {
+			return fmt.Errorf("failed to read storage of %s: %w", token, err)
+		}
+		if err := f.SetStorageAt(ctx, token, slot, want); err != nil {
+			return err
+		}
+		balance, err := f.tokenBalance(ctx, token, account)
+		if err != nil {
+			return err
+		}
+		if balance.Cmp(amount) == 0 {
+			return nil
+		}
+		if err := f.SetStorageAt(ctx, token, slot, common.BytesToHash(prev)); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("no balance mapping found in the first %d slots of %s", maxBalanceSlot, token)
+}
+
+func (f *Fork) tokenBalance(ctx context.Context, token, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	bound := bind.NewBoundContract(token, abis.ERC20, f.Backend, nil, nil)
+	if err := bound.Call(&bind.CallOpts{Context: ctx}, &out, "balanceOf", account); err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s in %s: %w", account, token, err)
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Snapshot snapshots the chain and returns the snapshot's ID.
+func (f *Fork) Snapshot(ctx context.Context) (string, error) {
+	var id string
+	if err := f.rpc.CallContext(ctx, &id, "evm_snapshot"); err != nil {
+		return "", fmt.Errorf("failed to snapshot: %w", err)
+	}
+	return id, nil
+}
+
+// Revert reverts the chain to the snapshot id. A snapshot can only be reverted to once.
+func (f *Fork) Revert(ctx context.Context, id string) error {
+	var ok bool
+	if err := f.rpc.CallContext(ctx, &ok, "evm_revert", id); err != nil {
+		return fmt.Errorf("failed to revert to snapshot %s: %w", id, err)
+	}
+	if !ok {
+		return fmt.Errorf("snapshot %s not found", id)
+	}
+	return nil
+}
+
+// Isolate snapshots the chain and reverts to the snapshot when t finishes, so that the state
+// changes of t are not seen by the tests after it.
+func (f *Fork) Isolate(t testing.TB) {
+	t.Helper()
+	id, err := f.Snapshot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := f.Revert(context.Background(), id); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,310 @@
+// Package testutils sets up a complete EigenLayer deployment on a local chain for integration
+// tests:
+//
+//	func TestDeposit(t *testing.T) {
+//		env := testutils.SetupTestEnvironment(t)
+//		staker := env.Accounts[0]
+//		token, err := env.EigenLayer.Token(ctx, env.Token)
+//		_, err = env.EigenLayer.ApproveAndDeposit(ctx, staker.Opts, env.Strategy, token.Amount(amount), nil)
+//		...
+//	}
+//
+// The chain is the node at $EIGENLAYER_TEST_RPC_URL if set, and otherwise an anvil started for
+// the test. Proxy and mock token bytecode is read from the forge output at
+// $EIGENLAYER_TEST_FORGE_OUT, or the out/ directory of the enclosing forge project. Tests are
+// skipped when no chain or build output is available.
+//
+// Delays are skipped rather than waited for: AdvanceBlocks and AdvanceTime mine blocks and move
+// the time of the chain forward, on anvil or go-ethereum's simulated backend (see Chain).
+package testutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/client"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/signer"
+)
+
+// Environment variables read by SetupTestEnvironment.
+const (
+	EnvRPCURL     = "EIGENLAYER_TEST_RPC_URL"
+	EnvPrivateKey = "EIGENLAYER_TEST_PRIVATE_KEY"
+	EnvForgeOut   = "EIGENLAYER_TEST_FORGE_OUT"
+)
+
+// anvilKey is the key of anvil's first default account, which deploys when no key is set in
+// EnvPrivateKey.
+const anvilKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// NumAccounts is the number of funded accounts of a TestEnvironment.
+const NumAccounts = 4
+
+var (
+	// AccountBalance is the ETH balance each account is funded with.
+	AccountBalance = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+	// AccountTokens is the Token balance each account is funded with.
+	AccountTokens = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+)
+
+// setupTimeout bounds the time SetupTestEnvironment spends on the chain.
+const setupTimeout = 2 * time.Minute
+
+// erc20MockABI holds the methods of src/test/mocks/ERC20Mock.sol used by this package. Its
+// constructor mints the deployer type(uint88).max tokens, and it lets anyone transferFrom
+// without an allowance.
+const erc20MockABI = `[
+{"type":"constructor","inputs":[],"stateMutability":"nonpayable"},
+{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var parsedERC20MockABI = abis.MustParse(erc20MockABI)
+
+// Account is a funded test account.
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+	Opts    *bind.TransactOpts
+}
+
+// TestEnvironment is a deployment of the core contracts with a mock token, a strategy for it and
+// funded accounts.
+type TestEnvironment struct {
+	Backend    *ethclient.Client
+	ChainID    *big.Int
+	Deployer   *bind.TransactOpts
+	Deployment *deploy.Deployment
+	// Registry resolves the contracts of Deployment on ChainID.
+	Registry *addresses.Registry
+	// EigenLayer holds the bindings of the core contracts.
+	EigenLayer *client.EigenLayerClient
+	// Token is an ERC20Mock, and Strategy a StrategyBaseTVLLimits for it whitelisted for deposits.
+	Token    common.Address
+	Strategy common.Address
+	Accounts []*Account
+}
+
+// SetupTestEnvironment deploys a TestEnvironment, skipping t if no chain or forge output is
+// available and failing it on any other error.
+func SetupTestEnvironment(t testing.TB) *TestEnvironment {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), setupTimeout)
+	defer cancel()
+
+	artifacts, err := deploy.LoadArtifacts(forgeOut())
+	if err != nil {
+		t.Skipf("forge build output not available: %v", err)
+	}
+	tokenCode, err := deploy.LoadBytecode(forgeOut(), "ERC20Mock")
+	if err != nil {
+		t.Skipf("forge build output not available: %v", err)
+	}
+	backend := dial(ctx, t)
+
+	env, err := setup(ctx, backend, artifacts, tokenCode)
+	if err != nil {
+		t.Fatalf("failed to set up test environment: %v", err)
+	}
+	return env
+}
+
+func setup(ctx context.Context, backend *ethclient.Client, artifacts *deploy.Artifacts, tokenCode []byte) (*TestEnvironment, error) {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	keyHex := os.Getenv(EnvPrivateKey)
+	if keyHex == "" {
+		keyHex = anvilKey
+	}
+	deployer, err := signer.FromHexKey(keyHex, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", EnvPrivateKey, err)
+	}
+	env := &TestEnvironment{Backend: backend, ChainID: chainID, Deployer: deployer, Registry: addresses.NewRegistry()}
+
+	token, tx, _, err := bind.DeployContract(txOpts(ctx, deployer), parsedERC20MockABI, tokenCode, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy ERC20Mock: %w", err)
+	}
+	if err := waitMined(ctx, backend, tx); err != nil {
+		return nil, err
+	}
+	env.Token = token
+
+	cfg := deploy.DefaultConfig()
+	cfg.Strategies = []deploy.StrategyConfig{{
+		Token:            token,
+		MaxPerDeposit:    new(big.Int).Mul(AccountTokens, big.NewInt(NumAccounts)),
+		MaxTotalDeposits: new(big.Int).Mul(AccountTokens, big.NewInt(NumAccounts)),
+	}}
+	env.Deployment, err = deploy.Deploy(ctx, backend, deployer, artifacts, cfg)
+	if err != nil {
+		return nil, err
+	}
+	env.Strategy = env.Deployment.Strategies[0]
+	env.Deployment.Register(env.Registry, chainID.Uint64())
+	env.EigenLayer, err = client.NewEigenLayerClientWithBackend(backend, chainID.Uint64(), env.Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < NumAccounts; i++ {
+		account, err := env.fund(ctx)
+		if err != nil {
+			return nil, err
+		}
+		env.Accounts = append(env.Accounts, account)
+	}
+	return env, nil
+}
+
+// fund creates an account and sends it AccountBalance ETH and AccountTokens of Token.
+func (env *TestEnvironment) fund(ctx context.Context) (*Account, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, env.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	account := &Account{Key: key, Address: opts.From, Opts: opts}
+
+	value := txOpts(ctx, env.Deployer)
+	value.Value = AccountBalance
+	tx, err := bind.NewBoundContract(account.Address, abi.ABI{}, env.Backend, env.Backend, env.Backend).Transfer(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fund %s: %w", account.Address, err)
+	}
+	if err := waitMined(ctx, env.Backend, tx); err != nil {
+		return nil, err
+	}
+	token := bind.NewBoundContract(env.Token, parsedERC20MockABI, env.Backend, env.Backend, env.Backend)
+	tx, err = token.Transact(txOpts(ctx, env.Deployer), "transfer", account.Address, AccountTokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fund %s with tokens: %w", account.Address, err)
+	}
+	if err := waitMined(ctx, env.Backend, tx); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// dial connects to the node at EnvRPCURL, or starts anvil, skipping t if neither is possible.
+func dial(ctx context.Context, t testing.TB) *ethclient.Client {
+	t.Helper()
+	if url := os.Getenv(EnvRPCURL); url != "" {
+		backend, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			t.Fatalf("failed to dial %s: %v", url, err)
+		}
+		t.Cleanup(backend.Close)
+		return backend
+	}
+
+	if _, err := exec.LookPath("anvil"); err != nil {
+		t.Skipf("anvil not found and %s not set", EnvRPCURL)
+	}
+	return startAnvil(ctx, t)
+}
+
+// startAnvil starts anvil with args on a free port for the duration of t and dials it.
+func startAnvil(ctx context.Context, t testing.TB, args ...string) *ethclient.Client {
+	t.Helper()
+	port, err := freePort()
+	if err != nil {
+		t.Fatalf("failed to pick a port for anvil: %v", err)
+	}
+	cmd := exec.Command("anvil", append([]string{"--port", fmt.Sprint(port), "--silent"}, args...)...)
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start anvil: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	backend, err := ethclient.DialContext(ctx, fmt.Sprintf("http://127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatalf("failed to dial anvil: %v", err)
+	}
+	t.Cleanup(backend.Close)
+	for {
+		if _, err := backend.ChainID(ctx); err == nil {
+			return backend
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("anvil did not start: %v", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// forgeOut returns EnvForgeOut, or the out/ directory next to the nearest foundry.toml above
+// the working directory.
+func forgeOut() string {
+	if out := os.Getenv(EnvForgeOut); out != "" {
+		return out
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "out"
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "foundry.toml")); err == nil {
+			return filepath.Join(dir, "out")
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "out"
+		}
+		dir = parent
+	}
+}
+
+func txOpts(ctx context.Context, opts *bind.TransactOpts) *bind.TransactOpts {
+	copied := *opts
+	copied.Context = ctx
+	return &copied
+}
+
+func waitMined(ctx context.Context, backend bind.DeployBackend, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Chain moves the chain of a test forward, so that flows gated by a delay, such as completing a
+// queued withdrawal once the withdrawal delay has elapsed, run without waiting for it.
+// TestEnvironment and Fork are Chains driving anvil, and Simulated one driving go-ethereum's
+// simulated backend:
+//
+//	queued := queueWithdrawal(t, env)
+//	delay, _ := env.EigenLayer.DelegationManager.MinWithdrawalDelayBlocks(nil)
+//	err := env.AdvanceBlocks(ctx, delay.Uint64())
+//	completeWithdrawal(t, env, queued)
+//
+// The withdrawal delays of this tree are counted in blocks; AdvanceTime serves the flows gated
+// by timestamps, such as EigenPod checkpoints and rewards submissions.
+type Chain interface {
+	// AdvanceBlocks mines n empty blocks.
+	AdvanceBlocks(ctx context.Context, n uint64) error
+	// AdvanceTime mines a block whose timestamp is d later than it would otherwise be.
+	AdvanceTime(ctx context.Context, d time.Duration) error
+}
+
+var (
+	_ Chain = (*TestEnvironment)(nil)
+	_ Chain = (*Fork)(nil)
+	_ Chain = Simulated{}
+)
+
+// AdvanceBlocks mines n empty blocks.
+func (env *TestEnvironment) AdvanceBlocks(ctx context.Context, n uint64) error {
+	return anvilMine(ctx, env.Backend.Client(), n)
+}
+
+// AdvanceTime mines a block whose timestamp is d later than it would otherwise be.
+func (env *TestEnvironment) AdvanceTime(ctx context.Context, d time.Duration) error {
+	return anvilIncreaseTime(ctx, env.Backend.Client(), d)
+}
+
+// AdvanceBlocks mines n empty blocks.
+func (f *Fork) AdvanceBlocks(ctx context.Context, n uint64) error {
+	return anvilMine(ctx, f.rpc, n)
+}
+
+// AdvanceTime mines a block whose timestamp is d later than it would otherwise be.
+func (f *Fork) AdvanceTime(ctx context.Context, d time.Duration) error {
+	return anvilIncreaseTime(ctx, f.rpc, d)
+}
+
+// anvilMine mines n blocks in a single call.
+func anvilMine(ctx context.Context, client *rpc.Client, n uint64) error {
+	if n == 0 {
+		return nil
+	}
+	if err := client.CallContext(ctx, nil, "anvil_mine", hexutil.Uint64(n)); err != nil {
+		return fmt.Errorf("failed to mine %d blocks: %w", n, err)
+	}
+	return nil
+}
+
+// anvilIncreaseTime moves the time of the chain forward by d, rounded up to a second, and mines
+// a block at the new time.
+func anvilIncreaseTime(ctx context.Context, client *rpc.Client, d time.Duration) error {
+	seconds := uint64((d + time.Second - 1) / time.Second)
+	if err := client.CallContext(ctx, nil, "evm_increaseTime", seconds); err != nil {
+		return fmt.Errorf("failed to increase time by %s: %w", d, err)
+	}
+	return anvilMine(ctx, client, 1)
+}
+
+// SimulatedBackend is the part of go-ethereum's *simulated.Backend that Simulated drives.
+type SimulatedBackend interface {
+	Commit() common.Hash
+	AdjustTime(adjustment time.Duration) error
+}
+
+// Simulated is a Chain on go-ethereum's simulated backend, which mines a block on every Commit.
+type Simulated struct {
+	Backend SimulatedBackend
+}
+
+// AdvanceBlocks commits n empty blocks.
+func (s Simulated) AdvanceBlocks(ctx context.Context, n uint64) error {
+	for i := uint64(0); i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.Backend.Commit()
+	}
+	return nil
+}
+
+// AdvanceTime commits a block whose timestamp is d later than its parent's, rounded up to a
+// second. Pending transactions must be committed first, as the simulated backend only adjusts
+// the time of empty blocks.
+func (s Simulated) AdvanceTime(_ context.Context, d time.Duration) error {
+	// Block timestamps are in seconds, and AdjustTime truncates the adjustment to a second.
+	rounded := (d + time.Second - 1) / time.Second * time.Second
+	if err := s.Backend.AdjustTime(rounded); err != nil {
+		return fmt.Errorf("failed to increase time by %s: %w", d, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+func TestSimulatedAdvanceTime(t *testing.T) {
+	ctx := context.Background()
+	backend := simulated.NewBackend(types.GenesisAlloc{})
+	defer backend.Close()
+	chain := Simulated{Backend: backend}
+	client := backend.Client()
+
+	tests := []struct {
+		d    time.Duration
+		want uint64
+	}{
+		{time.Hour, 3600},
+		{7 * 24 * time.Hour, 604800},
+		{1500 * time.Millisecond, 2},
+		{time.Second, 1},
+	}
+	for _, tt := range tests {
+		parent, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := chain.AdvanceTime(ctx, tt.d); err != nil {
+			t.Fatal(err)
+		}
+		head, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if head.Number.Uint64() != parent.Number.Uint64()+1 {
+			t.Errorf("AdvanceTime(%s) mined block %d on %d", tt.d, head.Number, parent.Number)
+		}
+		if delta := head.Time - parent.Time; delta != tt.want {
+			t.Errorf("AdvanceTime(%s) moved the time by %ds, want %ds", tt.d, delta, tt.want)
+		}
+	}
+}
+
+func TestSimulatedAdvanceBlocks(t *testing.T) {
+	ctx := context.Background()
+	backend := simulated.NewBackend(types.GenesisAlloc{})
+	defer backend.Close()
+
+	if err := (Simulated{Backend: backend}).AdvanceBlocks(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	head, err := backend.Client().BlockNumber(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != 5 {
+		t.Errorf("block number = %d, want 5", head)
+	}
+}
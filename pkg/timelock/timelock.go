@@ -0,0 +1,199 @@
+// Package timelock encodes governance operations for the OpenZeppelin TimelockController used
+// by EigenLayer protocol upgrades and parameter changes.
+//
+// Calls built with pkg/calldata are wrapped into an Operation, whose schedule and execute
+// calldata is then proposed by the timelock's proposer and executor:
+//
+//	call, _ := calldata.Build(func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return strategyManager.SetStrategyWhitelister(opts, whitelister)
+//	})
+//	op := timelock.NewOperation(call)
+//	schedule, _ := op.Schedule(timelockAddress, minDelay)
+//	// ... after minDelay has passed:
+//	execute, _ := op.Execute(timelockAddress)
+package timelock
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+)
+
+const timelockControllerABI = `[
+{"type":"function","name":"schedule","stateMutability":"nonpayable","inputs":[{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"predecessor","type":"bytes32"},{"name":"salt","type":"bytes32"},{"name":"delay","type":"uint256"}],"outputs":[]},
+{"type":"function","name":"scheduleBatch","stateMutability":"nonpayable","inputs":[{"name":"targets","type":"address[]"},{"name":"values","type":"uint256[]"},{"name":"payloads","type":"bytes[]"},{"name":"predecessor","type":"bytes32"},{"name":"salt","type":"bytes32"},{"name":"delay","type":"uint256"}],"outputs":[]},
+{"type":"function","name":"execute","stateMutability":"payable","inputs":[{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"payload","type":"bytes"},{"name":"predecessor","type":"bytes32"},{"name":"salt","type":"bytes32"}],"outputs":[]},
+{"type":"function","name":"executeBatch","stateMutability":"payable","inputs":[{"name":"targets","type":"address[]"},{"name":"values","type":"uint256[]"},{"name":"payloads","type":"bytes[]"},{"name":"predecessor","type":"bytes32"},{"name":"salt","type":"bytes32"}],"outputs":[]},
+{"type":"function","name":"cancel","stateMutability":"nonpayable","inputs":[{"name":"id","type":"bytes32"}],"outputs":[]}
+]`
+
+var parsedTimelockControllerABI = abis.MustParse(timelockControllerABI)
+
+// hashArgs and hashBatchArgs are the arguments hashed by hashOperation and hashOperationBatch.
+var (
+	hashArgs      = parsedTimelockControllerABI.Methods["execute"].Inputs
+	hashBatchArgs = parsedTimelockControllerABI.Methods["executeBatch"].Inputs
+)
+
+// Operation is a timelock operation executing one or more calls in order.
+type Operation struct {
+	// Calls are the calls the operation executes. An operation with more than one call is
+	// scheduled and executed with scheduleBatch and executeBatch. The timelock executes calls
+	// directly, so their Operation must be calldata.OpCall.
+	Calls []calldata.Call
+	// Predecessor is the ID of an operation that must be executed before this one, or zero.
+	Predecessor common.Hash
+	// Salt distinguishes otherwise identical operations.
+	Salt common.Hash
+}
+
+// NewOperation returns an operation executing calls, with no predecessor and a zero salt.
+func NewOperation(calls ...calldata.Call) *Operation {
+	return &Operation{Calls: calls}
+}
+
+// RandomSalt returns a random salt, so that an operation identical to one already scheduled
+// can be scheduled again.
+func RandomSalt() (common.Hash, error) {
+	var salt common.Hash
+	if _, err := rand.Read(salt[:]); err != nil {
+		return common.Hash{}, err
+	}
+	return salt, nil
+}
+
+// Chain sets the predecessor of each operation to the one before it, so the timelock only
+// executes them in order. The predecessor of the first operation is left unchanged.
+func Chain(ops ...*Operation) error {
+	for i := 1; i < len(ops); i++ {
+		id, err := ops[i-1].ID()
+		if err != nil {
+			return err
+		}
+		ops[i].Predecessor = id
+	}
+	return nil
+}
+
+// ID returns the operation ID, computed identically to TimelockController.hashOperation, or
+// hashOperationBatch for operations with more than one call.
+func (op *Operation) ID() (common.Hash, error) {
+	if err := op.validate(); err != nil {
+		return common.Hash{}, err
+	}
+	var (
+		encoded []byte
+		err     error
+	)
+	if len(op.Calls) == 1 {
+		call := op.Calls[0]
+		encoded, err = hashArgs.Pack(call.To, value(call), call.Data, op.Predecessor, op.Salt)
+	} else {
+		targets, values, payloads := op.columns()
+		encoded, err = hashBatchArgs.Pack(targets, values, payloads, op.Predecessor, op.Salt)
+	}
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode operation: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// Schedule returns the call scheduling the operation on the timelock at timelock, to become
+// executable after delay seconds. delay must be at least the timelock's getMinDelay.
+func (op *Operation) Schedule(timelock common.Address, delay *big.Int) (calldata.Call, error) {
+	if err := op.validate(); err != nil {
+		return calldata.Call{}, err
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if len(op.Calls) == 1 {
+		call := op.Calls[0]
+		data, err = parsedTimelockControllerABI.Pack("schedule", call.To, value(call), call.Data, op.Predecessor, op.Salt, delay)
+	} else {
+		targets, values, payloads := op.columns()
+		data, err = parsedTimelockControllerABI.Pack("scheduleBatch", targets, values, payloads, op.Predecessor, op.Salt, delay)
+	}
+	if err != nil {
+		return calldata.Call{}, fmt.Errorf("failed to encode schedule: %w", err)
+	}
+	return calldata.Call{To: timelock, Value: new(big.Int), Data: data}, nil
+}
+
+// Execute returns the call executing the scheduled operation on the timelock at timelock. Its
+// value is the total value of the operation's calls, which the executor must send.
+func (op *Operation) Execute(timelock common.Address) (calldata.Call, error) {
+	if err := op.validate(); err != nil {
+		return calldata.Call{}, err
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if len(op.Calls) == 1 {
+		call := op.Calls[0]
+		data, err = parsedTimelockControllerABI.Pack("execute", call.To, value(call), call.Data, op.Predecessor, op.Salt)
+	} else {
+		targets, values, payloads := op.columns()
+		data, err = parsedTimelockControllerABI.Pack("executeBatch", targets, values, payloads, op.Predecessor, op.Salt)
+	}
+	if err != nil {
+		return calldata.Call{}, fmt.Errorf("failed to encode execute: %w", err)
+	}
+	total := new(big.Int)
+	for _, call := range op.Calls {
+		total.Add(total, value(call))
+	}
+	return calldata.Call{To: timelock, Value: total, Data: data}, nil
+}
+
+// Cancel returns the call cancelling the pending operation on the timelock at timelock.
+func (op *Operation) Cancel(timelock common.Address) (calldata.Call, error) {
+	id, err := op.ID()
+	if err != nil {
+		return calldata.Call{}, err
+	}
+	data, err := parsedTimelockControllerABI.Pack("cancel", id)
+	if err != nil {
+		return calldata.Call{}, fmt.Errorf("failed to encode cancel: %w", err)
+	}
+	return calldata.Call{To: timelock, Value: new(big.Int), Data: data}, nil
+}
+
+func (op *Operation) validate() error {
+	if len(op.Calls) == 0 {
+		return errors.New("operation has no calls")
+	}
+	for _, call := range op.Calls {
+		if call.Operation != calldata.OpCall {
+			return errors.New("the timelock cannot delegatecall")
+		}
+	}
+	return nil
+}
+
+// columns splits the operation's calls into the arguments of the batch methods.
+func (op *Operation) columns() ([]common.Address, []*big.Int, [][]byte) {
+	targets := make([]common.Address, len(op.Calls))
+	values := make([]*big.Int, len(op.Calls))
+	payloads := make([][]byte, len(op.Calls))
+	for i, call := range op.Calls {
+		targets[i], values[i], payloads[i] = call.To, value(call), call.Data
+	}
+	return targets, values, payloads
+}
+
+func value(call calldata.Call) *big.Int {
+	if call.Value == nil {
+		return new(big.Int)
+	}
+	return call.Value
+}
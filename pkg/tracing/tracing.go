@@ -0,0 +1,225 @@
+// Package tracing wraps a chain backend so that every binding call, transaction and receipt
+// lookup made through it is recorded as a span, named after the contract and method decoded
+// from its calldata.
+//
+// Tracer and Span follow the shape of the OpenTelemetry trace API without depending on it, so
+// that an OpenTelemetry tracer is adapted in a few lines:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name)
+//		s := otelSpan{span}
+//		s.SetAttributes(attrs...)
+//		return ctx, s
+//	}
+//
+// The context returned by Start is passed on to the wrapped backend, so that spans of the RPC
+// transport nest under the span of the binding call, which itself nests under any span in the
+// context of the binding's options:
+//
+//	backend := tracing.NewBackend(ethClient, otelTracer{otel.Tracer("withdrawals")}, nil)
+//	c, err := client.NewEigenLayerClientWithBackend(backend, chainID, addresses.Default)
+package tracing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/decoder"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ethbackend"
+)
+
+// Keys of the attributes set on spans.
+const (
+	AttrRPC       = "eth.rpc"
+	AttrContract  = "eth.contract"
+	AttrMethod    = "eth.method"
+	AttrTo        = "eth.to"
+	AttrFrom      = "eth.from"
+	AttrBlock     = "eth.block"
+	AttrTxHash    = "eth.tx_hash"
+	AttrNonce     = "eth.nonce"
+	AttrGasLimit  = "eth.gas_limit"
+	AttrGasUsed   = "eth.gas_used"
+	AttrStatus    = "eth.status"
+	AttrFromBlock = "eth.from_block"
+	AttrToBlock   = "eth.to_block"
+	AttrLogs      = "eth.logs"
+)
+
+// Attribute is a span attribute. Values are strings, int64s or bools.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string attribute.
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int64 returns an int64 attribute.
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a bool attribute.
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Tracer starts spans.
+type Tracer interface {
+	// Start starts a span that is a child of any span in ctx, and returns a context holding it.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is an operation being traced.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	// RecordError records err and marks the span as failed.
+	RecordError(err error)
+	End()
+}
+
+// NopTracer is a Tracer whose spans record nothing.
+type NopTracer struct{}
+
+// Start returns ctx and a span that records nothing.
+func (NopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(...Attribute) {}
+func (nopSpan) RecordError(error)          {}
+func (nopSpan) End()                       {}
+
+// Backend is an ethbackend.Backend that traces the calls made through it. Methods that are not
+// traced are passed on to the wrapped backend.
+type Backend struct {
+	ethbackend.Backend
+	tracer  Tracer
+	decoder *decoder.Decoder
+}
+
+var _ ethbackend.Backend = (*Backend)(nil)
+
+// NewBackend returns backend traced by tracer. Calldata is decoded by d, or, if d is nil, by a
+// Decoder that knows no addresses.
+func NewBackend(backend ethbackend.Backend, tracer Tracer, d *decoder.Decoder) *Backend {
+	if d == nil {
+		d = decoder.New(nil)
+	}
+	return &Backend{Backend: backend, tracer: tracer, decoder: d}
+}
+
+// start starts the span of the rpc call carrying data to to, named after the contract
+// and method decoded from data when possible.
+func (b *Backend) start(ctx context.Context, rpc string, to *common.Address, data []byte, attrs ...Attribute) (context.Context, Span) {
+	name := rpc
+	attrs = append(attrs, String(AttrRPC, rpc))
+	if to != nil {
+		attrs = append(attrs, String(AttrTo, to.Hex()))
+		if call, err := b.decoder.DecodeCalldata(*to, data); err == nil {
+			name = call.Contract + "." + call.Name
+			attrs = append(attrs, String(AttrContract, call.Contract), String(AttrMethod, call.Name))
+		}
+	}
+	return b.tracer.Start(ctx, name, attrs...)
+}
+
+// end records err, if any, and ends span.
+func end(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// blockAttr returns the block attribute of blockNumber, which is the latest block when nil.
+func blockAttr(blockNumber *big.Int) Attribute {
+	if blockNumber == nil {
+		return String(AttrBlock, "latest")
+	}
+	return String(AttrBlock, blockNumber.String())
+}
+
+// CallContract traces an eth_call.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ctx, span := b.start(ctx, "eth_call", call.To, call.Data, blockAttr(blockNumber), String(AttrFrom, call.From.Hex()))
+	out, err := b.Backend.CallContract(ctx, call, blockNumber)
+	end(span, err)
+	return out, err
+}
+
+// EstimateGas traces an eth_estimateGas.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	ctx, span := b.start(ctx, "eth_estimateGas", call.To, call.Data, String(AttrFrom, call.From.Hex()))
+	gas, err := b.Backend.EstimateGas(ctx, call)
+	if err == nil {
+		span.SetAttributes(Int64(AttrGasLimit, int64(gas)))
+	}
+	end(span, err)
+	return gas, err
+}
+
+// SendTransaction traces an eth_sendRawTransaction.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	ctx, span := b.start(ctx, "eth_sendRawTransaction", tx.To(), tx.Data(),
+		String(AttrTxHash, tx.Hash().Hex()),
+		Int64(AttrNonce, int64(tx.Nonce())),
+		Int64(AttrGasLimit, int64(tx.Gas())),
+	)
+	err := b.Backend.SendTransaction(ctx, tx)
+	end(span, err)
+	return err
+}
+
+// TransactionReceipt traces an eth_getTransactionReceipt. A receipt not found yet, as when
+// bind.WaitMined polls for it, is not recorded as an error.
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ctx, span := b.tracer.Start(ctx, "eth_getTransactionReceipt",
+		String(AttrRPC, "eth_getTransactionReceipt"),
+		String(AttrTxHash, txHash.Hex()),
+	)
+	receipt, err := b.Backend.TransactionReceipt(ctx, txHash)
+	if err == nil {
+		span.SetAttributes(
+			String(AttrBlock, receipt.BlockNumber.String()),
+			Int64(AttrGasUsed, int64(receipt.GasUsed)),
+			Bool(AttrStatus, receipt.Status == types.ReceiptStatusSuccessful),
+		)
+	}
+	if errors.Is(err, ethereum.NotFound) {
+		end(span, nil)
+	} else {
+		end(span, err)
+	}
+	return receipt, err
+}
+
+// FilterLogs traces an eth_getLogs.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	attrs := []Attribute{String(AttrRPC, "eth_getLogs")}
+	if query.FromBlock != nil {
+		attrs = append(attrs, Int64(AttrFromBlock, query.FromBlock.Int64()))
+	}
+	if query.ToBlock != nil {
+		attrs = append(attrs, Int64(AttrToBlock, query.ToBlock.Int64()))
+	}
+	ctx, span := b.tracer.Start(ctx, "eth_getLogs", attrs...)
+	logs, err := b.Backend.FilterLogs(ctx, query)
+	if err == nil {
+		span.SetAttributes(Int64(AttrLogs, int64(len(logs))))
+	}
+	end(span, err)
+	return logs, err
+}
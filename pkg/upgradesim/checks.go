@@ -0,0 +1,259 @@
+package upgradesim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+)
+
+// oneToken is the amount of shares or underlying converted by the strategy checks.
+var oneToken = big.NewInt(1e18)
+
+// Sample is the accounts the checks of CoreChecks exercise. Pick accounts that cover the states
+// the upgrade touches, such as a delegated and an undelegated staker, an EigenPod owner and an
+// operator registered to an AVS.
+type Sample struct {
+	Stakers    []common.Address
+	Operators  []common.Address
+	AVSs       []common.Address
+	Strategies []common.Address
+}
+
+type (
+	dmCaller   = DelegationManager.DelegationManagerCaller
+	smCaller   = StrategyManager.StrategyManagerCaller
+	epmCaller  = EigenPodManager.EigenPodManagerCaller
+	avsdCaller = AVSDirectory.AVSDirectoryCaller
+	rcCaller   = RewardsCoordinator.RewardsCoordinatorCaller
+	sCaller    = StrategyBase.StrategyBaseCaller
+)
+
+// CoreChecks returns a suite of checks of the core contracts of deployment, a map of contract
+// names to addresses as returned by addresses.Registry.Deployment, over the accounts of sample:
+// their global views, the views of each staker, operator, AVS registration and strategy, and the
+// canned transactions of each staker queueing the withdrawal of all its deposits and undelegating,
+// and of each operator re-setting its operator details. Contracts missing from deployment are
+// skipped.
+func CoreChecks(deployment map[string]common.Address, sample Sample) []Check {
+	var checks []Check
+
+	if dm, ok := deployment[addresses.DelegationManager]; ok {
+		newCaller := DelegationManager.NewDelegationManagerCaller
+		checks = append(checks,
+			View("DelegationManager.minWithdrawalDelayBlocks", dm, newCaller, (*dmCaller).MinWithdrawalDelayBlocks),
+			View("DelegationManager.domainSeparator", dm, newCaller, hexView((*dmCaller).DomainSeparator)),
+			View("DelegationManager.paused", dm, newCaller, (*dmCaller).Paused0),
+		)
+		for _, staker := range sample.Stakers {
+			staker := staker
+			checks = append(checks,
+				View(keyed("DelegationManager.delegatedTo", staker), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (common.Address, error) {
+					return c.DelegatedTo(opts, staker)
+				}),
+				View(keyed("DelegationManager.getDelegatableShares", staker), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (string, error) {
+					strategies, shares, err := c.GetDelegatableShares(opts, staker)
+					return fmt.Sprintf("%v %v", strategies, shares), err
+				}),
+				View(keyed("DelegationManager.cumulativeWithdrawalsQueued", staker), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (*big.Int, error) {
+					return c.CumulativeWithdrawalsQueued(opts, staker)
+				}),
+				Transaction(keyed("DelegationManager.queueWithdrawals", staker), staker, queueAllWithdrawals(dm, staker)),
+				Call(keyed("DelegationManager.undelegate", staker), staker, dm, DelegationManager.DelegationManagerMetaData, "undelegate", staker),
+			)
+		}
+		for _, operator := range sample.Operators {
+			operator := operator
+			checks = append(checks,
+				View(keyed("DelegationManager.isOperator", operator), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (bool, error) {
+					return c.IsOperator(opts, operator)
+				}),
+				View(keyed("DelegationManager.operatorDetails", operator), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (DelegationManager.IDelegationManagerOperatorDetails, error) {
+					return c.OperatorDetails(opts, operator)
+				}),
+				View(keyed("DelegationManager.getOperatorShares", operator), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) ([]*big.Int, error) {
+					return c.GetOperatorShares(opts, operator, sample.Strategies)
+				}),
+				Transaction(keyed("DelegationManager.modifyOperatorDetails", operator), operator, modifyOperatorDetails(dm, operator)),
+			)
+		}
+		for _, s := range append([]common.Address{addresses.BeaconChainETHStrategy}, sample.Strategies...) {
+			s := s
+			checks = append(checks, View(keyed("DelegationManager.strategyWithdrawalDelayBlocks", s), dm, newCaller, func(c *dmCaller, opts *bind.CallOpts) (*big.Int, error) {
+				return c.StrategyWithdrawalDelayBlocks(opts, s)
+			}))
+		}
+	}
+
+	if sm, ok := deployment[addresses.StrategyManager]; ok {
+		newCaller := StrategyManager.NewStrategyManagerCaller
+		checks = append(checks,
+			View("StrategyManager.strategyWhitelister", sm, newCaller, (*smCaller).StrategyWhitelister),
+			View("StrategyManager.domainSeparator", sm, newCaller, hexView((*smCaller).DomainSeparator)),
+			View("StrategyManager.paused", sm, newCaller, (*smCaller).Paused0),
+		)
+		for _, staker := range sample.Stakers {
+			staker := staker
+			checks = append(checks,
+				View(keyed("StrategyManager.getDeposits", staker), sm, newCaller, func(c *smCaller, opts *bind.CallOpts) (string, error) {
+					strategies, shares, err := c.GetDeposits(opts, staker)
+					return fmt.Sprintf("%v %v", strategies, shares), err
+				}),
+				View(keyed("StrategyManager.nonces", staker), sm, newCaller, func(c *smCaller, opts *bind.CallOpts) (*big.Int, error) {
+					return c.Nonces(opts, staker)
+				}),
+			)
+		}
+		for _, s := range sample.Strategies {
+			s := s
+			checks = append(checks, View(keyed("StrategyManager.strategyIsWhitelistedForDeposit", s), sm, newCaller, func(c *smCaller, opts *bind.CallOpts) (bool, error) {
+				return c.StrategyIsWhitelistedForDeposit(opts, s)
+			}))
+		}
+	}
+
+	if epm, ok := deployment[addresses.EigenPodManager]; ok {
+		newCaller := EigenPodManager.NewEigenPodManagerCaller
+		checks = append(checks,
+			View("EigenPodManager.numPods", epm, newCaller, (*epmCaller).NumPods),
+			View("EigenPodManager.eigenPodBeacon", epm, newCaller, (*epmCaller).EigenPodBeacon),
+			View("EigenPodManager.paused", epm, newCaller, (*epmCaller).Paused0),
+		)
+		for _, staker := range sample.Stakers {
+			staker := staker
+			checks = append(checks,
+				View(keyed("EigenPodManager.getPod", staker), epm, newCaller, func(c *epmCaller, opts *bind.CallOpts) (common.Address, error) {
+					return c.GetPod(opts, staker)
+				}),
+				View(keyed("EigenPodManager.podOwnerShares", staker), epm, newCaller, func(c *epmCaller, opts *bind.CallOpts) (*big.Int, error) {
+					return c.PodOwnerShares(opts, staker)
+				}),
+			)
+		}
+	}
+
+	if avsd, ok := deployment[addresses.AVSDirectory]; ok {
+		newCaller := AVSDirectory.NewAVSDirectoryCaller
+		checks = append(checks,
+			View("AVSDirectory.domainSeparator", avsd, newCaller, hexView((*avsdCaller).DomainSeparator)),
+			View("AVSDirectory.paused", avsd, newCaller, (*avsdCaller).Paused0),
+		)
+		for _, avs := range sample.AVSs {
+			for _, operator := range sample.Operators {
+				avs, operator := avs, operator
+				checks = append(checks, View(fmt.Sprintf("AVSDirectory.avsOperatorStatus[%s][%s]", avs.Hex(), operator.Hex()), avsd, newCaller, func(c *avsdCaller, opts *bind.CallOpts) (uint8, error) {
+					return c.AvsOperatorStatus(opts, avs, operator)
+				}))
+			}
+		}
+	}
+
+	if rc, ok := deployment[addresses.RewardsCoordinator]; ok {
+		newCaller := RewardsCoordinator.NewRewardsCoordinatorCaller
+		checks = append(checks,
+			View("RewardsCoordinator.rewardsUpdater", rc, newCaller, (*rcCaller).RewardsUpdater),
+			View("RewardsCoordinator.activationDelay", rc, newCaller, (*rcCaller).ActivationDelay),
+			View("RewardsCoordinator.currRewardsCalculationEndTimestamp", rc, newCaller, (*rcCaller).CurrRewardsCalculationEndTimestamp),
+			View("RewardsCoordinator.getDistributionRootsLength", rc, newCaller, (*rcCaller).GetDistributionRootsLength),
+			View("RewardsCoordinator.getCurrentClaimableDistributionRoot", rc, newCaller, (*rcCaller).GetCurrentClaimableDistributionRoot),
+			View("RewardsCoordinator.domainSeparator", rc, newCaller, hexView((*rcCaller).DomainSeparator)),
+			View("RewardsCoordinator.paused", rc, newCaller, (*rcCaller).Paused0),
+		)
+		for _, staker := range sample.Stakers {
+			staker := staker
+			checks = append(checks, View(keyed("RewardsCoordinator.claimerFor", staker), rc, newCaller, func(c *rcCaller, opts *bind.CallOpts) (common.Address, error) {
+				return c.ClaimerFor(opts, staker)
+			}))
+		}
+	}
+
+	for _, s := range sample.Strategies {
+		newCaller := StrategyBase.NewStrategyBaseCaller
+		checks = append(checks,
+			View(keyed("Strategy.underlyingToken", s), s, newCaller, (*sCaller).UnderlyingToken),
+			View(keyed("Strategy.totalShares", s), s, newCaller, (*sCaller).TotalShares),
+			View(keyed("Strategy.sharesToUnderlyingView", s), s, newCaller, func(c *sCaller, opts *bind.CallOpts) (*big.Int, error) {
+				return c.SharesToUnderlyingView(opts, oneToken)
+			}),
+			View(keyed("Strategy.underlyingToSharesView", s), s, newCaller, func(c *sCaller, opts *bind.CallOpts) (*big.Int, error) {
+				return c.UnderlyingToSharesView(opts, oneToken)
+			}),
+		)
+	}
+	return checks
+}
+
+// keyed names the check of a view or transaction for account.
+func keyed(name string, account common.Address) string {
+	return name + "[" + account.Hex() + "]"
+}
+
+// hexView adapts a view returning a bytes32 so that its outcome is printed in hex.
+func hexView[B any](view func(B, *bind.CallOpts) ([32]byte, error)) func(B, *bind.CallOpts) (string, error) {
+	return func(b B, opts *bind.CallOpts) (string, error) {
+		value, err := view(b, opts)
+		return common.Hash(value).Hex(), err
+	}
+}
+
+// queueAllWithdrawals builds the queueWithdrawals call of staker withdrawing all its delegatable
+// shares, as read from the fork.
+func queueAllWithdrawals(dm, staker common.Address) func(ctx context.Context, caller bind.ContractCaller) (common.Address, []byte, error) {
+	return func(ctx context.Context, caller bind.ContractCaller) (common.Address, []byte, error) {
+		c, err := DelegationManager.NewDelegationManagerCaller(dm, caller)
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		strategies, shares, err := c.GetDelegatableShares(&bind.CallOpts{Context: ctx}, staker)
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to fetch delegatable shares: %w", err)
+		}
+		parsed, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		data, err := parsed.Pack("queueWithdrawals", []DelegationManager.IDelegationManagerQueuedWithdrawalParams{{
+			Strategies: strategies,
+			Shares:     shares,
+			Withdrawer: staker,
+		}})
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to pack queueWithdrawals: %w", err)
+		}
+		return dm, data, nil
+	}
+}
+
+// modifyOperatorDetails builds the modifyOperatorDetails call of operator re-setting its current
+// operator details, as read from the fork.
+func modifyOperatorDetails(dm, operator common.Address) func(ctx context.Context, caller bind.ContractCaller) (common.Address, []byte, error) {
+	return func(ctx context.Context, caller bind.ContractCaller) (common.Address, []byte, error) {
+		c, err := DelegationManager.NewDelegationManagerCaller(dm, caller)
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		details, err := c.OperatorDetails(&bind.CallOpts{Context: ctx}, operator)
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to fetch operator details: %w", err)
+		}
+		parsed, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		data, err := parsed.Pack("modifyOperatorDetails", details)
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to pack modifyOperatorDetails: %w", err)
+		}
+		return dm, data, nil
+	}
+}
@@ -0,0 +1,142 @@
+package upgradesim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+)
+
+// Upgrade is a proxy upgrade to simulate.
+type Upgrade struct {
+	// Proxy is the TransparentUpgradeableProxy being upgraded.
+	Proxy common.Address `json:"proxy"`
+	// Implementation is the implementation Proxy is upgraded to.
+	Implementation common.Address `json:"implementation"`
+	// Code, if set, is the runtime bytecode of an implementation that is not deployed yet, placed
+	// at Implementation, such as the address it will be deployed at. Its immutables must already
+	// be filled in.
+	Code hexutil.Bytes `json:"code,omitempty"`
+	// Data is the call of ProxyAdmin.upgradeAndCall, empty for ProxyAdmin.upgrade. The state it
+	// writes is not applied to the fork, since each call runs against the forked block; Run only
+	// reports its outcome.
+	Data hexutil.Bytes `json:"data,omitempty"`
+}
+
+// Fork is a bind.ContractCaller that executes calls against a fixed block with a set of upgrades
+// applied through eth_call state overrides, without sending any transaction. Bindings bound to a
+// Fork behave as they would once the upgrades are executed at that block. A Fork is safe for
+// concurrent use.
+type Fork struct {
+	rpc       *rpc.Client
+	client    *ethclient.Client
+	block     *big.Int
+	overrides map[common.Address]overrideAccount
+}
+
+// overrideAccount is the state override of an account in eth_call.
+type overrideAccount struct {
+	Code      hexutil.Bytes               `json:"code,omitempty"`
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+}
+
+var _ bind.ContractCaller = (*Fork)(nil)
+
+// NewFork returns a Fork of the chain behind client at blockNumber, or the latest block if nil,
+// with upgrades applied. The node must support state overrides in eth_call, as geth, erigon,
+// reth and anvil do. It fails if a proxy is not an EIP-1967 proxy, is upgraded twice, or if an
+// implementation has no code.
+func NewFork(ctx context.Context, client *rpc.Client, blockNumber *big.Int, upgrades ...Upgrade) (*Fork, error) {
+	f := &Fork{
+		rpc:       client,
+		client:    ethclient.NewClient(client),
+		overrides: make(map[common.Address]overrideAccount),
+	}
+	header, err := f.client.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch header: %w", err)
+	}
+	f.block = header.Number
+
+	for _, u := range upgrades {
+		if _, ok := f.overrides[u.Proxy]; ok {
+			return nil, fmt.Errorf("proxy %s is upgraded more than once", u.Proxy.Hex())
+		}
+		current, err := f.client.StorageAt(ctx, u.Proxy, deploy.ImplementationSlot, f.block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read implementation of %s: %w", u.Proxy.Hex(), err)
+		}
+		if common.BytesToAddress(current) == (common.Address{}) {
+			return nil, fmt.Errorf("%s is not an EIP-1967 proxy", u.Proxy.Hex())
+		}
+		if len(u.Code) > 0 {
+			f.overrides[u.Implementation] = overrideAccount{Code: u.Code}
+		} else {
+			code, err := f.client.CodeAt(ctx, u.Implementation, f.block)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch code of %s: %w", u.Implementation.Hex(), err)
+			}
+			if len(code) == 0 {
+				return nil, fmt.Errorf("implementation %s has no code at block %s", u.Implementation.Hex(), f.block)
+			}
+		}
+		f.overrides[u.Proxy] = overrideAccount{
+			StateDiff: map[common.Hash]common.Hash{
+				deploy.ImplementationSlot: common.BytesToHash(u.Implementation.Bytes()),
+			},
+		}
+	}
+	return f, nil
+}
+
+// BlockNumber returns the block the fork executes against.
+func (f *Fork) BlockNumber() uint64 {
+	return f.block.Uint64()
+}
+
+// CallContract executes call against the fork. blockNumber is ignored.
+func (f *Fork) CallContract(ctx context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if len(f.overrides) == 0 {
+		return f.client.CallContract(ctx, call, f.block)
+	}
+	var out hexutil.Bytes
+	if err := f.rpc.CallContext(ctx, &out, "eth_call", toCallArg(call), hexutil.EncodeBig(f.block), f.overrides); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CodeAt returns the code of contract in the fork. blockNumber is ignored.
+func (f *Fork) CodeAt(ctx context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	if override, ok := f.overrides[contract]; ok && override.Code != nil {
+		return override.Code, nil
+	}
+	return f.client.CodeAt(ctx, contract, f.block)
+}
+
+// toCallArg encodes call as ethclient does, without the gas price fields, which are irrelevant to
+// the fork.
+func toCallArg(call ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": call.From,
+		"to":   call.To,
+	}
+	if len(call.Data) > 0 {
+		arg["input"] = hexutil.Bytes(call.Data)
+	}
+	if call.Value != nil {
+		arg["value"] = (*hexutil.Big)(call.Value)
+	}
+	if call.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(call.Gas)
+	}
+	return arg
+}
@@ -0,0 +1,248 @@
+// Package upgradesim previews the behavior of the core contracts after a proposed ProxyAdmin
+// upgrade, before governance executes it.
+//
+// A Fork serves binding calls against a block with the upgrades applied through eth_call state
+// overrides: the EIP-1967 implementation slot of each proxy is pointed at its new implementation,
+// whose code is also overridden if it is not deployed yet. Run executes a suite of Checks, reads
+// and canned transactions, on a fork with and without the upgrades, and reports the checks whose
+// outcome differs:
+//
+//	upgrade, _ := upgradesim.ParseUpgrade(proxyAdminCalldata)
+//	checks := upgradesim.CoreChecks(deployment, upgradesim.Sample{Stakers: stakers, Operators: operators, Strategies: list})
+//	report, _ := upgradesim.Run(ctx, rpcClient, nil, []upgradesim.Upgrade{upgrade}, checks)
+//	for _, r := range report.Changed() {
+//		fmt.Printf("%s: %s -> %s\n", r.Name, r.Before, r.After)
+//	}
+//
+// Transactions are executed as eth_calls, so each runs on the forked state alone and none sees
+// the state written by another.
+package upgradesim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// DefaultConcurrency is the number of checks Run executes at once.
+const DefaultConcurrency = 8
+
+// ErrNotAnUpgrade is returned by ParseUpgrade for calldata that is not a call of
+// ProxyAdmin.upgrade or ProxyAdmin.upgradeAndCall.
+var ErrNotAnUpgrade = errors.New("not a ProxyAdmin upgrade")
+
+// ParseUpgrade decodes the calldata of a ProxyAdmin.upgrade or upgradeAndCall call, such as the
+// call of a timelock operation or multisig transaction, into an Upgrade.
+func ParseUpgrade(calldata []byte) (Upgrade, error) {
+	if len(calldata) < 4 {
+		return Upgrade{}, ErrNotAnUpgrade
+	}
+	method, err := abis.ProxyAdmin.MethodById(calldata[:4])
+	if err != nil || (method.Name != "upgrade" && method.Name != "upgradeAndCall") {
+		return Upgrade{}, ErrNotAnUpgrade
+	}
+	args, err := method.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return Upgrade{}, fmt.Errorf("failed to unpack %s: %w", method.Name, err)
+	}
+	u := Upgrade{
+		Proxy:          *abi.ConvertType(args[0], new(common.Address)).(*common.Address),
+		Implementation: *abi.ConvertType(args[1], new(common.Address)).(*common.Address),
+	}
+	if method.Name == "upgradeAndCall" {
+		u.Data = *abi.ConvertType(args[2], new([]byte)).(*[]byte)
+	}
+	return u, nil
+}
+
+// Check is a representative call whose outcome is compared before and after the upgrades.
+type Check struct {
+	Name string
+	// Run executes the check through caller, which serves every call from the fork, and returns
+	// a description of its outcome. An error, such as a revert, is itself an outcome.
+	Run func(ctx context.Context, caller bind.ContractCaller) (string, error)
+}
+
+// View returns a check of a view of the contract at addr. newCaller is the constructor of the
+// binding's caller, such as DelegationManager.NewDelegationManagerCaller, and view a method of
+// it, such as (*DelegationManager.DelegationManagerCaller).MinWithdrawalDelayBlocks, or a closure
+// over a method with arguments.
+func View[B, T any](name string, addr common.Address, newCaller func(common.Address, bind.ContractCaller) (B, error), view func(B, *bind.CallOpts) (T, error)) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context, caller bind.ContractCaller) (string, error) {
+			b, err := newCaller(addr, caller)
+			if err != nil {
+				return "", err
+			}
+			value, err := view(b, &bind.CallOpts{Context: ctx})
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%v", value), nil
+		},
+	}
+}
+
+// Transaction returns a check that executes the transaction built by build as an eth_call from
+// from, and reports its return data. build may read what it needs, such as a staker's deposits,
+// through caller.
+func Transaction(name string, from common.Address, build func(ctx context.Context, caller bind.ContractCaller) (to common.Address, data []byte, err error)) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context, caller bind.ContractCaller) (string, error) {
+			to, data, err := build(ctx, caller)
+			if err != nil {
+				return "", err
+			}
+			out, err := caller.CallContract(ctx, ethereum.CallMsg{From: from, To: &to, Data: data}, nil)
+			if err != nil {
+				return "", err
+			}
+			return "ok " + hexutil.Encode(out), nil
+		},
+	}
+}
+
+// Call returns a Transaction check of method of the contract at to described by metadata, such
+// as DelegationManager.DelegationManagerMetaData, with fixed arguments.
+func Call(name string, from, to common.Address, metadata *bind.MetaData, method string, args ...interface{}) Check {
+	return Transaction(name, from, func(context.Context, bind.ContractCaller) (common.Address, []byte, error) {
+		parsed, err := metadata.GetAbi()
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		data, err := parsed.Pack(method, args...)
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to pack %s: %w", method, err)
+		}
+		return to, data, nil
+	})
+}
+
+// Result is the outcome of a check before and after the upgrades.
+type Result struct {
+	Name string `json:"name"`
+	// Before and After are the outcomes of the check without and with the upgrades: the value it
+	// returned, or "error: " and its decoded error.
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// Changed reports whether the outcome of the check differs after the upgrades.
+func (r *Result) Changed() bool {
+	return r.Before != r.After
+}
+
+// Report is the outcome of every check of a Run.
+type Report struct {
+	BlockNumber uint64    `json:"blockNumber"`
+	Upgrades    []Upgrade `json:"upgrades"`
+	// Results are in the order of the checks.
+	Results []Result `json:"results"`
+}
+
+// Changed returns the results whose outcome differs after the upgrades.
+func (r *Report) Changed() []Result {
+	var changed []Result
+	for _, result := range r.Results {
+		if result.Changed() {
+			changed = append(changed, result)
+		}
+	}
+	return changed
+}
+
+// Run executes checks against the chain behind client at blockNumber, or the latest block if nil,
+// once as is and once with upgrades applied. The initializer Data of an upgrade, if any, is run
+// as an additional check from the zero address, since the ProxyAdmin itself cannot call through a
+// TransparentUpgradeableProxy.
+func Run(ctx context.Context, client *rpc.Client, blockNumber *big.Int, upgrades []Upgrade, checks []Check) (*Report, error) {
+	upgraded, err := NewFork(ctx, client, blockNumber, upgrades...)
+	if err != nil {
+		return nil, err
+	}
+	// Pin the baseline to the block the upgraded fork resolved, so both see the same state.
+	baseline, err := NewFork(ctx, client, upgraded.block)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range upgrades {
+		if len(u.Data) == 0 {
+			continue
+		}
+		proxy, data := u.Proxy, u.Data
+		checks = append(checks, Transaction(fmt.Sprintf("%s upgradeAndCall", proxy.Hex()), common.Address{}, func(context.Context, bind.ContractCaller) (common.Address, []byte, error) {
+			return proxy, data, nil
+		}))
+	}
+
+	report := &Report{
+		BlockNumber: upgraded.BlockNumber(),
+		Upgrades:    upgrades,
+		Results:     make([]Result, len(checks)),
+	}
+	sem := make(chan struct{}, DefaultConcurrency)
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		i, check := i, check
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			report.Results[i] = Result{
+				Name:   check.Name,
+				Before: outcome(ctx, check, baseline),
+				After:  outcome(ctx, check, upgraded),
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// outcome runs check against fork and describes its outcome.
+func outcome(ctx context.Context, check Check, fork *Fork) string {
+	value, err := check.Run(ctx, fork)
+	if err != nil {
+		return "error: " + elerrors.Decode(err).Error()
+	}
+	return value
+}
+
+// WriteText writes the changed results of report as a table, or a line saying that no behavior
+// changed.
+func WriteText(w io.Writer, report *Report) error {
+	changed := report.Changed()
+	if _, err := fmt.Fprintf(w, "block %d, %d upgrades: %d of %d checks changed\n", report.BlockNumber, len(report.Upgrades), len(changed), len(report.Results)); err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tBEFORE\tAFTER")
+	for _, r := range changed {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Name, r.Before, r.After)
+	}
+	return tw.Flush()
+}
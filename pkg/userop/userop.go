@@ -0,0 +1,385 @@
+// Package userop sends the calls of the bindings from ERC-4337 smart accounts, as
+// UserOperations submitted to a bundler through EntryPoint v0.6.
+//
+// A Builder wraps calls built by pkg/calldata into the account's execute calldata, reads the
+// account's nonce from the EntryPoint, deploys the account with its init code if needed, has
+// the bundler estimate gas, lets a paymaster sponsor it, signs it and submits it:
+//
+//	b, _ := userop.New(ctx, ethClient, bundler, userop.Config{
+//		Sender: account,
+//		Sign:   userop.KeySigner(ownerKey),
+//	})
+//	deposit, _ := calldata.Build(func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return strategyManager.DepositIntoStrategy(opts, strategy, token, amount)
+//	})
+//	receipt, err := b.Execute(ctx, approve, deposit)
+package userop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+)
+
+// EntryPointV06 is the address of the ERC-4337 EntryPoint v0.6 contract, deployed at the same
+// address on every chain supported by bundlers.
+var EntryPointV06 = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+
+// DefaultPollInterval is the default interval at which Wait polls the bundler for receipts.
+const DefaultPollInterval = 2 * time.Second
+
+// dummySignature is a well-formed ECDSA signature that fails verification, used while
+// estimating gas before the operation can be signed.
+var dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+// ErrFailed is returned by Wait and Execute for operations included with a reverted execution.
+var ErrFailed = errors.New("user operation failed")
+
+const entryPointABI = `[
+{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]}
+]`
+
+const simpleAccountABI = `[
+{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]},
+{"type":"function","name":"executeBatch","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address[]"},{"name":"func","type":"bytes[]"}],"outputs":[]}
+]`
+
+var parsedEntryPointABI = abis.MustParse(entryPointABI)
+
+var parsedSimpleAccountABI = abis.MustParse(simpleAccountABI)
+
+// packArgs are the fields of a UserOperation hashed into its hash, with the dynamic fields
+// replaced by their hashes.
+var packArgs = func() abi.Arguments {
+	var args abi.Arguments
+	for _, t := range []string{"address", "uint256", "bytes32", "bytes32", "uint256", "uint256", "uint256", "uint256", "uint256", "bytes32"} {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args
+}()
+
+var hashArgs = func() abi.Arguments {
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	uint256, _ := abi.NewType("uint256", "", nil)
+	return abi.Arguments{{Type: bytes32}, {Type: address}, {Type: uint256}}
+}()
+
+// UserOperation is an ERC-4337 v0.6 user operation.
+type UserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// Hash returns the hash of op signed by the account's owner, which binds it to entryPoint and
+// chainID.
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed, err := packArgs.Pack(
+		op.Sender, orZero(op.Nonce), crypto.Keccak256Hash(op.InitCode), crypto.Keccak256Hash(op.CallData),
+		orZero(op.CallGasLimit), orZero(op.VerificationGasLimit), orZero(op.PreVerificationGas),
+		orZero(op.MaxFeePerGas), orZero(op.MaxPriorityFeePerGas), crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		panic(err)
+	}
+	encoded, err := hashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(encoded)
+}
+
+// MarshalJSON encodes op as the bundler RPC methods expect it.
+func (op *UserOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"sender":               op.Sender,
+		"nonce":                (*hexutil.Big)(orZero(op.Nonce)),
+		"initCode":             hexutil.Bytes(op.InitCode),
+		"callData":             hexutil.Bytes(op.CallData),
+		"callGasLimit":         (*hexutil.Big)(orZero(op.CallGasLimit)),
+		"verificationGasLimit": (*hexutil.Big)(orZero(op.VerificationGasLimit)),
+		"preVerificationGas":   (*hexutil.Big)(orZero(op.PreVerificationGas)),
+		"maxFeePerGas":         (*hexutil.Big)(orZero(op.MaxFeePerGas)),
+		"maxPriorityFeePerGas": (*hexutil.Big)(orZero(op.MaxPriorityFeePerGas)),
+		"paymasterAndData":     hexutil.Bytes(op.PaymasterAndData),
+		"signature":            hexutil.Bytes(op.Signature),
+	})
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// Account encodes calls into the calldata of a smart account executing them.
+type Account interface {
+	EncodeCalls(calls []calldata.Call) ([]byte, error)
+}
+
+// SimpleAccount is the Account of the eth-infinitism SimpleAccount and the many accounts
+// sharing its execute and executeBatch methods.
+type SimpleAccount struct{}
+
+// EncodeCalls encodes a single call with execute and several with executeBatch, which cannot
+// send value.
+func (SimpleAccount) EncodeCalls(calls []calldata.Call) ([]byte, error) {
+	for _, call := range calls {
+		if call.Operation != calldata.OpCall {
+			return nil, errors.New("SimpleAccount cannot delegatecall")
+		}
+	}
+	switch len(calls) {
+	case 0:
+		return nil, errors.New("no calls")
+	case 1:
+		return parsedSimpleAccountABI.Pack("execute", calls[0].To, orZero(calls[0].Value), calls[0].Data)
+	}
+	dest := make([]common.Address, len(calls))
+	data := make([][]byte, len(calls))
+	for i, call := range calls {
+		if orZero(call.Value).Sign() != 0 {
+			return nil, errors.New("SimpleAccount cannot send value in a batch")
+		}
+		dest[i], data[i] = call.To, call.Data
+	}
+	return parsedSimpleAccountABI.Pack("executeBatch", dest, data)
+}
+
+// KeySigner returns a Config.Sign signing the hash of operations with key as an Ethereum signed
+// message, as SimpleAccount verifies it.
+func KeySigner(key *ecdsa.PrivateKey) func(common.Hash) ([]byte, error) {
+	return func(hash common.Hash) ([]byte, error) {
+		sig, err := crypto.Sign(accounts.TextHash(hash[:]), key)
+		if err != nil {
+			return nil, err
+		}
+		sig[crypto.RecoveryIDOffset] += 27
+		return sig, nil
+	}
+}
+
+// Backend is the chain access required by Builder.
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// BundlerClient makes JSON-RPC requests to a bundler. *rpc.Client satisfies it.
+type BundlerClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Config configures a Builder.
+type Config struct {
+	// Sender is the address of the smart account.
+	Sender common.Address
+	// EntryPoint is the EntryPoint the account uses. It defaults to EntryPointV06.
+	EntryPoint common.Address
+	// Account encodes the calls of operations. It defaults to SimpleAccount.
+	Account Account
+	// InitCode is the init code deploying the account, the factory address followed by the
+	// calldata of its deployment method. It is sent while the account has no code.
+	InitCode []byte
+	// NonceKey is the key of the nonce sequence of operations, zero by default.
+	NonceKey *big.Int
+	// Sign signs the hash of operations for the account, such as KeySigner of its owner.
+	Sign func(hash common.Hash) ([]byte, error)
+	// Paymaster, if set, returns the paymasterAndData of an operation to sponsor it. It is called
+	// before gas is estimated, and again with the final gas limits and fees.
+	Paymaster func(ctx context.Context, op *UserOperation) ([]byte, error)
+	// PollInterval is the interval at which Wait polls for receipts. It defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Builder builds, signs and submits the user operations of a smart account.
+type Builder struct {
+	backend Backend
+	bundler BundlerClient
+	cfg     Config
+	chainID *big.Int
+}
+
+// New returns a Builder for the account cfg.Sender, reading the chain through backend and
+// submitting operations to bundler.
+func New(ctx context.Context, backend Backend, bundler BundlerClient, cfg Config) (*Builder, error) {
+	if cfg.Sign == nil {
+		return nil, errors.New("no signer")
+	}
+	if cfg.EntryPoint == (common.Address{}) {
+		cfg.EntryPoint = EntryPointV06
+	}
+	if cfg.Account == nil {
+		cfg.Account = SimpleAccount{}
+	}
+	if cfg.NonceKey == nil {
+		cfg.NonceKey = new(big.Int)
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	return &Builder{backend: backend, bundler: bundler, cfg: cfg, chainID: chainID}, nil
+}
+
+// Build returns the signed operation executing calls from the account.
+func (b *Builder) Build(ctx context.Context, calls ...calldata.Call) (*UserOperation, error) {
+	callData, err := b.cfg.Account.EncodeCalls(calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calls: %w", err)
+	}
+	op := &UserOperation{Sender: b.cfg.Sender, CallData: callData, Signature: dummySignature}
+
+	code, err := b.backend.CodeAt(ctx, b.cfg.Sender, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch code at %s: %w", b.cfg.Sender, err)
+	}
+	if len(code) == 0 {
+		if len(b.cfg.InitCode) == 0 {
+			return nil, fmt.Errorf("account %s is not deployed and has no init code", b.cfg.Sender)
+		}
+		op.InitCode = b.cfg.InitCode
+	}
+	entryPoint := bind.NewBoundContract(b.cfg.EntryPoint, parsedEntryPointABI, b.backend, nil, nil)
+	var out []interface{}
+	if err := entryPoint.Call(&bind.CallOpts{Context: ctx}, &out, "getNonce", b.cfg.Sender, b.cfg.NonceKey); err != nil {
+		return nil, fmt.Errorf("failed to fetch nonce of %s: %w", b.cfg.Sender, elerrors.Decode(err))
+	}
+	op.Nonce = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	head, err := b.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	if op.MaxPriorityFeePerGas, err = b.backend.SuggestGasTipCap(ctx); err != nil {
+		return nil, fmt.Errorf("failed to suggest tip: %w", err)
+	}
+	op.MaxFeePerGas = new(big.Int).Add(new(big.Int).Mul(orZero(head.BaseFee), big.NewInt(2)), op.MaxPriorityFeePerGas)
+
+	if err := b.sponsor(ctx, op); err != nil {
+		return nil, err
+	}
+	var estimate struct {
+		PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+		VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+		CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+	}
+	if err := b.bundler.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, b.cfg.EntryPoint); err != nil {
+		return nil, fmt.Errorf("failed to estimate user operation gas: %w", elerrors.Decode(err))
+	}
+	op.PreVerificationGas = (*big.Int)(estimate.PreVerificationGas)
+	op.VerificationGasLimit = (*big.Int)(estimate.VerificationGasLimit)
+	op.CallGasLimit = (*big.Int)(estimate.CallGasLimit)
+	if err := b.sponsor(ctx, op); err != nil {
+		return nil, err
+	}
+
+	if op.Signature, err = b.cfg.Sign(op.Hash(b.cfg.EntryPoint, b.chainID)); err != nil {
+		return nil, fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	return op, nil
+}
+
+func (b *Builder) sponsor(ctx context.Context, op *UserOperation) error {
+	if b.cfg.Paymaster == nil {
+		return nil
+	}
+	data, err := b.cfg.Paymaster(ctx, op)
+	if err != nil {
+		return fmt.Errorf("failed to get paymaster data: %w", err)
+	}
+	op.PaymasterAndData = data
+	return nil
+}
+
+// Send submits op to the bundler and returns its hash.
+func (b *Builder) Send(ctx context.Context, op *UserOperation) (common.Hash, error) {
+	var hash common.Hash
+	if err := b.bundler.CallContext(ctx, &hash, "eth_sendUserOperation", op, b.cfg.EntryPoint); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send user operation: %w", elerrors.Decode(err))
+	}
+	return hash, nil
+}
+
+// Receipt is the receipt of an included user operation.
+type Receipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Success       bool           `json:"success"`
+	Reason        string         `json:"reason"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	Receipt       *types.Receipt `json:"receipt"`
+}
+
+// Wait polls the bundler until the operation with hash is included, and returns its receipt.
+// It fails with ErrFailed, along with the receipt, if the execution of its calls reverted.
+func (b *Builder) Wait(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	ticker := time.NewTicker(b.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		var receipt *Receipt
+		if err := b.bundler.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", hash); err != nil {
+			return nil, fmt.Errorf("failed to fetch user operation receipt: %w", err)
+		}
+		if receipt != nil {
+			if !receipt.Success {
+				return receipt, fmt.Errorf("%w: %s %s", ErrFailed, hash, receipt.Reason)
+			}
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Execute builds, sends and waits for the operation executing calls.
+func (b *Builder) Execute(ctx context.Context, calls ...calldata.Call) (*Receipt, error) {
+	op, err := b.Build(ctx, calls...)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := b.Send(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return b.Wait(ctx, hash)
+}
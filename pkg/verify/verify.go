@@ -0,0 +1,227 @@
+// Package verify checks that the contracts of a deployment run the code of the bindings in
+// pkg/bindings, to catch bindings gone stale after a protocol upgrade.
+//
+// The runtime code of each contract, or of its implementation when it is behind a proxy or a
+// beacon, is compared with the runtime code embedded in the creation code of its binding. Two
+// differences are ignored: the metadata hash the compiler appends, and immutables, which the
+// creation code holds as zeroed words filled in by the constructor:
+//
+//	results, _ := verify.Deployment(ctx, client, addresses.Default, addresses.ChainIDMainnet)
+//	for _, r := range results {
+//		if r.Err != nil {
+//			fmt.Printf("%s at %s: %v\n", r.Name, r.CodeAddress, r.Err)
+//		}
+//	}
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Eigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyFactory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deploy"
+)
+
+// immutableSize is the size of the zeroed word an immutable occupies in creation code.
+const immutableSize = 32
+
+var (
+	// ErrNoCode is returned when there is no code at the address compared.
+	ErrNoCode = errors.New("no code deployed")
+	// ErrNoBytecode is returned when a binding has no creation code to compare against, as for
+	// interfaces and contracts linked against libraries.
+	ErrNoBytecode = errors.New("binding has no bytecode")
+	// ErrMismatch is returned when the deployed code differs from the binding's.
+	ErrMismatch = errors.New("deployed code does not match binding")
+)
+
+// Bindings maps the contract names of pkg/addresses to the metadata of their binding. Contracts
+// with no binding, such as the ProxyAdmin, are not verified.
+var Bindings = map[string]*bind.MetaData{
+	addresses.DelegationManager:  DelegationManager.DelegationManagerMetaData,
+	addresses.StrategyManager:    StrategyManager.StrategyManagerMetaData,
+	addresses.EigenPodManager:    EigenPodManager.EigenPodManagerMetaData,
+	addresses.AVSDirectory:       AVSDirectory.AVSDirectoryMetaData,
+	addresses.RewardsCoordinator: RewardsCoordinator.RewardsCoordinatorMetaData,
+	addresses.StrategyFactory:    StrategyFactory.StrategyFactoryMetaData,
+	addresses.StrategyBeacon:     StrategyBase.StrategyBaseMetaData,
+	addresses.EigenPodBeacon:     EigenPod.EigenPodMetaData,
+	addresses.EigenStrategy:      EigenStrategy.EigenStrategyMetaData,
+	addresses.Eigen:              Eigen.EigenMetaData,
+	addresses.BackingEigen:       BackingEigen.BackingEigenMetaData,
+	addresses.PauserRegistry:     PauserRegistry.PauserRegistryMetaData,
+}
+
+// beacons are the contracts that are UpgradeableBeacons, verified against the code of their
+// implementation.
+var beacons = map[string]bool{
+	addresses.StrategyBeacon: true,
+	addresses.EigenPodBeacon: true,
+}
+
+// Backend is the chain access required by Deployment.
+type Backend interface {
+	bind.ContractCaller
+	deploy.StorageReader
+}
+
+// Result is the verification of one contract.
+type Result struct {
+	Name    string
+	Address common.Address
+	// CodeAddress is the address whose code was compared: Address, or the implementation of the
+	// proxy or beacon at Address.
+	CodeAddress common.Address
+	// Err is nil if the code matches, and otherwise wraps ErrNoCode, ErrNoBytecode or
+	// ErrMismatch, or is the error that prevented the comparison.
+	Err error
+}
+
+// Deployment verifies each contract of the deployment of chainID in registry that has a binding
+// in Bindings. Results are ordered by name. The error is only set if the deployment cannot be
+// resolved.
+func Deployment(ctx context.Context, backend Backend, registry *addresses.Registry, chainID uint64) ([]Result, error) {
+	deployment, err := registry.Deployment(chainID)
+	if err != nil {
+		return nil, err
+	}
+	var results []Result
+	for name, addr := range deployment {
+		metadata, ok := Bindings[name]
+		if !ok {
+			continue
+		}
+		r := Result{Name: name, Address: addr}
+		r.CodeAddress, r.Err = codeAddress(ctx, backend, name, addr)
+		if r.Err == nil {
+			r.Err = Contract(ctx, backend, r.CodeAddress, metadata)
+		}
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results, nil
+}
+
+// codeAddress returns the implementation of the beacon or proxy at addr, or addr itself.
+func codeAddress(ctx context.Context, backend Backend, name string, addr common.Address) (common.Address, error) {
+	if beacons[name] {
+		var out []interface{}
+		beacon := bind.NewBoundContract(addr, abis.UpgradeableBeacon, backend, nil, nil)
+		if err := beacon.Call(&bind.CallOpts{Context: ctx}, &out, "implementation"); err != nil {
+			return common.Address{}, fmt.Errorf("failed to fetch beacon implementation: %w", err)
+		}
+		return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+	}
+	impl, err := deploy.Implementation(ctx, backend, addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if impl == (common.Address{}) {
+		return addr, nil
+	}
+	return impl, nil
+}
+
+// Contract verifies the code at addr against the binding with metadata.
+func Contract(ctx context.Context, backend bind.ContractCaller, addr common.Address, metadata *bind.MetaData) error {
+	code, err := backend.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch code of %s: %w", addr, err)
+	}
+	return Code(code, metadata)
+}
+
+// Code verifies runtime code against the binding with metadata.
+func Code(runtime []byte, metadata *bind.MetaData) error {
+	if len(runtime) == 0 {
+		return ErrNoCode
+	}
+	if metadata.Bin == "" || strings.Contains(metadata.Bin, "__") {
+		return ErrNoBytecode
+	}
+	creation, err := hexutil.Decode(metadata.Bin)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNoBytecode, err)
+	}
+
+	deployed := stripMetadata(runtime)
+	if len(deployed) > len(creation) {
+		return ErrMismatch
+	}
+	zeroed := zeroedWords(creation)
+	for offset := 0; offset+len(deployed) <= len(creation); offset++ {
+		if matches(deployed, creation, offset, zeroed) {
+			return nil
+		}
+	}
+	return ErrMismatch
+}
+
+// matches reports whether deployed is found in creation at offset, ignoring the bytes of
+// creation marked zeroed.
+func matches(deployed, creation []byte, offset int, zeroed []bool) bool {
+	for i, b := range deployed {
+		if b != creation[offset+i] && !zeroed[offset+i] {
+			return false
+		}
+	}
+	return true
+}
+
+// zeroedWords marks the bytes of code in runs of at least immutableSize zero bytes, where
+// creation code holds immutables.
+func zeroedWords(code []byte) []bool {
+	zeroed := make([]bool, len(code))
+	for start := 0; start < len(code); {
+		if code[start] != 0 {
+			start++
+			continue
+		}
+		end := start
+		for end < len(code) && code[end] == 0 {
+			end++
+		}
+		if end-start >= immutableSize {
+			for i := start; i < end; i++ {
+				zeroed[i] = true
+			}
+		}
+		start = end
+	}
+	return zeroed
+}
+
+// stripMetadata removes the CBOR encoded metadata solc appends to runtime code, whose length is
+// given by the last two bytes.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	if n == 0 || start < 0 || code[start]&0xf0 != 0xa0 {
+		return code
+	}
+	return code[:start]
+}
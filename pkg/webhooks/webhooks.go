@@ -0,0 +1,520 @@
+// Package webhooks delivers indexed events to HTTP webhooks, such as the off-chain services of an
+// AVS that act on delegation changes.
+//
+// A Dispatcher holds the registered webhooks, each with a Filter on the contract, the event names
+// and the addresses in the indexed arguments of the events it receives. It is an
+// indexer.Exporter, so wrapping the indexer's store dispatches every event as it is indexed:
+//
+//	d := webhooks.NewDispatcher(webhooks.Config{DeadLetters: webhooks.NewMemoryDeadLetters()})
+//	defer d.Close()
+//	_, _ = d.Register(webhooks.Webhook{
+//		URL:    "https://avs.example.com/hooks/delegation",
+//		Secret: secret,
+//		Filter: webhooks.Filter{Contract: "DelegationManager", Events: []string{"StakerDelegated", "StakerUndelegated"}, Addresses: []common.Address{operator}},
+//	})
+//	ix, _ := indexer.New(backend, indexer.Exporting(store, d), cfg)
+//
+// Each webhook receives its events in order, from a queue and worker of its own, so a slow or
+// failing endpoint does not hold up the others. A delivery is a POST of a JSON Payload signed with
+// the webhook's secret in SignatureHeader. Failed deliveries are retried with exponential backoff,
+// and those that still fail, or are rejected with a client error, are handed to the DeadLetters
+// queue, from which Redeliver sends them again. Webhooks are held in memory and must be registered
+// again after a restart.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/abis"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/indexer"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logging"
+)
+
+const (
+	// DefaultMaxAttempts is the default number of attempts of a delivery, including the first.
+	DefaultMaxAttempts = 5
+	// DefaultInitialBackoff is the default delay before the first retry of a delivery.
+	DefaultInitialBackoff = time.Second
+	// DefaultMaxBackoff is the default maximum delay between attempts.
+	DefaultMaxBackoff = time.Minute
+	// DefaultQueueSize is the default number of deliveries queued per webhook.
+	DefaultQueueSize = 10_000
+)
+
+// Headers of a delivery.
+const (
+	// SignatureHeader holds the hex HMAC-SHA256 of the body of a delivery, keyed with the secret
+	// of the webhook, as "sha256=<hex>".
+	SignatureHeader = "X-EigenLayer-Signature"
+	// DeliveryHeader holds the ID of the delivery, the same across its attempts and redeliveries,
+	// for receivers to ignore duplicates.
+	DeliveryHeader = "X-EigenLayer-Delivery"
+)
+
+var (
+	// ErrNotFound is returned for an ID that names no registered webhook.
+	ErrNotFound = errors.New("webhook not found")
+	// ErrClosed is returned by a Dispatcher that was closed.
+	ErrClosed = errors.New("dispatcher closed")
+)
+
+// Filter selects the events delivered to a webhook. Zero-valued fields match every event.
+type Filter struct {
+	// Contract is the name of the binding of the contract that emitted the event, such as
+	// "DelegationManager".
+	Contract string `json:"contract,omitempty"`
+	// Events are the names of the events of Contract, such as "StakerDelegated".
+	Events []string `json:"events,omitempty"`
+	// Addresses match the events with any of these addresses in an indexed address argument,
+	// such as the staker or operator of StakerDelegated.
+	Addresses []common.Address `json:"addresses,omitempty"`
+}
+
+// validate checks that the contract and events of f exist in the bindings.
+func (f *Filter) validate() error {
+	if f.Contract == "" {
+		if len(f.Events) > 0 {
+			return errors.New("filter names events without a contract")
+		}
+		return nil
+	}
+	parsed, err := abis.ABI(f.Contract)
+	if err != nil {
+		return err
+	}
+	for _, name := range f.Events {
+		if _, ok := parsed.Events[name]; !ok {
+			return fmt.Errorf("no event %s in %s", name, f.Contract)
+		}
+	}
+	return nil
+}
+
+func (f *Filter) matches(e indexer.Event) bool {
+	if f.Contract != "" && e.Contract != f.Contract {
+		return false
+	}
+	if len(f.Events) > 0 {
+		found := false
+		for _, name := range f.Events {
+			found = found || name == e.Name
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Addresses) == 0 {
+		return true
+	}
+	parsed, err := abis.ABI(e.Contract)
+	if err != nil {
+		return false
+	}
+	for _, input := range parsed.Events[e.Name].Inputs {
+		if !input.Indexed || input.Type.T != abi.AddressTy {
+			continue
+		}
+		var addr common.Address
+		switch v := e.Args[input.Name].(type) {
+		case common.Address:
+			addr = v
+		case string:
+			addr = common.HexToAddress(v)
+		default:
+			continue
+		}
+		for _, want := range f.Addresses {
+			if addr == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Webhook is a registered endpoint.
+type Webhook struct {
+	// ID is assigned by Register.
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret, if set, signs deliveries in SignatureHeader.
+	Secret string `json:"-"`
+	Filter Filter `json:"filter"`
+}
+
+// Payload is the body of a delivery.
+type Payload struct {
+	// ID identifies the delivery of the event to the webhook, as in DeliveryHeader.
+	ID      string `json:"id"`
+	Webhook string `json:"webhook"`
+	Event   Event  `json:"event"`
+}
+
+// Event is an indexed event, as delivered.
+type Event struct {
+	Contract        string                 `json:"contract"`
+	Address         string                 `json:"address"`
+	Name            string                 `json:"name"`
+	BlockNumber     uint64                 `json:"blockNumber"`
+	BlockHash       string                 `json:"blockHash"`
+	TransactionHash string                 `json:"transactionHash"`
+	LogIndex        uint                   `json:"logIndex"`
+	Args            map[string]interface{} `json:"args"`
+}
+
+// DeadLetter is a delivery that failed.
+type DeadLetter struct {
+	Webhook  string    `json:"webhook"`
+	URL      string    `json:"url"`
+	Payload  Payload   `json:"payload"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// DeadLetters receives the deliveries that failed.
+type DeadLetters interface {
+	Add(ctx context.Context, letter DeadLetter) error
+}
+
+// MemoryDeadLetters is a DeadLetters that keeps the failed deliveries in memory.
+type MemoryDeadLetters struct {
+	mu      sync.Mutex
+	letters []DeadLetter
+}
+
+// NewMemoryDeadLetters returns an empty MemoryDeadLetters.
+func NewMemoryDeadLetters() *MemoryDeadLetters {
+	return &MemoryDeadLetters{}
+}
+
+// Add implements DeadLetters.
+func (q *MemoryDeadLetters) Add(_ context.Context, letter DeadLetter) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.letters = append(q.letters, letter)
+	return nil
+}
+
+// Take removes and returns the failed deliveries, oldest first, such as to Redeliver them.
+func (q *MemoryDeadLetters) Take() []DeadLetter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	letters := q.letters
+	q.letters = nil
+	return letters
+}
+
+// Config configures a Dispatcher.
+type Config struct {
+	// HTTPClient delivers the payloads. It defaults to a client with a 10 second timeout.
+	HTTPClient *http.Client
+	// MaxAttempts is the number of attempts of a delivery. It defaults to DefaultMaxAttempts.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled after each. It defaults to
+	// DefaultInitialBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. It defaults to DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// QueueSize is the number of deliveries queued per webhook; events dispatched to a full
+	// queue are dead-lettered at once. It defaults to DefaultQueueSize.
+	QueueSize int
+	// DeadLetters receives the deliveries that failed. If nil, they are only logged.
+	DeadLetters DeadLetters
+	// Logger receives failed attempts and deliveries. It defaults to logging.Nop.
+	Logger logging.Logger
+}
+
+// Dispatcher delivers events to webhooks. It is safe for concurrent use.
+type Dispatcher struct {
+	cfg    Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	hooks  map[string]*worker
+	closed bool
+}
+
+var _ indexer.Exporter = (*Dispatcher)(nil)
+
+// worker delivers the queue of a webhook.
+type worker struct {
+	hook   Webhook
+	queue  chan Payload
+	cancel context.CancelFunc
+}
+
+// NewDispatcher returns a Dispatcher without webhooks.
+func NewDispatcher(cfg Config) *Dispatcher {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.InitialBackoff == 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.QueueSize == 0 {
+		cfg.QueueSize = DefaultQueueSize
+	}
+	cfg.Logger = logging.OrNop(cfg.Logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{cfg: cfg, ctx: ctx, cancel: cancel, hooks: make(map[string]*worker)}
+}
+
+// Register adds hook and starts delivering the events dispatched from then on to it. It returns
+// hook with its ID.
+func (d *Dispatcher) Register(hook Webhook) (Webhook, error) {
+	u, err := url.Parse(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Webhook{}, fmt.Errorf("invalid webhook URL %q", hook.URL)
+	}
+	if err := hook.Filter.validate(); err != nil {
+		return Webhook{}, fmt.Errorf("invalid filter: %w", err)
+	}
+	id, err := randomID()
+	if err != nil {
+		return Webhook{}, err
+	}
+	hook.ID = id
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return Webhook{}, ErrClosed
+	}
+	ctx, cancel := context.WithCancel(d.ctx)
+	w := &worker{hook: hook, queue: make(chan Payload, d.cfg.QueueSize), cancel: cancel}
+	d.hooks[id] = w
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.run(ctx, w)
+	}()
+	return hook, nil
+}
+
+// Unregister removes the webhook with id. Its queued deliveries are dropped.
+func (d *Dispatcher) Unregister(id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w, ok := d.hooks[id]
+	if !ok {
+		return ErrNotFound
+	}
+	w.cancel()
+	delete(d.hooks, id)
+	return nil
+}
+
+// Webhooks returns the registered webhooks, ordered by URL.
+func (d *Dispatcher) Webhooks() []Webhook {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	hooks := make([]Webhook, 0, len(d.hooks))
+	for _, w := range d.hooks {
+		hooks = append(hooks, w.hook)
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		if hooks[i].URL != hooks[j].URL {
+			return hooks[i].URL < hooks[j].URL
+		}
+		return hooks[i].ID < hooks[j].ID
+	})
+	return hooks
+}
+
+// Export queues events for delivery to the webhooks they match, without waiting for the
+// deliveries. It implements indexer.Exporter.
+func (d *Dispatcher) Export(ctx context.Context, events []indexer.Event) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	for _, e := range events {
+		for _, w := range d.hooks {
+			if !w.hook.Filter.matches(e) {
+				continue
+			}
+			payload := newPayload(w.hook.ID, e)
+			select {
+			case w.queue <- payload:
+			default:
+				d.deadLetter(ctx, w.hook, payload, 0, errors.New("delivery queue is full"))
+			}
+		}
+	}
+	return nil
+}
+
+// Redeliver sends a dead-lettered delivery again, with the retries of any delivery, and returns
+// its error if it fails again.
+func (d *Dispatcher) Redeliver(ctx context.Context, letter DeadLetter) error {
+	d.mu.Lock()
+	w, ok := d.hooks[letter.Webhook]
+	d.mu.Unlock()
+	if !ok {
+		return ErrNotFound
+	}
+	_, err := d.deliver(ctx, w.hook, letter.Payload)
+	return err
+}
+
+// Close stops the deliveries, dropping those still queued, and waits for the workers to exit.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+	d.cancel()
+	d.wg.Wait()
+}
+
+// run delivers the queue of w in order until ctx is done.
+func (d *Dispatcher) run(ctx context.Context, w *worker) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case payload := <-w.queue:
+			attempts, err := d.deliver(ctx, w.hook, payload)
+			if err != nil && ctx.Err() == nil {
+				d.deadLetter(ctx, w.hook, payload, attempts, err)
+			}
+		}
+	}
+}
+
+// deliver posts payload to hook until it succeeds, is rejected with a client error, or runs out
+// of attempts, and returns the number of attempts made.
+func (d *Dispatcher) deliver(ctx context.Context, hook Webhook, payload Payload) (int, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode payload: %w", err)
+	}
+	backoff := d.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.post(ctx, hook, payload.ID, body)
+		var rejected *rejectedError
+		if err == nil || errors.As(err, &rejected) || attempt == d.cfg.MaxAttempts {
+			return attempt, err
+		}
+		d.cfg.Logger.Debug("webhook delivery failed", "webhook", hook.ID, "delivery", payload.ID, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > d.cfg.MaxBackoff {
+			backoff = d.cfg.MaxBackoff
+		}
+	}
+}
+
+// rejectedError is a client error response, which retrying will not fix.
+type rejectedError struct {
+	status string
+}
+
+func (e *rejectedError) Error() string {
+	return "webhook rejected the delivery: " + e.status
+}
+
+func (d *Dispatcher) post(ctx context.Context, hook Webhook, id string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, id)
+	if hook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(hook.Secret, body))
+	}
+	resp, err := d.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		return &rejectedError{status: resp.Status}
+	default:
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+}
+
+func (d *Dispatcher) deadLetter(ctx context.Context, hook Webhook, payload Payload, attempts int, err error) {
+	d.cfg.Logger.Warn("failed to deliver webhook", "webhook", hook.ID, "url", hook.URL, "delivery", payload.ID, "attempts", attempts, "err", err)
+	if d.cfg.DeadLetters == nil {
+		return
+	}
+	letter := DeadLetter{
+		Webhook:  hook.ID,
+		URL:      hook.URL,
+		Payload:  payload,
+		Attempts: attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now().UTC(),
+	}
+	if err := d.cfg.DeadLetters.Add(ctx, letter); err != nil {
+		d.cfg.Logger.Error("failed to dead-letter webhook delivery", "webhook", hook.ID, "delivery", payload.ID, "err", err)
+	}
+}
+
+// Sign returns the value of SignatureHeader for body, signed with secret. Receivers verify a
+// delivery by comparing the header with Sign of the raw body with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newPayload(webhook string, e indexer.Event) Payload {
+	return Payload{
+		ID:      fmt.Sprintf("%s:%s:%d", webhook, strings.ToLower(e.TxHash.Hex()), e.LogIndex),
+		Webhook: webhook,
+		Event: Event{
+			Contract:        e.Contract,
+			Address:         e.Address.Hex(),
+			Name:            e.Name,
+			BlockNumber:     e.BlockNumber,
+			BlockHash:       e.BlockHash.Hex(),
+			TransactionHash: e.TxHash.Hex(),
+			LogIndex:        e.LogIndex,
+			Args:            indexer.JSONArgs(e.Args),
+		},
+	}
+}
+
+func randomID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
+}
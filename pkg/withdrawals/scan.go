@@ -0,0 +1,198 @@
+package withdrawals
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/logpager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+// scanBatchSize is the number of withdrawals whose state is read per multicall.
+const scanBatchSize = 250
+
+// ScanBackend is the chain access required by Scanner.
+type ScanBackend interface {
+	bind.ContractCaller
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// ScanConfig configures a Scanner.
+type ScanConfig struct {
+	DelegationManager common.Address
+	// FromBlock is the first block searched for queued withdrawals. Withdrawals queued before it
+	// are not found, so it should be at or before the deployment of the DelegationManager.
+	FromBlock uint64
+	// Pager configures the paging of the log queries.
+	Pager logpager.Config
+}
+
+// AddressFilter selects withdrawals by the accounts they involve. A withdrawal matches if any of
+// its staker, delegatedTo operator or withdrawer is listed; an empty AddressFilter matches every
+// withdrawal.
+type AddressFilter struct {
+	Stakers     []common.Address
+	Operators   []common.Address
+	Withdrawers []common.Address
+}
+
+func (f AddressFilter) matches(w DelegationManager.IDelegationManagerWithdrawal) bool {
+	if len(f.Stakers) == 0 && len(f.Operators) == 0 && len(f.Withdrawers) == 0 {
+		return true
+	}
+	return contains(f.Stakers, w.Staker) || contains(f.Operators, w.DelegatedTo) || contains(f.Withdrawers, w.Withdrawer)
+}
+
+func contains(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingWithdrawal is a queued withdrawal that has not been completed.
+type PendingWithdrawal struct {
+	Root       common.Hash
+	Withdrawal DelegationManager.IDelegationManagerWithdrawal
+	// TxHash is the transaction that queued the withdrawal.
+	TxHash common.Hash
+	// CompletableAt is the first block in which the withdrawal can be completed: its start block
+	// plus the largest withdrawal delay of its strategies, as currently set.
+	CompletableAt uint64
+}
+
+// Scanner reconstructs the pending withdrawals of the DelegationManager from its events.
+type Scanner struct {
+	backend  ScanBackend
+	cfg      ScanConfig
+	filterer *DelegationManager.DelegationManagerFilterer
+}
+
+// NewScanner returns a Scanner of the withdrawals of the DelegationManager in cfg.
+func NewScanner(backend ScanBackend, cfg ScanConfig) (*Scanner, error) {
+	filterer, err := DelegationManager.NewDelegationManagerFilterer(cfg.DelegationManager, logpager.New(backend, cfg.Pager))
+	if err != nil {
+		return nil, err
+	}
+	return &Scanner{backend: backend, cfg: cfg, filterer: filterer}, nil
+}
+
+// PendingWithdrawals returns the withdrawals matching filter that are queued and not completed at
+// the latest block, ordered by start block and nonce. The withdrawals queued since
+// ScanConfig.FromBlock, less those completed, are checked against the DelegationManager's
+// pendingWithdrawals, so a withdrawal is only returned if its root is pending on-chain.
+func (s *Scanner) PendingWithdrawals(ctx context.Context, filter AddressFilter) ([]PendingWithdrawal, error) {
+	head, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	opts := &bind.FilterOpts{Start: s.cfg.FromBlock, End: &head, Context: ctx}
+
+	queued := make(map[common.Hash]*PendingWithdrawal)
+	it, err := s.filterer.FilterWithdrawalQueued(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter WithdrawalQueued events: %w", err)
+	}
+	for it.Next() {
+		if !filter.matches(it.Event.Withdrawal) {
+			continue
+		}
+		if err := VerifyRoot(it.Event.Withdrawal, it.Event.WithdrawalRoot); err != nil {
+			it.Close()
+			return nil, fmt.Errorf("withdrawal queued in %s: %w", it.Event.Raw.TxHash, err)
+		}
+		queued[it.Event.WithdrawalRoot] = &PendingWithdrawal{
+			Root:       it.Event.WithdrawalRoot,
+			Withdrawal: it.Event.Withdrawal,
+			TxHash:     it.Event.Raw.TxHash,
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read WithdrawalQueued events: %w", err)
+	}
+	it.Close()
+
+	completed, err := s.filterer.FilterWithdrawalCompleted(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter WithdrawalCompleted events: %w", err)
+	}
+	for completed.Next() {
+		delete(queued, completed.Event.WithdrawalRoot)
+	}
+	if err := completed.Error(); err != nil {
+		return nil, fmt.Errorf("failed to read WithdrawalCompleted events: %w", err)
+	}
+	completed.Close()
+
+	candidates := make([]*PendingWithdrawal, 0, len(queued))
+	for _, w := range queued {
+		candidates = append(candidates, w)
+	}
+	pending, err := s.verify(ctx, candidates, head)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		a, b := pending[i].Withdrawal, pending[j].Withdrawal
+		if a.StartBlock != b.StartBlock {
+			return a.StartBlock < b.StartBlock
+		}
+		return a.Nonce.Cmp(b.Nonce) < 0
+	})
+	return pending, nil
+}
+
+// verify returns the candidates whose root is pending at block, with their CompletableAt set.
+func (s *Scanner) verify(ctx context.Context, candidates []*PendingWithdrawal, block uint64) ([]PendingWithdrawal, error) {
+	var pending []PendingWithdrawal
+	for start := 0; start < len(candidates); start += scanBatchSize {
+		chunk := candidates[start:min(start+scanBatchSize, len(candidates))]
+		batch := multicall.NewBatch(s.backend)
+		caller, err := DelegationManager.NewDelegationManagerCaller(s.cfg.DelegationManager, batch)
+		if err != nil {
+			return nil, err
+		}
+		isPending := make([]*multicall.Result[bool], len(chunk))
+		delays := make([]*multicall.Result[*big.Int], len(chunk))
+		for i, w := range chunk {
+			root, strategies := w.Root, w.Withdrawal.Strategies
+			isPending[i] = multicall.Add(batch, func(opts *bind.CallOpts) (bool, error) {
+				return caller.PendingWithdrawals(opts, root)
+			})
+			delays[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+				return caller.GetWithdrawalDelay(opts, strategies)
+			})
+		}
+		if err := batch.Execute(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}); err != nil {
+			return nil, fmt.Errorf("failed to read withdrawal state: %w", err)
+		}
+		for i, w := range chunk {
+			ok, err := isPending[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read pending status of %s: %w", w.Root, err)
+			}
+			if !ok {
+				continue
+			}
+			delay, err := delays[i].Get()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read withdrawal delay of %s: %w", w.Root, err)
+			}
+			if !delay.IsUint64() {
+				return nil, fmt.Errorf("withdrawal delay %s of %s overflows uint64", delay, w.Root)
+			}
+			w.CompletableAt = uint64(w.Withdrawal.StartBlock) + delay.Uint64()
+			pending = append(pending, *w)
+		}
+	}
+	return pending, nil
+}
@@ -0,0 +1,233 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/multicall"
+)
+
+var (
+	// ErrNotDelegated is returned by EstimateUndelegation for a staker that is not delegated.
+	ErrNotDelegated = errors.New("staker is not delegated")
+	// ErrOperatorUndelegation is returned by EstimateUndelegation for an operator, which is
+	// delegated to itself and cannot be undelegated.
+	ErrOperatorUndelegation = errors.New("operators cannot be undelegated")
+)
+
+// EstimateBackend is the chain access required by EstimateUndelegation.
+type EstimateBackend interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// UndelegationEstimate is what undelegating a staker will queue, as predicted from the state of
+// the chain at BlockNumber.
+type UndelegationEstimate struct {
+	Staker   common.Address
+	Operator common.Address
+	// BlockNumber is the block the state was read at.
+	BlockNumber uint64
+	// Withdrawals are the withdrawals undelegate queues, one per strategy the staker has
+	// delegatable shares in, in the order it queues them, starting at the block after
+	// BlockNumber. They are empty if the staker has no shares.
+	Withdrawals []EstimatedWithdrawal
+}
+
+// EstimatedWithdrawal is a withdrawal undelegate will queue.
+type EstimatedWithdrawal struct {
+	Root       common.Hash
+	Withdrawal DelegationManager.IDelegationManagerWithdrawal
+	// Underlying is the amount of the strategy's underlying token the shares are worth now, or
+	// of wei for beacon chain ETH.
+	Underlying *big.Int
+	// DelayBlocks is the withdrawal delay of the strategy, and CompletableAt the first block the
+	// withdrawal can be completed at.
+	DelayBlocks   uint64
+	CompletableAt uint64
+}
+
+// EstimateUndelegation predicts the withdrawals that undelegating staker from its operator will
+// queue, for frontends to show before the staker signs. undelegate queues one withdrawal of all
+// the staker's shares per strategy, to the staker as withdrawer; this tree has no redelegate, and
+// shares are withdrawn as is, without scaling.
+//
+// The prediction is checked by calling undelegate from the staker against the latest block,
+// whose returned roots must match those predicted for a withdrawal started in that block, so that
+// an undelegation that would revert, such as while withdrawals are paused, returns its decoded
+// error. The estimate's withdrawals start in the next block; use At for a later one.
+func EstimateUndelegation(ctx context.Context, backend EstimateBackend, delegationManager, staker common.Address) (*UndelegationEstimate, error) {
+	head, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block number: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}
+
+	batch := multicall.NewBatch(backend)
+	dm, err := DelegationManager.NewDelegationManagerCaller(delegationManager, batch)
+	if err != nil {
+		return nil, err
+	}
+	operator := multicall.Add(batch, func(opts *bind.CallOpts) (common.Address, error) {
+		return dm.DelegatedTo(opts, staker)
+	})
+	isOperator := multicall.Add(batch, func(opts *bind.CallOpts) (bool, error) {
+		return dm.IsOperator(opts, staker)
+	})
+	deposits := multicall.Add2(batch, func(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+		return dm.GetDelegatableShares(opts, staker)
+	})
+	nonce := multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+		return dm.CumulativeWithdrawalsQueued(opts, staker)
+	})
+	if err := batch.Execute(opts); err != nil {
+		return nil, fmt.Errorf("failed to read delegation of %s: %w", staker, err)
+	}
+	est := &UndelegationEstimate{Staker: staker, BlockNumber: head}
+	if est.Operator, err = operator.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read delegatedTo: %w", err)
+	}
+	if est.Operator == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrNotDelegated, staker)
+	}
+	if self, err := isOperator.Get(); err != nil {
+		return nil, fmt.Errorf("failed to read isOperator: %w", err)
+	} else if self {
+		return nil, fmt.Errorf("%w: %s", ErrOperatorUndelegation, staker)
+	}
+	strategies, shares, err := deposits.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delegatable shares: %w", err)
+	}
+	firstNonce, err := nonce.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read withdrawal nonce: %w", err)
+	}
+
+	// Read the delay and underlying value of each strategy.
+	batch = multicall.NewBatch(backend)
+	dm, err = DelegationManager.NewDelegationManagerCaller(delegationManager, batch)
+	if err != nil {
+		return nil, err
+	}
+	delays := make([]*multicall.Result[*big.Int], len(strategies))
+	underlying := make([]*multicall.Result[*big.Int], len(strategies))
+	for i, strategy := range strategies {
+		single := []common.Address{strategy}
+		delays[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return dm.GetWithdrawalDelay(opts, single)
+		})
+		if strategy == addresses.BeaconChainETHStrategy {
+			continue
+		}
+		s, err := StrategyBase.NewStrategyBaseCaller(strategy, batch)
+		if err != nil {
+			return nil, err
+		}
+		amount := shares[i]
+		underlying[i] = multicall.Add(batch, func(opts *bind.CallOpts) (*big.Int, error) {
+			return s.SharesToUnderlyingView(opts, amount)
+		})
+	}
+	if err := batch.Execute(opts); err != nil {
+		return nil, fmt.Errorf("failed to read withdrawal delays: %w", err)
+	}
+	for i, strategy := range strategies {
+		delay, err := delays[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read withdrawal delay of %s: %w", strategy, err)
+		}
+		if !delay.IsUint64() {
+			return nil, fmt.Errorf("withdrawal delay %s of %s overflows uint64", delay, strategy)
+		}
+		w := EstimatedWithdrawal{
+			Withdrawal: DelegationManager.IDelegationManagerWithdrawal{
+				Staker:      staker,
+				DelegatedTo: est.Operator,
+				Withdrawer:  staker,
+				Nonce:       new(big.Int).Add(firstNonce, big.NewInt(int64(i))),
+				Strategies:  []common.Address{strategy},
+				Shares:      []*big.Int{shares[i]},
+			},
+			Underlying:  shares[i],
+			DelayBlocks: delay.Uint64(),
+		}
+		if underlying[i] != nil {
+			if w.Underlying, err = underlying[i].Get(); err != nil {
+				return nil, fmt.Errorf("failed to read underlying value of %s: %w", strategy, err)
+			}
+		}
+		est.Withdrawals = append(est.Withdrawals, w)
+	}
+
+	if err := est.check(ctx, backend, delegationManager, opts.BlockNumber); err != nil {
+		return nil, err
+	}
+	return est.At(head + 1)
+}
+
+// At returns a copy of e with its withdrawals started at startBlock, the block the undelegation
+// is mined in, which determines their roots and completion blocks.
+func (e *UndelegationEstimate) At(startBlock uint64) (*UndelegationEstimate, error) {
+	if startBlock > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("start block %d overflows uint32", startBlock)
+	}
+	out := *e
+	out.Withdrawals = make([]EstimatedWithdrawal, len(e.Withdrawals))
+	for i, w := range e.Withdrawals {
+		w.Withdrawal.StartBlock = uint32(startBlock)
+		root, err := Root(w.Withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		w.Root, w.CompletableAt = root, startBlock+w.DelayBlocks
+		out.Withdrawals[i] = w
+	}
+	return &out, nil
+}
+
+// check calls undelegate from the staker at block and compares the roots it returns with those
+// predicted for withdrawals started in that block.
+func (e *UndelegationEstimate) check(ctx context.Context, backend EstimateBackend, delegationManager common.Address, block *big.Int) error {
+	parsed, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	data, err := parsed.Pack("undelegate", e.Staker)
+	if err != nil {
+		return err
+	}
+	out, err := backend.CallContract(ctx, ethereum.CallMsg{From: e.Staker, To: &delegationManager, Data: data}, block)
+	if err != nil {
+		return fmt.Errorf("undelegate would fail: %w", elerrors.Decode(err))
+	}
+	values, err := parsed.Unpack("undelegate", out)
+	if err != nil {
+		return fmt.Errorf("failed to unpack undelegate: %w", err)
+	}
+	roots := *abi.ConvertType(values[0], new([][32]byte)).(*[][32]byte)
+	predicted, err := e.At(block.Uint64())
+	if err != nil {
+		return err
+	}
+	if len(roots) != len(predicted.Withdrawals) {
+		return fmt.Errorf("undelegate queues %d withdrawals, predicted %d", len(roots), len(predicted.Withdrawals))
+	}
+	for i, w := range predicted.Withdrawals {
+		if common.Hash(roots[i]) != w.Root {
+			return fmt.Errorf("%w: undelegate queues %s for %s, predicted %s", ErrRootMismatch, common.Hash(roots[i]).Hex(), w.Withdrawal.Strategies[0].Hex(), w.Root.Hex())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+// Package withdrawals manages the lifecycle of DelegationManager queued withdrawals: queuing,
+// computing withdrawal roots, tracking the withdrawal delay and completing the withdrawal once
+// the delay has elapsed.
+//
+// A Scanner finds the withdrawals still pending for a set of stakers, operators or withdrawers,
+// from the DelegationManager's events, with the first block each can be completed at:
+//
+//	scanner, _ := withdrawals.NewScanner(client, withdrawals.ScanConfig{DelegationManager: dm, FromBlock: deployBlock})
+//	pending, err := scanner.PendingWithdrawals(ctx, withdrawals.AddressFilter{Operators: []common.Address{operator}})
+//
+// EstimateUndelegation predicts the withdrawals undelegating a staker will queue, with their
+// roots and completion blocks, so they can be shown before the staker signs.
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/addresses"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IStrategy"
+)
+
+// DefaultPollInterval is how often CompleteWhenReady checks the chain head, one slot.
+const DefaultPollInterval = 12 * time.Second
+
+// ErrNotPending is returned when a withdrawal is not pending in the DelegationManager, either
+// because it was never queued or because it has already been completed.
+var ErrNotPending = errors.New("withdrawal is not pending")
+
+// ErrRootMismatch is returned when a withdrawal does not hash to the expected withdrawal root.
+var ErrRootMismatch = errors.New("withdrawal root mismatch")
+
+// Backend is the chain access required by Manager.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Root returns the withdrawal root of w, computed identically to
+// DelegationManager.calculateWithdrawalRoot as keccak256(abi.encode(w)).
+func Root(w DelegationManager.IDelegationManagerWithdrawal) (common.Hash, error) {
+	parsed, err := DelegationManager.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	method, ok := parsed.Methods["calculateWithdrawalRoot"]
+	if !ok {
+		return common.Hash{}, errors.New("calculateWithdrawalRoot not found in DelegationManager ABI")
+	}
+	encoded, err := method.Inputs.Pack(w)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode withdrawal: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// VerifyRoot checks that root is the withdrawal root of w. It returns an error wrapping
+// ErrRootMismatch if it is not, which means w differs from the withdrawal that was queued.
+func VerifyRoot(w DelegationManager.IDelegationManagerWithdrawal, root common.Hash) error {
+	computed, err := Root(w)
+	if err != nil {
+		return err
+	}
+	if computed != root {
+		return fmt.Errorf("%w: computed %s, expected %s", ErrRootMismatch, computed.Hex(), root.Hex())
+	}
+	return nil
+}
+
+// VerifyQueuedLog parses a WithdrawalQueued log of the DelegationManager and checks that the
+// root it emitted is the root of the withdrawal it emitted, returning the event.
+func VerifyQueuedLog(log types.Log) (*DelegationManager.DelegationManagerWithdrawalQueued, error) {
+	filterer, err := DelegationManager.NewDelegationManagerFilterer(log.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	event, err := filterer.ParseWithdrawalQueued(log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse WithdrawalQueued log: %w", err)
+	}
+	if err := VerifyRoot(event.Withdrawal, event.WithdrawalRoot); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// Manager queues and completes withdrawals through the DelegationManager, sending
+// transactions with opts.
+type Manager struct {
+	// PollInterval is how often CompleteWhenReady checks the chain head. It defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+
+	backend           Backend
+	opts              *bind.TransactOpts
+	address           common.Address
+	delegationManager *DelegationManager.DelegationManager
+}
+
+// NewManager returns a Manager for the DelegationManager at delegationManager. opts.From is
+// the staker, and the withdrawer of every withdrawal it queues.
+func NewManager(backend Backend, delegationManager common.Address, opts *bind.TransactOpts) (*Manager, error) {
+	dm, err := DelegationManager.NewDelegationManager(delegationManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		PollInterval:      DefaultPollInterval,
+		backend:           backend,
+		opts:              opts,
+		address:           delegationManager,
+		delegationManager: dm,
+	}, nil
+}
+
+// QueuedWithdrawal is a withdrawal that has been queued in the DelegationManager.
+type QueuedWithdrawal struct {
+	Withdrawal DelegationManager.IDelegationManagerWithdrawal
+	Root       common.Hash
+	// ReceiveAsShares makes CompleteWhenReady re-award the withdrawn shares to the withdrawer
+	// instead of withdrawing the underlying tokens.
+	ReceiveAsShares bool
+
+	manager *Manager
+}
+
+// Queue queues a withdrawal of shares from strategies, waits for it to be mined and returns it.
+func (m *Manager) Queue(ctx context.Context, strategies []common.Address, shares []*big.Int) (*QueuedWithdrawal, error) {
+	if len(strategies) != len(shares) {
+		return nil, fmt.Errorf("strategies and shares length mismatch: %d != %d", len(strategies), len(shares))
+	}
+	tx, err := m.delegationManager.QueueWithdrawals(m.txOpts(ctx), []DelegationManager.IDelegationManagerQueuedWithdrawalParams{{
+		Strategies: strategies,
+		Shares:     shares,
+		Withdrawer: m.opts.From,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue withdrawal: %w", err)
+	}
+	receipt, err := m.waitMined(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	queued, err := m.FromReceipt(receipt)
+	if err != nil {
+		return nil, err
+	}
+	if len(queued) != 1 {
+		return nil, fmt.Errorf("expected 1 WithdrawalQueued event in %s, found %d", tx.Hash(), len(queued))
+	}
+	return queued[0], nil
+}
+
+// FromReceipt returns the withdrawals queued in receipt, such as one for queueWithdrawals or undelegate.
+func (m *Manager) FromReceipt(receipt *types.Receipt) ([]*QueuedWithdrawal, error) {
+	var queued []*QueuedWithdrawal
+	for _, log := range receipt.Logs {
+		if log.Address != m.address {
+			continue
+		}
+		event, err := m.delegationManager.ParseWithdrawalQueued(*log)
+		if err != nil {
+			// Not a WithdrawalQueued event.
+			continue
+		}
+		queued = append(queued, &QueuedWithdrawal{
+			Withdrawal: event.Withdrawal,
+			Root:       event.WithdrawalRoot,
+			manager:    m,
+		})
+	}
+	return queued, nil
+}
+
+// Track returns a QueuedWithdrawal for a withdrawal queued elsewhere, such as one read from an indexer.
+func (m *Manager) Track(w DelegationManager.IDelegationManagerWithdrawal) (*QueuedWithdrawal, error) {
+	root, err := Root(w)
+	if err != nil {
+		return nil, err
+	}
+	return &QueuedWithdrawal{Withdrawal: w, Root: root, manager: m}, nil
+}
+
+// IsPending reports whether the withdrawal is queued and not yet completed.
+func (q *QueuedWithdrawal) IsPending(ctx context.Context) (bool, error) {
+	return q.manager.delegationManager.PendingWithdrawals(&bind.CallOpts{Context: ctx}, q.Root)
+}
+
+// DelayBlocks returns the number of blocks that must pass after the withdrawal's start block
+// before every strategy in it can be completed.
+func (q *QueuedWithdrawal) DelayBlocks(ctx context.Context) (uint64, error) {
+	delay, err := q.manager.delegationManager.GetWithdrawalDelay(&bind.CallOpts{Context: ctx}, q.Withdrawal.Strategies)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch withdrawal delay: %w", err)
+	}
+	if !delay.IsUint64() {
+		return 0, fmt.Errorf("withdrawal delay %s overflows uint64", delay)
+	}
+	return delay.Uint64(), nil
+}
+
+// CompletableAt returns the first block in which the withdrawal can be completed.
+func (q *QueuedWithdrawal) CompletableAt(ctx context.Context) (uint64, error) {
+	delay, err := q.DelayBlocks(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(q.Withdrawal.StartBlock) + delay, nil
+}
+
+// CompleteWhenReady waits until the withdrawal delay has elapsed, then completes the withdrawal
+// and waits for the transaction to be mined. It returns ErrNotPending if the withdrawal is not
+// pending, and stops waiting when ctx is done.
+func (q *QueuedWithdrawal) CompleteWhenReady(ctx context.Context) (*types.Receipt, error) {
+	m := q.manager
+	if m.opts.From != q.Withdrawal.Withdrawer {
+		return nil, fmt.Errorf("only the withdrawer %s can complete withdrawal %s", q.Withdrawal.Withdrawer, q.Root)
+	}
+
+	pending, err := q.IsPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending status: %w", err)
+	}
+	if !pending {
+		return nil, fmt.Errorf("%w: %s", ErrNotPending, q.Root)
+	}
+
+	completableAt, err := q.CompletableAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.waitForBlock(ctx, completableAt); err != nil {
+		return nil, err
+	}
+
+	tokens, err := q.tokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// middlewareTimesIndex is unused by the DelegationManager
+	tx, err := m.delegationManager.CompleteQueuedWithdrawal(m.txOpts(ctx), q.Withdrawal, tokens, big.NewInt(0), !q.ReceiveAsShares)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete withdrawal %s: %w", q.Root, err)
+	}
+	return m.waitMined(ctx, tx)
+}
+
+// tokens returns the underlying token of each strategy in the withdrawal. The beacon chain ETH
+// strategy has no token and is given the zero address.
+func (q *QueuedWithdrawal) tokens(ctx context.Context) ([]common.Address, error) {
+	tokens := make([]common.Address, len(q.Withdrawal.Strategies))
+	if q.ReceiveAsShares {
+		return tokens, nil
+	}
+	for i, strategy := range q.Withdrawal.Strategies {
+		if strategy == addresses.BeaconChainETHStrategy {
+			continue
+		}
+		caller, err := IStrategy.NewIStrategyCaller(strategy, q.manager.backend)
+		if err != nil {
+			return nil, err
+		}
+		if tokens[i], err = caller.UnderlyingToken(&bind.CallOpts{Context: ctx}); err != nil {
+			return nil, fmt.Errorf("failed to fetch underlying token of %s: %w", strategy, err)
+		}
+	}
+	return tokens, nil
+}
+
+// waitForBlock polls the chain head until it reaches blockNumber.
+func (m *Manager) waitForBlock(ctx context.Context, blockNumber uint64) error {
+	interval := m.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		head, err := m.backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block number: %w", err)
+		}
+		if head >= blockNumber {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) txOpts(ctx context.Context) *bind.TransactOpts {
+	opts := *m.opts
+	opts.Context = ctx
+	return &opts
+}
+
+func (m *Manager) waitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, m.backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
@@ -137,6 +137,7 @@ contract StrategyManager is
 
     /// @inheritdoc IStrategyManager
     function removeShares(address staker, IStrategy strategy, uint256 shares) external onlyDelegationManager {
+        _requireSharesNotFrozen(staker, strategy);
         _removeShares(staker, strategy, shares);
     }
 
@@ -275,6 +276,27 @@ contract StrategyManager is
         return shares;
     }
 
+    /**
+     * @notice Reverts if `strategy` reports that `staker`'s shares are frozen, as `StrategyBaseTVLLimits.freezeShares` does.
+     * @dev Checked whenever shares leave a staker, i.e. when the DelegationManager queues a withdrawal or undelegates the staker,
+     * so that frozen shares can neither be withdrawn nor moved to another withdrawer or operator.
+     * This is checked here rather than in the strategy, since only the StrategyManager knows whose shares are being removed.
+     * Strategies that do not implement `isFrozen(address)` revert without data and never freeze shares; any other revert
+     * is not taken to mean that the shares are unfrozen.
+     */
+    function _requireSharesNotFrozen(address staker, IStrategy strategy) internal view {
+        (bool success, bytes memory returnData) =
+            address(strategy).staticcall(abi.encodeWithSignature("isFrozen(address)", staker));
+        if (!success) {
+            require(returnData.length == 0, "StrategyManager._requireSharesNotFrozen: isFrozen reverted");
+            return;
+        }
+        require(
+            returnData.length != 32 || !abi.decode(returnData, (bool)),
+            "StrategyManager._requireSharesNotFrozen: staker shares are frozen"
+        );
+    }
+
     /**
      * @notice Decreases the shares that `staker` holds in `strategy` by `shareAmount`.
      * @param staker The address to decrement shares from
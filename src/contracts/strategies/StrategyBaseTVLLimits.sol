@@ -17,12 +17,48 @@ contract StrategyBaseTVLLimits is StrategyBase {
     /// The maximum deposits (in underlyingToken) that this strategy will hold
     uint256 public maxTotalDeposits;
 
+    /// The address permitted to veto a cap increase within `vetoWindow` of it being made
+    address public capVeto;
+
+    /// The amount of time (in seconds) after a cap increase during which `capVeto` may revert it
+    uint256 public vetoWindow;
+
+    /// The value of `maxPerDeposit` prior to the most recent cap increase
+    uint256 public previousMaxPerDeposit;
+
+    /// The value of `maxTotalDeposits` prior to the most recent cap increase
+    uint256 public previousMaxTotalDeposits;
+
+    /// The timestamp of the most recent cap increase, or zero if there is no pending increase that can be vetoed
+    uint256 public capChangeTimestamp;
+
+    /// Mapping: user => whether the user's shares are frozen, preventing them from being withdrawn from this strategy
+    mapping(address => bool) public isFrozen;
+
     /// @notice Emitted when `maxPerDeposit` value is updated from `previousValue` to `newValue`
     event MaxPerDepositUpdated(uint256 previousValue, uint256 newValue);
 
     /// @notice Emitted when `maxTotalDeposits` value is updated from `previousValue` to `newValue`
     event MaxTotalDepositsUpdated(uint256 previousValue, uint256 newValue);
 
+    /// @notice Emitted when the `capVeto` address is updated from `previousCapVeto` to `newCapVeto`, with a `vetoWindow` of `newVetoWindow`
+    event CapVetoSet(address previousCapVeto, address newCapVeto, uint256 newVetoWindow);
+
+    /// @notice Emitted when `capVeto` reverts a cap increase, restoring `maxPerDeposit` and `maxTotalDeposits` to their prior values
+    event CapChangeVetoed(uint256 restoredMaxPerDeposit, uint256 restoredMaxTotalDeposits);
+
+    /// @notice Emitted when the shares of `user` are frozen
+    event SharesFrozen(address indexed user);
+
+    /// @notice Emitted when the shares of `user` are unfrozen
+    event SharesUnfrozen(address indexed user);
+
+    /// @notice Simply checks that the `msg.sender` is the `capVeto` address
+    modifier onlyCapVeto() {
+        require(msg.sender == capVeto, "StrategyBaseTVLLimits.onlyCapVeto: not capVeto");
+        _;
+    }
+
     // solhint-disable-next-line no-empty-blocks
     constructor(IStrategyManager _strategyManager) StrategyBase(_strategyManager) {}
 
@@ -42,16 +78,115 @@ contract StrategyBaseTVLLimits is StrategyBase {
      * @dev Callable only by the unpauser of this contract
      * @dev We note that there is a potential race condition between a call to this function that lowers either or both of these limits and call(s)
      * to `deposit`, that may result in some calls to `deposit` reverting.
+     * @dev If either limit is increased, the prior limits are recorded so that `capVeto` may restore them within `vetoWindow`.
+     * The prior limits are only recorded when no veto window is open, so that several increases within one window are vetoed
+     * back to the limits from before the first of them, and each increase restarts the window.
+     * @dev A decrease within an open veto window leaves the pending veto in place, unless it lowers both limits to or below
+     * the recorded prior limits.
      */
     function setTVLLimits(uint256 newMaxPerDeposit, uint256 newMaxTotalDeposits) external onlyUnpauser {
+        bool vetoWindowOpen = capChangeTimestamp != 0 && block.timestamp <= capChangeTimestamp + vetoWindow;
+        if (newMaxPerDeposit > maxPerDeposit || newMaxTotalDeposits > maxTotalDeposits) {
+            if (!vetoWindowOpen) {
+                previousMaxPerDeposit = maxPerDeposit;
+                previousMaxTotalDeposits = maxTotalDeposits;
+            }
+            capChangeTimestamp = block.timestamp;
+        } else if (
+            !vetoWindowOpen ||
+            (newMaxPerDeposit <= previousMaxPerDeposit && newMaxTotalDeposits <= previousMaxTotalDeposits)
+        ) {
+            capChangeTimestamp = 0;
+        }
         _setTVLLimits(newMaxPerDeposit, newMaxTotalDeposits);
     }
 
+    /**
+     * @notice Sets the address permitted to veto cap increases, and the window after an increase during which it may do so
+     * @param newCapVeto The new veto address. Setting this to the zero address disables vetoes.
+     * @param newVetoWindow The new veto window, in seconds
+     * @dev Callable only by the unpauser of this contract
+     */
+    function setCapVeto(address newCapVeto, uint256 newVetoWindow) external onlyUnpauser {
+        emit CapVetoSet(capVeto, newCapVeto, newVetoWindow);
+        capVeto = newCapVeto;
+        vetoWindow = newVetoWindow;
+    }
+
+    /**
+     * @notice Reverts the most recent cap increase, restoring `maxPerDeposit` and `maxTotalDeposits` to their prior values
+     * @dev Callable only by `capVeto`, and only within `vetoWindow` seconds of the increase
+     */
+    function vetoCapChange() external onlyCapVeto {
+        require(capChangeTimestamp != 0, "StrategyBaseTVLLimits.vetoCapChange: no cap increase to veto");
+        require(
+            block.timestamp <= capChangeTimestamp + vetoWindow,
+            "StrategyBaseTVLLimits.vetoCapChange: veto window has passed"
+        );
+        capChangeTimestamp = 0;
+        emit CapChangeVetoed(previousMaxPerDeposit, previousMaxTotalDeposits);
+        _setTVLLimits(previousMaxPerDeposit, previousMaxTotalDeposits);
+    }
+
     /// @notice Simple getter function that returns the current values of `maxPerDeposit` and `maxTotalDeposits`.
     function getTVLLimits() external view returns (uint256, uint256) {
         return (maxPerDeposit, maxTotalDeposits);
     }
 
+    /**
+     * @notice Freezes the shares of `user`, preventing them from being withdrawn from this strategy while leaving
+     * all other users' positions operational. Intended for use in response to legal requirements such as a court order.
+     * @dev Callable only by the unpauser of this contract, which is expected to be governance acting through a timelock.
+     * @dev The freeze is enforced by the StrategyManager, which refuses to remove a frozen user's shares. This blocks queueing
+     * a withdrawal of them (whether it is later completed as tokens or as shares) as well as undelegating the user. It is keyed
+     * on the owner of the shares rather than the recipient of a withdrawal, which this strategy does not know. Withdrawals
+     * queued before the freeze no longer hold the user's shares and complete as usual.
+     */
+    function freezeShares(address user) external onlyUnpauser {
+        require(!isFrozen[user], "StrategyBaseTVLLimits.freezeShares: user shares already frozen");
+        isFrozen[user] = true;
+        emit SharesFrozen(user);
+    }
+
+    /**
+     * @notice Unfreezes the shares of `user`, allowing them to be withdrawn from this strategy again
+     * @dev Callable only by the unpauser of this contract
+     */
+    function unfreezeShares(address user) external onlyUnpauser {
+        require(isFrozen[user], "StrategyBaseTVLLimits.unfreezeShares: user shares not frozen");
+        isFrozen[user] = false;
+        emit SharesUnfrozen(user);
+    }
+
+    /// @notice Returns the number of `user`'s shares that are frozen in this strategy, which is either all or none of them
+    function frozenShares(address user) external view returns (uint256) {
+        return isFrozen[user] ? shares(user) : 0;
+    }
+
+    /**
+     * @notice Reverts if any of this strategy's core invariants is violated, and returns silently otherwise.
+     * The revert reason identifies the violated invariant, allowing keepers to cheaply assert health and alert on a revert.
+     * @dev Checks, in order, that:
+     * a) `maxPerDeposit` does not exceed `maxTotalDeposits` when both are nonzero
+     * b) the underlying amount implied by `totalShares` does not exceed this contract's balance of `underlyingToken`
+     * c) no pause bits are set above `PAUSED_WITHDRAWALS`, unless the contract is fully paused
+     */
+    function checkInvariants() external view {
+        require(
+            maxPerDeposit == 0 || maxTotalDeposits == 0 || maxPerDeposit <= maxTotalDeposits,
+            "StrategyBaseTVLLimits.checkInvariants: maxPerDeposit exceeds maxTotalDeposits"
+        );
+        require(
+            sharesToUnderlyingView(totalShares) <= _tokenBalance(),
+            "StrategyBaseTVLLimits.checkInvariants: totalShares exceeds token balance"
+        );
+        uint256 pausedStatus = paused();
+        require(
+            pausedStatus == PAUSE_ALL || pausedStatus >> (PAUSED_WITHDRAWALS + 1) == 0,
+            "StrategyBaseTVLLimits.checkInvariants: paused bit exceeds max pause bit"
+        );
+    }
+
     /// @notice Internal setter for TVL limits
     function _setTVLLimits(uint256 newMaxPerDeposit, uint256 newMaxTotalDeposits) internal {
         emit MaxPerDepositUpdated(maxPerDeposit, newMaxPerDeposit);
@@ -88,5 +223,5 @@ contract StrategyBaseTVLLimits is StrategyBase {
      * variables without shifting down storage in the inheritance chain.
      * See https://docs.openzeppelin.com/contracts/4.x/upgradeable#storage_gaps
      */
-    uint256[48] private __gap;
+    uint256[42] private __gap;
 }
@@ -19,6 +19,18 @@ contract StrategyBaseTVLLimitsUnitTests is StrategyBaseUnitTests {
     /// @notice Emitted when `maxTotalDeposits` value is updated from `previousValue` to `newValue`
     event MaxTotalDepositsUpdated(uint256 previousValue, uint256 newValue);
 
+    /// @notice Emitted when `capVeto` reverts a cap increase, restoring `maxPerDeposit` and `maxTotalDeposits` to their prior values
+    event CapChangeVetoed(uint256 restoredMaxPerDeposit, uint256 restoredMaxTotalDeposits);
+
+    /// @notice Emitted when the shares of `user` are frozen
+    event SharesFrozen(address indexed user);
+
+    /// @notice Emitted when the shares of `user` are unfrozen
+    event SharesUnfrozen(address indexed user);
+
+    address public capVeto = address(4444);
+    uint256 public vetoWindow = 1 days;
+
     function setUp() virtual public override {
         // copy setup for StrategyBaseUnitTests
         StrategyBaseUnitTests.setUp();
@@ -224,6 +236,245 @@ contract StrategyBaseTVLLimitsUnitTests is StrategyBaseUnitTests {
         }
     }
 
+    function testVetoCapChangeWithinWindowRestoresPriorLimits(uint256 timeElapsed) public {
+        cheats.assume(timeElapsed <= vetoWindow);
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.stopPrank();
+
+        cheats.warp(block.timestamp + timeElapsed);
+
+        cheats.startPrank(capVeto);
+        cheats.expectEmit(true, true, true, true, address(strategyWithTVLLimits));
+        emit CapChangeVetoed(maxPerDeposit, maxTotalDeposits);
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+
+        (uint256 _maxPerDeposit, uint256 _maxTotalDeposits) = strategyWithTVLLimits.getTVLLimits();
+        assertEq(_maxPerDeposit, maxPerDeposit, "maxPerDeposit not restored");
+        assertEq(_maxTotalDeposits, maxTotalDeposits, "maxTotalDeposits not restored");
+        assertEq(strategyWithTVLLimits.capChangeTimestamp(), 0, "capChangeTimestamp not cleared");
+    }
+
+    function testVetoCapChangeAfterTwoIncreasesRestoresOriginalLimits(uint256 timeElapsed) public {
+        cheats.assume(timeElapsed <= vetoWindow);
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.warp(block.timestamp + timeElapsed);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 4, maxTotalDeposits * 4);
+        cheats.stopPrank();
+
+        assertEq(strategyWithTVLLimits.previousMaxPerDeposit(), maxPerDeposit, "previousMaxPerDeposit overwritten");
+        assertEq(strategyWithTVLLimits.previousMaxTotalDeposits(), maxTotalDeposits, "previousMaxTotalDeposits overwritten");
+        assertEq(strategyWithTVLLimits.capChangeTimestamp(), block.timestamp, "veto window not restarted");
+
+        cheats.warp(block.timestamp + vetoWindow);
+
+        cheats.startPrank(capVeto);
+        cheats.expectEmit(true, true, true, true, address(strategyWithTVLLimits));
+        emit CapChangeVetoed(maxPerDeposit, maxTotalDeposits);
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+
+        (uint256 _maxPerDeposit, uint256 _maxTotalDeposits) = strategyWithTVLLimits.getTVLLimits();
+        assertEq(_maxPerDeposit, maxPerDeposit, "maxPerDeposit not restored");
+        assertEq(_maxTotalDeposits, maxTotalDeposits, "maxTotalDeposits not restored");
+    }
+
+    function testIncreaseAfterWindowRecordsNewPriorLimits() public {
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.warp(block.timestamp + vetoWindow + 1);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 4, maxTotalDeposits * 4);
+        cheats.stopPrank();
+
+        assertEq(strategyWithTVLLimits.previousMaxPerDeposit(), maxPerDeposit * 2, "previousMaxPerDeposit not recorded");
+        assertEq(strategyWithTVLLimits.previousMaxTotalDeposits(), maxTotalDeposits * 2, "previousMaxTotalDeposits not recorded");
+    }
+
+    function testDecreaseWithinWindowKeepsPendingVeto() public {
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 4, maxTotalDeposits * 4);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.stopPrank();
+
+        cheats.startPrank(capVeto);
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+
+        (uint256 _maxPerDeposit, uint256 _maxTotalDeposits) = strategyWithTVLLimits.getTVLLimits();
+        assertEq(_maxPerDeposit, maxPerDeposit, "maxPerDeposit not restored");
+        assertEq(_maxTotalDeposits, maxTotalDeposits, "maxTotalDeposits not restored");
+    }
+
+    function testVetoCapChangeFailsAfterWindow(uint256 timeElapsed) public {
+        cheats.assume(timeElapsed > vetoWindow && timeElapsed < type(uint64).max);
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.stopPrank();
+
+        cheats.warp(block.timestamp + timeElapsed);
+
+        cheats.startPrank(capVeto);
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.vetoCapChange: veto window has passed"));
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+    }
+
+    function testVetoCapChangeFailsWithoutCapIncrease() public {
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit / 2, maxTotalDeposits / 2);
+        cheats.stopPrank();
+
+        cheats.startPrank(capVeto);
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.vetoCapChange: no cap increase to veto"));
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+    }
+
+    function testVetoCapChangeFailsWhenNotCalledByCapVeto(address notCapVeto) public {
+        cheats.assume(notCapVeto != capVeto && notCapVeto != address(proxyAdmin));
+        _setCapVeto();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setTVLLimits(maxPerDeposit * 2, maxTotalDeposits * 2);
+        cheats.stopPrank();
+
+        cheats.startPrank(notCapVeto);
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.onlyCapVeto: not capVeto"));
+        strategyWithTVLLimits.vetoCapChange();
+        cheats.stopPrank();
+    }
+
+    function testSetCapVetoFailsWhenNotCalledByUnpauser(address notUnpauser) public {
+        cheats.assume(notUnpauser != address(proxyAdmin));
+        cheats.assume(notUnpauser != unpauser);
+        cheats.startPrank(notUnpauser);
+        cheats.expectRevert(bytes("msg.sender is not permissioned as unpauser"));
+        strategyWithTVLLimits.setCapVeto(capVeto, vetoWindow);
+        cheats.stopPrank();
+    }
+
+    function testCheckInvariantsPassesAfterSetup() public view {
+        strategyWithTVLLimits.checkInvariants();
+    }
+
+    function testCheckInvariantsPassesWhenFullyPaused() public {
+        cheats.startPrank(pauser);
+        strategyWithTVLLimits.pauseAll();
+        cheats.stopPrank();
+
+        strategyWithTVLLimits.checkInvariants();
+    }
+
+    function testCheckInvariantsRevertsWhenMaxPerDepositExceedsMaxTotalDeposits() public {
+        // `_setTVLLimits` prevents this state, so write the storage slots for `maxPerDeposit` and `maxTotalDeposits` directly
+        cheats.store(address(strategyWithTVLLimits), bytes32(uint256(100)), bytes32(uint256(2e18)));
+        cheats.store(address(strategyWithTVLLimits), bytes32(uint256(101)), bytes32(uint256(1e18)));
+
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.checkInvariants: maxPerDeposit exceeds maxTotalDeposits"));
+        strategyWithTVLLimits.checkInvariants();
+    }
+
+    function testCheckInvariantsRevertsWhenTotalSharesExceedsTokenBalance(uint96 totalShares) public {
+        cheats.assume(totalShares > 0);
+        // write the storage slot for `totalShares` directly, leaving the strategy with no backing tokens
+        cheats.store(address(strategyWithTVLLimits), bytes32(uint256(51)), bytes32(uint256(totalShares) * 1e3));
+        require(underlyingToken.balanceOf(address(strategyWithTVLLimits)) == 0, "bad test setup");
+
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.checkInvariants: totalShares exceeds token balance"));
+        strategyWithTVLLimits.checkInvariants();
+    }
+
+    function testCheckInvariantsRevertsWhenPausedBitExceedsMaxPauseBit(uint8 index) public {
+        cheats.assume(index > 1);
+        cheats.startPrank(pauser);
+        strategyWithTVLLimits.pause(1 << index);
+        cheats.stopPrank();
+
+        cheats.expectRevert(bytes("StrategyBaseTVLLimits.checkInvariants: paused bit exceeds max pause bit"));
+        strategyWithTVLLimits.checkInvariants();
+    }
+
+    /// @notice The freeze is keyed on the owner of the shares and enforced by the StrategyManager, not on the recipient of `withdraw`
+    function testFreezeSharesDoesNotBlockRecipient(address frozenUser) public {
+        cheats.assume(frozenUser != address(0) && frozenUser != address(strategyWithTVLLimits));
+        uint256 depositAmount = maxPerDeposit;
+
+        underlyingToken.transfer(address(strategyWithTVLLimits), depositAmount);
+        cheats.startPrank(address(strategyManager));
+        uint256 newShares = strategyWithTVLLimits.deposit(underlyingToken, depositAmount);
+        cheats.stopPrank();
+
+        cheats.startPrank(unpauser);
+        cheats.expectEmit(true, true, true, true, address(strategyWithTVLLimits));
+        emit SharesFrozen(frozenUser);
+        strategyWithTVLLimits.freezeShares(frozenUser);
+        cheats.stopPrank();
+        require(strategyWithTVLLimits.isFrozen(frozenUser), "user not frozen");
+
+        uint256 balanceBefore = underlyingToken.balanceOf(frozenUser);
+        cheats.startPrank(address(strategyManager));
+        strategyWithTVLLimits.withdraw(frozenUser, underlyingToken, newShares);
+        cheats.stopPrank();
+        assertGt(underlyingToken.balanceOf(frozenUser), balanceBefore, "recipient could not withdraw");
+    }
+
+    function testUnfrozenUserCanWithdraw(address user) public {
+        cheats.assume(user != address(0) && user != address(strategyWithTVLLimits));
+        uint256 depositAmount = maxPerDeposit;
+
+        underlyingToken.transfer(address(strategyWithTVLLimits), depositAmount);
+        cheats.startPrank(address(strategyManager));
+        uint256 newShares = strategyWithTVLLimits.deposit(underlyingToken, depositAmount);
+        cheats.stopPrank();
+
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.freezeShares(user);
+        cheats.expectEmit(true, true, true, true, address(strategyWithTVLLimits));
+        emit SharesUnfrozen(user);
+        strategyWithTVLLimits.unfreezeShares(user);
+        cheats.stopPrank();
+        require(!strategyWithTVLLimits.isFrozen(user), "user still frozen");
+        assertEq(strategyWithTVLLimits.frozenShares(user), 0, "frozen shares not cleared");
+
+        uint256 balanceBefore = underlyingToken.balanceOf(user);
+        cheats.startPrank(address(strategyManager));
+        strategyWithTVLLimits.withdraw(user, underlyingToken, newShares);
+        cheats.stopPrank();
+        assertGt(underlyingToken.balanceOf(user), balanceBefore, "user could not withdraw");
+    }
+
+    function testFreezeSharesFailsWhenNotCalledByUnpauser(address notUnpauser, address user) public {
+        cheats.assume(notUnpauser != address(proxyAdmin));
+        cheats.assume(notUnpauser != unpauser);
+        cheats.startPrank(notUnpauser);
+        cheats.expectRevert(bytes("msg.sender is not permissioned as unpauser"));
+        strategyWithTVLLimits.freezeShares(user);
+        cheats.stopPrank();
+    }
+
+    // sets the `capVeto` address and `vetoWindow` to the test defaults
+    function _setCapVeto() internal {
+        cheats.startPrank(unpauser);
+        strategyWithTVLLimits.setCapVeto(capVeto, vetoWindow);
+        cheats.stopPrank();
+        require(strategyWithTVLLimits.capVeto() == capVeto, "bad test setup");
+        require(strategyWithTVLLimits.vetoWindow() == vetoWindow, "bad test setup");
+    }
+
     // sets the TVL Limits and checks that events were emitted correctly
     function _setTVLLimits(uint256 _maxPerDeposit, uint256 _maxTotalDeposits) internal {
         cheats.assume(_maxPerDeposit < _maxTotalDeposits);
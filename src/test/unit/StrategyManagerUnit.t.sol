@@ -4,6 +4,7 @@ pragma solidity ^0.8.12;
 import "@openzeppelin/contracts/mocks/ERC1271WalletMock.sol";
 import "src/contracts/core/StrategyManager.sol";
 import "src/contracts/strategies/StrategyBase.sol";
+import "src/contracts/strategies/StrategyBaseTVLLimits.sol";
 import "src/contracts/permissions/PauserRegistry.sol";
 import "src/test/mocks/ERC20Mock.sol";
 import "src/test/mocks/ERC20_SetTransferReverting_Mock.sol";
@@ -1329,3 +1330,109 @@ contract StrategyManagerUnitTests_removeStrategiesFromDepositWhitelist is Strate
         }
     }
 }
+
+contract StrategyManagerUnitTests_frozenShares is StrategyManagerUnitTests {
+    StrategyBaseTVLLimits public freezableStrat;
+
+    address staker = address(this);
+    address otherStaker = address(uint160(uint256(keccak256("OtherStaker"))));
+    uint256 depositAmount = 1e18;
+
+    function setUp() public override {
+        StrategyManagerUnitTests.setUp();
+
+        StrategyBaseTVLLimits freezableStratImplementation = new StrategyBaseTVLLimits(strategyManager);
+        freezableStrat = StrategyBaseTVLLimits(
+            address(
+                new TransparentUpgradeableProxy(
+                    address(freezableStratImplementation),
+                    address(dummyAdmin),
+                    abi.encodeWithSelector(
+                        StrategyBaseTVLLimits.initialize.selector,
+                        type(uint256).max,
+                        type(uint256).max,
+                        dummyToken,
+                        pauserRegistry
+                    )
+                )
+            )
+        );
+
+        IStrategy[] memory _strategies = new IStrategy[](1);
+        _strategies[0] = freezableStrat;
+        bool[] memory _thirdPartyTransfersForbiddenValues = new bool[](1);
+        cheats.prank(strategyManager.strategyWhitelister());
+        strategyManager.addStrategiesToDepositWhitelist(_strategies, _thirdPartyTransfersForbiddenValues);
+
+        _depositIntoStrategySuccessfully(freezableStrat, staker, depositAmount);
+        _depositIntoStrategySuccessfully(freezableStrat, otherStaker, depositAmount);
+
+        cheats.prank(unpauser);
+        freezableStrat.freezeShares(staker);
+    }
+
+    /**
+     * @notice `DelegationManager.queueWithdrawals` removes the staker's shares when the withdrawal is queued, so a frozen
+     * staker can queue neither a withdrawal completed as tokens nor one completed as shares to re-credit them.
+     */
+    function test_Revert_QueueWithdrawalOfFrozenShares() external {
+        cheats.expectRevert("StrategyManager._requireSharesNotFrozen: staker shares are frozen");
+        delegationManagerMock.removeShares(strategyManager, staker, freezableStrat, depositAmount / 2);
+
+        assertEq(strategyManager.stakerStrategyShares(staker, freezableStrat), depositAmount, "frozen shares removed");
+    }
+
+    /**
+     * @notice `DelegationManager.undelegate` removes all of the staker's shares into a withdrawal, so a frozen staker
+     * cannot be undelegated, and therefore cannot redelegate to another operator.
+     */
+    function test_Revert_UndelegateFrozenStaker() external {
+        cheats.expectRevert("StrategyManager._requireSharesNotFrozen: staker shares are frozen");
+        delegationManagerMock.removeShares(strategyManager, staker, freezableStrat, depositAmount);
+
+        assertEq(strategyManager.stakerStrategyListLength(staker), 1, "frozen strategy removed from staker list");
+    }
+
+    /// @notice Shares that left the staker before the freeze are no longer theirs, so a withdrawal of them completes
+    function test_WithdrawSharesAsTokensQueuedBeforeFreeze() external {
+        uint256 balanceBefore = dummyToken.balanceOf(staker);
+        delegationManagerMock.withdrawSharesAsTokens(strategyManager, staker, freezableStrat, depositAmount, dummyToken);
+        assertEq(dummyToken.balanceOf(staker), balanceBefore + depositAmount, "tokens not withdrawn");
+    }
+
+    /// @notice A strategy whose `isFrozen` reverts with data is not taken to have unfrozen shares
+    function test_Revert_IsFrozenReverts() external {
+        IStrategy revertingStrat = IStrategy(address(new IsFrozenRevertingStrategy()));
+
+        cheats.expectRevert("StrategyManager._requireSharesNotFrozen: isFrozen reverted");
+        delegationManagerMock.removeShares(strategyManager, otherStaker, revertingStrat, depositAmount);
+    }
+
+    function test_RemoveSharesOfOtherStaker() external {
+        delegationManagerMock.removeShares(strategyManager, otherStaker, freezableStrat, depositAmount);
+        assertEq(strategyManager.stakerStrategyShares(otherStaker, freezableStrat), 0, "shares not removed");
+    }
+
+    function test_RemoveSharesAfterUnfreeze() external {
+        cheats.prank(unpauser);
+        freezableStrat.unfreezeShares(staker);
+
+        delegationManagerMock.removeShares(strategyManager, staker, freezableStrat, depositAmount);
+        assertEq(strategyManager.stakerStrategyShares(staker, freezableStrat), 0, "shares not removed");
+    }
+
+    /// @notice Strategies without `isFrozen` never freeze shares
+    function test_RemoveSharesFromStrategyWithoutFreeze() external {
+        _depositIntoStrategySuccessfully(dummyStrat, staker, depositAmount);
+
+        delegationManagerMock.removeShares(strategyManager, staker, dummyStrat, depositAmount);
+        assertEq(strategyManager.stakerStrategyShares(staker, dummyStrat), 0, "shares not removed");
+    }
+}
+
+/// @notice A strategy stub whose `isFrozen` reverts with a reason
+contract IsFrozenRevertingStrategy {
+    function isFrozen(address) external pure returns (bool) {
+        revert("IsFrozenRevertingStrategy: not available");
+    }
+}